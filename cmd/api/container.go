@@ -1,21 +1,44 @@
 package main
 
 import (
+	"fmt"
 	http "net/http"
 
 	configs "github.com/your-org/jvairv2/configs"
 	commonAuth "github.com/your-org/jvairv2/pkg/common/auth"
+	commonMail "github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+	commonStorage "github.com/your-org/jvairv2/pkg/common/storage"
 	ability "github.com/your-org/jvairv2/pkg/domain/ability"
+	domainAlert "github.com/your-org/jvairv2/pkg/domain/alert"
 	assignedRole "github.com/your-org/jvairv2/pkg/domain/assigned_role"
 	domainAuth "github.com/your-org/jvairv2/pkg/domain/auth"
+	domainAuthorization "github.com/your-org/jvairv2/pkg/domain/authorization"
 	customer "github.com/your-org/jvairv2/pkg/domain/customer"
+	domainEmailTemplate "github.com/your-org/jvairv2/pkg/domain/email_template"
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
 	domainInvoice "github.com/your-org/jvairv2/pkg/domain/invoice"
 	domainInvoicePayment "github.com/your-org/jvairv2/pkg/domain/invoice_payment"
 	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainJobActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
 	jobCategory "github.com/your-org/jvairv2/pkg/domain/job_category"
+	domainJobEmail "github.com/your-org/jvairv2/pkg/domain/job_email"
 	domainJobEquip "github.com/your-org/jvairv2/pkg/domain/job_equipment"
+	domainJobHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+	domainJobPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
 	jobPriority "github.com/your-org/jvairv2/pkg/domain/job_priority"
+	domainJobRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+	domainJobRateStatus "github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+	domainJobResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+	domainJobSMS "github.com/your-org/jvairv2/pkg/domain/job_sms"
 	jobStatus "github.com/your-org/jvairv2/pkg/domain/job_status"
+	domainJobTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	domainJobVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+	domainPasswordPolicy "github.com/your-org/jvairv2/pkg/domain/password_policy"
+	domainPasswordReset "github.com/your-org/jvairv2/pkg/domain/password_reset"
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
 	permission "github.com/your-org/jvairv2/pkg/domain/permission"
 	property "github.com/your-org/jvairv2/pkg/domain/property"
 	domainPropEquip "github.com/your-org/jvairv2/pkg/domain/property_equipment"
@@ -23,57 +46,117 @@ import (
 	quoteStatus "github.com/your-org/jvairv2/pkg/domain/quote_status"
 	role "github.com/your-org/jvairv2/pkg/domain/role"
 	settings "github.com/your-org/jvairv2/pkg/domain/settings"
+	domainSMSTemplate "github.com/your-org/jvairv2/pkg/domain/sms_template"
 	domainSupervisor "github.com/your-org/jvairv2/pkg/domain/supervisor"
 	taskStatus "github.com/your-org/jvairv2/pkg/domain/task_status"
 	techJobStatus "github.com/your-org/jvairv2/pkg/domain/technician_job_status"
 	user "github.com/your-org/jvairv2/pkg/domain/user"
+	domainWarranty "github.com/your-org/jvairv2/pkg/domain/warranty"
+	domainWarrantyClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+	domainWarrantyClaimStatus "github.com/your-org/jvairv2/pkg/domain/warranty_claim_status"
+	domainWarrantyClaimType "github.com/your-org/jvairv2/pkg/domain/warranty_claim_type"
+	domainWarrantyEquip "github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+	domainWarrantyStatus "github.com/your-org/jvairv2/pkg/domain/warranty_status"
+	domainWarrantyType "github.com/your-org/jvairv2/pkg/domain/warranty_type"
 	workflow "github.com/your-org/jvairv2/pkg/domain/workflow"
 	mysql "github.com/your-org/jvairv2/pkg/repository/mysql"
 	mysqlAbility "github.com/your-org/jvairv2/pkg/repository/mysql/ability"
+	mysqlAlert "github.com/your-org/jvairv2/pkg/repository/mysql/alert"
 	mysqlAssignedRole "github.com/your-org/jvairv2/pkg/repository/mysql/assigned_role"
+	mysqlAuthorization "github.com/your-org/jvairv2/pkg/repository/mysql/authorization"
 	mysqlCustomer "github.com/your-org/jvairv2/pkg/repository/mysql/customer"
+	mysqlEmailTemplate "github.com/your-org/jvairv2/pkg/repository/mysql/email_template"
+	mysqlFile "github.com/your-org/jvairv2/pkg/repository/mysql/file"
 	mysqlInvoice "github.com/your-org/jvairv2/pkg/repository/mysql/invoice"
 	mysqlInvoicePayment "github.com/your-org/jvairv2/pkg/repository/mysql/invoice_payment"
 	mysqlJob "github.com/your-org/jvairv2/pkg/repository/mysql/job"
+	mysqlJobActivity "github.com/your-org/jvairv2/pkg/repository/mysql/job_activity_log"
 	mysqlJobCategory "github.com/your-org/jvairv2/pkg/repository/mysql/job_category"
+	mysqlJobEmail "github.com/your-org/jvairv2/pkg/repository/mysql/job_email"
 	mysqlJobEquip "github.com/your-org/jvairv2/pkg/repository/mysql/job_equipment"
+	mysqlJobHistory "github.com/your-org/jvairv2/pkg/repository/mysql/job_history"
 	mysqlJobPriority "github.com/your-org/jvairv2/pkg/repository/mysql/job_priority"
+	mysqlJobRate "github.com/your-org/jvairv2/pkg/repository/mysql/job_rate"
+	mysqlJobRateStatus "github.com/your-org/jvairv2/pkg/repository/mysql/job_rate_status"
+	mysqlJobResident "github.com/your-org/jvairv2/pkg/repository/mysql/job_resident"
+	mysqlJobSMS "github.com/your-org/jvairv2/pkg/repository/mysql/job_sms"
 	mysqlJobStatus "github.com/your-org/jvairv2/pkg/repository/mysql/job_status"
+	mysqlJobTask "github.com/your-org/jvairv2/pkg/repository/mysql/job_task"
+	mysqlJobVisit "github.com/your-org/jvairv2/pkg/repository/mysql/job_visit"
+	mysqlOutbox "github.com/your-org/jvairv2/pkg/repository/mysql/outbox"
+	mysqlPasswordPolicy "github.com/your-org/jvairv2/pkg/repository/mysql/password_policy"
+	mysqlPasswordReset "github.com/your-org/jvairv2/pkg/repository/mysql/password_reset"
+	mysqlPayroll "github.com/your-org/jvairv2/pkg/repository/mysql/payroll"
 	mysqlPermission "github.com/your-org/jvairv2/pkg/repository/mysql/permission"
 	mysqlProperty "github.com/your-org/jvairv2/pkg/repository/mysql/property"
 	mysqlPropEquip "github.com/your-org/jvairv2/pkg/repository/mysql/property_equipment"
 	mysqlQuote "github.com/your-org/jvairv2/pkg/repository/mysql/quote"
 	mysqlQuoteStatus "github.com/your-org/jvairv2/pkg/repository/mysql/quote_status"
 	mysqlRole "github.com/your-org/jvairv2/pkg/repository/mysql/role"
+	mysqlSession "github.com/your-org/jvairv2/pkg/repository/mysql/session"
 	mysqlSettings "github.com/your-org/jvairv2/pkg/repository/mysql/settings"
+	mysqlSMSTemplate "github.com/your-org/jvairv2/pkg/repository/mysql/sms_template"
 	mysqlSupervisor "github.com/your-org/jvairv2/pkg/repository/mysql/supervisor"
 	mysqlTaskStatus "github.com/your-org/jvairv2/pkg/repository/mysql/task_status"
 	mysqlTechJobStatus "github.com/your-org/jvairv2/pkg/repository/mysql/technician_job_status"
 	mysqlUser "github.com/your-org/jvairv2/pkg/repository/mysql/user"
+	mysqlWarranty "github.com/your-org/jvairv2/pkg/repository/mysql/warranty"
+	mysqlWarrantyClaim "github.com/your-org/jvairv2/pkg/repository/mysql/warranty_claim"
+	mysqlWarrantyClaimStatus "github.com/your-org/jvairv2/pkg/repository/mysql/warranty_claim_status"
+	mysqlWarrantyClaimType "github.com/your-org/jvairv2/pkg/repository/mysql/warranty_claim_type"
+	mysqlWarrantyEquip "github.com/your-org/jvairv2/pkg/repository/mysql/warranty_equipment"
+	mysqlWarrantyStatus "github.com/your-org/jvairv2/pkg/repository/mysql/warranty_status"
+	mysqlWarrantyType "github.com/your-org/jvairv2/pkg/repository/mysql/warranty_type"
 	mysqlWorkflow "github.com/your-org/jvairv2/pkg/repository/mysql/workflow"
 	handler "github.com/your-org/jvairv2/pkg/rest/handler"
 	abilityHandler "github.com/your-org/jvairv2/pkg/rest/handler/ability"
+	alertHandler "github.com/your-org/jvairv2/pkg/rest/handler/alert"
 	assignedRoleHandler "github.com/your-org/jvairv2/pkg/rest/handler/assigned_role"
 	authHandler "github.com/your-org/jvairv2/pkg/rest/handler/auth"
 	customerHandler "github.com/your-org/jvairv2/pkg/rest/handler/customer"
+	emailTemplateHandler "github.com/your-org/jvairv2/pkg/rest/handler/email_template"
+	eventHandler "github.com/your-org/jvairv2/pkg/rest/handler/event"
+	fileHandler "github.com/your-org/jvairv2/pkg/rest/handler/file"
 	invoiceHandler "github.com/your-org/jvairv2/pkg/rest/handler/invoice"
 	invoicePaymentHandler "github.com/your-org/jvairv2/pkg/rest/handler/invoice_payment"
 	jobHandler "github.com/your-org/jvairv2/pkg/rest/handler/job"
+	jobActivityHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_activity_log"
 	jobCategoryHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_category"
+	jobEmailHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_email"
 	jobEquipHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_equipment"
+	jobHistoryHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_history"
 	jobPriorityHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_priority"
+	jobRateHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_rate"
+	jobRateStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_rate_status"
+	jobResidentHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_resident"
+	jobSMSHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_sms"
 	jobStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_status"
+	jobTaskHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_task"
+	jobVisitHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_visit"
+	outboxHandler "github.com/your-org/jvairv2/pkg/rest/handler/outbox"
+	passwordPolicyHandler "github.com/your-org/jvairv2/pkg/rest/handler/password_policy"
+	passwordResetHandler "github.com/your-org/jvairv2/pkg/rest/handler/password_reset"
+	payrollHandler "github.com/your-org/jvairv2/pkg/rest/handler/payroll"
 	permissionHandler "github.com/your-org/jvairv2/pkg/rest/handler/permission"
 	propertyHandler "github.com/your-org/jvairv2/pkg/rest/handler/property"
 	propEquipHandler "github.com/your-org/jvairv2/pkg/rest/handler/property_equipment"
 	quoteHandler "github.com/your-org/jvairv2/pkg/rest/handler/quote"
 	quoteStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/quote_status"
 	roleHandler "github.com/your-org/jvairv2/pkg/rest/handler/role"
+	routeHandler "github.com/your-org/jvairv2/pkg/rest/handler/route"
 	settingsHandler "github.com/your-org/jvairv2/pkg/rest/handler/settings"
+	smsTemplateHandler "github.com/your-org/jvairv2/pkg/rest/handler/sms_template"
 	supervisorHandler "github.com/your-org/jvairv2/pkg/rest/handler/supervisor"
 	taskStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/task_status"
 	techJobStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/technician_job_status"
 	userHandler "github.com/your-org/jvairv2/pkg/rest/handler/user"
+	warrantyHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty"
+	warrantyClaimHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_claim"
+	warrantyClaimStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_claim_status"
+	warrantyClaimTypeHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_claim_type"
+	warrantyEquipHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_equipment"
+	warrantyStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_status"
+	warrantyTypeHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_type"
 	workflowHandler "github.com/your-org/jvairv2/pkg/rest/handler/workflow"
 	middleware "github.com/your-org/jvairv2/pkg/rest/middleware"
 	router "github.com/your-org/jvairv2/pkg/rest/router"
@@ -81,34 +164,63 @@ import (
 
 // Container contiene todas las dependencias de la aplicación
 type Container struct {
-	Config                *configs.Config
-	DBConnection          *mysql.Connection
-	HealthHandler         *handler.HealthHandler
-	AuthHandler           *authHandler.Handler
-	UserHandler           *userHandler.Handler
-	RoleHandler           *roleHandler.Handler
-	AbilityHandler        *abilityHandler.Handler
-	AssignedRoleHandler   *assignedRoleHandler.Handler
-	PermissionHandler     *permissionHandler.Handler
-	SettingsHandler       *settingsHandler.Handler
-	WorkflowHandler       *workflowHandler.Handler
-	CustomerHandler       *customerHandler.Handler
-	PropertyHandler       *propertyHandler.Handler
-	JobHandler            *jobHandler.Handler
-	JobCategoryHandler    *jobCategoryHandler.Handler
-	JobStatusHandler      *jobStatusHandler.Handler
-	JobPriorityHandler    *jobPriorityHandler.Handler
-	TechJobStatusHandler  *techJobStatusHandler.Handler
-	TaskStatusHandler     *taskStatusHandler.Handler
-	QuoteHandler          *quoteHandler.Handler
-	QuoteStatusHandler    *quoteStatusHandler.Handler
-	SupervisorHandler     *supervisorHandler.Handler
-	PropEquipHandler      *propEquipHandler.Handler
-	JobEquipHandler       *jobEquipHandler.Handler
-	AuthMiddleware        *middleware.AuthMiddleware
-	Router                http.Handler
-	InvoiceHandler        *invoiceHandler.Handler
-	InvoicePaymentHandler *invoicePaymentHandler.Handler
+	Config                     *configs.Config
+	DBConnection               *mysql.Connection
+	OutboxWorker               *domainOutbox.Worker
+	SessionSweeper             *commonAuth.ExpirySweeper
+	HealthHandler              *handler.HealthHandler
+	AuthHandler                *authHandler.Handler
+	UserHandler                *userHandler.Handler
+	RoleHandler                *roleHandler.Handler
+	AbilityHandler             *abilityHandler.Handler
+	AssignedRoleHandler        *assignedRoleHandler.Handler
+	PermissionHandler          *permissionHandler.Handler
+	SettingsHandler            *settingsHandler.Handler
+	WorkflowHandler            *workflowHandler.Handler
+	CustomerHandler            *customerHandler.Handler
+	PropertyHandler            *propertyHandler.Handler
+	JobHandler                 *jobHandler.Handler
+	JobCategoryHandler         *jobCategoryHandler.Handler
+	JobStatusHandler           *jobStatusHandler.Handler
+	JobPriorityHandler         *jobPriorityHandler.Handler
+	TechJobStatusHandler       *techJobStatusHandler.Handler
+	TaskStatusHandler          *taskStatusHandler.Handler
+	QuoteHandler               *quoteHandler.Handler
+	QuoteStatusHandler         *quoteStatusHandler.Handler
+	SupervisorHandler          *supervisorHandler.Handler
+	PropEquipHandler           *propEquipHandler.Handler
+	JobEquipHandler            *jobEquipHandler.Handler
+	AuthMiddleware             *middleware.AuthMiddleware
+	Router                     http.Handler
+	InvoiceHandler             *invoiceHandler.Handler
+	InvoicePaymentHandler      *invoicePaymentHandler.Handler
+	JobActivityHandler         *jobActivityHandler.Handler
+	JobHistoryHandler          *jobHistoryHandler.Handler
+	WarrantyHandler            *warrantyHandler.Handler
+	WarrantyTypeHandler        *warrantyTypeHandler.Handler
+	WarrantyStatusHandler      *warrantyStatusHandler.Handler
+	WarrantyEquipHandler       *warrantyEquipHandler.Handler
+	WarrantyClaimHandler       *warrantyClaimHandler.Handler
+	WarrantyClaimTypeHandler   *warrantyClaimTypeHandler.Handler
+	WarrantyClaimStatusHandler *warrantyClaimStatusHandler.Handler
+	JobTaskHandler             *jobTaskHandler.Handler
+	JobVisitHandler            *jobVisitHandler.Handler
+	JobResidentHandler         *jobResidentHandler.Handler
+	JobRateStatusHandler       *jobRateStatusHandler.Handler
+	JobRateHandler             *jobRateHandler.Handler
+	PayrollHandler             *payrollHandler.Handler
+	EmailTemplateHandler       *emailTemplateHandler.Handler
+	SMSTemplateHandler         *smsTemplateHandler.Handler
+	JobSMSHandler              *jobSMSHandler.Handler
+	JobEmailHandler            *jobEmailHandler.Handler
+	OutboxHandler              *outboxHandler.Handler
+	AlertHandler               *alertHandler.Handler
+	EventBus                   *domainEvent.Bus
+	EventHandler               *eventHandler.Handler
+	FileHandler                *fileHandler.Handler
+	PasswordResetHandler       *passwordResetHandler.Handler
+	PasswordPolicyHandler      *passwordPolicyHandler.Handler
+	RouteHandler               *routeHandler.Handler
 }
 
 // NewContainer crea un nuevo contenedor con todas las dependencias inicializadas
@@ -136,7 +248,17 @@ func NewContainer(configPath string) (*Container, error) {
 	customerRepo := mysqlCustomer.NewRepository(dbConn.GetDB())
 
 	// Inicializar servicios
-	tokenStore := commonAuth.NewMemoryTokenStore()
+	// Sesiones en MySQL para que sobrevivan a los despliegues y se compartan entre instancias
+	var tokenStore commonAuth.TokenStore
+	switch config.JWT.TokenStore {
+	case "mysql":
+		tokenStore = mysqlSession.NewRepository(dbConn.GetDB())
+	case "memory":
+		tokenStore = commonAuth.NewMemoryTokenStore()
+	default:
+		return nil, fmt.Errorf("almacén de tokens no soportado: %s", config.JWT.TokenStore)
+	}
+	sessionSweeper := commonAuth.NewExpirySweeper(tokenStore, config.JWT.SweepInterval)
 	authService := commonAuth.NewJWTService(
 		config.JWT.AccessSecret,
 		config.JWT.RefreshSecret,
@@ -145,13 +267,30 @@ func NewContainer(configPath string) (*Container, error) {
 		tokenStore,
 	)
 
+	// Permisos con la semántica de Bouncer (prohibiciones, scope, modelos y only_owned)
+	var permissionScope *int
+	if config.Auth.PermissionScope != 0 {
+		permissionScope = &config.Auth.PermissionScope
+	}
+	// Los permisos resueltos se guardan en memoria y se invalidan al cambiar roles o permisos
+	authorizationUC := domainAuthorization.NewUseCase(
+		mysqlAuthorization.NewRepository(dbConn.GetDB()),
+		permissionScope,
+		domainAuthorization.NewMemoryCache(),
+		config.Auth.PermissionCacheTTL,
+	)
+
+	// Política de contraseñas de settings, usada por usuarios, login y restablecimiento
+	passwordPolicyRepo := mysqlPasswordPolicy.NewRepository(dbConn.GetDB())
+	passwordPolicyUC := domainPasswordPolicy.NewUseCase(passwordPolicyRepo, settingsRepo, userRepo, middleware.GetUserID)
+
 	// Inicializar casos de uso
-	authUC := domainAuth.NewUseCase(userRepo, authService)
-	userUC := user.NewUseCase(userRepo, assignedRoleRepo, roleRepo)
-	roleUC := role.NewUseCase(roleRepo)
-	abilityUC := ability.NewUseCase(abilityRepo)
-	assignedRoleUC := assignedRole.NewUseCase(assignedRoleRepo, roleRepo)
-	permissionUC := permission.NewUseCase(permissionRepo, abilityRepo)
+	authUC := domainAuth.NewUseCase(userRepo, authService, passwordPolicyUC)
+	userUC := user.NewUseCase(userRepo, assignedRoleRepo, roleRepo, passwordPolicyUC, authorizationUC, authService)
+	roleUC := role.NewUseCase(roleRepo, authorizationUC)
+	abilityUC := ability.NewUseCase(abilityRepo, authorizationUC)
+	assignedRoleUC := assignedRole.NewUseCase(assignedRoleRepo, roleRepo, authorizationUC)
+	permissionUC := permission.NewUseCase(permissionRepo, abilityRepo, authorizationUC)
 	settingsUC := settings.NewUseCase(settingsRepo)
 	workflowUC := workflow.NewUseCase(workflowRepo)
 	customerUC := customer.NewUseCase(customerRepo, workflowRepo)
@@ -168,6 +307,8 @@ func NewContainer(configPath string) (*Container, error) {
 	techJobStatusUC := techJobStatus.NewUseCase(techJobStatusRepo, jobStatusRepo)
 	taskStatusUC := taskStatus.NewUseCase(taskStatusRepo)
 	jobRepo := mysqlJob.NewRepository(dbConn.GetDB())
+	// Scope forCurrentUser: con job_view_user_only solo se ven los jobs propios y sus recursos hijos
+	jobScope := domainJob.NewScope(middleware.GetUserID, middleware.HasAbility, middleware.CanAccess, middleware.EntityAbility)
 	jobCategoryChecker := mysqlJob.NewJobCategoryCheckerAdapter(dbConn.GetDB())
 	jobPriorityChecker := mysqlJob.NewJobPriorityCheckerAdapter(dbConn.GetDB())
 	jobStatusChecker := mysqlJob.NewJobStatusCheckerAdapter(dbConn.GetDB())
@@ -175,26 +316,158 @@ func NewContainer(configPath string) (*Container, error) {
 	propertyChecker := mysqlJob.NewPropertyCheckerAdapter(dbConn.GetDB())
 	userChecker := mysqlJob.NewUserCheckerAdapter(dbConn.GetDB())
 	techJobStatusChecker := mysqlJob.NewTechnicianJobStatusCheckerAdapter(dbConn.GetDB())
-	jobUC := domainJob.NewUseCase(jobRepo, jobCategoryChecker, jobPriorityChecker, jobStatusChecker, workflowChecker, propertyChecker, userChecker, techJobStatusChecker)
+	jobWarrantyClaimChecker := mysqlJob.NewWarrantyClaimCheckerAdapter(dbConn.GetDB())
+	jobActivityRepo := mysqlJobActivity.NewRepository(dbConn.GetDB())
+	jobActivityJobChecker := domainJob.NewScopedJobChecker(mysqlJobActivity.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobActivityUC := domainJobActivity.NewUseCase(jobActivityRepo, jobActivityJobChecker, middleware.GetUserID)
+	jobHistoryRepo := mysqlJobHistory.NewRepository(dbConn.GetDB())
+	jobHistoryJobChecker := domainJob.NewScopedJobChecker(mysqlJobHistory.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobHistoryUC := domainJobHistory.NewUseCase(jobHistoryRepo, jobHistoryJobChecker, middleware.GetUserID)
+	jobResidentRepo := mysqlJobResident.NewRepository(dbConn.GetDB())
+	// Bus de eventos en memoria para el stream en tiempo real
+	eventBus := domainEvent.NewBus(config.Events.BufferSize)
+	alertRepo := mysqlAlert.NewRepository(dbConn.GetDB())
+	alertJobChecker := domainJob.NewScopedJobChecker(mysqlAlert.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	alertUC := domainAlert.NewUseCase(alertRepo, alertJobChecker, middleware.GetUserID, eventBus)
+	jobUC := domainJob.NewUseCase(jobRepo, jobCategoryChecker, jobPriorityChecker, jobStatusChecker, workflowChecker, propertyChecker, userChecker, techJobStatusChecker, jobActivityUC, jobHistoryUC, jobWarrantyClaimChecker, jobResidentRepo, alertUC, eventBus, jobScope)
 	quoteStatusRepo := mysqlQuoteStatus.NewRepository(dbConn.GetDB())
 	quoteStatusUC := quoteStatus.NewUseCase(quoteStatusRepo)
 	quoteRepo := mysqlQuote.NewRepository(dbConn.GetDB())
-	quoteJobChecker := mysqlQuote.NewJobCheckerAdapter(dbConn.GetDB())
+	quoteJobChecker := domainJob.NewScopedJobChecker(mysqlQuote.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
 	quoteQSChecker := mysqlQuote.NewQuoteStatusCheckerAdapter(dbConn.GetDB())
-	quoteUC := domainQuote.NewUseCase(quoteRepo, quoteJobChecker, quoteQSChecker)
+	quoteUC := domainQuote.NewUseCase(quoteRepo, quoteJobChecker, quoteQSChecker, jobScope.OwnerID)
 	supervisorRepo := mysqlSupervisor.NewRepository(dbConn.GetDB())
-	supervisorUC := domainSupervisor.NewUseCase(supervisorRepo, customerRepo)
+	supervisorUC := domainSupervisor.NewUseCase(supervisorRepo, customerRepo, jobScope.OwnerID)
 	propEquipRepo := mysqlPropEquip.NewRepository(dbConn.GetDB())
 	propEquipUC := domainPropEquip.NewUseCase(propEquipRepo, propertyRepo)
 	jobEquipRepo := mysqlJobEquip.NewRepository(dbConn.GetDB())
-	jobEquipJobChecker := mysqlJobEquip.NewJobCheckerAdapter(dbConn.GetDB())
+	jobEquipJobChecker := domainJob.NewScopedJobChecker(mysqlJobEquip.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
 	jobEquipUC := domainJobEquip.NewUseCase(jobEquipRepo, jobEquipJobChecker)
 	invoiceRepo := mysqlInvoice.NewRepository(dbConn.GetDB())
-	invoiceJobChecker := mysqlInvoice.NewJobCheckerAdapter(dbConn.GetDB())
-	invoiceUC := domainInvoice.NewUseCase(invoiceRepo, invoiceJobChecker)
+	invoiceJobChecker := domainJob.NewScopedJobChecker(mysqlInvoice.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	invoiceUC := domainInvoice.NewUseCase(invoiceRepo, invoiceJobChecker, jobScope.OwnerID)
 	invoicePaymentRepo := mysqlInvoicePayment.NewRepository(dbConn.GetDB())
-	invoiceChecker := mysqlInvoice.NewInvoiceCheckerAdapter(dbConn.GetDB())
-	invoicePaymentUC := domainInvoicePayment.NewUseCase(invoicePaymentRepo, invoiceChecker)
+	invoiceChecker := domainInvoice.NewVisibilityChecker(mysqlInvoice.NewInvoiceCheckerAdapter(dbConn.GetDB()), invoiceUC)
+	invoicePaymentUC := domainInvoicePayment.NewUseCase(invoicePaymentRepo, invoiceChecker, eventBus)
+	warrantyTypeRepo := mysqlWarrantyType.NewRepository(dbConn.GetDB())
+	warrantyTypeUC := domainWarrantyType.NewUseCase(warrantyTypeRepo)
+	warrantyStatusRepo := mysqlWarrantyStatus.NewRepository(dbConn.GetDB())
+	warrantyStatusUC := domainWarrantyStatus.NewUseCase(warrantyStatusRepo)
+	warrantyRepo := mysqlWarranty.NewRepository(dbConn.GetDB())
+	warrantyJobChecker := domainJob.NewScopedJobChecker(mysqlWarranty.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	warrantyTypeChecker := mysqlWarranty.NewWarrantyTypeCheckerAdapter(dbConn.GetDB())
+	warrantyStatusChecker := mysqlWarranty.NewWarrantyStatusCheckerAdapter(dbConn.GetDB())
+	warrantyUC := domainWarranty.NewUseCase(warrantyRepo, warrantyJobChecker, warrantyTypeChecker, warrantyStatusChecker, jobScope.OwnerID)
+	warrantyEquipRepo := mysqlWarrantyEquip.NewRepository(dbConn.GetDB())
+	warrantyEquipChecker := domainWarranty.NewVisibilityChecker(mysqlWarrantyEquip.NewWarrantyCheckerAdapter(dbConn.GetDB()), warrantyUC)
+	warrantyEquipUC := domainWarrantyEquip.NewUseCase(warrantyEquipRepo, warrantyEquipChecker)
+	warrantyClaimTypeRepo := mysqlWarrantyClaimType.NewRepository(dbConn.GetDB())
+	warrantyClaimTypeUC := domainWarrantyClaimType.NewUseCase(warrantyClaimTypeRepo)
+	warrantyClaimStatusRepo := mysqlWarrantyClaimStatus.NewRepository(dbConn.GetDB())
+	warrantyClaimStatusUC := domainWarrantyClaimStatus.NewUseCase(warrantyClaimStatusRepo)
+	warrantyClaimRepo := mysqlWarrantyClaim.NewRepository(dbConn.GetDB())
+	warrantyClaimJobChecker := domainJob.NewScopedJobChecker(mysqlWarrantyClaim.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	warrantyClaimTypeChecker := mysqlWarrantyClaim.NewWarrantyClaimTypeCheckerAdapter(dbConn.GetDB())
+	warrantyClaimStatusChecker := mysqlWarrantyClaim.NewWarrantyClaimStatusCheckerAdapter(dbConn.GetDB())
+	warrantyClaimUC := domainWarrantyClaim.NewUseCase(warrantyClaimRepo, warrantyClaimJobChecker, warrantyClaimTypeChecker, warrantyClaimStatusChecker, jobScope.OwnerID)
+	jobTaskRepo := mysqlJobTask.NewRepository(dbConn.GetDB())
+	jobTaskJobChecker := domainJob.NewScopedJobChecker(mysqlJobTask.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobTaskUserChecker := mysqlJobTask.NewUserCheckerAdapter(dbConn.GetDB())
+	jobTaskStatusChecker := mysqlJobTask.NewTaskStatusCheckerAdapter(dbConn.GetDB())
+	jobTaskUC := domainJobTask.NewUseCase(jobTaskRepo, jobTaskJobChecker, jobTaskUserChecker, jobTaskStatusChecker, alertUC, middleware.GetUserID, jobScope.OwnerID)
+	jobVisitRepo := mysqlJobVisit.NewRepository(dbConn.GetDB())
+	jobVisitJobChecker := domainJob.NewScopedJobChecker(mysqlJobVisit.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobVisitUserChecker := mysqlJobVisit.NewUserCheckerAdapter(dbConn.GetDB())
+	jobVisitRoleProvider := mysqlJobVisit.NewRoleProviderAdapter(dbConn.GetDB())
+	jobVisitUC := domainJobVisit.NewUseCase(jobVisitRepo, jobVisitJobChecker, jobVisitUserChecker, jobVisitRoleProvider, middleware.GetUserID, middleware.HasAbility)
+	jobResidentJobChecker := domainJob.NewScopedJobChecker(mysqlJobResident.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobResidentUC := domainJobResident.NewUseCase(jobResidentRepo, jobResidentJobChecker)
+	jobRateStatusRepo := mysqlJobRateStatus.NewRepository(dbConn.GetDB())
+	jobRateStatusUC := domainJobRateStatus.NewUseCase(jobRateStatusRepo)
+	jobRateRepo := mysqlJobRate.NewRepository(dbConn.GetDB())
+	jobRateJobChecker := domainJob.NewScopedJobChecker(mysqlJobRate.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobRateUserChecker := mysqlJobRate.NewUserCheckerAdapter(dbConn.GetDB())
+	jobRateStatusChecker := mysqlJobRate.NewJobRateStatusCheckerAdapter(dbConn.GetDB())
+	jobRateUC := domainJobRate.NewUseCase(jobRateRepo, jobRateJobChecker, jobRateUserChecker, jobRateStatusChecker)
+	mailSender, err := commonMail.New(commonMail.Config{
+		Driver:   config.Mail.Driver,
+		Host:     config.Mail.Host,
+		Port:     config.Mail.Port,
+		Username: config.Mail.Username,
+		Password: config.Mail.Password,
+		From:     config.Mail.From,
+		FromName: config.Mail.FromName,
+		Dir:      config.Mail.Dir,
+	})
+	if err != nil {
+		return nil, err
+	}
+	outboxRepo := mysqlOutbox.NewRepository(dbConn.GetDB())
+	outboxUC := domainOutbox.NewUseCase(outboxRepo, config.Outbox.MaxAttempts)
+	outboxWorker := domainOutbox.NewWorker(outboxRepo, domainOutbox.WorkerConfig{
+		Concurrency:     config.Outbox.Workers,
+		PollInterval:    config.Outbox.PollInterval,
+		DeliveryTimeout: config.Outbox.DeliveryTimeout,
+		BaseBackoff:     config.Outbox.BaseBackoff,
+		MaxBackoff:      config.Outbox.MaxBackoff,
+	})
+	outboxWorker.Register(domainOutbox.ChannelEmail, domainOutbox.NewEmailDeliverer(mailSender))
+	outboxWorker.Register(domainOutbox.ChannelSMS, domainOutbox.NewSMSDeliverer(settingsRepo, nil))
+	payrollRepo := mysqlPayroll.NewRepository(dbConn.GetDB())
+	payrollUC := domainPayroll.NewUseCase(payrollRepo, middleware.GetUserID, outboxUC)
+	outboxWorker.OnResult(domainPayroll.PaystubKind, payrollUC.OnDeliveryResult)
+	// El caso de uso de jobs aplica el scope del usuario a despachos y vistas previas
+	jobPlaceholderUC := domainJobPlaceholder.NewUseCase(jobUC, propertyRepo, customerRepo, userRepo)
+	emailTemplateRepo := mysqlEmailTemplate.NewRepository(dbConn.GetDB())
+	emailTemplateUC := domainEmailTemplate.NewUseCase(emailTemplateRepo, jobPlaceholderUC)
+	smsTemplateRepo := mysqlSMSTemplate.NewRepository(dbConn.GetDB())
+	smsTemplateUC := domainSMSTemplate.NewUseCase(smsTemplateRepo)
+	jobSMSRepo := mysqlJobSMS.NewRepository(dbConn.GetDB())
+	jobSMSJobChecker := domainJob.NewScopedJobChecker(mysqlJobSMS.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobSMSUC := domainJobSMS.NewUseCase(jobSMSRepo, jobSMSJobChecker, jobPlaceholderUC, smsTemplateRepo, jobResidentRepo, settingsRepo, func(sms.TwilioConfig) sms.Sender {
+		// Los SMS se encolan; el worker los entrega con las credenciales vigentes
+		return domainOutbox.NewSMSQueue(outboxUC, "job_dispatch")
+	})
+	jobEmailRepo := mysqlJobEmail.NewRepository(dbConn.GetDB())
+	jobEmailJobChecker := domainJob.NewScopedJobChecker(mysqlJobEmail.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	jobEmailUC := domainJobEmail.NewUseCase(jobEmailRepo, jobEmailJobChecker, jobPlaceholderUC, emailTemplateRepo, supervisorRepo, domainOutbox.NewMailQueue(outboxUC, "job_dispatch"))
+	fileStore, err := commonStorage.New(commonStorage.Config{
+		Driver:     config.Storage.Driver,
+		Dir:        config.Storage.LocalDir,
+		BaseURL:    config.Storage.BaseURL,
+		SigningKey: config.Storage.SigningKey,
+		S3: commonStorage.S3Config{
+			Endpoint:  config.Storage.S3Endpoint,
+			Region:    config.Storage.S3Region,
+			Bucket:    config.Storage.S3Bucket,
+			AccessKey: config.Storage.S3AccessKey,
+			SecretKey: config.Storage.S3SecretKey,
+			PathStyle: config.Storage.S3PathStyle,
+			PublicURL: config.Storage.S3PublicURL,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fileRepo := mysqlFile.NewRepository(dbConn.GetDB())
+	fileFileableChecker := mysqlFile.NewFileableCheckerAdapter(dbConn.GetDB())
+	fileJobChecker := domainJob.NewScopedJobChecker(mysqlFile.NewJobCheckerAdapter(dbConn.GetDB()), jobRepo, jobScope)
+	fileUC := domainFile.NewUseCase(fileRepo, fileFileableChecker, fileJobChecker, fileStore, domainFile.Config{
+		MaxSize:       config.Storage.MaxUploadSize,
+		URLExpiry:     config.Storage.URLExpiry,
+		ThumbnailSize: config.Storage.ThumbnailSize,
+		WebSize:       config.Storage.WebSize,
+	}, jobScope.OwnerID)
+	// Con el driver local la API sirve las descargas firmadas
+	localStore, _ := fileStore.(*commonStorage.LocalStorage)
+
+	// Restablecimiento de contraseñas: el enlace se envía por la cola de salida
+	passwordResetRepo := mysqlPasswordReset.NewRepository(dbConn.GetDB())
+	passwordResetUC := domainPasswordReset.NewUseCase(passwordResetRepo, userRepo, authService, passwordPolicyUC, domainOutbox.NewMailQueue(outboxUC, "password_reset"), domainPasswordReset.Config{
+		ResetURL: config.Auth.PasswordResetURL,
+		Expiry:   config.Auth.PasswordResetExpiry,
+		Throttle: config.Auth.PasswordResetThrottle,
+	})
 
 	// Inicializar handlers
 	healthHandler := handler.NewHealthHandler(dbConn)
@@ -223,6 +496,33 @@ func NewContainer(configPath string) (*Container, error) {
 	jobEquipHdlr := jobEquipHandler.NewHandler(jobEquipUC)
 	invHdlr := invoiceHandler.NewHandler(invoiceUC)
 	invPayHdlr := invoicePaymentHandler.NewHandler(invoicePaymentUC)
+	jobActivityHdlr := jobActivityHandler.NewHandler(jobActivityUC)
+	jobHistoryHdlr := jobHistoryHandler.NewHandler(jobHistoryUC)
+	warrantyHdlr := warrantyHandler.NewHandler(warrantyUC)
+	warrantyTypeHdlr := warrantyTypeHandler.NewHandler(warrantyTypeUC)
+	warrantyStatusHdlr := warrantyStatusHandler.NewHandler(warrantyStatusUC)
+	warrantyEquipHdlr := warrantyEquipHandler.NewHandler(warrantyEquipUC)
+	warrantyClaimHdlr := warrantyClaimHandler.NewHandler(warrantyClaimUC)
+	warrantyClaimTypeHdlr := warrantyClaimTypeHandler.NewHandler(warrantyClaimTypeUC)
+	warrantyClaimStatusHdlr := warrantyClaimStatusHandler.NewHandler(warrantyClaimStatusUC)
+	jobTaskHdlr := jobTaskHandler.NewHandler(jobTaskUC)
+	jobVisitHdlr := jobVisitHandler.NewHandler(jobVisitUC)
+	jobResidentHdlr := jobResidentHandler.NewHandler(jobResidentUC)
+	jobRateStatusHdlr := jobRateStatusHandler.NewHandler(jobRateStatusUC)
+	jobRateHdlr := jobRateHandler.NewHandler(jobRateUC)
+	payrollHdlr := payrollHandler.NewHandler(payrollUC)
+	emailTemplateHdlr := emailTemplateHandler.NewHandler(emailTemplateUC)
+	smsTemplateHdlr := smsTemplateHandler.NewHandler(smsTemplateUC)
+	jobSMSHdlr := jobSMSHandler.NewHandler(jobSMSUC)
+	jobEmailHdlr := jobEmailHandler.NewHandler(jobEmailUC)
+	outboxHdlr := outboxHandler.NewHandler(outboxUC)
+	alertHdlr := alertHandler.NewHandler(alertUC)
+	eventHdlr := eventHandler.NewHandler(eventBus, config.Events.Heartbeat)
+	fileHdlr := fileHandler.NewHandler(fileUC, localStore)
+	passwordResetHdlr := passwordResetHandler.NewHandler(passwordResetUC)
+	passwordPolicyHdlr := passwordPolicyHandler.NewHandler(passwordPolicyUC)
+	routeGuard := middleware.NewRouteGuard(router.APIPrefix, router.RouteAbilities()).AllowEntityGrants(router.EntityRoutes...)
+	routeHdlr := routeHandler.NewHandler(routeGuard)
 
 	// Inicializar middlewares
 	authMiddleware := middleware.NewAuthMiddleware(authUC)
@@ -253,39 +553,96 @@ func NewContainer(configPath string) (*Container, error) {
 		jobEquipHdlr,
 		invHdlr,
 		invPayHdlr,
+		jobActivityHdlr,
+		jobHistoryHdlr,
+		warrantyHdlr,
+		warrantyTypeHdlr,
+		warrantyStatusHdlr,
+		warrantyEquipHdlr,
+		warrantyClaimHdlr,
+		warrantyClaimTypeHdlr,
+		warrantyClaimStatusHdlr,
+		jobTaskHdlr,
+		jobVisitHdlr,
+		jobResidentHdlr,
+		jobRateStatusHdlr,
+		jobRateHdlr,
+		payrollHdlr,
+		emailTemplateHdlr,
+		smsTemplateHdlr,
+		jobSMSHdlr,
+		jobEmailHdlr,
+		outboxHdlr,
+		alertHdlr,
+		eventHdlr,
+		fileHdlr,
+		passwordResetHdlr,
+		passwordPolicyHdlr,
+		passwordPolicyUC,
+		routeHdlr,
+		routeGuard,
 		authMiddleware,
-		userUC,
+		authorizationUC,
 	)
 
 	return &Container{
-		Config:                config,
-		DBConnection:          dbConn,
-		HealthHandler:         healthHandler,
-		AuthHandler:           authHandler,
-		UserHandler:           userHandler,
-		RoleHandler:           roleHandler,
-		AbilityHandler:        abilityHandler,
-		AssignedRoleHandler:   assignedRoleHandler,
-		PermissionHandler:     permissionHandler,
-		SettingsHandler:       settingsHandler,
-		WorkflowHandler:       workflowHandler,
-		CustomerHandler:       customerHandler,
-		PropertyHandler:       propHandler,
-		JobHandler:            jobHdlr,
-		JobCategoryHandler:    jobCatHandler,
-		JobStatusHandler:      jobStatHandler,
-		JobPriorityHandler:    jobPrioHandler,
-		TechJobStatusHandler:  techJobStatHandler,
-		TaskStatusHandler:     taskStatHandler,
-		QuoteHandler:          quoteHdlr,
-		QuoteStatusHandler:    quoteStatHandler,
-		SupervisorHandler:     supervisorHdlr,
-		PropEquipHandler:      propEquipHdlr,
-		JobEquipHandler:       jobEquipHdlr,
-		AuthMiddleware:        authMiddleware,
-		Router:                r,
-		InvoiceHandler:        invHdlr,
-		InvoicePaymentHandler: invPayHdlr,
+		Config:                     config,
+		DBConnection:               dbConn,
+		OutboxWorker:               outboxWorker,
+		SessionSweeper:             sessionSweeper,
+		HealthHandler:              healthHandler,
+		AuthHandler:                authHandler,
+		UserHandler:                userHandler,
+		RoleHandler:                roleHandler,
+		AbilityHandler:             abilityHandler,
+		AssignedRoleHandler:        assignedRoleHandler,
+		PermissionHandler:          permissionHandler,
+		SettingsHandler:            settingsHandler,
+		WorkflowHandler:            workflowHandler,
+		CustomerHandler:            customerHandler,
+		PropertyHandler:            propHandler,
+		JobHandler:                 jobHdlr,
+		JobCategoryHandler:         jobCatHandler,
+		JobStatusHandler:           jobStatHandler,
+		JobPriorityHandler:         jobPrioHandler,
+		TechJobStatusHandler:       techJobStatHandler,
+		TaskStatusHandler:          taskStatHandler,
+		QuoteHandler:               quoteHdlr,
+		QuoteStatusHandler:         quoteStatHandler,
+		SupervisorHandler:          supervisorHdlr,
+		PropEquipHandler:           propEquipHdlr,
+		JobEquipHandler:            jobEquipHdlr,
+		AuthMiddleware:             authMiddleware,
+		Router:                     r,
+		InvoiceHandler:             invHdlr,
+		InvoicePaymentHandler:      invPayHdlr,
+		JobActivityHandler:         jobActivityHdlr,
+		JobHistoryHandler:          jobHistoryHdlr,
+		WarrantyHandler:            warrantyHdlr,
+		WarrantyTypeHandler:        warrantyTypeHdlr,
+		WarrantyStatusHandler:      warrantyStatusHdlr,
+		WarrantyEquipHandler:       warrantyEquipHdlr,
+		WarrantyClaimHandler:       warrantyClaimHdlr,
+		WarrantyClaimTypeHandler:   warrantyClaimTypeHdlr,
+		WarrantyClaimStatusHandler: warrantyClaimStatusHdlr,
+		JobTaskHandler:             jobTaskHdlr,
+		JobVisitHandler:            jobVisitHdlr,
+		JobResidentHandler:         jobResidentHdlr,
+		JobRateStatusHandler:       jobRateStatusHdlr,
+		JobRateHandler:             jobRateHdlr,
+		PayrollHandler:             payrollHdlr,
+		EmailTemplateHandler:       emailTemplateHdlr,
+		SMSTemplateHandler:         smsTemplateHdlr,
+		JobSMSHandler:              jobSMSHdlr,
+		JobEmailHandler:            jobEmailHdlr,
+		OutboxHandler:              outboxHdlr,
+		AlertHandler:               alertHdlr,
+		EventBus:                   eventBus,
+		EventHandler:               eventHdlr,
+		FileHandler:                fileHdlr,
+		PasswordResetHandler:       passwordResetHdlr,
+		PasswordPolicyHandler:      passwordPolicyHdlr,
+		RouteHandler:               routeHdlr,
 	}, nil
 }
 
@@ -46,6 +46,12 @@ func main() {
 
 	slog.Info("Conexión a la base de datos establecida correctamente")
 
+	// Iniciar el worker de la cola de mensajes salientes (email y SMS)
+	container.OutboxWorker.Start(context.Background())
+
+	// Iniciar el barrido de sesiones expiradas
+	container.SessionSweeper.Start(context.Background())
+
 	// Configurar servidor HTTP
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%s", container.Config.Server.Port),
@@ -55,6 +61,9 @@ func main() {
 		IdleTimeout:  120 * time.Second,
 	}
 
+	// Al iniciar el apagado se cierran los streams de eventos para que Shutdown no espere por ellos
+	server.RegisterOnShutdown(container.EventBus.Close)
+
 	// Canal para recibir errores del servidor
 	serverErrors := make(chan error, 1)
 
@@ -91,6 +100,15 @@ func main() {
 			}
 		}
 
+		// Detener el worker esperando a las entregas en curso
+		if err := container.OutboxWorker.Stop(ctx); err != nil {
+			slog.Error("Error al detener el worker de mensajes", "error", err)
+		}
+
+		if err := container.SessionSweeper.Stop(ctx); err != nil {
+			slog.Error("Error al detener el barrido de sesiones", "error", err)
+		}
+
 		slog.Info("Servidor apagado correctamente")
 	}
 }
@@ -9,10 +9,15 @@ import (
 
 // Config almacena toda la configuración de la aplicación
 type Config struct {
-	App    AppConfig
-	Server ServerConfig
-	DB     DBConfig
-	JWT    JWTConfig
+	App     AppConfig
+	Server  ServerConfig
+	DB      DBConfig
+	JWT     JWTConfig
+	Mail    MailConfig
+	Outbox  OutboxConfig
+	Events  EventsConfig
+	Storage StorageConfig
+	Auth    AuthConfig
 }
 
 // AppConfig almacena la configuración general de la aplicación
@@ -47,6 +52,64 @@ type JWTConfig struct {
 	RefreshSecret     string
 	AccessExpiration  time.Duration
 	RefreshExpiration time.Duration
+	TokenStore        string        // mysql (compartido entre instancias) o memory
+	SweepInterval     time.Duration // cada cuánto se eliminan las sesiones expiradas
+}
+
+// AuthConfig almacena la configuración del restablecimiento de contraseñas y de permisos
+type AuthConfig struct {
+	PasswordResetURL      string        // formulario del frontend que recibe token y email
+	PasswordResetExpiry   time.Duration // vigencia del token enviado por correo
+	PasswordResetThrottle time.Duration // espera mínima entre solicitudes del mismo email
+	PermissionScope       int           // scope de Bouncer activo; 0 si no se usan scopes
+	PermissionCacheTTL    time.Duration // vigencia de los permisos resueltos por usuario; 0 desactiva la caché
+}
+
+// MailConfig almacena la configuración del envío de correos
+type MailConfig struct {
+	Driver   string // smtp, file, memory
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
+	Dir      string // directorio de salida del driver file
+}
+
+// OutboxConfig almacena la configuración del worker de la cola de mensajes salientes
+type OutboxConfig struct {
+	Workers         int
+	PollInterval    time.Duration
+	DeliveryTimeout time.Duration
+	MaxAttempts     int
+	BaseBackoff     time.Duration
+	MaxBackoff      time.Duration
+}
+
+// EventsConfig almacena la configuración del stream de eventos en tiempo real
+type EventsConfig struct {
+	BufferSize int           // eventos recientes conservados para reanudar con Last-Event-ID
+	Heartbeat  time.Duration // intervalo entre heartbeats del stream SSE
+}
+
+// StorageConfig almacena la configuración del almacenamiento de archivos adjuntos
+type StorageConfig struct {
+	Driver        string // local, s3
+	LocalDir      string
+	BaseURL       string // URL pública de la API, para las descargas del driver local
+	SigningKey    string // firma de URLs del driver local (por defecto JWT_ACCESS_SECRET)
+	S3Endpoint    string
+	S3Region      string
+	S3Bucket      string
+	S3AccessKey   string
+	S3SecretKey   string
+	S3PathStyle   bool
+	S3PublicURL   string
+	MaxUploadSize int64
+	URLExpiry     time.Duration
+	ThumbnailSize int // lado máximo en píxeles de la miniatura de las imágenes
+	WebSize       int // lado máximo en píxeles de la variante web de las imágenes
 }
 
 // LoadConfig carga la configuración desde el archivo app.env
@@ -92,6 +155,71 @@ func LoadConfig(path string) (*Config, error) {
 	config.JWT.RefreshSecret = viper.GetString("JWT_REFRESH_SECRET")
 	config.JWT.AccessExpiration = viper.GetDuration("JWT_ACCESS_EXPIRATION")
 	config.JWT.RefreshExpiration = viper.GetDuration("JWT_REFRESH_EXPIRATION")
+	config.JWT.TokenStore = viper.GetString("JWT_TOKEN_STORE")
+	if config.JWT.TokenStore == "" {
+		config.JWT.TokenStore = "mysql" // Valor por defecto: sesiones persistentes en auth_sessions
+	}
+	config.JWT.SweepInterval = viper.GetDuration("JWT_SESSION_SWEEP_INTERVAL")
+	if config.JWT.SweepInterval <= 0 {
+		config.JWT.SweepInterval = time.Hour
+	}
+
+	// Configuración de correo
+	config.Mail.Driver = viper.GetString("MAIL_DRIVER")
+	if config.Mail.Driver == "" {
+		config.Mail.Driver = "file" // Valor por defecto: escribir .eml en disco
+	}
+	config.Mail.Host = viper.GetString("MAIL_HOST")
+	config.Mail.Port = viper.GetInt("MAIL_PORT")
+	config.Mail.Username = viper.GetString("MAIL_USERNAME")
+	config.Mail.Password = viper.GetString("MAIL_PASSWORD")
+	config.Mail.From = viper.GetString("MAIL_FROM_ADDRESS")
+	config.Mail.FromName = viper.GetString("MAIL_FROM_NAME")
+	config.Mail.Dir = viper.GetString("MAIL_FILE_DIR")
+
+	// Configuración de la cola de mensajes salientes (0 = valores por defecto del worker)
+	config.Outbox.Workers = viper.GetInt("OUTBOX_WORKERS")
+	config.Outbox.PollInterval = viper.GetDuration("OUTBOX_POLL_INTERVAL")
+	config.Outbox.DeliveryTimeout = viper.GetDuration("OUTBOX_DELIVERY_TIMEOUT")
+	config.Outbox.MaxAttempts = viper.GetInt("OUTBOX_MAX_ATTEMPTS")
+	config.Outbox.BaseBackoff = viper.GetDuration("OUTBOX_BASE_BACKOFF")
+	config.Outbox.MaxBackoff = viper.GetDuration("OUTBOX_MAX_BACKOFF")
+
+	// Configuración del stream de eventos (0 = valores por defecto)
+	config.Events.BufferSize = viper.GetInt("EVENTS_BUFFER_SIZE")
+	config.Events.Heartbeat = viper.GetDuration("EVENTS_HEARTBEAT")
+
+	// Configuración del almacenamiento de archivos
+	config.Storage.Driver = viper.GetString("STORAGE_DRIVER")
+	if config.Storage.Driver == "" {
+		config.Storage.Driver = "local" // Valor por defecto: disco local
+	}
+	config.Storage.LocalDir = viper.GetString("STORAGE_LOCAL_DIR")
+	config.Storage.BaseURL = viper.GetString("STORAGE_BASE_URL")
+	config.Storage.SigningKey = viper.GetString("STORAGE_SIGNING_KEY")
+	if config.Storage.SigningKey == "" {
+		config.Storage.SigningKey = config.JWT.AccessSecret
+	}
+	config.Storage.S3Endpoint = viper.GetString("S3_ENDPOINT")
+	config.Storage.S3Region = viper.GetString("S3_REGION")
+	config.Storage.S3Bucket = viper.GetString("S3_BUCKET")
+	config.Storage.S3AccessKey = viper.GetString("S3_ACCESS_KEY")
+	config.Storage.S3SecretKey = viper.GetString("S3_SECRET_KEY")
+	config.Storage.S3PathStyle = viper.GetBool("S3_PATH_STYLE")
+	config.Storage.S3PublicURL = viper.GetString("S3_PUBLIC_URL")
+	config.Storage.MaxUploadSize = viper.GetInt64("FILES_MAX_UPLOAD_SIZE")
+	config.Storage.URLExpiry = viper.GetDuration("FILES_URL_EXPIRY")
+	config.Storage.ThumbnailSize = viper.GetInt("FILES_THUMBNAIL_SIZE")
+	config.Storage.WebSize = viper.GetInt("FILES_WEB_SIZE")
+
+	// Configuración del restablecimiento de contraseñas
+	config.Auth.PasswordResetURL = viper.GetString("PASSWORD_RESET_URL")
+	config.Auth.PasswordResetExpiry = viper.GetDuration("PASSWORD_RESET_EXPIRY")
+	config.Auth.PasswordResetThrottle = viper.GetDuration("PASSWORD_RESET_THROTTLE")
+
+	// Scope de permisos (multi-tenant de Bouncer)
+	config.Auth.PermissionScope = viper.GetInt("PERMISSION_SCOPE")
+	config.Auth.PermissionCacheTTL = viper.GetDuration("PERMISSION_CACHE_TTL")
 
 	return &config, nil
 }
@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -25,11 +27,28 @@ type JWTService struct {
 	tokenStore    TokenStore
 }
 
-// TokenStore define la interfaz para almacenar y recuperar tokens
+// TokenStore define la interfaz para almacenar las sesiones y verificar sus tokens.
+// Cada sesión agrupa el token de acceso y el de refresco emitidos en un login.
 type TokenStore interface {
-	StoreToken(ctx context.Context, userID int64, tokenID string, expiration time.Duration) error
-	DeleteToken(ctx context.Context, tokenID string) error
+	// CreateSession registra una sesión nueva
+	CreateSession(ctx context.Context, session *auth.Session) error
+	// RotateSession reemplaza los tokens de la sesión solo si refreshUUID sigue vigente en ella;
+	// retorna false si la sesión se cerró o el token de refresco ya se usó
+	RotateSession(ctx context.Context, refreshUUID string, session *auth.Session) (bool, error)
+	// CheckToken verifica que el token de acceso o de refresco pertenezca a una sesión vigente
 	CheckToken(ctx context.Context, tokenID string) (bool, error)
+	// DeleteToken cierra la sesión a la que pertenece el token
+	DeleteToken(ctx context.Context, tokenID string) error
+	// DeleteUserTokens cierra todas las sesiones de un usuario
+	DeleteUserTokens(ctx context.Context, userID int64) error
+	// ListUserSessions retorna las sesiones vigentes de un usuario, la más reciente primero
+	ListUserSessions(ctx context.Context, userID int64) ([]*auth.Session, error)
+	// DeleteUserSession cierra una sesión del usuario; retorna false si no existe
+	DeleteUserSession(ctx context.Context, userID int64, sessionID string) (bool, error)
+	// DeleteOtherUserSessions cierra todas las sesiones del usuario excepto sessionID
+	DeleteOtherUserSessions(ctx context.Context, userID int64, sessionID string) error
+	// DeleteExpired elimina las sesiones cuyo token de refresco expiró
+	DeleteExpired(ctx context.Context) (int64, error)
 }
 
 // NewJWTService crea una nueva instancia del servicio JWT
@@ -43,13 +62,44 @@ func NewJWTService(accessSecret, refreshSecret string, accessExp, refreshExp tim
 	}
 }
 
-// CreateToken genera tokens JWT para un usuario
+// CreateToken genera tokens JWT para un usuario y abre una sesión nueva
 func (s *JWTService) CreateToken(ctx context.Context, u *user.User) (*auth.TokenDetails, error) {
+	sessionID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	td, err := s.signTokens(u, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Registrar la sesión en el almacén de tokens
+	if err := s.StoreTokenDetails(ctx, u.ID, td); err != nil {
+		return nil, err
+	}
+
+	return td, nil
+}
+
+// signTokens firma el par de tokens de una sesión
+func (s *JWTService) signTokens(u *user.User, sessionID string) (*auth.TokenDetails, error) {
+	accessUUID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+	refreshUUID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
 	td := &auth.TokenDetails{
-		AtExpires:   time.Now().Add(s.accessExp).Unix(),
-		RtExpires:   time.Now().Add(s.refreshExp).Unix(),
-		AccessUUID:  fmt.Sprintf("%d-%d", u.ID, time.Now().Unix()),
-		RefreshUUID: fmt.Sprintf("%d-%d-refresh", u.ID, time.Now().Unix()),
+		AtExpires:   now.Add(s.accessExp).Unix(),
+		RtExpires:   now.Add(s.refreshExp).Unix(),
+		AccessUUID:  accessUUID,
+		RefreshUUID: refreshUUID,
+		SessionID:   sessionID,
 	}
 
 	// Crear token de acceso
@@ -57,11 +107,11 @@ func (s *JWTService) CreateToken(ctx context.Context, u *user.User) (*auth.Token
 		"user_id":     u.ID,
 		"role_id":     u.RoleID,
 		"access_uuid": td.AccessUUID,
+		"session_id":  td.SessionID,
 		"exp":         td.AtExpires,
 	}
 
 	at := jwt.NewWithClaims(jwt.SigningMethodHS256, atClaims)
-	var err error
 	td.AccessToken, err = at.SignedString([]byte(s.accessSecret))
 	if err != nil {
 		return nil, err
@@ -71,6 +121,7 @@ func (s *JWTService) CreateToken(ctx context.Context, u *user.User) (*auth.Token
 	rtClaims := jwt.MapClaims{
 		"user_id":      u.ID,
 		"refresh_uuid": td.RefreshUUID,
+		"session_id":   td.SessionID,
 		"exp":          td.RtExpires,
 	}
 
@@ -80,18 +131,32 @@ func (s *JWTService) CreateToken(ctx context.Context, u *user.User) (*auth.Token
 		return nil, err
 	}
 
-	// Almacenar tokens en caché/redis
-	err = s.tokenStore.StoreToken(ctx, u.ID, td.AccessUUID, s.accessExp)
-	if err != nil {
-		return nil, err
-	}
+	return td, nil
+}
 
-	err = s.tokenStore.StoreToken(ctx, u.ID, td.RefreshUUID, s.refreshExp)
-	if err != nil {
-		return nil, err
+// newTokenID genera un identificador aleatorio para sesiones y tokens
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
+	return hex.EncodeToString(b), nil
+}
 
-	return td, nil
+// sessionFromDetails construye la sesión de un par de tokens con el dispositivo del contexto
+func sessionFromDetails(ctx context.Context, userID int64, td *auth.TokenDetails) *auth.Session {
+	client := auth.ClientInfoFromContext(ctx)
+	return &auth.Session{
+		ID:               td.SessionID,
+		UserID:           userID,
+		AccessUUID:       td.AccessUUID,
+		RefreshUUID:      td.RefreshUUID,
+		AccessExpiresAt:  time.Unix(td.AtExpires, 0),
+		RefreshExpiresAt: time.Unix(td.RtExpires, 0),
+		UserAgent:        client.UserAgent,
+		IPAddress:        client.IPAddress,
+		CreatedAt:        time.Now(),
+	}
 }
 
 // ExtractTokenMetadata extrae información de un token JWT
@@ -131,6 +196,9 @@ func (s *JWTService) ExtractTokenMetadata(ctx context.Context, tokenString strin
 	}
 	userID := int64(userIDFloat)
 
+	// Los tokens emitidos antes de las sesiones no incluyen session_id
+	sessionID, _ := claims["session_id"].(string)
+
 	roleIDValue, ok := claims["role_id"]
 	var roleID string
 	if ok && roleIDValue != nil {
@@ -149,6 +217,7 @@ func (s *JWTService) ExtractTokenMetadata(ctx context.Context, tokenString strin
 
 	return &auth.AccessDetails{
 		AccessUUID: accessUUID,
+		SessionID:  sessionID,
 		UserID:     fmt.Sprintf("%d", userID),
 		RoleID:     roleID,
 	}, nil
@@ -165,15 +234,15 @@ func (s *JWTService) ValidateToken(ctx context.Context, tokenString string) (boo
 	return true, nil
 }
 
-// StoreTokenDetails almacena información del token en caché/redis
+// StoreTokenDetails registra la sesión de un par de tokens en el almacén
 func (s *JWTService) StoreTokenDetails(ctx context.Context, userID int64, td *auth.TokenDetails) error {
-	// Almacenar tokens en caché/redis
-	err := s.tokenStore.StoreToken(ctx, userID, td.AccessUUID, s.accessExp)
-	if err != nil {
-		return err
-	}
+	return s.tokenStore.CreateSession(ctx, sessionFromDetails(ctx, userID, td))
+}
 
-	err = s.tokenStore.StoreToken(ctx, userID, td.RefreshUUID, s.refreshExp)
+// DeleteTokenDetails cierra la sesión del token de acceso (logout)
+func (s *JWTService) DeleteTokenDetails(ctx context.Context, accessUUID string) error {
+	// Eliminar la sesión del almacén
+	err := s.tokenStore.DeleteToken(ctx, accessUUID)
 	if err != nil {
 		return err
 	}
@@ -181,17 +250,33 @@ func (s *JWTService) StoreTokenDetails(ctx context.Context, userID int64, td *au
 	return nil
 }
 
-// DeleteTokenDetails elimina información del token de caché/redis
-func (s *JWTService) DeleteTokenDetails(ctx context.Context, accessUUID string) error {
-	// Eliminar token de caché/redis
-	err := s.tokenStore.DeleteToken(ctx, accessUUID)
+// RevokeUserTokens elimina todos los tokens de acceso y de refresco de un usuario
+func (s *JWTService) RevokeUserTokens(ctx context.Context, userID int64) error {
+	return s.tokenStore.DeleteUserTokens(ctx, userID)
+}
+
+// ListSessions retorna las sesiones vigentes de un usuario
+func (s *JWTService) ListSessions(ctx context.Context, userID int64) ([]*auth.Session, error) {
+	return s.tokenStore.ListUserSessions(ctx, userID)
+}
+
+// RevokeSession cierra una sesión del usuario
+func (s *JWTService) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	deleted, err := s.tokenStore.DeleteUserSession(ctx, userID, sessionID)
 	if err != nil {
 		return err
 	}
-
+	if !deleted {
+		return auth.ErrSessionNotFound
+	}
 	return nil
 }
 
+// RevokeOtherSessions cierra todas las sesiones del usuario excepto sessionID
+func (s *JWTService) RevokeOtherSessions(ctx context.Context, userID int64, sessionID string) error {
+	return s.tokenStore.DeleteOtherUserSessions(ctx, userID, sessionID)
+}
+
 // RefreshToken refresca un token JWT
 func (s *JWTService) RefreshToken(ctx context.Context, refreshToken string) (*auth.TokenDetails, error) {
 	// Verificar si el token es válido
@@ -229,27 +314,28 @@ func (s *JWTService) RefreshToken(ctx context.Context, refreshToken string) (*au
 	}
 	userID := int64(userIDFloat)
 
-	// Verificar si el token existe en la caché/redis
-	exists, err := s.tokenStore.CheckToken(ctx, refreshUUID)
-	if err != nil {
-		return nil, err
-	}
-
-	if !exists {
+	sessionID, ok := claims["session_id"].(string)
+	if !ok || sessionID == "" {
 		return nil, ErrInvalidToken
 	}
 
-	// Eliminar el token de refresco actual
-	err = s.tokenStore.DeleteToken(ctx, refreshUUID)
+	// Generar nuevos tokens para la misma sesión
+	u := &user.User{
+		ID: userID,
+	}
+	td, err := s.signTokens(u, sessionID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Crear un nuevo usuario temporal para generar nuevos tokens
-	u := &user.User{
-		ID: userID,
+	// Rotar los tokens; falla si la sesión se cerró o el token de refresco ya se usó
+	rotated, err := s.tokenStore.RotateSession(ctx, refreshUUID, sessionFromDetails(ctx, userID, td))
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		return nil, ErrInvalidToken
 	}
 
-	// Generar nuevos tokens
-	return s.CreateToken(ctx, u)
+	return td, nil
 }
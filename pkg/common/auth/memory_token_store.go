@@ -2,84 +2,171 @@ package auth
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/your-org/jvairv2/pkg/domain/auth"
 )
 
-// MemoryTokenStore implementa TokenStore usando un mapa en memoria
+// MemoryTokenStore implementa TokenStore usando un mapa en memoria.
+// Las sesiones se pierden al reiniciar y no se comparten entre instancias.
 type MemoryTokenStore struct {
-	tokens map[string]tokenInfo
-	mu     sync.RWMutex
-}
-
-type tokenInfo struct {
-	userID     int64
-	expiration time.Time
+	sessions map[string]*auth.Session
+	mu       sync.RWMutex
 }
 
 // NewMemoryTokenStore crea una nueva instancia de MemoryTokenStore
 func NewMemoryTokenStore() *MemoryTokenStore {
 	return &MemoryTokenStore{
-		tokens: make(map[string]tokenInfo),
+		sessions: make(map[string]*auth.Session),
 	}
 }
 
-// StoreToken almacena un token en memoria
-func (s *MemoryTokenStore) StoreToken(ctx context.Context, userID int64, tokenID string, expiration time.Duration) error {
+// CreateSession registra una sesión nueva en memoria
+func (s *MemoryTokenStore) CreateSession(ctx context.Context, session *auth.Session) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.tokens[tokenID] = tokenInfo{
-		userID:     userID,
-		expiration: time.Now().Add(expiration),
-	}
+	stored := *session
+	s.sessions[session.ID] = &stored
 
 	return nil
 }
 
-// DeleteToken elimina un token de memoria
+// RotateSession reemplaza los tokens de la sesión si refreshUUID sigue vigente en ella
+func (s *MemoryTokenStore) RotateSession(ctx context.Context, refreshUUID string, session *auth.Session) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, exists := s.sessions[session.ID]
+	if !exists || current.RefreshUUID != refreshUUID || current.IsExpired(time.Now()) {
+		return false, nil
+	}
+
+	current.AccessUUID = session.AccessUUID
+	current.RefreshUUID = session.RefreshUUID
+	current.AccessExpiresAt = session.AccessExpiresAt
+	current.RefreshExpiresAt = session.RefreshExpiresAt
+	if session.UserAgent != "" {
+		current.UserAgent = session.UserAgent
+	}
+	if session.IPAddress != "" {
+		current.IPAddress = session.IPAddress
+	}
+
+	return true, nil
+}
+
+// CheckToken verifica si un token pertenece a una sesión y no ha expirado
+func (s *MemoryTokenStore) CheckToken(ctx context.Context, tokenID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, session := range s.sessions {
+		if session.AccessUUID == tokenID && now.Before(session.AccessExpiresAt) {
+			session.LastSeenAt = &now
+			return true, nil
+		}
+		if session.RefreshUUID == tokenID && now.Before(session.RefreshExpiresAt) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// DeleteToken elimina de memoria la sesión del token
 func (s *MemoryTokenStore) DeleteToken(ctx context.Context, tokenID string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.tokens, tokenID)
+	for id, session := range s.sessions {
+		if session.AccessUUID == tokenID || session.RefreshUUID == tokenID {
+			delete(s.sessions, id)
+		}
+	}
+
+	return nil
+}
+
+// DeleteUserTokens elimina de memoria todas las sesiones de un usuario
+func (s *MemoryTokenStore) DeleteUserTokens(ctx context.Context, userID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, session := range s.sessions {
+		if session.UserID == userID {
+			delete(s.sessions, id)
+		}
+	}
 
 	return nil
 }
 
-// CheckToken verifica si un token existe y no ha expirado
-func (s *MemoryTokenStore) CheckToken(ctx context.Context, tokenID string) (bool, error) {
+// ListUserSessions retorna las sesiones vigentes de un usuario, la más reciente primero
+func (s *MemoryTokenStore) ListUserSessions(ctx context.Context, userID int64) ([]*auth.Session, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	info, exists := s.tokens[tokenID]
-	if !exists {
-		return false, nil
+	now := time.Now()
+	sessions := []*auth.Session{}
+	for _, session := range s.sessions {
+		if session.UserID == userID && !session.IsExpired(now) {
+			copied := *session
+			sessions = append(sessions, &copied)
+		}
 	}
 
-	// Verificar si el token ha expirado
-	if time.Now().After(info.expiration) {
-		// Eliminar token expirado
-		go func() {
-			s.mu.Lock()
-			defer s.mu.Unlock()
-			delete(s.tokens, tokenID)
-		}()
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+
+	return sessions, nil
+}
+
+// DeleteUserSession elimina una sesión del usuario
+func (s *MemoryTokenStore) DeleteUserSession(ctx context.Context, userID int64, sessionID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, exists := s.sessions[sessionID]
+	if !exists || session.UserID != userID {
 		return false, nil
 	}
+	delete(s.sessions, sessionID)
 
 	return true, nil
 }
 
-// CleanupExpired elimina tokens expirados
-func (s *MemoryTokenStore) CleanupExpired() {
+// DeleteOtherUserSessions elimina las sesiones del usuario excepto sessionID
+func (s *MemoryTokenStore) DeleteOtherUserSessions(ctx context.Context, userID int64, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, session := range s.sessions {
+		if session.UserID == userID && id != sessionID {
+			delete(s.sessions, id)
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpired elimina las sesiones expiradas
+func (s *MemoryTokenStore) DeleteExpired(ctx context.Context) (int64, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	var deleted int64
 	now := time.Now()
-	for tokenID, info := range s.tokens {
-		if now.After(info.expiration) {
-			delete(s.tokens, tokenID)
+	for id, session := range s.sessions {
+		if session.IsExpired(now) {
+			delete(s.sessions, id)
+			deleted++
 		}
 	}
+
+	return deleted, nil
 }
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ExpirySweeper elimina periódicamente las sesiones expiradas del TokenStore
+type ExpirySweeper struct {
+	store    TokenStore
+	interval time.Duration
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewExpirySweeper crea un barrido de sesiones expiradas cada interval
+func NewExpirySweeper(store TokenStore, interval time.Duration) *ExpirySweeper {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &ExpirySweeper{
+		store:    store,
+		interval: interval,
+	}
+}
+
+// Start inicia el barrido en segundo plano; llamarlo de nuevo no tiene efecto
+func (s *ExpirySweeper) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	go s.loop(context.WithoutCancel(ctx), s.stop, s.done)
+
+	slog.InfoContext(ctx, "Session sweeper started",
+		slog.Duration("interval", s.interval))
+}
+
+// Stop detiene el barrido y espera a que termine el que esté en curso
+func (s *ExpirySweeper) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop == nil {
+		return nil
+	}
+
+	close(stop)
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *ExpirySweeper) loop(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.Sweep(ctx)
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep elimina una vez las sesiones expiradas
+func (s *ExpirySweeper) Sweep(ctx context.Context) {
+	deleted, err := s.store.DeleteExpired(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete expired sessions",
+			slog.String("error", err.Error()))
+		return
+	}
+	if deleted > 0 {
+		slog.InfoContext(ctx, "Expired sessions deleted",
+			slog.Int64("count", deleted))
+	}
+}
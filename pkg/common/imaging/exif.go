@@ -0,0 +1,247 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Marcadores JPEG relevantes
+const (
+	markerSOI  = 0xD8
+	markerAPP1 = 0xE1
+	markerSOS  = 0xDA
+	markerEOI  = 0xD9
+)
+
+// Etiquetas EXIF usadas
+const (
+	tagOrientation = 0x0112
+	tagGPSInfo     = 0x8825
+)
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	xmpGPS     = []byte("exif:GPS")
+)
+
+// typeSizes es el tamaño en bytes de cada tipo de dato TIFF
+var typeSizes = map[uint16]uint32{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+// segment es un segmento de cabecera JPEG; start apunta al marcador y end al byte siguiente
+type segment struct {
+	marker     byte
+	start, end int
+}
+
+// payload retorna los datos del segmento sin marcador ni longitud
+func (s segment) payload(data []byte) []byte {
+	return data[s.start+4 : s.end]
+}
+
+// segments recorre los segmentos de cabecera de un JPEG hasta el inicio de los datos de imagen
+func segments(data []byte) []segment {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return nil
+	}
+
+	var result []segment
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return result
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			// Relleno entre marcadores
+			pos++
+			continue
+		}
+		if marker == markerSOS || marker == markerEOI {
+			return result
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return result
+		}
+		result = append(result, segment{marker: marker, start: pos, end: pos + 2 + length})
+		pos += 2 + length
+	}
+	return result
+}
+
+// tiff da acceso a la estructura TIFF contenida en el segmento EXIF
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func newTIFF(data []byte) (*tiff, bool) {
+	if len(data) < 8 {
+		return nil, false
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, false
+	}
+	if order.Uint16(data[2:]) != 42 {
+		return nil, false
+	}
+	return &tiff{data: data, order: order}, true
+}
+
+// ifd0 retorna el desplazamiento del primer IFD
+func (t *tiff) ifd0() uint32 {
+	return t.order.Uint32(t.data[4:])
+}
+
+// entries retorna el número de entradas de un IFD, o false si no cabe en los datos
+func (t *tiff) entries(offset uint32) (int, bool) {
+	if uint64(offset)+2 > uint64(len(t.data)) {
+		return 0, false
+	}
+	n := int(t.order.Uint16(t.data[offset:]))
+	if uint64(offset)+2+uint64(n)*12+4 > uint64(len(t.data)) {
+		return 0, false
+	}
+	return n, true
+}
+
+// find busca una etiqueta en un IFD y retorna el desplazamiento de su entrada
+func (t *tiff) find(ifd uint32, tag uint16) (uint32, bool) {
+	n, ok := t.entries(ifd)
+	if !ok {
+		return 0, false
+	}
+	for i := 0; i < n; i++ {
+		entry := ifd + 2 + uint32(i)*12
+		if t.order.Uint16(t.data[entry:]) == tag {
+			return entry, true
+		}
+	}
+	return 0, false
+}
+
+// exifTIFF localiza la estructura TIFF del segmento EXIF de un JPEG
+func exifTIFF(data []byte) (*tiff, segment, bool) {
+	for _, s := range segments(data) {
+		if s.marker != markerAPP1 || !bytes.HasPrefix(s.payload(data), exifHeader) {
+			continue
+		}
+		t, ok := newTIFF(s.payload(data)[len(exifHeader):])
+		return t, s, ok
+	}
+	return nil, segment{}, false
+}
+
+// ReadOrientation retorna la orientación EXIF (1 a 8) de un JPEG; 1 si no la indica
+func ReadOrientation(data []byte) int {
+	t, _, ok := exifTIFF(data)
+	if !ok {
+		return 1
+	}
+
+	entry, ok := t.find(t.ifd0(), tagOrientation)
+	if !ok || t.order.Uint16(t.data[entry+2:]) != 3 {
+		return 1
+	}
+
+	orientation := int(t.order.Uint16(t.data[entry+8:]))
+	if orientation < 1 || orientation > 8 {
+		return 1
+	}
+	return orientation
+}
+
+// StripGPS retorna una copia del JPEG sin datos de ubicación y si se eliminó algo.
+// El IFD de GPS se vacía en el sitio para no mover el resto del EXIF; si el EXIF no se
+// puede interpretar se elimina el segmento completo. También se descarta el XMP con GPS.
+func StripGPS(data []byte) ([]byte, bool) {
+	segs := segments(data)
+	if len(segs) == 0 {
+		return data, false
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:2]...)
+	stripped := false
+	last := 2
+
+	for _, s := range segs {
+		out = append(out, data[last:s.start]...)
+		last = s.end
+		raw := data[s.start:s.end]
+
+		if s.marker == markerAPP1 {
+			payload := s.payload(data)
+			switch {
+			case bytes.HasPrefix(payload, exifHeader):
+				cleaned, changed, ok := clearGPS(raw)
+				if !ok {
+					stripped = true
+					continue
+				}
+				stripped = stripped || changed
+				raw = cleaned
+			case bytes.HasPrefix(payload, xmpHeader) && bytes.Contains(payload, xmpGPS):
+				stripped = true
+				continue
+			}
+		}
+		out = append(out, raw...)
+	}
+	out = append(out, data[last:]...)
+
+	if !stripped {
+		return data, false
+	}
+	return out, true
+}
+
+// clearGPS vacía el IFD de GPS de un segmento EXIF. ok es false si el EXIF está dañado.
+func clearGPS(raw []byte) (cleaned []byte, changed, ok bool) {
+	cleaned = append([]byte(nil), raw...)
+	t, valid := newTIFF(cleaned[4+len(exifHeader):])
+	if !valid {
+		return nil, false, false
+	}
+
+	if _, valid := t.entries(t.ifd0()); !valid {
+		return nil, false, false
+	}
+	entry, found := t.find(t.ifd0(), tagGPSInfo)
+	if !found {
+		return cleaned, false, true
+	}
+
+	gps := t.order.Uint32(t.data[entry+8:])
+	n, valid := t.entries(gps)
+	if !valid {
+		return nil, false, false
+	}
+
+	for i := 0; i < n; i++ {
+		e := gps + 2 + uint32(i)*12
+		size := uint64(typeSizes[t.order.Uint16(t.data[e+2:])]) * uint64(t.order.Uint32(t.data[e+4:]))
+		if size > 4 {
+			// El valor está fuera de la entrada; se borra donde esté
+			offset := uint64(t.order.Uint32(t.data[e+8:]))
+			if offset+size > uint64(len(t.data)) {
+				return nil, false, false
+			}
+			clear(t.data[offset : offset+size])
+		}
+	}
+
+	// Se deja un IFD vacío al que sigue apuntando la etiqueta GPSInfo
+	clear(t.data[gps : gps+2+uint32(n)*12+4])
+	return cleaned, true, true
+}
@@ -0,0 +1,283 @@
+// Package imaging genera variantes redimensionadas de imágenes en Go puro: aplica la
+// orientación EXIF, reduce con promedio de área y elimina la ubicación GPS de los JPEG.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+// DefaultMaxPixels limita el tamaño de las imágenes a decodificar para evitar
+// agotar la memoria con imágenes muy grandes o malformadas (≈ 60 megapíxeles)
+const DefaultMaxPixels = 60_000_000
+
+// DefaultQuality es la calidad JPEG de las variantes
+const DefaultQuality = 82
+
+var (
+	// ErrUnsupportedFormat se retorna cuando la imagen no es JPEG, PNG ni GIF
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+
+	// ErrTooManyPixels se retorna cuando la imagen supera el límite de píxeles
+	ErrTooManyPixels = errors.New("image exceeds maximum pixel count")
+)
+
+// Formatos que se pueden decodificar
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+)
+
+var contentTypes = map[string]string{
+	"image/jpeg": FormatJPEG,
+	"image/png":  FormatPNG,
+	"image/gif":  FormatGIF,
+}
+
+// Supported indica si se pueden generar variantes para el tipo de contenido
+func Supported(contentType string) bool {
+	_, ok := contentTypes[contentType]
+	return ok
+}
+
+// Decode decodifica la imagen comprobando antes sus dimensiones. También retorna la
+// orientación EXIF, que se aplica después de reducir para no rotar la imagen completa.
+func Decode(data []byte, maxPixels int) (image.Image, int, error) {
+	if maxPixels <= 0 {
+		maxPixels = DefaultMaxPixels
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, 0, ErrUnsupportedFormat
+		}
+		return nil, 0, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return nil, 0, ErrTooManyPixels
+	}
+
+	var img image.Image
+	switch format {
+	case FormatJPEG:
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	case FormatPNG:
+		img, err = png.Decode(bytes.NewReader(data))
+	case FormatGIF:
+		// Solo el primer fotograma
+		img, err = gif.Decode(bytes.NewReader(data))
+	default:
+		return nil, 0, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orientation := 1
+	if format == FormatJPEG {
+		orientation = ReadOrientation(data)
+	}
+	return img, orientation, nil
+}
+
+// Variant reduce la imagen para que quepa en maxSide y la deja derecha según la orientación
+func Variant(img image.Image, orientation, maxSide int) image.Image {
+	// La reducción es simétrica, así que se puede rotar después sobre la imagen pequeña
+	return Orient(Fit(img, maxSide), orientation)
+}
+
+// Encode codifica la imagen en JPEG o PNG. Las imágenes con transparencia
+// (PNG y GIF de origen) se mantienen en PNG; el resto se codifica en JPEG.
+// Retorna el tipo de contenido generado. Al recodificar no se copia ningún metadato.
+func Encode(w io.Writer, img image.Image, sourceContentType string, quality int) (string, error) {
+	if contentTypes[sourceContentType] != FormatJPEG {
+		encoder := png.Encoder{CompressionLevel: png.BestSpeed}
+		return "image/png", encoder.Encode(w, img)
+	}
+
+	if quality <= 0 || quality > 100 {
+		quality = DefaultQuality
+	}
+	return "image/jpeg", jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+}
+
+// Fit reduce la imagen para que ningún lado supere maxSide, manteniendo la proporción.
+// Nunca amplía: si la imagen ya cabe se retorna tal cual.
+func Fit(img image.Image, maxSide int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if maxSide <= 0 || (w <= maxSide && h <= maxSide) {
+		return img
+	}
+
+	dw, dh := maxSide, maxSide
+	if w >= h {
+		dh = max(1, (h*maxSide+w/2)/w)
+	} else {
+		dw = max(1, (w*maxSide+h/2)/h)
+	}
+	return resize(img, dw, dh)
+}
+
+// Orient rota o refleja la imagen según la orientación EXIF (1 a 8)
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	src := toRGBA(img)
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			si := y*src.Stride + x*4
+			di := dy*dst.Stride + dx*4
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// toRGBA convierte la imagen a RGBA con origen en (0, 0)
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, b.Min, draw.Src)
+	return rgba
+}
+
+// readRow escribe en row los valores RGBA premultiplicados de la fila y de la imagen
+func readRow(img image.Image, y int, row []float32) {
+	b := img.Bounds()
+	switch src := img.(type) {
+	case *image.YCbCr:
+		for x := 0; x < b.Dx(); x++ {
+			yi := src.YOffset(b.Min.X+x, b.Min.Y+y)
+			ci := src.COffset(b.Min.X+x, b.Min.Y+y)
+			r, g, bl := color.YCbCrToRGB(src.Y[yi], src.Cb[ci], src.Cr[ci])
+			row[x*4], row[x*4+1], row[x*4+2], row[x*4+3] = float32(r), float32(g), float32(bl), 255
+		}
+	case *image.RGBA:
+		pix := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+		for i := 0; i < b.Dx()*4; i++ {
+			row[i] = float32(pix[i])
+		}
+	case *image.Gray:
+		pix := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+		for x := 0; x < b.Dx(); x++ {
+			v := float32(pix[x])
+			row[x*4], row[x*4+1], row[x*4+2], row[x*4+3] = v, v, v, 255
+		}
+	default:
+		for x := 0; x < b.Dx(); x++ {
+			r, g, bl, a := img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			row[x*4], row[x*4+1], row[x*4+2], row[x*4+3] = float32(r>>8), float32(g>>8), float32(bl>>8), float32(a>>8)
+		}
+	}
+}
+
+// contribution es el peso de un píxel de origen en un píxel de destino
+type contribution struct {
+	index  int
+	weight float32
+}
+
+// weights calcula, para cada píxel de destino, la fracción de área que aporta
+// cada píxel de origen al reducir de srcLen a dstLen
+func weights(srcLen, dstLen int) [][]contribution {
+	scale := float64(srcLen) / float64(dstLen)
+	result := make([][]contribution, dstLen)
+	for i := range result {
+		start, end := float64(i)*scale, float64(i+1)*scale
+		for j := int(start); j < srcLen && float64(j) < end; j++ {
+			covered := min(end, float64(j+1)) - max(start, float64(j))
+			if covered > 0 {
+				result[i] = append(result[i], contribution{index: j, weight: float32(covered / scale)})
+			}
+		}
+	}
+	return result
+}
+
+// resize reduce la imagen por promedio de área, fila a fila, para no tener en memoria
+// más que la imagen de origen y la de destino
+func resize(img image.Image, dw, dh int) *image.RGBA {
+	switch img.(type) {
+	case *image.YCbCr, *image.RGBA, *image.Gray:
+	default:
+		img = toRGBA(img)
+	}
+
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	xWeights := weights(sw, dw)
+	yWeights := weights(sh, dh)
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	srcRow := make([]float32, sw*4)
+	rowCache := make([]float32, dw*4)
+	acc := make([]float32, dw*4)
+	cached := -1
+
+	for y, ys := range yWeights {
+		clear(acc)
+		for _, cy := range ys {
+			if cached != cy.index {
+				readRow(img, cy.index, srcRow)
+				clear(rowCache)
+				for x, xs := range xWeights {
+					for _, cx := range xs {
+						for c := 0; c < 4; c++ {
+							rowCache[x*4+c] += srcRow[cx.index*4+c] * cx.weight
+						}
+					}
+				}
+				cached = cy.index
+			}
+			for i, v := range rowCache {
+				acc[i] += v * cy.weight
+			}
+		}
+
+		pix := dst.Pix[y*dst.Stride:]
+		for i, v := range acc {
+			pix[i] = uint8(min(255, max(0, v+0.5)))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,428 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// latitude son los tres racionales de GPSLatitude que deben desaparecer al limpiar
+var latitude = []uint32{25, 1, 46, 1, 3000, 100}
+
+// exifTIFFData arma un TIFF con IFD0 (Orientation y, si gps, GPSInfo) y un IFD de GPS
+// con GPSLatitude fuera de la entrada, en el orden de bytes indicado
+func exifTIFFData(order binary.AppendByteOrder, orientation uint16, gps bool) []byte {
+	var data []byte
+	if order == binary.AppendByteOrder(binary.LittleEndian) {
+		data = []byte("II")
+	} else {
+		data = []byte("MM")
+	}
+	data = order.AppendUint16(data, 42)
+	data = order.AppendUint32(data, 8)
+
+	entries := uint16(1)
+	if gps {
+		entries = 2
+	}
+	data = order.AppendUint16(data, entries)
+	data = order.AppendUint16(data, tagOrientation)
+	data = order.AppendUint16(data, 3)
+	data = order.AppendUint32(data, 1)
+	data = order.AppendUint16(data, orientation)
+	data = order.AppendUint16(data, 0)
+	if !gps {
+		return order.AppendUint32(data, 0)
+	}
+
+	// IFD0 ocupa 8 + 2 + 2*12 + 4 = 38 bytes; el IFD de GPS ocupa 2 + 12 + 4 = 18
+	data = order.AppendUint16(data, tagGPSInfo)
+	data = order.AppendUint16(data, 4)
+	data = order.AppendUint32(data, 1)
+	data = order.AppendUint32(data, 38)
+	data = order.AppendUint32(data, 0)
+
+	data = order.AppendUint16(data, 1)
+	data = order.AppendUint16(data, 0x0002)
+	data = order.AppendUint16(data, 5)
+	data = order.AppendUint32(data, 3)
+	data = order.AppendUint32(data, 56)
+	data = order.AppendUint32(data, 0)
+	for _, v := range latitude {
+		data = order.AppendUint32(data, v)
+	}
+	return data
+}
+
+// app1 arma un segmento APP1 con el payload indicado
+func app1(payload []byte) []byte {
+	seg := binary.BigEndian.AppendUint16([]byte{0xFF, markerAPP1}, uint16(len(payload)+2))
+	return append(seg, payload...)
+}
+
+// exifSegment arma el segmento APP1 de EXIF con el TIFF indicado
+func exifSegment(tiff []byte) []byte {
+	return app1(append(append([]byte{}, exifHeader...), tiff...))
+}
+
+// withSegments retorna un JPEG real de 4x2 con los segmentos insertados tras SOI
+func withSegments(t *testing.T, segs ...[]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 4, 2)), nil))
+
+	data := buf.Bytes()
+	out := append([]byte{}, data[:2]...)
+	for _, s := range segs {
+		out = append(out, s...)
+	}
+	return append(out, data[2:]...)
+}
+
+// rationals retorna los racionales codificados como aparecen en el TIFF
+func rationals(order binary.AppendByteOrder, values []uint32) []byte {
+	var out []byte
+	for _, v := range values {
+		out = order.AppendUint32(out, v)
+	}
+	return out
+}
+
+var byteOrders = []struct {
+	name  string
+	order binary.AppendByteOrder
+}{
+	{"little endian", binary.LittleEndian},
+	{"big endian", binary.BigEndian},
+}
+
+func TestReadOrientation(t *testing.T) {
+	for _, bo := range byteOrders {
+		for orientation := uint16(1); orientation <= 8; orientation++ {
+			data := withSegments(t, exifSegment(exifTIFFData(bo.order, orientation, true)))
+			assert.Equal(t, int(orientation), ReadOrientation(data), "%s orientation %d", bo.name, orientation)
+		}
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no exif", withSegments(t)},
+		{"not a jpeg", []byte("\x89PNG\r\n\x1a\n")},
+		{"orientation out of range", withSegments(t, exifSegment(exifTIFFData(binary.BigEndian, 9, false)))},
+		{"orientation zero", withSegments(t, exifSegment(exifTIFFData(binary.LittleEndian, 0, false)))},
+		{"invalid tiff header", withSegments(t, exifSegment([]byte("XX\x00\x2a\x00\x00\x00\x08")))},
+		{"other app1 segment", withSegments(t, app1([]byte("Other\x00data")))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, 1, ReadOrientation(tt.data))
+		})
+	}
+
+	t.Run("orientation with wrong type", func(t *testing.T) {
+		tiff := exifTIFFData(binary.BigEndian, 6, false)
+		// Tipo LONG en lugar de SHORT
+		binary.BigEndian.PutUint16(tiff[12:], 4)
+		assert.Equal(t, 1, ReadOrientation(withSegments(t, exifSegment(tiff))))
+	})
+}
+
+// labeled arma una imagen de 3x2 cuyos píxeles se identifican por la letra en el canal rojo:
+//
+//	a b c
+//	d e f
+func labeled() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for i, label := range "abcdef" {
+		img.Set(i%3, i/3, color.RGBA{R: uint8(label), A: 255})
+	}
+	return img
+}
+
+// labels retorna las filas de la imagen como letras
+func labels(img image.Image) []string {
+	b := img.Bounds()
+	rows := make([]string, 0, b.Dy())
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		var row []byte
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, _, _, _ := img.At(x, y).RGBA()
+			row = append(row, byte(r>>8))
+		}
+		rows = append(rows, string(row))
+	}
+	return rows
+}
+
+func TestOrient(t *testing.T) {
+	// Resultado esperado de mostrar derecha la imagen guardada con cada orientación EXIF
+	tests := []struct {
+		orientation int
+		want        []string
+	}{
+		{1, []string{"abc", "def"}},
+		{2, []string{"cba", "fed"}},     // espejo horizontal
+		{3, []string{"fed", "cba"}},     // 180°
+		{4, []string{"def", "abc"}},     // espejo vertical
+		{5, []string{"ad", "be", "cf"}}, // transpuesta
+		{6, []string{"da", "eb", "fc"}}, // 90° horario
+		{7, []string{"fc", "eb", "da"}}, // transversa
+		{8, []string{"cf", "be", "ad"}}, // 90° antihorario
+		{0, []string{"abc", "def"}},     // inválida: sin cambios
+		{9, []string{"abc", "def"}},     // inválida: sin cambios
+	}
+
+	for _, tt := range tests {
+		got := Orient(labeled(), tt.orientation)
+		assert.Equal(t, tt.want, labels(got), "orientation %d", tt.orientation)
+		assert.Equal(t, image.Point{}, got.Bounds().Min, "orientation %d", tt.orientation)
+	}
+
+	t.Run("non zero origin", func(t *testing.T) {
+		sub := image.NewRGBA(image.Rect(0, 0, 5, 4)).SubImage(image.Rect(1, 1, 4, 3)).(*image.RGBA)
+		for i, label := range "abcdef" {
+			sub.Set(1+i%3, 1+i/3, color.RGBA{R: uint8(label), A: 255})
+		}
+		assert.Equal(t, []string{"da", "eb", "fc"}, labels(Orient(sub, 6)))
+	})
+}
+
+func TestStripGPS(t *testing.T) {
+	for _, bo := range byteOrders {
+		t.Run(bo.name, func(t *testing.T) {
+			tiff := exifTIFFData(bo.order, 6, true)
+			original := withSegments(t, exifSegment(tiff))
+			before := append([]byte{}, original...)
+
+			stripped, changed := StripGPS(original)
+
+			assert.True(t, changed)
+			assert.Equal(t, before, original, "the input must not be modified")
+			assert.Len(t, stripped, len(original))
+			assert.Equal(t, 6, ReadOrientation(stripped))
+			assert.True(t, bytes.Contains(original, rationals(bo.order, latitude)))
+			assert.False(t, bytes.Contains(stripped, rationals(bo.order, latitude)))
+
+			// El IFD de GPS queda vacío y la imagen se sigue pudiendo decodificar
+			tr, _, ok := exifTIFF(stripped)
+			require.True(t, ok)
+			entry, ok := tr.find(tr.ifd0(), tagGPSInfo)
+			require.True(t, ok)
+			n, ok := tr.entries(tr.order.Uint32(tr.data[entry+8:]))
+			assert.True(t, ok)
+			assert.Zero(t, n)
+			_, err := jpeg.Decode(bytes.NewReader(stripped))
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("without gps", func(t *testing.T) {
+		original := withSegments(t, exifSegment(exifTIFFData(binary.BigEndian, 3, false)))
+
+		stripped, changed := StripGPS(original)
+
+		assert.False(t, changed)
+		assert.Equal(t, original, stripped)
+	})
+
+	t.Run("xmp with gps is removed", func(t *testing.T) {
+		xmp := app1(append(append([]byte{}, xmpHeader...), `<x:xmpmeta><rdf:Description exif:GPSLatitude="25,46.5N"/></x:xmpmeta>`...))
+		original := withSegments(t, exifSegment(exifTIFFData(binary.LittleEndian, 1, false)), xmp)
+
+		stripped, changed := StripGPS(original)
+
+		assert.True(t, changed)
+		assert.Len(t, stripped, len(original)-len(xmp))
+		assert.False(t, bytes.Contains(stripped, []byte("GPSLatitude")))
+		_, err := jpeg.Decode(bytes.NewReader(stripped))
+		assert.NoError(t, err)
+	})
+
+	t.Run("xmp without gps is kept", func(t *testing.T) {
+		xmp := app1(append(append([]byte{}, xmpHeader...), `<x:xmpmeta><rdf:Description dc:title="Unit"/></x:xmpmeta>`...))
+		original := withSegments(t, xmp)
+
+		stripped, changed := StripGPS(original)
+
+		assert.False(t, changed)
+		assert.Equal(t, original, stripped)
+	})
+
+	t.Run("unreadable exif is removed", func(t *testing.T) {
+		tiff := exifTIFFData(binary.BigEndian, 6, true)
+		// GPSInfo apunta fuera del TIFF
+		binary.BigEndian.PutUint32(tiff[30:], 0xFFFFFF00)
+		segment := exifSegment(tiff)
+		original := withSegments(t, segment)
+
+		stripped, changed := StripGPS(original)
+
+		assert.True(t, changed)
+		assert.Len(t, stripped, len(original)-len(segment))
+		assert.Equal(t, 1, ReadOrientation(stripped))
+	})
+
+	t.Run("not a jpeg", func(t *testing.T) {
+		data := []byte("GIF89a")
+		stripped, changed := StripGPS(data)
+		assert.False(t, changed)
+		assert.Equal(t, data, stripped)
+	})
+}
+
+func TestMalformedMetadataDoesNotPanic(t *testing.T) {
+	valid := withSegments(t, exifSegment(exifTIFFData(binary.BigEndian, 6, true)))
+	exifStart, exifEnd := 2, 2+len(exifSegment(exifTIFFData(binary.BigEndian, 6, true)))
+
+	check := func(t *testing.T, name string, data []byte) {
+		t.Helper()
+		assert.NotPanics(t, func() {
+			orientation := ReadOrientation(data)
+			assert.True(t, orientation >= 1 && orientation <= 8, name)
+			StripGPS(data)
+		}, name)
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		for n := 0; n <= exifEnd+4; n++ {
+			check(t, "prefix", valid[:n])
+		}
+	})
+
+	t.Run("corrupted bytes", func(t *testing.T) {
+		for i := exifStart; i < exifEnd; i++ {
+			for _, v := range []byte{0x00, 0x7F, 0xFF} {
+				data := append([]byte{}, valid...)
+				data[i] = v
+				check(t, "corrupted", data)
+			}
+		}
+	})
+
+	tiffWith := func(mutate func(tiff []byte)) []byte {
+		tiff := exifTIFFData(binary.BigEndian, 6, true)
+		mutate(tiff)
+		return withSegments(t, exifSegment(tiff))
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"ifd0 offset out of range", tiffWith(func(d []byte) { binary.BigEndian.PutUint32(d[4:], 0xFFFFFFFF) })},
+		{"ifd0 entry count too large", tiffWith(func(d []byte) { binary.BigEndian.PutUint16(d[8:], 0xFFFF) })},
+		{"gps ifd offset out of range", tiffWith(func(d []byte) { binary.BigEndian.PutUint32(d[30:], 0xFFFFFFF0) })},
+		{"gps entry count too large", tiffWith(func(d []byte) { binary.BigEndian.PutUint16(d[38:], 0xFFFF) })},
+		{"gps value offset out of range", tiffWith(func(d []byte) { binary.BigEndian.PutUint32(d[48:], 0xFFFFFFF0) })},
+		{"gps value count overflows", tiffWith(func(d []byte) { binary.BigEndian.PutUint32(d[44:], 0xFFFFFFFF) })},
+		{"gps unknown type", tiffWith(func(d []byte) { binary.BigEndian.PutUint16(d[42:], 0xFFFF) })},
+		{"segment length beyond data", append([]byte{0xFF, markerSOI, 0xFF, markerAPP1, 0xFF, 0xFF}, exifHeader...)},
+		{"segment length below minimum", []byte{0xFF, markerSOI, 0xFF, markerAPP1, 0x00, 0x01, 0xFF, markerEOI}},
+		{"empty exif segment", []byte{0xFF, markerSOI, 0xFF, markerAPP1, 0x00, 0x02, 0xFF, markerEOI}},
+		{"exif header only", withSegments(t, app1(exifHeader))},
+		{"padding only", []byte{0xFF, markerSOI, 0xFF, 0xFF, 0xFF, 0xFF}},
+		{"garbage after soi", []byte{0xFF, markerSOI, 0x12, 0x34, 0x56, 0x78}},
+	}
+	for _, tt := range tests {
+		check(t, tt.name, tt.data)
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxSide       int
+		wantW, wantH  int
+	}{
+		{"landscape", 40, 20, 10, 10, 5},
+		{"portrait", 20, 40, 10, 5, 10},
+		{"square", 30, 30, 7, 7, 7},
+		{"rounds to nearest", 30, 20, 4, 4, 3},
+		{"keeps at least one pixel", 1000, 1, 10, 10, 1},
+		{"non integer scale", 7, 5, 3, 3, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fit(image.NewRGBA(image.Rect(0, 0, tt.width, tt.height)), tt.maxSide)
+			assert.Equal(t, image.Rect(0, 0, tt.wantW, tt.wantH), got.Bounds())
+		})
+	}
+
+	t.Run("never enlarges", func(t *testing.T) {
+		img := image.NewRGBA(image.Rect(0, 0, 10, 5))
+		assert.Same(t, img, Fit(img, 20))
+		assert.Same(t, img, Fit(img, 10))
+		assert.Same(t, img, Fit(img, 0))
+	})
+}
+
+func TestResizeAveragesArea(t *testing.T) {
+	// Columnas alternas negras y blancas: al reducir a la mitad cada píxel promedia ambas
+	gray := image.NewGray(image.Rect(0, 0, 8, 4))
+	for x := 0; x < 8; x += 2 {
+		for y := 0; y < 4; y++ {
+			gray.SetGray(x, y, color.Gray{Y: 255})
+		}
+	}
+
+	paletted := image.NewPaletted(image.Rect(0, 0, 8, 4), color.Palette{color.Black, color.White})
+	for x := 0; x < 8; x += 2 {
+		for y := 0; y < 4; y++ {
+			paletted.SetColorIndex(x, y, 1)
+		}
+	}
+
+	ycbcr := image.NewYCbCr(image.Rect(0, 0, 8, 4), image.YCbCrSubsampleRatio444)
+	for i := range ycbcr.Y {
+		ycbcr.Y[i] = 0
+		if i%2 == 0 {
+			ycbcr.Y[i] = 255
+		}
+	}
+	for i := range ycbcr.Cb {
+		ycbcr.Cb[i], ycbcr.Cr[i] = 128, 128
+	}
+
+	for name, img := range map[string]image.Image{"gray": gray, "paletted": paletted, "ycbcr": ycbcr} {
+		t.Run(name, func(t *testing.T) {
+			got := Fit(img, 4)
+			require.Equal(t, image.Rect(0, 0, 4, 2), got.Bounds())
+			for y := 0; y < 2; y++ {
+				for x := 0; x < 4; x++ {
+					r, g, b, a := got.At(x, y).RGBA()
+					assert.InDelta(t, 128, r>>8, 1)
+					assert.InDelta(t, 128, g>>8, 1)
+					assert.InDelta(t, 128, b>>8, 1)
+					assert.Equal(t, uint32(255), a>>8)
+				}
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	data := withSegments(t, exifSegment(exifTIFFData(binary.LittleEndian, 8, false)))
+
+	img, orientation, err := Decode(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+	assert.Equal(t, 8, orientation)
+
+	_, _, err = Decode(data, 7)
+	assert.Equal(t, ErrTooManyPixels, err)
+
+	_, _, err = Decode([]byte("not an image"), 0)
+	assert.Equal(t, ErrUnsupportedFormat, err)
+
+	rotated := Variant(img, orientation, 2)
+	assert.Equal(t, image.Rect(0, 0, 1, 2), rotated.Bounds())
+}
@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"fmt"
+	"time"
+)
+
+// Drivers de envío soportados
+const (
+	DriverSMTP   = "smtp"
+	DriverFile   = "file"
+	DriverMemory = "memory"
+)
+
+// Config selecciona e inicializa el sender de correo
+type Config struct {
+	Driver   string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
+	Timeout  time.Duration
+	// Dir es el directorio de salida del driver file
+	Dir string
+}
+
+// New crea el sender correspondiente al driver configurado
+func New(config Config) (Sender, error) {
+	switch config.Driver {
+	case DriverSMTP:
+		if config.Host == "" || config.From == "" {
+			return nil, fmt.Errorf("mail: smtp driver requires host and from address")
+		}
+		return NewSMTPSender(SMTPConfig{
+			Host:     config.Host,
+			Port:     config.Port,
+			Username: config.Username,
+			Password: config.Password,
+			From:     config.From,
+			FromName: config.FromName,
+			Timeout:  config.Timeout,
+		}), nil
+	case DriverFile, "":
+		dir := config.Dir
+		if dir == "" {
+			dir = "storage/mail"
+		}
+		return NewFileSender(dir, config.From)
+	case DriverMemory:
+		return NewMemorySender(), nil
+	}
+	return nil, fmt.Errorf("mail: unknown driver %q", config.Driver)
+}
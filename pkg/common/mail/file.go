@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// FileSender escribe cada correo como archivo .eml en un directorio en lugar de enviarlo.
+// Sirve para desarrollo local: los archivos pueden abrirse con cualquier cliente de correo.
+type FileSender struct {
+	dir  string
+	from string
+	seq  atomic.Int64
+}
+
+// NewFileSender crea un nuevo sender que escribe en dir (se crea si no existe)
+func NewFileSender(dir, from string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileSender{dir: dir, from: from}, nil
+}
+
+// Send escribe el mensaje en un archivo
+func (s *FileSender) Send(ctx context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("%s-%04d.eml", now.Format("20060102-150405.000"), s.seq.Add(1))
+	return os.WriteFile(filepath.Join(s.dir, name), msg.Bytes(s.from, now), 0o644)
+}
@@ -0,0 +1,161 @@
+// Package mail define el envío de correos electrónicos y sus implementaciones.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoRecipients indica que el mensaje no tiene destinatarios
+	ErrNoRecipients = errors.New("mail message has no recipients")
+
+	// ErrInvalidAddress indica que alguna dirección de correo no es válida
+	ErrInvalidAddress = errors.New("invalid email address")
+)
+
+// Attachment es un archivo adjunto de un correo
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Message es un correo a enviar. Body es texto plano; HTML es opcional.
+type Message struct {
+	To          []string
+	Subject     string
+	Body        string
+	HTML        string
+	Attachments []Attachment
+}
+
+// Sender envía correos electrónicos
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// ValidAddress verifica que s sea una dirección de correo simple (sin nombre)
+func ValidAddress(s string) bool {
+	addr, err := netmail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+// Validate verifica que el mensaje tenga destinatarios válidos
+func (m *Message) Validate() error {
+	if len(m.To) == 0 {
+		return ErrNoRecipients
+	}
+	for _, to := range m.To {
+		if !ValidAddress(to) {
+			return fmt.Errorf("%w: %s", ErrInvalidAddress, to)
+		}
+	}
+	return nil
+}
+
+// Bytes construye el mensaje MIME (RFC 5322) listo para entregarse por SMTP
+func (m *Message) Bytes(from string, now time.Time) []byte {
+	var buf bytes.Buffer
+
+	header := func(k, v string) { fmt.Fprintf(&buf, "%s: %s\r\n", k, v) }
+	header("From", from)
+	header("To", strings.Join(m.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", randomToken(), domainOf(from)))
+	header("MIME-Version", "1.0")
+
+	if len(m.Attachments) == 0 {
+		writeBody(&buf, m)
+		return buf.Bytes()
+	}
+
+	boundary := "mixed-" + randomToken()
+	header("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%q", boundary))
+	buf.WriteString("\r\n")
+
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	writeBody(&buf, m)
+
+	for _, a := range m.Attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		fmt.Fprintf(&buf, "\r\n--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s\r\n", contentType)
+		buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+		fmt.Fprintf(&buf, "Content-Disposition: attachment; filename=%q\r\n\r\n", a.Filename)
+		writeBase64(&buf, a.Data)
+	}
+	fmt.Fprintf(&buf, "\r\n--%s--\r\n", boundary)
+
+	return buf.Bytes()
+}
+
+// writeBody escribe las cabeceras de contenido y el cuerpo (texto y, si existe, HTML)
+func writeBody(buf *bytes.Buffer, m *Message) {
+	if m.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		writeQuotedPrintable(buf, m.Body)
+		return
+	}
+
+	boundary := "alt-" + randomToken()
+	fmt.Fprintf(buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain", m.Body},
+		{"text/html", m.HTML},
+	} {
+		fmt.Fprintf(buf, "--%s\r\n", boundary)
+		fmt.Fprintf(buf, "Content-Type: %s; charset=utf-8\r\n", part.contentType)
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		writeQuotedPrintable(buf, part.content)
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(buf, "--%s--\r\n", boundary)
+}
+
+func writeQuotedPrintable(buf *bytes.Buffer, s string) {
+	w := quotedprintable.NewWriter(buf)
+	_, _ = w.Write([]byte(strings.ReplaceAll(s, "\n", "\r\n")))
+	_ = w.Close()
+}
+
+// writeBase64 escribe data en base64 con líneas de 76 caracteres
+func writeBase64(buf *bytes.Buffer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+}
+
+func randomToken() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func domainOf(addr string) string {
+	if parsed, err := netmail.ParseAddress(addr); err == nil {
+		addr = parsed.Address
+	}
+	if i := strings.LastIndex(addr, "@"); i >= 0 {
+		return addr[i+1:]
+	}
+	return "localhost"
+}
@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"context"
+	"sync"
+)
+
+// MemorySender guarda los correos en memoria en lugar de enviarlos.
+// Sirve para pruebas.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+	// Err, si no es nil, es el error que retorna Send
+	Err error
+}
+
+// NewMemorySender crea un nuevo sender en memoria
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send registra el mensaje
+func (s *MemorySender) Send(ctx context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.messages = append(s.messages, *msg)
+	return nil
+}
+
+// Messages retorna una copia de los mensajes enviados
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
@@ -0,0 +1,211 @@
+// Package money representa montos decimales con dos posiciones como enteros
+// (centésimas) para evitar errores de redondeo de punto flotante.
+// Se usa tanto para montos en dólares como para porcentajes (ej. 12.50%).
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Amount es un valor decimal con dos posiciones expresado en centésimas
+type Amount int64
+
+// Zero es el monto cero
+const Zero Amount = 0
+
+// MaxAmount es el mayor valor absoluto que admite una columna DECIMAL(8,2) (999999.99)
+const MaxAmount Amount = 99999999
+
+// ErrOutOfRange indica que el monto no cabe en una columna DECIMAL(8,2)
+var ErrOutOfRange = errors.New("money: amount out of range")
+
+// Parse interpreta una cadena decimal ("12", "12.5", "-3.25"). Más de dos decimales
+// se redondean a la centésima más cercana (mitad alejándose de cero). Los montos
+// que superan MaxAmount, ya redondeados, retornan ErrOutOfRange.
+func Parse(s string) (Amount, error) {
+	input := strings.TrimSpace(s)
+	if input == "" {
+		return Zero, fmt.Errorf("money: empty amount")
+	}
+
+	s = input
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return Zero, fmt.Errorf("money: invalid amount %q", input)
+	}
+	if !isDigits(intPart) || !isDigits(fracPart) {
+		return Zero, fmt.Errorf("money: invalid amount %q", input)
+	}
+
+	// Los ceros a la izquierda no cuentan para el rango
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if len(intPart) > len(strconv.FormatInt(int64(MaxAmount/100), 10)) {
+		return Zero, ErrOutOfRange
+	}
+
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return Zero, fmt.Errorf("money: invalid amount %q", input)
+	}
+
+	padded := fracPart + "00"
+	cents := int64(padded[0]-'0')*10 + int64(padded[1]-'0')
+	if len(fracPart) > 2 && fracPart[2] >= '5' {
+		cents++
+	}
+
+	value := units*100 + cents
+	if value > int64(MaxAmount) {
+		return Zero, ErrOutOfRange
+	}
+	if negative {
+		value = -value
+	}
+	return Amount(value), nil
+}
+
+// MustParse es como Parse pero hace panic si la cadena no es válida; útil en pruebas y constantes
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Cents retorna el monto en centésimas
+func (a Amount) Cents() int64 {
+	return int64(a)
+}
+
+// Float64 retorna el monto como float64; solo para presentación
+func (a Amount) Float64() float64 {
+	return float64(a) / 100
+}
+
+// IsNegative indica si el monto es menor que cero
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+// MulPercent aplica un porcentaje (también en centésimas, 12.50 = 12.50%) y redondea
+// a la centésima más cercana, con la mitad alejándose de cero
+func (a Amount) MulPercent(percent Amount) Amount {
+	return Amount(divRound(int64(a)*int64(percent), 10000))
+}
+
+// String formatea el monto con dos decimales (ej. "-12.50")
+func (a Amount) String() string {
+	v := int64(a)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// MarshalJSON serializa el monto como número JSON con dos decimales
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON acepta números o cadenas JSON sin pasar por float64
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		return nil
+	}
+	s = strings.Trim(s, `"`)
+	if s == "" {
+		*a = Zero
+		return nil
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Value implementa driver.Valuer; se envía como cadena para columnas DECIMAL
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan implementa sql.Scanner para columnas DECIMAL
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = Zero
+		return nil
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	case int64:
+		*a = Amount(v * 100)
+		return nil
+	case float64:
+		parsed, err := Parse(strconv.FormatFloat(v, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+}
+
+// divRound divide redondeando a la unidad más cercana, con la mitad alejándose de cero
+func divRound(n, d int64) int64 {
+	q, r := n/d, n%d
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= d {
+		if n < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
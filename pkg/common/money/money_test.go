@@ -0,0 +1,115 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Amount
+	}{
+		{"12", 1200},
+		{"12.5", 1250},
+		{"12.50", 1250},
+		{" 7.05 ", 705},
+		{".5", 50},
+		{"3.", 300},
+		{"+4.1", 410},
+		{"0", 0},
+		{"-0", 0},
+		{"007.25", 725},
+
+		// Redondeo a la centésima, la mitad alejándose de cero
+		{"1.234", 123},
+		{"1.235", 124},
+		{"1.2349", 123},
+		{"0.005", 1},
+		{"0.004", 0},
+		{"-1.235", -124},
+		{"-1.234", -123},
+		{"2.999", 300},
+
+		// Negativos
+		{"-3.25", -325},
+		{"-.5", -50},
+
+		// Límites de DECIMAL(8,2)
+		{"999999.99", MaxAmount},
+		{"-999999.99", -MaxAmount},
+		{"999999.994", MaxAmount},
+		{"0000000999999.99", MaxAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, in := range []string{"", "  ", "-", "+", ".", "-.", "+.", "abc", "1,5", "1.2.3", "--1", "+-1", "1e3", "1 000", "0x10", "1.-5", "12a"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := Parse(in)
+			assert.Error(t, err)
+			assert.NotEqual(t, ErrOutOfRange, err)
+		})
+	}
+}
+
+func TestParse_OutOfRange(t *testing.T) {
+	for _, in := range []string{"1000000", "-1000000", "999999.995", "99999999999999999999", "9223372036854775807", "92233720368547758.07"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := Parse(in)
+			assert.Equal(t, ErrOutOfRange, err)
+		})
+	}
+}
+
+func TestAmount_String(t *testing.T) {
+	assert.Equal(t, "0.00", Zero.String())
+	assert.Equal(t, "12.50", Amount(1250).String())
+	assert.Equal(t, "-0.05", Amount(-5).String())
+	assert.Equal(t, "999999.99", MaxAmount.String())
+}
+
+func TestAmount_MulPercent(t *testing.T) {
+	assert.Equal(t, Amount(1250), MustParse("100").MulPercent(MustParse("12.50")))
+	assert.Equal(t, Amount(2), MustParse("0.15").MulPercent(MustParse("10")))
+	assert.Equal(t, Amount(-2), MustParse("-0.15").MulPercent(MustParse("10")))
+}
+
+func TestAmount_JSON(t *testing.T) {
+	var v struct {
+		A Amount  `json:"a"`
+		B Amount  `json:"b"`
+		C *Amount `json:"c"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"a": 10.555, "b": "-2.5", "c": null}`), &v))
+	assert.Equal(t, Amount(1056), v.A)
+	assert.Equal(t, Amount(-250), v.B)
+	assert.Nil(t, v.C)
+
+	out, err := json.Marshal(v.A)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.56", string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"a": "-"}`), &v))
+}
+
+func TestAmount_Scan(t *testing.T) {
+	var a Amount
+	assert.NoError(t, a.Scan([]byte("12.34")))
+	assert.Equal(t, Amount(1234), a)
+	assert.NoError(t, a.Scan(int64(3)))
+	assert.Equal(t, Amount(300), a)
+	assert.NoError(t, a.Scan(nil))
+	assert.Equal(t, Zero, a)
+	assert.Error(t, a.Scan(true))
+}
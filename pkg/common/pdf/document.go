@@ -0,0 +1,329 @@
+// Package pdf genera documentos PDF sencillos (texto, tablas y líneas) sin
+// dependencias externas. Usa las fuentes estándar Helvetica y Helvetica-Bold,
+// por lo que no es necesario incrustar fuentes en el documento.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Tamaño carta en puntos
+const (
+	PageWidth  = 612.0
+	PageHeight = 792.0
+)
+
+// Align define la alineación horizontal del texto en una celda
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+)
+
+// Cell es una celda de una fila de tabla
+type Cell struct {
+	Text  string
+	Width float64
+	Align Align
+	Bold  bool
+}
+
+// Document es un documento PDF en construcción
+type Document struct {
+	Title  string
+	Footer string
+
+	margin   float64
+	pages    []*bytes.Buffer
+	current  *bytes.Buffer
+	y        float64
+	bold     bool
+	fontSize float64
+}
+
+// New crea un documento tamaño carta con una primera página vacía
+func New(title string) *Document {
+	d := &Document{
+		Title:    title,
+		margin:   50,
+		fontSize: 10,
+	}
+	d.AddPage()
+	return d
+}
+
+// ContentWidth retorna el ancho disponible entre márgenes
+func (d *Document) ContentWidth() float64 {
+	return PageWidth - 2*d.margin
+}
+
+// AddPage agrega una nueva página y posiciona el cursor arriba
+func (d *Document) AddPage() {
+	d.current = &bytes.Buffer{}
+	d.pages = append(d.pages, d.current)
+	d.y = PageHeight - d.margin
+}
+
+// SetFont establece la fuente para el texto siguiente
+func (d *Document) SetFont(bold bool, size float64) {
+	d.bold = bold
+	d.fontSize = size
+}
+
+// Space avanza el cursor verticalmente
+func (d *Document) Space(h float64) {
+	d.ensure(h)
+	d.y -= h
+}
+
+// Heading escribe un título en negrita
+func (d *Document) Heading(text string, size float64) {
+	prevBold, prevSize := d.bold, d.fontSize
+	d.SetFont(true, size)
+	d.Paragraph(text)
+	d.SetFont(prevBold, prevSize)
+	d.Space(size * 0.3)
+}
+
+// Paragraph escribe texto ajustado al ancho de la página, respetando saltos de línea
+func (d *Document) Paragraph(text string) {
+	for _, line := range d.wrap(text, d.ContentWidth(), d.bold, d.fontSize) {
+		d.writeLine(line)
+	}
+}
+
+// KeyValue escribe una etiqueta en negrita seguida de su valor
+func (d *Document) KeyValue(label, value string) {
+	labelWidth := 140.0
+	valueLines := d.wrap(value, d.ContentWidth()-labelWidth, false, d.fontSize)
+	if len(valueLines) == 0 {
+		valueLines = []string{""}
+	}
+
+	for i, line := range valueLines {
+		lh := d.lineHeight()
+		d.ensure(lh)
+		d.y -= lh
+		if i == 0 {
+			d.text(d.margin, d.y, label, true, d.fontSize)
+		}
+		d.text(d.margin+labelWidth, d.y, line, false, d.fontSize)
+	}
+}
+
+// Row escribe una fila de tabla; el texto que no cabe en la celda se recorta
+func (d *Document) Row(cells ...Cell) {
+	lh := d.lineHeight()
+	d.ensure(lh)
+	d.y -= lh
+
+	x := d.margin
+	for _, c := range cells {
+		text := d.truncate(c.Text, c.Width-4, c.Bold, d.fontSize)
+		w := textWidth(text, c.Bold, d.fontSize)
+		tx := x + 2
+		switch c.Align {
+		case AlignRight:
+			tx = x + c.Width - 2 - w
+		case AlignCenter:
+			tx = x + (c.Width-w)/2
+		}
+		d.text(tx, d.y, text, c.Bold, d.fontSize)
+		x += c.Width
+	}
+}
+
+// Rule dibuja una línea horizontal de margen a margen
+func (d *Document) Rule() {
+	d.ensure(8)
+	d.y -= 4
+	fmt.Fprintf(d.current, "0.5 w %.2f %.2f m %.2f %.2f l S\n", d.margin, d.y, PageWidth-d.margin, d.y)
+	d.y -= 4
+}
+
+// Bytes genera el PDF completo
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteTo escribe el PDF completo en w
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	// Objetos fijos: 1 catálogo, 2 árbol de páginas, 3 Helvetica, 4 Helvetica-Bold, 5 info.
+	// Cada página usa dos objetos: la página y su contenido.
+	const firstPageObj = 6
+	total := len(d.pages)
+
+	objects := make([][]byte, 0, 5+2*total)
+
+	kids := make([]string, total)
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObj+2*i)
+	}
+
+	objects = append(objects,
+		[]byte("<< /Type /Catalog /Pages 2 0 R >>"),
+		[]byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), total)),
+		[]byte("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"),
+		[]byte("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>"),
+		[]byte(fmt.Sprintf("<< /Title (%s) /Producer (jvairv2) >>", escape(encode(d.Title)))),
+	)
+
+	for i, page := range d.pages {
+		content := page.Bytes()
+		if d.Footer != "" || total > 1 {
+			footer := fmt.Sprintf("Page %d of %d", i+1, total)
+			if d.Footer != "" {
+				footer = d.Footer + "  -  " + footer
+			}
+			var fb bytes.Buffer
+			fb.Write(content)
+			fmt.Fprintf(&fb, "BT /F1 8 Tf %.2f %.2f Td (%s) Tj ET\n", d.margin, d.margin/2, escape(encode(footer)))
+			content = fb.Bytes()
+		}
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(content); err != nil {
+			return 0, err
+		}
+		if err := zw.Close(); err != nil {
+			return 0, err
+		}
+
+		objects = append(objects,
+			[]byte(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				PageWidth, PageHeight, firstPageObj+2*i+1)),
+			append([]byte(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n", compressed.Len())),
+				append(compressed.Bytes(), []byte("\nendstream")...)...),
+		)
+	}
+
+	cw := &countingWriter{w: w}
+	fmt.Fprint(cw, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int64, len(objects))
+	for i, obj := range objects {
+		offsets[i] = cw.n
+		fmt.Fprintf(cw, "%d 0 obj\n", i+1)
+		_, _ = cw.Write(obj)
+		fmt.Fprint(cw, "\nendobj\n")
+	}
+
+	xref := cw.n
+	fmt.Fprintf(cw, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(cw, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(cw, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return cw.n, cw.err
+}
+
+func (d *Document) lineHeight() float64 {
+	return d.fontSize * 1.4
+}
+
+// ensure agrega una página si no hay espacio para h puntos más
+func (d *Document) ensure(h float64) {
+	if d.y-h < d.margin {
+		d.AddPage()
+	}
+}
+
+func (d *Document) writeLine(line string) {
+	lh := d.lineHeight()
+	d.ensure(lh)
+	d.y -= lh
+	d.text(d.margin, d.y, line, d.bold, d.fontSize)
+}
+
+func (d *Document) text(x, y float64, s string, bold bool, size float64) {
+	if s == "" {
+		return
+	}
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.current, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escape(encode(s)))
+}
+
+// wrap divide el texto en líneas que caben en width
+func (d *Document) wrap(text string, width float64, bold bool, size float64) []string {
+	var lines []string
+	for _, para := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		words := strings.Fields(para)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		line := ""
+		for _, word := range words {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if textWidth(candidate, bold, size) <= width {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			// Palabras más largas que el ancho se cortan por caracteres
+			for textWidth(word, bold, size) > width {
+				cut := d.truncate(word, width, bold, size)
+				if cut == "" {
+					break
+				}
+				lines = append(lines, cut)
+				word = word[len(cut):]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// truncate retorna el prefijo más largo de s que cabe en width
+func (d *Document) truncate(s string, width float64, bold bool, size float64) string {
+	if textWidth(s, bold, size) <= width {
+		return s
+	}
+	w := 0.0
+	for i, r := range s {
+		w += runeWidth(r, bold) * size / 1000
+		if w > width {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
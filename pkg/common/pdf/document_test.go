@@ -0,0 +1,157 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// xrefOffsets lee la tabla xref a partir de startxref y retorna el desplazamiento de cada objeto
+func xrefOffsets(t *testing.T, data []byte) []int64 {
+	t.Helper()
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(data)
+	require.NotNil(t, m, "missing startxref")
+	start, _ := strconv.Atoi(string(m[1]))
+	require.True(t, bytes.HasPrefix(data[start:], []byte("xref\n")), "startxref does not point at xref")
+
+	header := regexp.MustCompile(`^xref\n0 (\d+)\n0000000000 65535 f \n`).FindSubmatch(data[start:])
+	require.NotNil(t, header, "invalid xref header")
+	size, _ := strconv.Atoi(string(header[1]))
+
+	entry := regexp.MustCompile(`^(\d{10}) 00000 n \n`)
+	rest := data[start+len(header[0]):]
+	offsets := make([]int64, 0, size-1)
+	for i := 1; i < size; i++ {
+		e := entry.FindSubmatch(rest)
+		require.NotNil(t, e, "invalid xref entry %d", i)
+		off, _ := strconv.ParseInt(string(e[1]), 10, 64)
+		offsets = append(offsets, off)
+		rest = rest[len(e[0]):]
+	}
+
+	trailer := regexp.MustCompile(`^trailer\n<< /Size (\d+) `).FindSubmatch(rest)
+	require.NotNil(t, trailer, "missing trailer")
+	assert.Equal(t, strconv.Itoa(size), string(trailer[1]))
+
+	return offsets
+}
+
+// contentStreams descomprime los streams de contenido de las páginas en orden
+func contentStreams(t *testing.T, data []byte) [][]byte {
+	t.Helper()
+
+	var streams [][]byte
+	re := regexp.MustCompile(`<< /Length (\d+) /Filter /FlateDecode >>\nstream\n`)
+	for _, loc := range re.FindAllSubmatchIndex(data, -1) {
+		length, _ := strconv.Atoi(string(data[loc[2]:loc[3]]))
+		body := data[loc[1] : loc[1]+length]
+		require.True(t, bytes.HasPrefix(data[loc[1]+length:], []byte("\nendstream")), "stream length mismatch")
+
+		zr, err := zlib.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		content, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		streams = append(streams, content)
+	}
+	return streams
+}
+
+// shownStrings extrae las cadenas literales de los operadores Tj, deshaciendo los escapes.
+// Falla si un paréntesis sin escapar deja la cadena desbalanceada.
+func shownStrings(t *testing.T, content []byte) []string {
+	t.Helper()
+
+	var out []string
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		i := bytes.Index(line, []byte("Td ("))
+		if i < 0 {
+			continue
+		}
+		var s []byte
+		j := i + len("Td (")
+		for ; j < len(line); j++ {
+			c := line[j]
+			if c == '\\' && j+1 < len(line) {
+				j++
+				s = append(s, line[j])
+				continue
+			}
+			if c == '(' {
+				t.Fatalf("unescaped ( in %q", line)
+			}
+			if c == ')' {
+				break
+			}
+			s = append(s, c)
+		}
+		require.Equal(t, ") Tj ET", string(line[j:]), "unbalanced string in %q", line)
+		out = append(out, string(s))
+	}
+	return out
+}
+
+func TestDocument_XrefOffsets(t *testing.T) {
+	d := New("Report (draft)")
+	d.Heading("Title", 16)
+	d.Paragraph("Hello")
+	d.Rule()
+	d.AddPage()
+	d.Row(Cell{Text: "A", Width: 100}, Cell{Text: "B", Width: 100, Align: AlignRight})
+
+	data, err := d.Bytes()
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+
+	offsets := xrefOffsets(t, data)
+	// 5 objetos fijos y dos por página
+	require.Len(t, offsets, 5+2*2)
+	for i, off := range offsets {
+		want := fmt.Sprintf("%d 0 obj\n", i+1)
+		require.True(t, bytes.HasPrefix(data[off:], []byte(want)), "object %d offset %d points at %q", i+1, off, data[off:off+10])
+	}
+}
+
+func TestDocument_EscapesAcrossPageBreak(t *testing.T) {
+	d := New("Escapes")
+	d.Footer = `Job (1) \ test`
+
+	var want []string
+	for i := 0; i < 80; i++ {
+		line := fmt.Sprintf(`line %d (a) \ b) (c`, i)
+		want = append(want, line)
+		d.Paragraph(line)
+	}
+
+	data, err := d.Bytes()
+	require.NoError(t, err)
+	xrefOffsets(t, data)
+
+	streams := contentStreams(t, data)
+	require.Greater(t, len(streams), 1, "expected a page break")
+
+	var got []string
+	for i, content := range streams {
+		shown := shownStrings(t, content)
+		require.NotEmpty(t, shown)
+
+		footer := shown[len(shown)-1]
+		assert.Equal(t, fmt.Sprintf(`Job (1) \ test  -  Page %d of %d`, i+1, len(streams)), footer)
+		got = append(got, shown[:len(shown)-1]...)
+	}
+	assert.Equal(t, want, got)
+
+	assert.Contains(t, string(data), `/Title (Escapes)`)
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\(b\)c\\d`, escape(`a(b)c\d`))
+	assert.Equal(t, `\\\(`, escape(`\(`))
+}
@@ -0,0 +1,65 @@
+// Package placeholder reemplaza marcadores del tipo {{clave}} en textos de plantillas.
+//
+// Las claves admiten letras, dígitos, guion bajo y punto ({{job.work_order}}) y se
+// permiten espacios alrededor ({{ job.work_order }}). Los marcadores sin valor se
+// dejan intactos para que quien edita la plantilla pueda detectarlos.
+package placeholder
+
+import (
+	"regexp"
+	"sort"
+)
+
+var pattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.]+)\s*\}\}`)
+
+// Keys retorna las claves distintas usadas en el texto, en orden de aparición
+func Keys(text string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range pattern.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			keys = append(keys, m[1])
+		}
+	}
+	return keys
+}
+
+// Render reemplaza los marcadores del texto con los valores indicados.
+// Retorna el texto resultante y las claves que no tenían valor, ordenadas.
+func Render(text string, values map[string]string) (string, []string) {
+	missing := make(map[string]bool)
+
+	out := pattern.ReplaceAllStringFunc(text, func(match string) string {
+		key := pattern.FindStringSubmatch(match)[1]
+		if v, ok := values[key]; ok {
+			return v
+		}
+		missing[key] = true
+		return match
+	})
+
+	keys := make([]string, 0, len(missing))
+	for k := range missing {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return out, keys
+}
+
+// Unknown retorna las claves usadas en el texto que no están en allowed
+func Unknown(text string, allowed []string) []string {
+	set := make(map[string]bool, len(allowed))
+	for _, k := range allowed {
+		set[k] = true
+	}
+
+	var unknown []string
+	for _, k := range Keys(text) {
+		if !set[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,49 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FakeSender guarda los mensajes en memoria en lugar de enviarlos.
+// Sirve para pruebas y entornos locales.
+type FakeSender struct {
+	mu       sync.Mutex
+	messages []Message
+	// FailFor contiene destinatarios para los que Send retorna error
+	FailFor map[string]error
+}
+
+// NewFakeSender crea un nuevo sender en memoria
+func NewFakeSender() *FakeSender {
+	return &FakeSender{FailFor: make(map[string]error)}
+}
+
+// Send registra el mensaje y retorna un identificador secuencial
+func (s *FakeSender) Send(ctx context.Context, msg Message) (string, error) {
+	if strings.TrimSpace(msg.To) == "" || strings.TrimSpace(msg.Body) == "" {
+		return "", ErrInvalidMessage
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err, ok := s.FailFor[msg.To]; ok {
+		return "", err
+	}
+
+	s.messages = append(s.messages, msg)
+	return fmt.Sprintf("FAKE%d", len(s.messages)), nil
+}
+
+// Messages retorna una copia de los mensajes enviados
+func (s *FakeSender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
@@ -0,0 +1,48 @@
+// Package sms define el envío de mensajes de texto y sus implementaciones.
+package sms
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidMessage indica que el mensaje no tiene destinatario o contenido
+var ErrInvalidMessage = errors.New("sms message requires a recipient and a body")
+
+// Message es un mensaje de texto a enviar
+type Message struct {
+	To   string
+	Body string
+}
+
+// Sender envía mensajes de texto
+type Sender interface {
+	// Send envía el mensaje y retorna el identificador asignado por el proveedor
+	Send(ctx context.Context, msg Message) (string, error)
+}
+
+// NormalizeNumber limpia un número de teléfono y lo lleva a formato E.164.
+// Los números de 10 dígitos se asumen de EE. UU. (+1). Retorna false si no es válido.
+func NormalizeNumber(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	plus := strings.HasPrefix(s, "+")
+
+	var digits strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	d := digits.String()
+
+	switch {
+	case plus && len(d) >= 8 && len(d) <= 15:
+		return "+" + d, true
+	case len(d) == 10:
+		return "+1" + d, true
+	case len(d) == 11 && d[0] == '1':
+		return "+" + d, true
+	}
+	return "", false
+}
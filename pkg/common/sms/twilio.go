@@ -0,0 +1,94 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultTwilioBaseURL es la URL base de la API REST de Twilio
+const DefaultTwilioBaseURL = "https://api.twilio.com"
+
+// TwilioConfig contiene las credenciales de la cuenta de Twilio
+type TwilioConfig struct {
+	AccountSID string
+	AuthToken  string
+	From       string
+	// BaseURL permite apuntar a un servidor compatible (por defecto DefaultTwilioBaseURL)
+	BaseURL string
+}
+
+// TwilioSender envía mensajes usando la API REST de Twilio (Messages resource)
+type TwilioSender struct {
+	config TwilioConfig
+	client *http.Client
+}
+
+// NewTwilioSender crea un nuevo sender de Twilio. Si client es nil se usa uno con timeout de 15s.
+func NewTwilioSender(config TwilioConfig, client *http.Client) *TwilioSender {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultTwilioBaseURL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &TwilioSender{config: config, client: client}
+}
+
+type twilioResponse struct {
+	SID     string `json:"sid"`
+	Status  string `json:"status"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Send envía el mensaje con POST /2010-04-01/Accounts/{sid}/Messages.json
+func (s *TwilioSender) Send(ctx context.Context, msg Message) (string, error) {
+	if strings.TrimSpace(msg.To) == "" || strings.TrimSpace(msg.Body) == "" {
+		return "", ErrInvalidMessage
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json",
+		strings.TrimRight(s.config.BaseURL, "/"), url.PathEscape(s.config.AccountSID))
+
+	form := url.Values{}
+	form.Set("To", msg.To)
+	form.Set("From", s.config.From)
+	form.Set("Body", msg.Body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(s.config.AccountSID, s.config.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return "", fmt.Errorf("twilio response read failed: %w", err)
+	}
+
+	var result twilioResponse
+	_ = json.Unmarshal(body, &result)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if result.Message != "" {
+			return "", fmt.Errorf("twilio error %d: %s", result.Code, result.Message)
+		}
+		return "", fmt.Errorf("twilio returned status %d", resp.StatusCode)
+	}
+
+	return result.SID, nil
+}
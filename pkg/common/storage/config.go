@@ -0,0 +1,36 @@
+package storage
+
+import "fmt"
+
+// Drivers de almacenamiento soportados
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// Config selecciona e inicializa el almacenamiento de archivos
+type Config struct {
+	Driver string
+	// Dir es el directorio raíz del driver local
+	Dir string
+	// BaseURL es la URL pública de la API, usada por el driver local para armar URLs
+	BaseURL string
+	// SigningKey firma las URLs de descarga del driver local
+	SigningKey string
+	S3         S3Config
+}
+
+// New crea el almacenamiento correspondiente al driver configurado
+func New(config Config) (Storage, error) {
+	switch config.Driver {
+	case DriverLocal, "":
+		dir := config.Dir
+		if dir == "" {
+			dir = "storage/files"
+		}
+		return NewLocalStorage(dir, config.BaseURL, config.SigningKey)
+	case DriverS3:
+		return NewS3Storage(config.S3)
+	}
+	return nil, fmt.Errorf("storage: unknown driver %q", config.Driver)
+}
@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// S3Config almacena la configuración de un almacenamiento S3 compatible (AWS, MinIO, etc.)
+type S3Config struct {
+	Endpoint  string // p. ej. https://s3.us-east-1.amazonaws.com o http://localhost:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PathStyle usa endpoint/bucket/key en lugar de bucket.endpoint/key (requerido por MinIO)
+	PathStyle bool
+	// PublicURL reemplaza la URL base de URL() cuando el bucket se sirve por CDN
+	PublicURL string
+	Timeout   time.Duration
+}
+
+// S3Storage guarda los archivos en un bucket S3 compatible, firmando cada petición con SigV4
+type S3Storage struct {
+	config   S3Config
+	endpoint *url.URL
+	signer   *signer
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Storage crea un almacenamiento S3
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	if config.Endpoint == "" || config.Bucket == "" || config.AccessKey == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("storage: s3 driver requires endpoint, bucket and credentials")
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(config.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("storage: invalid s3 endpoint %q", config.Endpoint)
+	}
+
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 60 * time.Second
+	}
+
+	return &S3Storage{
+		config:   config,
+		endpoint: endpoint,
+		signer: &signer{
+			accessKey: config.AccessKey,
+			secretKey: config.SecretKey,
+			region:    config.Region,
+			service:   "s3",
+		},
+		client: &http.Client{Timeout: config.Timeout},
+		now:    time.Now,
+	}, nil
+}
+
+// objectURL retorna la URL del objeto en el endpoint según el estilo configurado
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.config.PathStyle {
+		u.Path = u.Path + "/" + s.config.Bucket + "/" + key
+	} else {
+		u.Host = s.config.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + key
+	}
+	u.RawPath = ""
+	u.RawQuery = ""
+	return &u
+}
+
+func (s *S3Storage) do(ctx context.Context, method, key string, body io.Reader, size int64, contentType string) (*http.Response, error) {
+	key, err := CleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadHash := emptyPayload
+	if body != nil {
+		payloadHash = unsignedPayload
+		req.ContentLength = size
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+	}
+	s.signer.Sign(req, payloadHash, s.now())
+
+	return s.client.Do(req)
+}
+
+// s3Error construye un error con el estado y el cuerpo XML de la respuesta de S3
+func s3Error(op string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("storage: s3 %s failed with status %d: %s", op, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// Put sube el objeto con PUT Object
+func (s *S3Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	resp, err := s.do(ctx, http.MethodPut, key, body, size, contentType)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return s3Error("put", resp)
+	}
+	return nil
+}
+
+// Get descarga el objeto con GET Object
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		_ = resp.Body.Close()
+		return nil, ErrNotFound
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+	return nil, s3Error("get", resp)
+}
+
+// Delete elimina el objeto; S3 responde 204 aunque no exista
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, 0, "")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s3Error("delete", resp)
+	}
+	return nil
+}
+
+// URL retorna la URL permanente del objeto (PublicURL si está configurada)
+func (s *S3Storage) URL(key string) string {
+	if s.config.PublicURL != "" {
+		return strings.TrimRight(s.config.PublicURL, "/") + "/" + escapePath(key)
+	}
+	return s.objectURL(key).String()
+}
+
+// SignedURL retorna una URL prefirmada de GET Object
+func (s *S3Storage) SignedURL(ctx context.Context, key string, expires time.Duration) (string, error) {
+	key, err := CleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return s.signer.Presign(http.MethodGet, s.objectURL(key), expires, s.now()), nil
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound indica que el objeto no existe en el almacenamiento
+	ErrNotFound = errors.New("storage: object not found")
+
+	// ErrInvalidKey indica que la clave del objeto está vacía o sale del almacenamiento
+	ErrInvalidKey = errors.New("storage: invalid object key")
+
+	// ErrInvalidSignature indica que una URL firmada no es válida o ya expiró
+	ErrInvalidSignature = errors.New("storage: invalid or expired signature")
+)
+
+// Storage abstrae el almacenamiento de archivos (disco local o S3 compatible)
+type Storage interface {
+	// Put guarda el contenido bajo key; size puede ser -1 si se desconoce
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get abre el contenido de key; retorna ErrNotFound si no existe
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete elimina key; eliminar un objeto inexistente no es un error
+	Delete(ctx context.Context, key string) error
+	// URL retorna la URL permanente (sin firma) del objeto
+	URL(key string) string
+	// SignedURL retorna una URL de descarga temporal válida por expires
+	SignedURL(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+// CleanKey normaliza una clave de objeto y rechaza claves vacías o que intenten salir
+// del almacenamiento con ".."
+func CleanKey(key string) (string, error) {
+	key = strings.TrimLeft(strings.ReplaceAll(key, "\\", "/"), "/")
+	if key == "" {
+		return "", ErrInvalidKey
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == ".." {
+			return "", ErrInvalidKey
+		}
+	}
+
+	cleaned := path.Clean(key)
+	if cleaned == "." {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
+
+// escapePath codifica cada segmento de la clave para usarla en una URL
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, s := range segments {
+		segments[i] = uriEncode(s, true)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode codifica según RFC 3986 dejando sin codificar solo los caracteres no reservados,
+// como exige la firma SigV4
+func uriEncode(s string, encodeSlash bool) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		}
+	}
+	return b.String()
+}
@@ -2,17 +2,22 @@ package ability
 
 import (
 	"context"
+
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 )
 
 // UseCase define los casos de uso para la gestión de abilities (capacidades/permisos)
 type UseCase struct {
-	repo Repository
+	repo         Repository
+	abilityCache authorization.Invalidator
 }
 
-// NewUseCase crea una nueva instancia del caso de uso de abilities
-func NewUseCase(repo Repository) *UseCase {
+// NewUseCase crea una nueva instancia del caso de uso de abilities.
+// abilityCache puede ser nil si los permisos no se guardan en caché.
+func NewUseCase(repo Repository, abilityCache authorization.Invalidator) *UseCase {
 	return &UseCase{
-		repo: repo,
+		repo:         repo,
+		abilityCache: abilityCache,
 	}
 }
 
@@ -33,12 +38,22 @@ func (uc *UseCase) Create(ctx context.Context, ability *Ability) error {
 
 // Update actualiza una ability existente
 func (uc *UseCase) Update(ctx context.Context, ability *Ability) error {
-	return uc.repo.Update(ctx, ability)
+	if err := uc.repo.Update(ctx, ability); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEveryone(ctx, uc.abilityCache)
+	return nil
 }
 
 // Delete elimina una ability
 func (uc *UseCase) Delete(ctx context.Context, id int64) error {
-	return uc.repo.Delete(ctx, id)
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEveryone(ctx, uc.abilityCache)
+	return nil
 }
 
 // List obtiene una lista paginada de abilities con filtros opcionales
@@ -14,7 +14,7 @@ func TestUseCase_GetByID(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -58,7 +58,7 @@ func TestUseCase_GetByID_Error(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -85,7 +85,7 @@ func TestUseCase_GetByName(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -129,7 +129,7 @@ func TestUseCase_Create(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -167,7 +167,7 @@ func TestUseCase_Update(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -206,7 +206,7 @@ func TestUseCase_Delete(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -230,7 +230,7 @@ func TestUseCase_List(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
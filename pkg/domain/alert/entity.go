@@ -0,0 +1,79 @@
+package alert
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// EntityTypeJob es el entity_type polimórfico que usa Laravel para los jobs
+const EntityTypeJob = `App\Models\Job`
+
+// Tipos de alerta
+const (
+	TypeJobAssigned = "job_assigned"
+	TypeCallLog     = "call_log"
+	TypeJobTask     = "job_task"
+)
+
+// Niveles de mensaje (clases Bootstrap que usa la bandeja de alertas)
+const (
+	LevelInfo    = "info"
+	LevelSuccess = "success"
+	LevelWarning = "warning"
+	LevelDanger  = "danger"
+)
+
+// MaxMessageLength es la longitud máxima de la columna message
+const MaxMessageLength = 191
+
+// Alert representa una alerta de la bandeja de un usuario
+type Alert struct {
+	ID           int64      `json:"id"`
+	UserID       *int64     `json:"userId,omitempty"`
+	AlertType    string     `json:"alertType"`
+	EntityID     int64      `json:"entityId"`
+	EntityType   string     `json:"entityType"`
+	MessageLevel string     `json:"messageLevel"`
+	Message      string     `json:"message"`
+	IsRead       bool       `json:"isRead"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos de la alerta y recorta el mensaje al tamaño de la columna
+func (a *Alert) Validate() error {
+	if a.UserID == nil || *a.UserID <= 0 {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if a.AlertType == "" {
+		return fmt.Errorf("alert_type is required")
+	}
+
+	if a.EntityID <= 0 || a.EntityType == "" {
+		return fmt.Errorf("entity_id and entity_type are required")
+	}
+
+	if a.Message == "" {
+		return fmt.Errorf("message is required")
+	}
+
+	if a.MessageLevel == "" {
+		a.MessageLevel = LevelInfo
+	}
+
+	if utf8.RuneCountInString(a.Message) > MaxMessageLength {
+		a.Message = string([]rune(a.Message)[:MaxMessageLength])
+	}
+
+	return nil
+}
+
+// jobLabel retorna la referencia legible de un job: su work order o, si no tiene, su ID
+func jobLabel(jobID int64, workOrder *string) string {
+	if workOrder != nil && *workOrder != "" {
+		return *workOrder
+	}
+	return fmt.Sprintf("#%d", jobID)
+}
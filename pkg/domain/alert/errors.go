@@ -0,0 +1,14 @@
+package alert
+
+import "errors"
+
+var (
+	// ErrAlertNotFound indica que la alerta no existe o no pertenece al usuario
+	ErrAlertNotFound = errors.New("alert not found")
+
+	// ErrInvalidJob indica que el job no existe
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrUnauthenticated indica que no hay un usuario autenticado en el contexto
+	ErrUnauthenticated = errors.New("authenticated user required")
+)
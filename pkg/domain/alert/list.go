@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"context"
+	"log/slog"
+)
+
+// List obtiene las alertas del usuario autenticado, primero las no leídas y luego las más recientes
+func (uc *UseCase) List(ctx context.Context, unreadOnly bool, page, pageSize int) ([]*Alert, int, error) {
+	userID, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	alerts, total, err := uc.repo.ListByUser(ctx, userID, unreadOnly, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list alerts",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return alerts, total, nil
+}
@@ -0,0 +1,50 @@
+package alert
+
+import (
+	"context"
+	"log/slog"
+)
+
+// MarkRead marca como leídas las alertas indicadas del usuario autenticado.
+// Sin IDs marca como leída toda su bandeja. Retorna cuántas alertas cambiaron.
+func (uc *UseCase) MarkRead(ctx context.Context, ids []int64) (int64, error) {
+	userID, err := uc.currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := uc.repo.MarkRead(ctx, userID, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark alerts as read",
+			slog.Int64("userId", userID),
+			slog.Int("ids", len(ids)),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// MarkCallLogRead marca como leídas las alertas de registro de llamadas de un job
+// para el usuario autenticado (equivalente a alerts/mark-call-log/{job} en Laravel)
+func (uc *UseCase) MarkCallLogRead(ctx context.Context, jobID int64) (int64, error) {
+	userID, err := uc.currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		return 0, ErrInvalidJob
+	}
+
+	count, err := uc.repo.MarkReadByEntity(ctx, userID, TypeCallLog, EntityTypeJob, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark call log alerts as read",
+			slog.Int64("userId", userID),
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,84 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de alertas
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, alert *Alert) error {
+	args := m.Called(ctx, alert)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*Alert, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Alert), args.Error(1)
+}
+
+func (m *MockRepository) ListByUser(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*Alert, int, error) {
+	args := m.Called(ctx, userID, unreadOnly, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*Alert), args.Int(1), args.Error(2)
+}
+
+func (m *MockRepository) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	args := m.Called(ctx, userID, ids)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) MarkReadByEntity(ctx context.Context, userID int64, alertType, entityType string, entityID int64) (int64, error) {
+	args := m.Called(ctx, userID, alertType, entityType, entityID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockJobChecker es un mock del verificador de jobs
+type MockJobChecker struct {
+	mock.Mock
+}
+
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
+
+// MockService es un mock del servicio de alertas
+type MockService struct {
+	mock.Mock
+}
+
+func (m *MockService) List(ctx context.Context, unreadOnly bool, page, pageSize int) ([]*Alert, int, error) {
+	args := m.Called(ctx, unreadOnly, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*Alert), args.Int(1), args.Error(2)
+}
+
+func (m *MockService) Open(ctx context.Context, id int64) (*Alert, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Alert), args.Error(1)
+}
+
+func (m *MockService) MarkRead(ctx context.Context, ids []int64) (int64, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockService) MarkCallLogRead(ctx context.Context, jobID int64) (int64, error) {
+	args := m.Called(ctx, jobID)
+	return args.Get(0).(int64), args.Error(1)
+}
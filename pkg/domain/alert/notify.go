@@ -0,0 +1,72 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+)
+
+// Notify crea una alerta en la bandeja de un usuario
+func (uc *UseCase) Notify(ctx context.Context, alert *Alert) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(ctx, alert); err != nil {
+		slog.ErrorContext(ctx, "Failed to create alert",
+			slog.String("type", alert.AlertType),
+			slog.Int64("entityId", alert.EntityID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, &domainEvent.Event{
+			Type:      domainEvent.TypeAlertRaised,
+			EntityID:  alert.ID,
+			Data:      alert,
+			UserID:    alert.UserID,
+			OwnerOnly: true,
+		})
+	}
+
+	return nil
+}
+
+// NotifyJobAssigned avisa a un técnico que se le asignó un job
+func (uc *UseCase) NotifyJobAssigned(ctx context.Context, jobID, userID int64, workOrder *string) error {
+	return uc.Notify(ctx, &Alert{
+		UserID:       &userID,
+		AlertType:    TypeJobAssigned,
+		EntityID:     jobID,
+		EntityType:   EntityTypeJob,
+		MessageLevel: LevelInfo,
+		Message:      fmt.Sprintf("You have been assigned to job %s", jobLabel(jobID, workOrder)),
+	})
+}
+
+// NotifyCallLog avisa a un técnico que se actualizó el registro de llamadas de su job
+func (uc *UseCase) NotifyCallLog(ctx context.Context, jobID, userID int64, workOrder *string) error {
+	return uc.Notify(ctx, &Alert{
+		UserID:       &userID,
+		AlertType:    TypeCallLog,
+		EntityID:     jobID,
+		EntityType:   EntityTypeJob,
+		MessageLevel: LevelWarning,
+		Message:      fmt.Sprintf("Call log updated on job %s", jobLabel(jobID, workOrder)),
+	})
+}
+
+// NotifyJobTask recuerda a un usuario la tarea que tiene asignada en un job
+func (uc *UseCase) NotifyJobTask(ctx context.Context, jobID, userID int64, workOrder *string, task string) error {
+	return uc.Notify(ctx, &Alert{
+		UserID:       &userID,
+		AlertType:    TypeJobTask,
+		EntityID:     jobID,
+		EntityType:   EntityTypeJob,
+		MessageLevel: LevelInfo,
+		Message:      fmt.Sprintf("Task on job %s: %s", jobLabel(jobID, workOrder), task),
+	})
+}
@@ -0,0 +1,37 @@
+package alert
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Open obtiene una alerta del usuario autenticado y la marca como leída.
+// El cliente usa entityType y entityId para navegar a la entidad referida.
+func (uc *UseCase) Open(ctx context.Context, id int64) (*Alert, error) {
+	userID, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrAlertNotFound
+	}
+
+	// Las alertas de otros usuarios se tratan como inexistentes
+	if alert.UserID == nil || *alert.UserID != userID {
+		return nil, ErrAlertNotFound
+	}
+
+	if !alert.IsRead {
+		if _, err := uc.repo.MarkRead(ctx, userID, []int64{alert.ID}); err != nil {
+			slog.ErrorContext(ctx, "Failed to mark alert as read",
+				slog.Int64("id", alert.ID),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		alert.IsRead = true
+	}
+
+	return alert, nil
+}
@@ -0,0 +1,12 @@
+package alert
+
+import "context"
+
+// Repository define las operaciones de persistencia de alertas
+type Repository interface {
+	Create(ctx context.Context, alert *Alert) error
+	GetByID(ctx context.Context, id int64) (*Alert, error)
+	ListByUser(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*Alert, int, error)
+	MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error)
+	MarkReadByEntity(ctx context.Context, userID int64, alertType, entityType string, entityID int64) (int64, error)
+}
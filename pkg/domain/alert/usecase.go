@@ -0,0 +1,56 @@
+package alert
+
+import (
+	"context"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+)
+
+// Service define la interfaz del servicio de alertas
+type Service interface {
+	List(ctx context.Context, unreadOnly bool, page, pageSize int) ([]*Alert, int, error)
+	Open(ctx context.Context, id int64) (*Alert, error)
+	MarkRead(ctx context.Context, ids []int64) (int64, error)
+	MarkCallLogRead(ctx context.Context, jobID int64) (int64, error)
+}
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UseCase implementa la lógica de negocio de alertas
+type UseCase struct {
+	repo         Repository
+	jobCheck     JobChecker
+	userResolver UserIDResolver
+	publisher    domainEvent.Publisher
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de alertas.
+// Si publisher no es nil, cada alerta nueva se difunde a su dueño por el stream de eventos.
+func NewUseCase(repo Repository, jobCheck JobChecker, userResolver UserIDResolver, publisher domainEvent.Publisher) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		jobCheck:     jobCheck,
+		userResolver: userResolver,
+		publisher:    publisher,
+	}
+}
+
+// currentUser obtiene el usuario autenticado dueño de la bandeja
+func (uc *UseCase) currentUser(ctx context.Context) (int64, error) {
+	if uc.userResolver == nil {
+		return 0, ErrUnauthenticated
+	}
+
+	userID, ok := uc.userResolver(ctx)
+	if !ok || userID <= 0 {
+		return 0, ErrUnauthenticated
+	}
+
+	return userID, nil
+}
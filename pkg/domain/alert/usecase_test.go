@@ -0,0 +1,225 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+)
+
+func asUser(id int64) UserIDResolver {
+	return func(context.Context) (int64, bool) { return id, true }
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists current user inbox with defaults", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, asUser(5), nil)
+		alerts := []*Alert{{ID: 2}, {ID: 1, IsRead: true}}
+
+		repo.On("ListByUser", ctx, int64(5), false, 1, 10).Return(alerts, 2, nil)
+
+		result, total, err := uc.List(ctx, false, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, alerts, result)
+	})
+
+	t.Run("requires authenticated user", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, func(context.Context) (int64, bool) { return 0, false }, nil)
+
+		_, _, err := uc.List(ctx, true, 1, 10)
+
+		assert.Equal(t, ErrUnauthenticated, err)
+		repo.AssertNotCalled(t, "ListByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOpen(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("marks unread alert as read", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, asUser(5), nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(&Alert{ID: 3, UserID: int64Ptr(5)}, nil)
+		repo.On("MarkRead", ctx, int64(5), []int64{3}).Return(int64(1), nil)
+
+		alert, err := uc.Open(ctx, 3)
+
+		assert.NoError(t, err)
+		assert.True(t, alert.IsRead)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("already read alert is not updated", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, asUser(5), nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(&Alert{ID: 3, UserID: int64Ptr(5), IsRead: true}, nil)
+
+		_, err := uc.Open(ctx, 3)
+
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "MarkRead", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("alert of another user", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, asUser(5), nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(&Alert{ID: 3, UserID: int64Ptr(9)}, nil)
+
+		alert, err := uc.Open(ctx, 3)
+
+		assert.Nil(t, alert)
+		assert.Equal(t, ErrAlertNotFound, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, asUser(5), nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(nil, errors.New("sql: no rows"))
+
+		_, err := uc.Open(ctx, 3)
+
+		assert.Equal(t, ErrAlertNotFound, err)
+	})
+}
+
+func TestMarkRead(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo, nil, asUser(5), nil)
+
+	repo.On("MarkRead", ctx, int64(5), []int64{1, 2}).Return(int64(2), nil)
+
+	count, err := uc.MarkRead(ctx, []int64{1, 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestMarkCallLogRead(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("marks job call log alerts", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobCheck := new(MockJobChecker)
+		uc := NewUseCase(repo, jobCheck, asUser(5), nil)
+
+		jobCheck.On("GetByID", ctx, int64(10)).Return(true, nil)
+		repo.On("MarkReadByEntity", ctx, int64(5), TypeCallLog, EntityTypeJob, int64(10)).Return(int64(3), nil)
+
+		count, err := uc.MarkCallLogRead(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobCheck := new(MockJobChecker)
+		uc := NewUseCase(repo, jobCheck, asUser(5), nil)
+
+		jobCheck.On("GetByID", ctx, int64(10)).Return(nil, errors.New("not found"))
+
+		_, err := uc.MarkCallLogRead(ctx, 10)
+
+		assert.Equal(t, ErrInvalidJob, err)
+		repo.AssertNotCalled(t, "MarkReadByEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestNotifyJobAssigned(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("uses work order in message", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil)
+		workOrder := "WO-77"
+
+		repo.On("Create", ctx, mock.AnythingOfType("*alert.Alert")).Return(nil)
+
+		err := uc.NotifyJobAssigned(ctx, 10, 5, &workOrder)
+
+		assert.NoError(t, err)
+		alert := repo.Calls[0].Arguments.Get(1).(*Alert)
+		assert.Equal(t, int64(5), *alert.UserID)
+		assert.Equal(t, TypeJobAssigned, alert.AlertType)
+		assert.Equal(t, EntityTypeJob, alert.EntityType)
+		assert.Equal(t, int64(10), alert.EntityID)
+		assert.Equal(t, "You have been assigned to job WO-77", alert.Message)
+	})
+
+	t.Run("falls back to job id", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil)
+
+		repo.On("Create", ctx, mock.AnythingOfType("*alert.Alert")).Return(nil)
+
+		err := uc.NotifyCallLog(ctx, 10, 5, nil)
+
+		assert.NoError(t, err)
+		alert := repo.Calls[0].Arguments.Get(1).(*Alert)
+		assert.Equal(t, TypeCallLog, alert.AlertType)
+		assert.Equal(t, "Call log updated on job #10", alert.Message)
+	})
+
+	t.Run("job task reminder", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil)
+
+		repo.On("Create", ctx, mock.AnythingOfType("*alert.Alert")).Return(nil)
+
+		err := uc.NotifyJobTask(ctx, 10, 5, nil, "Replace filter")
+
+		assert.NoError(t, err)
+		alert := repo.Calls[0].Arguments.Get(1).(*Alert)
+		assert.Equal(t, int64(5), *alert.UserID)
+		assert.Equal(t, TypeJobTask, alert.AlertType)
+		assert.Equal(t, "Task on job #10: Replace filter", alert.Message)
+	})
+}
+
+func TestNotifyPublishesToOwner(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	publisher := new(domainEvent.MockPublisher)
+	uc := NewUseCase(repo, nil, nil, publisher)
+
+	repo.On("Create", ctx, mock.AnythingOfType("*alert.Alert")).Run(func(args mock.Arguments) {
+		args.Get(1).(*Alert).ID = 11
+	}).Return(nil)
+	publisher.On("Publish", ctx, mock.AnythingOfType("*event.Event")).Return()
+
+	err := uc.NotifyJobAssigned(ctx, 10, 5, nil)
+
+	assert.NoError(t, err)
+	e := publisher.Calls[0].Arguments.Get(1).(*domainEvent.Event)
+	assert.Equal(t, domainEvent.TypeAlertRaised, e.Type)
+	assert.Equal(t, int64(11), e.EntityID)
+	assert.Equal(t, int64(5), *e.UserID)
+	assert.True(t, e.OwnerOnly)
+}
+
+func TestAlertValidate(t *testing.T) {
+	alert := &Alert{UserID: int64Ptr(1), AlertType: TypeCallLog, EntityID: 1, EntityType: EntityTypeJob, Message: strings.Repeat("é", 200)}
+
+	assert.NoError(t, alert.Validate())
+	assert.Equal(t, LevelInfo, alert.MessageLevel)
+	assert.Equal(t, MaxMessageLength, len([]rune(alert.Message)))
+
+	assert.EqualError(t, (&Alert{AlertType: TypeCallLog}).Validate(), "user_id is required")
+}
@@ -3,20 +3,24 @@ package assigned_role
 import (
 	"context"
 
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 	"github.com/your-org/jvairv2/pkg/domain/role"
 )
 
 // UseCase define los casos de uso para la gestión de asignaciones de roles
 type UseCase struct {
-	repo     Repository
-	roleRepo role.Repository
+	repo         Repository
+	roleRepo     role.Repository
+	abilityCache authorization.Invalidator
 }
 
-// NewUseCase crea una nueva instancia del caso de uso de asignaciones de roles
-func NewUseCase(repo Repository, roleRepo role.Repository) *UseCase {
+// NewUseCase crea una nueva instancia del caso de uso de asignaciones de roles.
+// abilityCache puede ser nil si los permisos no se guardan en caché.
+func NewUseCase(repo Repository, roleRepo role.Repository, abilityCache authorization.Invalidator) *UseCase {
 	return &UseCase{
-		repo:     repo,
-		roleRepo: roleRepo,
+		repo:         repo,
+		roleRepo:     roleRepo,
+		abilityCache: abilityCache,
 	}
 }
 
@@ -38,12 +42,22 @@ func (uc *UseCase) Assign(ctx context.Context, assignedRole *AssignedRole) error
 		return err
 	}
 
-	return uc.repo.Assign(ctx, assignedRole)
+	if err := uc.repo.Assign(ctx, assignedRole); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEntity(ctx, uc.abilityCache, assignedRole.EntityType, assignedRole.EntityID)
+	return nil
 }
 
 // Revoke revoca un rol de una entidad
 func (uc *UseCase) Revoke(ctx context.Context, roleID, entityID int64, entityType string) error {
-	return uc.repo.Revoke(ctx, roleID, entityID, entityType)
+	if err := uc.repo.Revoke(ctx, roleID, entityID, entityType); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEntity(ctx, uc.abilityCache, entityType, entityID)
+	return nil
 }
 
 // HasRole verifica si una entidad tiene un rol específico
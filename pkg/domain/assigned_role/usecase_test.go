@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 	"github.com/your-org/jvairv2/pkg/domain/role"
 )
 
@@ -15,7 +16,7 @@ func TestUseCase_GetByID(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -54,7 +55,7 @@ func TestUseCase_GetByID_Error(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -82,7 +83,7 @@ func TestUseCase_GetByEntity(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -130,7 +131,7 @@ func TestUseCase_Assign_Success(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -168,7 +169,7 @@ func TestUseCase_Assign_RoleNotFound(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -207,7 +208,7 @@ func TestUseCase_Revoke(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -234,7 +235,7 @@ func TestUseCase_HasRole(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -262,7 +263,7 @@ func TestUseCase_List(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockRoleRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -307,3 +308,46 @@ func TestUseCase_List(t *testing.T) {
 	// Verificar que se llamó al método del repositorio con los argumentos correctos
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUseCase_InvalidatesAbilityCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("assign to user invalidates that user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRoleRepo := new(MockRoleRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, mockRoleRepo, mockCache)
+
+		assignedRole := &AssignedRole{RoleID: 2, EntityID: 10, EntityType: "App\\Models\\User"}
+		mockRoleRepo.On("GetByID", ctx, int64(2)).Return(&role.Role{ID: 2, Name: "admin"}, nil)
+		mockRepo.On("Assign", ctx, assignedRole).Return(nil)
+		mockCache.On("InvalidateUser", ctx, int64(10)).Return()
+
+		assert.NoError(t, useCase.Assign(ctx, assignedRole))
+		mockCache.AssertExpectations(t)
+		mockCache.AssertNotCalled(t, "InvalidateAll", ctx)
+	})
+
+	t.Run("revoke from another entity invalidates everyone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, new(MockRoleRepository), mockCache)
+
+		mockRepo.On("Revoke", ctx, int64(2), int64(5), "App\\Models\\Team").Return(nil)
+		mockCache.On("InvalidateAll", ctx).Return()
+
+		assert.NoError(t, useCase.Revoke(ctx, 2, 5, "App\\Models\\Team"))
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("failed revoke keeps cache", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, new(MockRoleRepository), mockCache)
+
+		mockRepo.On("Revoke", ctx, int64(2), int64(10), "App\\Models\\User").Return(errors.New("db down"))
+
+		assert.Error(t, useCase.Revoke(ctx, 2, 10, "App\\Models\\User"))
+		mockCache.AssertNotCalled(t, "InvalidateUser", ctx, int64(10))
+	})
+}
@@ -13,6 +13,7 @@ type TokenDetails struct {
 	RefreshToken string // @example "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
 	AccessUUID   string // @example "f8776176-9586-4e3c-a767-c011f4d178f8"
 	RefreshUUID  string // @example "a9776176-9586-4e3c-a767-c011f4d178f9"
+	SessionID    string // @example "3f0c9a1e5b7d4c2a8e6f1b3d5a7c9e0f"
 	AtExpires    int64  // @example 1625097600
 	RtExpires    int64  // @example 1625184000
 }
@@ -20,6 +21,7 @@ type TokenDetails struct {
 // AccessDetails contiene información extraída del token JWT
 type AccessDetails struct {
 	AccessUUID string
+	SessionID  string
 	UserID     string
 	RoleID     string
 }
@@ -59,6 +61,10 @@ type LoginResponse struct {
 	RefreshToken string        `json:"refreshToken" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
 	ExpiresAt    time.Time     `json:"expiresAt" example:"2023-01-01T00:00:00Z"`
 	User         *UserResponse `json:"user"`
+	// PasswordExpired y MustChangePassword indican que el resto de la API responde 403
+	// hasta que el usuario cambie su contraseña en /api/v1/password/change
+	PasswordExpired    bool `json:"passwordExpired" example:"false"`
+	MustChangePassword bool `json:"mustChangePassword" example:"false"`
 }
 
 // Service define las operaciones relacionadas con la autenticación
@@ -78,6 +84,18 @@ type Service interface {
 	// Eliminar información del token de caché/redis (logout)
 	DeleteTokenDetails(ctx context.Context, accessUUID string) error
 
+	// Eliminar todos los tokens de un usuario (p. ej. al restablecer su contraseña)
+	RevokeUserTokens(ctx context.Context, userID int64) error
+
 	// Refrescar token
 	RefreshToken(ctx context.Context, refreshToken string) (*TokenDetails, error)
+
+	// Listar las sesiones activas de un usuario
+	ListSessions(ctx context.Context, userID int64) ([]*Session, error)
+
+	// Cerrar una sesión del usuario
+	RevokeSession(ctx context.Context, userID int64, sessionID string) error
+
+	// Cerrar todas las sesiones del usuario excepto la indicada
+	RevokeOtherSessions(ctx context.Context, userID int64, sessionID string) error
 }
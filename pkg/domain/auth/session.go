@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrSessionNotFound se retorna cuando la sesión no existe o pertenece a otro usuario
+var ErrSessionNotFound = errors.New("sesión no encontrada")
+
+// Session representa un inicio de sesión activo: el par de tokens emitido en el login
+// y rotado en cada refresco, junto con el dispositivo desde el que se abrió
+type Session struct {
+	ID               string
+	UserID           int64
+	AccessUUID       string
+	RefreshUUID      string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+	UserAgent        string
+	IPAddress        string
+	LastSeenAt       *time.Time
+	CreatedAt        time.Time
+	// Current indica que es la sesión del token con el que se hizo la consulta
+	Current bool
+}
+
+// IsExpired indica si la sesión ya no puede usarse ni refrescarse
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.RefreshExpiresAt)
+}
+
+// ClientInfo identifica el dispositivo que abre o refresca una sesión
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type clientInfoKey struct{}
+
+// WithClientInfo agrega al contexto el dispositivo de la solicitud
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext obtiene el dispositivo de la solicitud; vacío si no se agregó
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
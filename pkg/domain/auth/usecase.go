@@ -3,8 +3,11 @@ package auth
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"strconv"
 	"time"
 
+	"github.com/your-org/jvairv2/pkg/domain/password_policy"
 	"github.com/your-org/jvairv2/pkg/domain/user"
 )
 
@@ -14,17 +17,24 @@ var (
 	ErrInvalidToken       = errors.New("token inválido")
 )
 
+// PasswordStatusChecker indica si el usuario debe cambiar su contraseña
+type PasswordStatusChecker interface {
+	Status(ctx context.Context, userID int64) (*password_policy.Status, error)
+}
+
 // UseCase define los casos de uso para autenticación
 type UseCase struct {
-	userRepo    user.Repository
-	authService Service
+	userRepo       user.Repository
+	authService    Service
+	passwordStatus PasswordStatusChecker
 }
 
 // NewUseCase crea una nueva instancia del caso de uso de autenticación
-func NewUseCase(userRepo user.Repository, authService Service) *UseCase {
+func NewUseCase(userRepo user.Repository, authService Service, passwordStatus PasswordStatusChecker) *UseCase {
 	return &UseCase{
-		userRepo:    userRepo,
-		authService: authService,
+		userRepo:       userRepo,
+		authService:    authService,
+		passwordStatus: passwordStatus,
 	}
 }
 
@@ -71,6 +81,21 @@ func (uc *UseCase) Login(ctx context.Context, req *LoginRequest) (*LoginResponse
 		User:         userResp,
 	}
 
+	// Informar si debe cambiar la contraseña; si no se puede calcular no se impide el login
+	if uc.passwordStatus != nil {
+		status, err := uc.passwordStatus.Status(ctx, u.ID)
+		if err != nil {
+			slog.Warn("Error al obtener el estado de la contraseña",
+				"user_id", u.ID,
+				"error", err,
+			)
+		} else {
+			resp.PasswordExpired = status.PasswordExpired
+			resp.MustChangePassword = status.MustChangePassword
+			userResp.IsChangePassword = status.MustChangePassword
+		}
+	}
+
 	return resp, nil
 }
 
@@ -129,3 +154,80 @@ func (uc *UseCase) GetUserFromToken(ctx context.Context, accessToken string) (*u
 
 	return u, nil
 }
+
+// Sessions lista las sesiones activas del usuario del token, marcando la actual
+func (uc *UseCase) Sessions(ctx context.Context, accessToken string) ([]*Session, error) {
+	ad, userID, err := uc.accessDetails(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.authService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessions {
+		s.Current = s.ID == ad.SessionID
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession cierra una de las sesiones del usuario del token
+func (uc *UseCase) RevokeSession(ctx context.Context, accessToken, sessionID string) error {
+	_, userID, err := uc.accessDetails(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.authService.RevokeSession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	slog.Info("Sesión cerrada por el usuario",
+		"user_id", userID,
+		"session_id", sessionID,
+	)
+
+	return nil
+}
+
+// RevokeOtherSessions cierra todas las sesiones del usuario del token excepto la actual
+func (uc *UseCase) RevokeOtherSessions(ctx context.Context, accessToken string) error {
+	ad, userID, err := uc.accessDetails(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	// Sin sesión identificada se cerrarían todas, incluida la actual
+	if ad.SessionID == "" {
+		return ErrInvalidToken
+	}
+
+	if err := uc.authService.RevokeOtherSessions(ctx, userID, ad.SessionID); err != nil {
+		return err
+	}
+
+	slog.Info("Otras sesiones cerradas por el usuario",
+		"user_id", userID,
+		"session_id", ad.SessionID,
+	)
+
+	return nil
+}
+
+// accessDetails extrae la información del token y el ID numérico de su usuario
+func (uc *UseCase) accessDetails(ctx context.Context, accessToken string) (*AccessDetails, int64, error) {
+	ad, err := uc.authService.ExtractTokenMetadata(ctx, accessToken)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	userID, err := strconv.ParseInt(ad.UserID, 10, 64)
+	if err != nil {
+		return nil, 0, ErrInvalidToken
+	}
+
+	return ad, userID, nil
+}
@@ -0,0 +1,135 @@
+package authorization
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache almacena los permisos resueltos por usuario para no consultarlos en cada petición.
+// Se guardan los grants (no el evaluador) para que un backend compartido pueda serializarlos.
+type Cache interface {
+	Get(ctx context.Context, userID int64) ([]Grant, bool, error)
+	Set(ctx context.Context, userID int64, grants []Grant, ttl time.Duration) error
+	Delete(ctx context.Context, userID int64) error
+	Flush(ctx context.Context) error
+}
+
+// Invalidator descarta los permisos resueltos en caché; lo implementa UseCase y lo usan los
+// casos de uso que modifican roles, asignaciones, permisos o abilities
+type Invalidator interface {
+	InvalidateUser(ctx context.Context, userID int64)
+	InvalidateAll(ctx context.Context)
+}
+
+// InvalidateEntity descarta los permisos en caché afectados por un cambio sobre una entidad
+// de Bouncer: los del usuario si es un usuario y, si no (roles o permisos globales), los de
+// todos, ya que no se sabe a quién afecta. inv puede ser nil si no hay caché.
+func InvalidateEntity(ctx context.Context, inv Invalidator, entityType string, entityID int64) {
+	if inv == nil {
+		return
+	}
+	if entityType == UserType {
+		inv.InvalidateUser(ctx, entityID)
+		return
+	}
+	inv.InvalidateAll(ctx)
+}
+
+// InvalidateEveryone descarta los permisos en caché de todos los usuarios; se usa cuando
+// cambia un rol o una ability que cualquiera puede tener. inv puede ser nil si no hay caché.
+func InvalidateEveryone(ctx context.Context, inv Invalidator) {
+	if inv != nil {
+		inv.InvalidateAll(ctx)
+	}
+}
+
+// sweepInterval es cada cuánto Set elimina las entradas vencidas de MemoryCache
+const sweepInterval = time.Minute
+
+// MemoryCache implementa Cache usando un mapa en memoria. Las entradas vencidas se
+// eliminan al leerlas y, como mucho una vez por sweepInterval, al guardar otra.
+type MemoryCache struct {
+	entries   map[int64]cacheEntry
+	mu        sync.RWMutex
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+type cacheEntry struct {
+	grants     []Grant
+	expiration time.Time
+}
+
+// NewMemoryCache crea una nueva instancia de MemoryCache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[int64]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get obtiene los permisos de un usuario si están en caché y no han expirado
+func (c *MemoryCache) Get(ctx context.Context, userID int64) ([]Grant, bool, error) {
+	c.mu.RLock()
+	entry, exists := c.entries[userID]
+	c.mu.RUnlock()
+
+	if !exists {
+		return nil, false, nil
+	}
+	if c.now().After(entry.expiration) {
+		c.mu.Lock()
+		// Otra llamada pudo guardar una entrada nueva mientras tanto
+		if current, ok := c.entries[userID]; ok && c.now().After(current.expiration) {
+			delete(c.entries, userID)
+		}
+		c.mu.Unlock()
+		return nil, false, nil
+	}
+
+	return entry.grants, true, nil
+}
+
+// Set almacena los permisos de un usuario durante ttl
+func (c *MemoryCache) Set(ctx context.Context, userID int64, grants []Grant, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.After(c.nextSweep) {
+		for id, entry := range c.entries {
+			if now.After(entry.expiration) {
+				delete(c.entries, id)
+			}
+		}
+		c.nextSweep = now.Add(sweepInterval)
+	}
+
+	c.entries[userID] = cacheEntry{
+		grants:     grants,
+		expiration: now.Add(ttl),
+	}
+
+	return nil
+}
+
+// Delete elimina de la caché los permisos de un usuario
+func (c *MemoryCache) Delete(ctx context.Context, userID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, userID)
+
+	return nil
+}
+
+// Flush elimina los permisos de todos los usuarios
+func (c *MemoryCache) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[int64]cacheEntry)
+
+	return nil
+}
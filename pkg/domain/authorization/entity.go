@@ -0,0 +1,40 @@
+package authorization
+
+// Tipos morph de Laravel usados en permissions y assigned_roles
+const (
+	UserType = "App\\Models\\User"
+	RoleType = "App\\Models\\Role"
+	JobType  = "App\\Models\\Job"
+)
+
+// Grant es una fila de permissions aplicable al usuario, ya sea directa, heredada de
+// un rol asignado o concedida a todos (entity_id y entity_type nulos), con los datos
+// de la habilidad y de la asignación necesarios para evaluarla como Bouncer
+type Grant struct {
+	AbilityID int64
+	Name      string
+	// EntityType y EntityID limitan la habilidad a un modelo; EntityType "*" es cualquier modelo
+	EntityType string
+	EntityID   *int64
+	OnlyOwned  bool
+	Forbidden  bool
+
+	// Scopes de la habilidad, del permiso, del rol y de la asignación (nil = sin scope)
+	AbilityScope    *int
+	PermissionScope *int
+	RoleScope       *int
+	AssignmentScope *int
+
+	// RestrictedToType y RestrictedToID limitan un rol asignado a un modelo concreto
+	RestrictedToType string
+	RestrictedToID   *int64
+}
+
+// Entity es el modelo sobre el que se evalúa una habilidad. ID 0 evalúa sobre el tipo
+// en general (por ejemplo "crear jobs"); Type "*" sobre cualquier modelo.
+type Entity struct {
+	Type string
+	ID   int64
+	// OwnerID es el dueño del modelo (columna user_id en Laravel), para las habilidades only_owned
+	OwnerID *int64
+}
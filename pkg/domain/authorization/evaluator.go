@@ -0,0 +1,180 @@
+package authorization
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Evaluator resuelve las habilidades de un usuario con la semántica de Bouncer:
+// lo prohibido prevalece sobre lo permitido, los registros de otro scope se ignoran,
+// las habilidades ligadas a un modelo solo aplican a ese modelo y las only_owned
+// solo aplican a los modelos del usuario
+type Evaluator struct {
+	userID    int64
+	allowed   []Grant
+	forbidden []Grant
+}
+
+// NewEvaluator crea el evaluador con los permisos del usuario. Si scope no es nil se
+// descartan los registros con un scope distinto (los que no tienen scope aplican siempre).
+func NewEvaluator(userID int64, grants []Grant, scope *int) *Evaluator {
+	e := &Evaluator{userID: userID}
+	for _, g := range grants {
+		if !g.inScope(scope) {
+			continue
+		}
+		if g.Forbidden {
+			e.forbidden = append(e.forbidden, g)
+		} else {
+			e.allowed = append(e.allowed, g)
+		}
+	}
+	return e
+}
+
+// UserID retorna el usuario evaluado
+func (e *Evaluator) UserID() int64 {
+	return e.userID
+}
+
+// Can indica si el usuario puede realizar ability sobre entity (nil si no hay modelo)
+func (e *Evaluator) Can(ability string, entity *Entity) bool {
+	if e == nil {
+		return false
+	}
+	applicable := applicableIdentifiers(ability, entity)
+	if e.matches(e.forbidden, applicable, entity) {
+		return false
+	}
+	return e.matches(e.allowed, applicable, entity)
+}
+
+// CanAccess indica si el usuario puede realizar ability sobre entity, ya sea por la
+// habilidad general (como la exigen las rutas) o por un permiso ligado al modelo.
+// Una prohibición sobre el modelo prevalece también sobre la habilidad general.
+func (e *Evaluator) CanAccess(ability string, entity *Entity) bool {
+	if e == nil {
+		return false
+	}
+	if entity == nil {
+		return e.Can(ability, nil)
+	}
+	applicable := applicableIdentifiers(ability, entity)
+	if e.matches(e.forbidden, applicable, entity) {
+		return false
+	}
+	return e.Can(ability, nil) || e.matches(e.allowed, applicable, entity)
+}
+
+// HasEntityGrant indica si ability está concedida sobre algún modelo (un tipo, un registro
+// o los propios), de modo que solo puede resolverse al conocer el modelo
+func (e *Evaluator) HasEntityGrant(ability string) bool {
+	if e == nil {
+		return false
+	}
+	for _, g := range e.allowed {
+		if g.EntityType != "" && (strings.EqualFold(g.Name, ability) || g.Name == "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// Names retorna los nombres de las habilidades permitidas, sin repetir
+func (e *Evaluator) Names() []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0, len(e.allowed))
+	for _, g := range e.allowed {
+		if !seen[g.Name] {
+			seen[g.Name] = true
+			names = append(names, g.Name)
+		}
+	}
+	return names
+}
+
+// matches busca un permiso cuyo identificador esté entre los aplicables. Si el modelo
+// es del usuario se prueban también los identificadores only_owned.
+func (e *Evaluator) matches(grants []Grant, applicable []string, entity *Entity) bool {
+	owned := entity != nil && entity.OwnerID != nil && *entity.OwnerID == e.userID
+
+	for _, g := range grants {
+		if !g.appliesTo(entity) {
+			continue
+		}
+		id := g.identifier()
+		for _, candidate := range applicable {
+			if id == candidate || (owned && id == candidate+"-owned") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// identifier arma el identificador de Bouncer: nombre[-tipo][-id][-owned], en minúsculas
+func (g Grant) identifier() string {
+	parts := []string{g.Name}
+	if g.EntityType != "" {
+		parts = append(parts, g.EntityType)
+	}
+	if g.EntityID != nil && *g.EntityID != 0 {
+		parts = append(parts, strconv.FormatInt(*g.EntityID, 10))
+	}
+	if g.OnlyOwned {
+		parts = append(parts, "owned")
+	}
+	return strings.ToLower(strings.Join(parts, "-"))
+}
+
+// inScope indica si todos los registros del permiso pertenecen al scope (o no tienen scope)
+func (g Grant) inScope(scope *int) bool {
+	if scope == nil {
+		return true
+	}
+	for _, s := range []*int{g.AbilityScope, g.PermissionScope, g.RoleScope, g.AssignmentScope} {
+		if s != nil && *s != *scope {
+			return false
+		}
+	}
+	return true
+}
+
+// appliesTo verifica la restricción del rol asignado: solo aplica al modelo indicado
+func (g Grant) appliesTo(entity *Entity) bool {
+	if g.RestrictedToType == "" {
+		return true
+	}
+	if entity == nil || !strings.EqualFold(entity.Type, g.RestrictedToType) {
+		return false
+	}
+	return g.RestrictedToID == nil || *g.RestrictedToID == entity.ID
+}
+
+// applicableIdentifiers lista los identificadores que conceden ability sobre entity,
+// igual que compileAbilityIdentifiers de Bouncer
+func applicableIdentifiers(ability string, entity *Entity) []string {
+	var ids []string
+	switch {
+	case entity == nil:
+		ids = []string{ability, "*-*", "*"}
+	case entity.Type == "*":
+		ids = []string{ability + "-*", "*-*"}
+	default:
+		ids = []string{
+			ability + "-" + entity.Type,
+			ability + "-*",
+			"*-" + entity.Type,
+			"*-*",
+		}
+		if entity.ID != 0 {
+			id := strconv.FormatInt(entity.ID, 10)
+			ids = append(ids, ability+"-"+entity.Type+"-"+id, "*-"+entity.Type+"-"+id)
+		}
+	}
+
+	for i := range ids {
+		ids[i] = strings.ToLower(ids[i])
+	}
+	return ids
+}
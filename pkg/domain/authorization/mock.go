@@ -0,0 +1,33 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de permisos
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListGrants(ctx context.Context, userID int64) ([]Grant, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Grant), args.Error(1)
+}
+
+// MockInvalidator es un mock de la interfaz Invalidator
+type MockInvalidator struct {
+	mock.Mock
+}
+
+func (m *MockInvalidator) InvalidateUser(ctx context.Context, userID int64) {
+	m.Called(ctx, userID)
+}
+
+func (m *MockInvalidator) InvalidateAll(ctx context.Context) {
+	m.Called(ctx)
+}
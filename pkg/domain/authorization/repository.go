@@ -0,0 +1,9 @@
+package authorization
+
+import "context"
+
+// Repository define la lectura de permisos para evaluar habilidades
+type Repository interface {
+	// ListGrants obtiene los permisos directos del usuario, los de sus roles y los concedidos a todos
+	ListGrants(ctx context.Context, userID int64) ([]Grant, error)
+}
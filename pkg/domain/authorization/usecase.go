@@ -0,0 +1,141 @@
+package authorization
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Service define la interfaz del servicio de autorización
+type Service interface {
+	ForUser(ctx context.Context, userID int64) (*Evaluator, error)
+	Can(ctx context.Context, userID int64, ability string, entity *Entity) (bool, error)
+	InvalidateUser(ctx context.Context, userID int64)
+	InvalidateAll(ctx context.Context)
+}
+
+// UseCase implementa la resolución de permisos
+type UseCase struct {
+	repo     Repository
+	scope    *int
+	cache    Cache
+	cacheTTL time.Duration
+
+	// generation aumenta con cada invalidación; los permisos leídos antes de una
+	// invalidación no se guardan en caché para no restaurar habilidades revocadas
+	generation atomic.Uint64
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de autorización.
+// scope es el scope de Bouncer activo; nil si la aplicación no usa scopes.
+// cache puede ser nil o cacheTTL cero; en ese caso los permisos se consultan en cada llamada.
+func NewUseCase(repo Repository, scope *int, cache Cache, cacheTTL time.Duration) *UseCase {
+	return &UseCase{
+		repo:     repo,
+		scope:    scope,
+		cache:    cache,
+		cacheTTL: cacheTTL,
+	}
+}
+
+// ForUser carga los permisos del usuario y retorna su evaluador
+func (uc *UseCase) ForUser(ctx context.Context, userID int64) (*Evaluator, error) {
+	if grants, ok := uc.cachedGrants(ctx, userID); ok {
+		return NewEvaluator(userID, grants, uc.scope), nil
+	}
+
+	generation := uc.generation.Load()
+	grants, err := uc.repo.ListGrants(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load user grants",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if uc.cachingEnabled() {
+		uc.storeGrants(ctx, userID, grants, generation)
+	}
+
+	return NewEvaluator(userID, grants, uc.scope), nil
+}
+
+// storeGrants guarda en caché los permisos leídos en la generación indicada. Si hubo una
+// invalidación desde entonces no se guardan; si ocurre mientras se guardan, se descartan.
+func (uc *UseCase) storeGrants(ctx context.Context, userID int64, grants []Grant, generation uint64) {
+	if uc.generation.Load() != generation {
+		return
+	}
+
+	if err := uc.cache.Set(ctx, userID, grants, uc.cacheTTL); err != nil {
+		slog.WarnContext(ctx, "Failed to cache user grants",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return
+	}
+
+	// La invalidación aumenta la generación antes de borrar: si ya se ve el cambio, su
+	// borrado pudo ocurrir antes de Set y hay que repetirlo; si no, su borrado será posterior
+	if uc.generation.Load() != generation {
+		if err := uc.cache.Delete(ctx, userID); err != nil {
+			slog.ErrorContext(ctx, "Failed to discard stale user grants",
+				slog.Int64("userId", userID),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+// InvalidateUser descarta los permisos en caché de un usuario
+func (uc *UseCase) InvalidateUser(ctx context.Context, userID int64) {
+	if uc.cache == nil {
+		return
+	}
+	uc.generation.Add(1)
+	if err := uc.cache.Delete(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "Failed to invalidate user grants",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+	}
+}
+
+// InvalidateAll descarta los permisos en caché de todos los usuarios.
+// Se usa cuando el cambio afecta a un rol o a permisos globales.
+func (uc *UseCase) InvalidateAll(ctx context.Context) {
+	if uc.cache == nil {
+		return
+	}
+	uc.generation.Add(1)
+	if err := uc.cache.Flush(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to flush grants cache",
+			slog.String("error", err.Error()))
+	}
+}
+
+func (uc *UseCase) cachingEnabled() bool {
+	return uc.cache != nil && uc.cacheTTL > 0
+}
+
+// cachedGrants obtiene los permisos de la caché; un error del backend se trata como ausencia
+func (uc *UseCase) cachedGrants(ctx context.Context, userID int64) ([]Grant, bool) {
+	if !uc.cachingEnabled() {
+		return nil, false
+	}
+	grants, ok, err := uc.cache.Get(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to read cached user grants",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, false
+	}
+	return grants, ok
+}
+
+// Can indica si el usuario puede realizar ability sobre entity
+func (uc *UseCase) Can(ctx context.Context, userID int64, ability string, entity *Entity) (bool, error) {
+	evaluator, err := uc.ForUser(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return evaluator.Can(ability, entity), nil
+}
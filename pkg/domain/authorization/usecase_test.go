@@ -0,0 +1,387 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const jobType = JobType
+
+func intPtr(v int) *int       { return &v }
+func int64Ptr(v int64) *int64 { return &v }
+
+func allow(name string) Grant {
+	return Grant{Name: name}
+}
+
+func forbid(name string) Grant {
+	return Grant{Name: name, Forbidden: true}
+}
+
+func onType(g Grant, entityType string, id int64) Grant {
+	g.EntityType = entityType
+	if id != 0 {
+		g.EntityID = int64Ptr(id)
+	}
+	return g
+}
+
+func job(id int64, owner int64) *Entity {
+	e := &Entity{Type: jobType, ID: id}
+	if owner != 0 {
+		e.OwnerID = int64Ptr(owner)
+	}
+	return e
+}
+
+func TestEvaluator_Can(t *testing.T) {
+	const userID = 7
+
+	tests := []struct {
+		name    string
+		grants  []Grant
+		scope   *int
+		ability string
+		entity  *Entity
+		want    bool
+	}{
+		// Habilidades simples
+		{"simple ability granted", []Grant{allow("job_view")}, nil, "job_view", nil, true},
+		{"simple ability missing", []Grant{allow("job_view")}, nil, "job_delete", nil, false},
+		{"name is case insensitive", []Grant{allow("Job_View")}, nil, "job_view", nil, true},
+		{"no grants", nil, nil, "job_view", nil, false},
+		{"star grants simple abilities", []Grant{allow("*")}, nil, "job_delete", nil, true},
+		{"star does not grant model abilities", []Grant{allow("*")}, nil, "edit", job(42, 0), false},
+		{"star-star grants everything", []Grant{onType(allow("*"), "*", 0)}, nil, "edit", job(42, 0), true},
+		{"star-star grants simple abilities", []Grant{onType(allow("*"), "*", 0)}, nil, "job_view", nil, true},
+
+		// Prohibiciones
+		{"forbidden overrides allowed", []Grant{allow("invoice_delete"), forbid("invoice_delete")}, nil, "invoice_delete", nil, false},
+		{"forbidden overrides star", []Grant{allow("*"), forbid("invoice_delete")}, nil, "invoice_delete", nil, false},
+		{"forbidden only affects its ability", []Grant{allow("*"), forbid("invoice_delete")}, nil, "invoice_view", nil, true},
+		{"forbidden on model type blocks instance", []Grant{onType(allow("edit"), jobType, 0), onType(forbid("edit"), jobType, 0)}, nil, "edit", job(42, 0), false},
+		{"forbidden on one instance only", []Grant{onType(allow("edit"), jobType, 0), onType(forbid("edit"), jobType, 42)}, nil, "edit", job(43, 0), true},
+		{"forbidden instance blocks it", []Grant{onType(allow("edit"), jobType, 0), onType(forbid("edit"), jobType, 42)}, nil, "edit", job(42, 0), false},
+		{"forbid everything on type", []Grant{allow("*"), onType(allow("*"), "*", 0), onType(forbid("*"), jobType, 0)}, nil, "edit", job(42, 0), false},
+
+		// Habilidades ligadas a un modelo
+		{"ability on one job", []Grant{onType(allow("edit"), jobType, 42)}, nil, "edit", job(42, 0), true},
+		{"ability on one job not another", []Grant{onType(allow("edit"), jobType, 42)}, nil, "edit", job(43, 0), false},
+		{"ability on one job not on class", []Grant{onType(allow("edit"), jobType, 42)}, nil, "edit", job(0, 0), false},
+		{"ability on type covers instances", []Grant{onType(allow("edit"), jobType, 0)}, nil, "edit", job(42, 0), true},
+		{"ability on type covers class", []Grant{onType(allow("create"), jobType, 0)}, nil, "create", job(0, 0), true},
+		{"ability on type not simple", []Grant{onType(allow("edit"), jobType, 0)}, nil, "edit", nil, false},
+		{"simple ability not on model", []Grant{allow("edit")}, nil, "edit", job(42, 0), false},
+		{"ability on any model", []Grant{onType(allow("edit"), "*", 0)}, nil, "edit", job(42, 0), true},
+		{"all abilities on type", []Grant{onType(allow("*"), jobType, 0)}, nil, "delete", job(42, 0), true},
+		{"all abilities on instance", []Grant{onType(allow("*"), jobType, 42)}, nil, "delete", job(42, 0), true},
+		{"all abilities on other type", []Grant{onType(allow("*"), "App\\Models\\Invoice", 0)}, nil, "delete", job(42, 0), false},
+		{"type is case insensitive", []Grant{onType(allow("edit"), "app\\models\\job", 0)}, nil, "edit", job(42, 0), true},
+		{"wildcard model check", []Grant{onType(allow("edit"), "*", 0)}, nil, "edit", &Entity{Type: "*"}, true},
+		{"type ability not for wildcard check", []Grant{onType(allow("edit"), jobType, 0)}, nil, "edit", &Entity{Type: "*"}, false},
+
+		// only_owned
+		{"owned grants own model", []Grant{{Name: "edit", EntityType: jobType, OnlyOwned: true}}, nil, "edit", job(42, userID), true},
+		{"owned denies other model", []Grant{{Name: "edit", EntityType: jobType, OnlyOwned: true}}, nil, "edit", job(42, 8), false},
+		{"owned denies unknown owner", []Grant{{Name: "edit", EntityType: jobType, OnlyOwned: true}}, nil, "edit", job(42, 0), false},
+		{"owned star on own model", []Grant{{Name: "*", EntityType: "*", OnlyOwned: true}}, nil, "delete", job(42, userID), true},
+		{"owned forbidden blocks own model", []Grant{onType(allow("edit"), jobType, 0), {Name: "edit", EntityType: jobType, OnlyOwned: true, Forbidden: true}}, nil, "edit", job(42, userID), false},
+		{"owned forbidden spares others", []Grant{onType(allow("edit"), jobType, 0), {Name: "edit", EntityType: jobType, OnlyOwned: true, Forbidden: true}}, nil, "edit", job(42, 8), true},
+
+		// Scope
+		{"no scope applies everything", []Grant{{Name: "job_view", PermissionScope: intPtr(2)}}, nil, "job_view", nil, true},
+		{"same scope applies", []Grant{{Name: "job_view", PermissionScope: intPtr(1)}}, intPtr(1), "job_view", nil, true},
+		{"null scope applies in any scope", []Grant{allow("job_view")}, intPtr(1), "job_view", nil, true},
+		{"other permission scope ignored", []Grant{{Name: "job_view", PermissionScope: intPtr(2)}}, intPtr(1), "job_view", nil, false},
+		{"other ability scope ignored", []Grant{{Name: "job_view", AbilityScope: intPtr(2)}}, intPtr(1), "job_view", nil, false},
+		{"other role scope ignored", []Grant{{Name: "job_view", RoleScope: intPtr(2)}}, intPtr(1), "job_view", nil, false},
+		{"other assignment scope ignored", []Grant{{Name: "job_view", AssignmentScope: intPtr(2)}}, intPtr(1), "job_view", nil, false},
+		{"forbidden in other scope ignored", []Grant{allow("job_view"), {Name: "job_view", Forbidden: true, PermissionScope: intPtr(2)}}, intPtr(1), "job_view", nil, true},
+
+		// Roles asignados con restricción a un modelo
+		{"restricted role on its model", []Grant{{Name: "edit", EntityType: "*", RestrictedToType: jobType, RestrictedToID: int64Ptr(42)}}, nil, "edit", job(42, 0), true},
+		{"restricted role on other model", []Grant{{Name: "edit", EntityType: "*", RestrictedToType: jobType, RestrictedToID: int64Ptr(42)}}, nil, "edit", job(43, 0), false},
+		{"restricted role without model", []Grant{{Name: "edit", RestrictedToType: jobType, RestrictedToID: int64Ptr(42)}}, nil, "edit", nil, false},
+		{"restricted role to type", []Grant{{Name: "edit", EntityType: "*", RestrictedToType: jobType}}, nil, "edit", job(43, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := NewEvaluator(userID, tt.grants, tt.scope)
+			assert.Equal(t, tt.want, evaluator.Can(tt.ability, tt.entity))
+		})
+	}
+}
+
+func TestEvaluator_CanAccess(t *testing.T) {
+	const userID = 7
+
+	tests := []struct {
+		name    string
+		grants  []Grant
+		ability string
+		entity  *Entity
+		want    bool
+	}{
+		{"simple ability covers every job", []Grant{allow("job_edit")}, "job_edit", job(42, 0), true},
+		{"ability on one job", []Grant{onType(allow("job_edit"), jobType, 42)}, "job_edit", job(42, 0), true},
+		{"ability on one job not another", []Grant{onType(allow("job_edit"), jobType, 42)}, "job_edit", job(43, 0), false},
+		{"owned ability on own job", []Grant{{Name: "job_edit", EntityType: jobType, OnlyOwned: true}}, "job_edit", job(42, userID), true},
+		{"owned ability on other job", []Grant{{Name: "job_edit", EntityType: jobType, OnlyOwned: true}}, "job_edit", job(42, 8), false},
+		{"forbidden job overrides simple ability", []Grant{allow("job_edit"), onType(forbid("job_edit"), jobType, 42)}, "job_edit", job(42, 0), false},
+		{"forbidden job spares others", []Grant{allow("job_edit"), onType(forbid("job_edit"), jobType, 42)}, "job_edit", job(43, 0), true},
+		{"without entity is the simple ability", []Grant{onType(allow("job_edit"), jobType, 42)}, "job_edit", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := NewEvaluator(userID, tt.grants, nil)
+			assert.Equal(t, tt.want, evaluator.CanAccess(tt.ability, tt.entity))
+		})
+	}
+}
+
+func TestEvaluator_HasEntityGrant(t *testing.T) {
+	assert.True(t, NewEvaluator(7, []Grant{onType(allow("job_edit"), jobType, 42)}, nil).HasEntityGrant("job_edit"))
+	assert.True(t, NewEvaluator(7, []Grant{{Name: "job_edit", EntityType: jobType, OnlyOwned: true}}, nil).HasEntityGrant("job_edit"))
+	assert.True(t, NewEvaluator(7, []Grant{onType(allow("*"), jobType, 0)}, nil).HasEntityGrant("job_edit"))
+	assert.False(t, NewEvaluator(7, []Grant{allow("job_edit")}, nil).HasEntityGrant("job_edit"))
+	assert.False(t, NewEvaluator(7, []Grant{onType(allow("job_view"), jobType, 42)}, nil).HasEntityGrant("job_edit"))
+}
+
+func TestEvaluator_Names(t *testing.T) {
+	evaluator := NewEvaluator(7, []Grant{allow("job_view"), allow("job_view"), forbid("invoice_delete"), allow("invoice_view")}, nil)
+
+	assert.Equal(t, []string{"job_view", "invoice_view"}, evaluator.Names())
+}
+
+func TestEvaluator_Nil(t *testing.T) {
+	var evaluator *Evaluator
+
+	assert.False(t, evaluator.Can("job_view", nil))
+}
+
+func TestUseCase_Can(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("loads grants with scope", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{
+			{Name: "job_view", PermissionScope: intPtr(1)},
+			{Name: "job_delete", PermissionScope: intPtr(2)},
+		}, nil)
+		uc := NewUseCase(repo, intPtr(1), nil, 0)
+
+		canView, err := uc.Can(ctx, 7, "job_view", nil)
+		assert.NoError(t, err)
+		assert.True(t, canView)
+
+		canDelete, err := uc.Can(ctx, 7, "job_delete", nil)
+		assert.NoError(t, err)
+		assert.False(t, canDelete)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return(nil, errors.New("db down"))
+		uc := NewUseCase(repo, nil, nil, 0)
+
+		can, err := uc.Can(ctx, 7, "job_view", nil)
+
+		assert.EqualError(t, err, "db down")
+		assert.False(t, can)
+	})
+}
+
+func TestUseCase_ForUser_Cache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("second call is served from cache", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Once()
+		uc := NewUseCase(repo, nil, NewMemoryCache(), time.Minute)
+
+		for i := 0; i < 2; i++ {
+			evaluator, err := uc.ForUser(ctx, 7)
+			assert.NoError(t, err)
+			assert.True(t, evaluator.Can("job_view", nil))
+		}
+		repo.AssertNumberOfCalls(t, "ListGrants", 1)
+	})
+
+	t.Run("invalidate user reloads grants", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Once()
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_delete")}, nil).Once()
+		uc := NewUseCase(repo, nil, NewMemoryCache(), time.Minute)
+
+		_, err := uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		uc.InvalidateUser(ctx, 7)
+
+		evaluator, err := uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		assert.False(t, evaluator.Can("job_view", nil))
+		assert.True(t, evaluator.Can("job_delete", nil))
+	})
+
+	t.Run("invalidate all reloads every user", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Twice()
+		repo.On("ListGrants", ctx, int64(8)).Return([]Grant{allow("job_view")}, nil).Twice()
+		uc := NewUseCase(repo, nil, NewMemoryCache(), time.Minute)
+
+		for _, id := range []int64{7, 8} {
+			_, err := uc.ForUser(ctx, id)
+			assert.NoError(t, err)
+		}
+		uc.InvalidateAll(ctx)
+		for _, id := range []int64{7, 8} {
+			_, err := uc.ForUser(ctx, id)
+			assert.NoError(t, err)
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("expired entry is reloaded", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Twice()
+		uc := NewUseCase(repo, nil, NewMemoryCache(), time.Nanosecond)
+
+		_, err := uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		_, err = uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListGrants", ctx, int64(7)).Return(nil, errors.New("db down")).Once()
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Once()
+		uc := NewUseCase(repo, nil, NewMemoryCache(), time.Minute)
+
+		_, err := uc.ForUser(ctx, 7)
+		assert.EqualError(t, err, "db down")
+
+		evaluator, err := uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		assert.True(t, evaluator.Can("job_view", nil))
+	})
+}
+
+// racingCache ejecuta beforeSet antes de guardar, para simular una invalidación
+// concurrente con el guardado de los permisos
+type racingCache struct {
+	*MemoryCache
+	beforeSet func()
+}
+
+func (c *racingCache) Set(ctx context.Context, userID int64, grants []Grant, ttl time.Duration) error {
+	if c.beforeSet != nil {
+		c.beforeSet()
+	}
+	return c.MemoryCache.Set(ctx, userID, grants, ttl)
+}
+
+func TestUseCase_ForUser_ConcurrentInvalidation(t *testing.T) {
+	ctx := context.Background()
+
+	for name, invalidate := range map[string]func(uc *UseCase){
+		"user": func(uc *UseCase) { uc.InvalidateUser(ctx, 7) },
+		"all":  func(uc *UseCase) { uc.InvalidateAll(ctx) },
+	} {
+		t.Run("invalidate "+name+" while loading grants", func(t *testing.T) {
+			repo := new(MockRepository)
+			uc := NewUseCase(repo, nil, NewMemoryCache(), time.Minute)
+			repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_delete")}, nil).Run(func(mock.Arguments) {
+				invalidate(uc)
+			}).Once()
+			repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Once()
+
+			stale, err := uc.ForUser(ctx, 7)
+			assert.NoError(t, err)
+			assert.True(t, stale.Can("job_delete", nil))
+
+			evaluator, err := uc.ForUser(ctx, 7)
+			assert.NoError(t, err)
+			assert.False(t, evaluator.Can("job_delete", nil))
+			repo.AssertNumberOfCalls(t, "ListGrants", 2)
+		})
+	}
+
+	t.Run("invalidate while storing grants", func(t *testing.T) {
+		repo := new(MockRepository)
+		cache := &racingCache{MemoryCache: NewMemoryCache()}
+		uc := NewUseCase(repo, nil, cache, time.Minute)
+		cache.beforeSet = func() {
+			cache.beforeSet = nil
+			uc.InvalidateUser(ctx, 7)
+		}
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_delete")}, nil).Once()
+		repo.On("ListGrants", ctx, int64(7)).Return([]Grant{allow("job_view")}, nil).Once()
+
+		_, err := uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		_, cached, _ := cache.Get(ctx, 7)
+		assert.False(t, cached)
+
+		evaluator, err := uc.ForUser(ctx, 7)
+		assert.NoError(t, err)
+		assert.False(t, evaluator.Can("job_delete", nil))
+	})
+}
+
+func TestMemoryCache_RemovesExpiredEntries(t *testing.T) {
+	ctx := context.Background()
+	current := time.Now()
+	cache := NewMemoryCache()
+	cache.now = func() time.Time { return current }
+
+	assert.NoError(t, cache.Set(ctx, 7, []Grant{allow("job_view")}, time.Second))
+	assert.NoError(t, cache.Set(ctx, 8, []Grant{allow("job_view")}, time.Second))
+
+	current = current.Add(2 * time.Second)
+	_, ok, err := cache.Get(ctx, 7)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NotContains(t, cache.entries, int64(7))
+	assert.Contains(t, cache.entries, int64(8))
+
+	// Pasado el intervalo, guardar otra entrada barre las vencidas que nadie volvió a leer
+	current = current.Add(sweepInterval)
+	assert.NoError(t, cache.Set(ctx, 9, []Grant{allow("job_view")}, time.Minute))
+	assert.NotContains(t, cache.entries, int64(8))
+	assert.Contains(t, cache.entries, int64(9))
+
+	grants, ok, err := cache.Get(ctx, 9)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Len(t, grants, 1)
+}
+
+func TestInvalidateEntity(t *testing.T) {
+	ctx := context.Background()
+
+	inv := new(MockInvalidator)
+	inv.On("InvalidateUser", ctx, int64(3)).Return()
+	InvalidateEntity(ctx, inv, UserType, 3)
+	inv.AssertExpectations(t)
+	inv.AssertNotCalled(t, "InvalidateAll", ctx)
+
+	inv = new(MockInvalidator)
+	inv.On("InvalidateAll", ctx).Return()
+	InvalidateEntity(ctx, inv, RoleType, 3)
+	InvalidateEveryone(ctx, inv)
+	inv.AssertNumberOfCalls(t, "InvalidateAll", 2)
+
+	// Sin caché no hay nada que invalidar
+	InvalidateEntity(ctx, nil, UserType, 3)
+	InvalidateEveryone(ctx, nil)
+}
@@ -0,0 +1,26 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create crea una nueva plantilla de email
+func (uc *UseCase) Create(ctx context.Context, template *EmailTemplate) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(ctx, template); err != nil {
+		slog.ErrorContext(ctx, "Failed to create email template",
+			slog.String("error", err.Error()),
+			slog.String("label", template.Label))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Email template created successfully",
+		slog.Int64("email_template_id", template.ID),
+		slog.String("label", template.Label))
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una plantilla de email
+func (uc *UseCase) Delete(ctx context.Context, id int64) error {
+	if _, err := uc.repo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete email template",
+			slog.String("error", err.Error()),
+			slog.Int64("email_template_id", id))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Email template deleted successfully",
+		slog.Int64("email_template_id", id))
+
+	return nil
+}
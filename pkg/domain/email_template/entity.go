@@ -0,0 +1,85 @@
+package email_template
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/placeholder"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+)
+
+// EmailTemplate representa una plantilla de email con marcadores del job
+type EmailTemplate struct {
+	ID        int64      `json:"id"`
+	Label     string     `json:"label"`
+	Subject   string     `json:"subject"`
+	Body      string     `json:"body"`
+	IsActive  bool       `json:"isActive"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos y que los marcadores usados existan
+func (t *EmailTemplate) Validate() error {
+	t.Label = strings.TrimSpace(t.Label)
+	t.Subject = strings.TrimSpace(t.Subject)
+
+	if t.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if t.Subject == "" {
+		return fmt.Errorf("subject is required")
+	}
+
+	if strings.TrimSpace(t.Body) == "" {
+		return fmt.Errorf("body is required")
+	}
+
+	return validatePlaceholders(t.Subject + "\n" + t.Body)
+}
+
+// validatePlaceholders verifica que el texto solo use marcadores conocidos
+func validatePlaceholders(text string) error {
+	if unknown := placeholder.Unknown(text, domainPlaceholder.Keys); len(unknown) > 0 {
+		return fmt.Errorf("unknown placeholders: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Rendered es el resultado de rellenar una plantilla con los datos de un job
+type Rendered struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	// Missing son los marcadores que no pudieron reemplazarse
+	Missing []string `json:"missing"`
+}
+
+// Render rellena el asunto y el cuerpo con los valores de los marcadores
+func Render(subject, body string, values map[string]string) *Rendered {
+	renderedSubject, missingSubject := placeholder.Render(subject, values)
+	renderedBody, missingBody := placeholder.Render(body, values)
+
+	missing := append([]string{}, missingSubject...)
+	for _, k := range missingBody {
+		if !contains(missing, k) {
+			missing = append(missing, k)
+		}
+	}
+
+	return &Rendered{
+		Subject: renderedSubject,
+		Body:    renderedBody,
+		Missing: missing,
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
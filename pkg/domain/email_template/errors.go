@@ -0,0 +1,11 @@
+package email_template
+
+import "errors"
+
+var (
+	// ErrEmailTemplateNotFound indica que la plantilla de email no fue encontrada
+	ErrEmailTemplateNotFound = errors.New("email template not found")
+
+	// ErrInvalidJob indica que el job con el que se renderiza la plantilla no existe
+	ErrInvalidJob = errors.New("invalid job")
+)
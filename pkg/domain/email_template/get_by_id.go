@@ -0,0 +1,19 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// GetByID obtiene una plantilla de email por su ID
+func (uc *UseCase) GetByID(ctx context.Context, id int64) (*EmailTemplate, error) {
+	template, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get email template by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("email_template_id", id))
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,27 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// List obtiene una lista paginada de plantillas de email
+func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*EmailTemplate, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	templates, total, err := uc.repo.List(ctx, filters, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list email templates",
+			slog.String("error", err.Error()),
+			slog.Int("page", page),
+			slog.Int("pageSize", pageSize))
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
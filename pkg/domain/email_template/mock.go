@@ -0,0 +1,43 @@
+package email_template
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de plantillas de email
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, template *EmailTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*EmailTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*EmailTemplate), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*EmailTemplate, int, error) {
+	args := m.Called(ctx, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*EmailTemplate), args.Int(1), args.Error(2)
+}
+
+func (m *MockRepository) Update(ctx context.Context, template *EmailTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,29 @@
+package email_template
+
+import (
+	"context"
+)
+
+// Preview renderiza una plantilla guardada con los datos de un job
+func (uc *UseCase) Preview(ctx context.Context, id, jobID int64) (*Rendered, error) {
+	template, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.render(ctx, template.Subject, template.Body, jobID)
+}
+
+// PreviewDraft renderiza un asunto y un cuerpo aún no guardados con los datos de un job
+func (uc *UseCase) PreviewDraft(ctx context.Context, subject, body string, jobID int64) (*Rendered, error) {
+	return uc.render(ctx, subject, body, jobID)
+}
+
+func (uc *UseCase) render(ctx context.Context, subject, body string, jobID int64) (*Rendered, error) {
+	data, err := uc.dataLoader.Load(ctx, jobID)
+	if err != nil {
+		return nil, ErrInvalidJob
+	}
+
+	return Render(subject, body, data.Values()), nil
+}
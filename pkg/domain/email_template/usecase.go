@@ -0,0 +1,32 @@
+package email_template
+
+import (
+	"context"
+
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+)
+
+// Service define la interfaz del servicio de plantillas de email
+type Service interface {
+	Create(ctx context.Context, template *EmailTemplate) error
+	GetByID(ctx context.Context, id int64) (*EmailTemplate, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*EmailTemplate, int, error)
+	Update(ctx context.Context, template *EmailTemplate) error
+	Delete(ctx context.Context, id int64) error
+	Preview(ctx context.Context, id, jobID int64) (*Rendered, error)
+	PreviewDraft(ctx context.Context, subject, body string, jobID int64) (*Rendered, error)
+}
+
+// UseCase implementa la lógica de negocio de plantillas de email
+type UseCase struct {
+	repo       Repository
+	dataLoader domainPlaceholder.Service
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de plantillas de email
+func NewUseCase(repo Repository, dataLoader domainPlaceholder.Service) *UseCase {
+	return &UseCase{
+		repo:       repo,
+		dataLoader: dataLoader,
+	}
+}
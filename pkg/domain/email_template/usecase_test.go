@@ -0,0 +1,100 @@
+package email_template
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+	domainProperty "github.com/your-org/jvairv2/pkg/domain/property"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		tpl := &EmailTemplate{Label: " Dispatch ", Subject: "Job {{job.work_order}}", Body: "At {{ property.address }}"}
+		assert.NoError(t, tpl.Validate())
+		assert.Equal(t, "Dispatch", tpl.Label)
+	})
+
+	t.Run("subject required", func(t *testing.T) {
+		err := (&EmailTemplate{Label: "Dispatch", Body: "Hi"}).Validate()
+		assert.EqualError(t, err, "subject is required")
+	})
+
+	t.Run("unknown placeholders", func(t *testing.T) {
+		err := (&EmailTemplate{Label: "Dispatch", Subject: "{{job.nope}}", Body: "{{customer.name}} {{foo}}"}).Validate()
+		assert.EqualError(t, err, "unknown placeholders: job.nope, foo")
+	})
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo, nil)
+
+	err := uc.Create(ctx, &EmailTemplate{Label: "Dispatch", Subject: "Hi", Body: "{{bad}}"})
+
+	assert.Error(t, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPreview(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("renders against job", func(t *testing.T) {
+		repo := new(MockRepository)
+		loader := new(domainPlaceholder.MockService)
+		uc := NewUseCase(repo, loader)
+
+		repo.On("GetByID", ctx, int64(1)).Return(&EmailTemplate{
+			ID:      1,
+			Subject: "Work order {{job.work_order}}",
+			Body:    "Please visit {{property.address}} ({{scheduled_time}}). Tech: {{technician.name}}",
+		}, nil)
+		loader.On("Load", ctx, int64(100)).Return(&domainPlaceholder.Data{
+			Job: &domainJob.Job{ID: 100, WorkOrder: strPtr("WO-1"), DateReceived: time.Now(), ScheduledTime: strPtr("9am")},
+			Property: &domainProperty.Property{
+				Street: "123 Main St", City: "Springfield", State: "IL", Zip: "62701",
+			},
+		}, nil)
+
+		rendered, err := uc.Preview(ctx, 1, 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Work order WO-1", rendered.Subject)
+		assert.Equal(t, "Please visit 123 Main St, Springfield, IL 62701 (9am). Tech: ", rendered.Body)
+		assert.Empty(t, rendered.Missing)
+	})
+
+	t.Run("reports unknown placeholders in drafts", func(t *testing.T) {
+		loader := new(domainPlaceholder.MockService)
+		uc := NewUseCase(new(MockRepository), loader)
+
+		loader.On("Load", ctx, int64(100)).Return(&domainPlaceholder.Data{Job: &domainJob.Job{ID: 100}}, nil)
+
+		rendered, err := uc.PreviewDraft(ctx, "{{job.id}} {{oops}}", "{{oops}} {{other}}", 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "100 {{oops}}", rendered.Subject)
+		assert.Equal(t, []string{"oops", "other"}, rendered.Missing)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		repo := new(MockRepository)
+		loader := new(domainPlaceholder.MockService)
+		uc := NewUseCase(repo, loader)
+
+		repo.On("GetByID", ctx, int64(1)).Return(&EmailTemplate{ID: 1, Subject: "s", Body: "b"}, nil)
+		loader.On("Load", ctx, int64(100)).Return(nil, errors.New("not found"))
+
+		_, err := uc.Preview(ctx, 1, 100)
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
@@ -0,0 +1,161 @@
+package event
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBufferSize es la cantidad de eventos recientes que se conservan para reanudar
+	DefaultBufferSize = 1024
+
+	// subscriptionBuffer es la cantidad de eventos pendientes por suscriptor antes de desconectarlo
+	subscriptionBuffer = 64
+)
+
+// Bus difunde eventos en memoria a los suscriptores del proceso.
+// Conserva los últimos eventos para que un cliente reconectado reanude desde Last-Event-ID.
+type Bus struct {
+	mu     sync.Mutex
+	lastID int64
+	buffer []*Event
+	size   int
+	subs   map[*Subscription]struct{}
+	closed bool
+	now    func() time.Time
+}
+
+// NewBus crea un bus que conserva hasta bufferSize eventos (DefaultBufferSize si es 0)
+func NewBus(bufferSize int) *Bus {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	return &Bus{
+		size: bufferSize,
+		subs: make(map[*Subscription]struct{}),
+		now:  time.Now,
+	}
+}
+
+// Publish asigna ID al evento y lo envía a los suscriptores que pueden verlo.
+// Un suscriptor que no consume a tiempo se desconecta para no frenar al publicador;
+// al reconectarse recupera lo perdido desde el buffer.
+func (b *Bus) Publish(ctx context.Context, e *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.lastID++
+	e.ID = b.lastID
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = b.now()
+	}
+
+	b.buffer = append(b.buffer, e)
+	if len(b.buffer) > b.size {
+		b.buffer = b.buffer[len(b.buffer)-b.size:]
+	}
+
+	for sub := range b.subs {
+		if !e.VisibleTo(&sub.subscriber) {
+			continue
+		}
+
+		select {
+		case sub.events <- e:
+		default:
+			slog.WarnContext(ctx, "Dropping slow event subscriber",
+				slog.Int64("userId", sub.subscriber.UserID),
+				slog.Int64("eventId", e.ID))
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribe registra un suscriptor. Si lastEventID es mayor que 0 se reenvían primero
+// los eventos del buffer posteriores a ese ID; si alguno ya salió del buffer se envía solo
+// un evento TypeReset para que el cliente recargue todo. Un ID desconocido (p. ej. de antes
+// de un reinicio) no reenvía nada.
+func (b *Bus) Subscribe(s Subscriber, lastEventID int64) (*Subscription, []*Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, nil, ErrBusClosed
+	}
+
+	var replay []*Event
+	if lastEventID > 0 && lastEventID < b.lastID {
+		if lastEventID < b.buffer[0].ID-1 {
+			// El reset lleva el último ID para que la próxima reconexión reanude desde aquí
+			replay = []*Event{{ID: b.lastID, Type: TypeReset, OccurredAt: b.now()}}
+		} else {
+			for _, e := range b.buffer {
+				if e.ID > lastEventID && e.VisibleTo(&s) {
+					replay = append(replay, e)
+				}
+			}
+		}
+	}
+
+	sub := &Subscription{
+		bus:        b,
+		subscriber: s,
+		events:     make(chan *Event, subscriptionBuffer),
+	}
+	b.subs[sub] = struct{}{}
+
+	return sub, replay, nil
+}
+
+// Close desconecta a todos los suscriptores y rechaza nuevas suscripciones
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+	for sub := range b.subs {
+		b.remove(sub)
+	}
+}
+
+// remove quita un suscriptor y cierra su canal; requiere b.mu tomado
+func (b *Bus) remove(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+
+	delete(b.subs, sub)
+	close(sub.events)
+}
+
+// Subscription es la suscripción de un cliente al bus
+type Subscription struct {
+	bus        *Bus
+	subscriber Subscriber
+	events     chan *Event
+}
+
+// Events retorna el canal de eventos; se cierra al cancelar la suscripción,
+// al desconectar a un suscriptor lento o al cerrar el bus
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Close cancela la suscripción
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.remove(s)
+}
@@ -0,0 +1,76 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Tipos de evento publicados por los casos de uso
+const (
+	TypeJobCreated      = "job.created"
+	TypeJobUpdated      = "job.updated"
+	TypeJobClosed       = "job.closed"
+	TypeAlertRaised     = "alert.raised"
+	TypePaymentRecorded = "payment.recorded"
+
+	// TypeReset indica al cliente que se perdieron eventos y debe recargar todo
+	TypeReset = "reset"
+)
+
+// Habilidades que permiten recibir eventos de entidades no asignadas al usuario
+const (
+	AbilityJobView     = "job_view"
+	AbilityInvoiceView = "invoice_view"
+
+	// AbilityJobViewUserOnly restringe al usuario a los eventos de sus propios jobs
+	AbilityJobViewUserOnly = "job_view_user_only"
+)
+
+// Abilities lista las habilidades que se evalúan al suscribirse
+var Abilities = []string{AbilityJobView, AbilityInvoiceView}
+
+// Event es un cambio de dominio que se difunde a los clientes conectados
+type Event struct {
+	ID         int64       `json:"id"`
+	Type       string      `json:"type"`
+	EntityID   int64       `json:"entityId"`
+	Data       interface{} `json:"data,omitempty"`
+	OccurredAt time.Time   `json:"occurredAt"`
+
+	// Ability es la habilidad requerida para recibir el evento ("" = cualquier usuario)
+	Ability string `json:"-"`
+	// UserID es el usuario asignado a la entidad (técnico del job o dueño de la alerta)
+	UserID *int64 `json:"-"`
+	// PreviousUserID es el asignado anterior cuando el evento reasigna la entidad;
+	// también lo recibe para que deje de mostrarla
+	PreviousUserID *int64 `json:"-"`
+	// OwnerOnly indica que solo los asignados reciben el evento, sin importar sus habilidades
+	OwnerOnly bool `json:"-"`
+}
+
+// Subscriber identifica a un cliente suscrito y lo que tiene permitido ver
+type Subscriber struct {
+	UserID int64
+	// Abilities contiene las habilidades de Abilities que el usuario tiene
+	Abilities map[string]bool
+	// AssignedOnly restringe al usuario a los eventos asignados a él (job_view_user_only)
+	AssignedOnly bool
+}
+
+// VisibleTo indica si el suscriptor puede recibir el evento.
+// Los eventos asignados al usuario (ahora o antes de una reasignación) siempre son visibles;
+// el resto requiere la habilidad del evento y que el usuario no esté restringido a sus propios jobs.
+func (e *Event) VisibleTo(s *Subscriber) bool {
+	assigned := (e.UserID != nil && *e.UserID == s.UserID) ||
+		(e.PreviousUserID != nil && *e.PreviousUserID == s.UserID)
+	if e.OwnerOnly || s.AssignedOnly {
+		return assigned
+	}
+
+	return assigned || e.Ability == "" || s.Abilities[e.Ability]
+}
+
+// Publisher publica eventos de dominio; la publicación no bloquea ni falla
+type Publisher interface {
+	Publish(ctx context.Context, e *Event)
+}
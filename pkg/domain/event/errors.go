@@ -0,0 +1,8 @@
+package event
+
+import "errors"
+
+var (
+	// ErrBusClosed indica que el bus de eventos fue cerrado por el apagado del servidor
+	ErrBusClosed = errors.New("event bus closed")
+)
@@ -0,0 +1,16 @@
+package event
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPublisher es un mock del publicador de eventos
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, e *Event) {
+	m.Called(ctx, e)
+}
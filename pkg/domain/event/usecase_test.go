@@ -0,0 +1,144 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestVisibleTo(t *testing.T) {
+	admin := &Subscriber{UserID: 1, Abilities: map[string]bool{AbilityJobView: true, AbilityInvoiceView: true}}
+	tech := &Subscriber{UserID: 5, Abilities: map[string]bool{AbilityJobView: true}, AssignedOnly: true}
+	other := &Subscriber{UserID: 9, Abilities: map[string]bool{}}
+
+	jobOfTech := &Event{Type: TypeJobUpdated, Ability: AbilityJobView, UserID: int64Ptr(5)}
+	jobOfOther := &Event{Type: TypeJobUpdated, Ability: AbilityJobView, UserID: int64Ptr(7)}
+	alertOfTech := &Event{Type: TypeAlertRaised, UserID: int64Ptr(5), OwnerOnly: true}
+	payment := &Event{Type: TypePaymentRecorded, Ability: AbilityInvoiceView}
+	reassignedFromTech := &Event{Type: TypeJobUpdated, Ability: AbilityJobView, UserID: int64Ptr(7), PreviousUserID: int64Ptr(5)}
+
+	cases := []struct {
+		name  string
+		event *Event
+		sub   *Subscriber
+		want  bool
+	}{
+		{"admin sees any job", jobOfOther, admin, true},
+		{"restricted tech sees own job", jobOfTech, tech, true},
+		{"restricted tech does not see other jobs", jobOfOther, tech, false},
+		{"user without ability does not see jobs", jobOfOther, other, false},
+		{"alert only for owner", alertOfTech, admin, false},
+		{"owner sees alert", alertOfTech, tech, true},
+		{"payment requires invoice ability", payment, admin, true},
+		{"restricted tech does not see payments", payment, tech, false},
+		{"previous tech sees reassignment", reassignedFromTech, tech, true},
+		{"reassignment still requires ability for others", reassignedFromTech, other, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, c.event.VisibleTo(c.sub))
+		})
+	}
+}
+
+func TestBusPublishFiltersSubscribers(t *testing.T) {
+	ctx := context.Background()
+	bus := NewBus(10)
+
+	admin, _, err := bus.Subscribe(Subscriber{UserID: 1, Abilities: map[string]bool{AbilityJobView: true}}, 0)
+	assert.NoError(t, err)
+	tech, _, err := bus.Subscribe(Subscriber{UserID: 5, AssignedOnly: true}, 0)
+	assert.NoError(t, err)
+
+	bus.Publish(ctx, &Event{Type: TypeJobCreated, EntityID: 1, Ability: AbilityJobView})
+	bus.Publish(ctx, &Event{Type: TypeJobUpdated, EntityID: 2, Ability: AbilityJobView, UserID: int64Ptr(5)})
+
+	first := <-admin.Events()
+	second := <-admin.Events()
+	assert.Equal(t, int64(1), first.ID)
+	assert.Equal(t, int64(2), second.ID)
+	assert.False(t, first.OccurredAt.IsZero())
+
+	own := <-tech.Events()
+	assert.Equal(t, int64(2), own.EntityID)
+	assert.Len(t, tech.Events(), 0)
+}
+
+func TestBusSubscribeReplaysAfterLastEventID(t *testing.T) {
+	ctx := context.Background()
+	bus := NewBus(3)
+	sub := Subscriber{UserID: 1, Abilities: map[string]bool{AbilityJobView: true}}
+
+	for i := int64(1); i <= 5; i++ {
+		bus.Publish(ctx, &Event{Type: TypeJobUpdated, EntityID: i, Ability: AbilityJobView})
+	}
+
+	t.Run("replays buffered events", func(t *testing.T) {
+		_, replay, err := bus.Subscribe(sub, 3)
+		assert.NoError(t, err)
+		assert.Len(t, replay, 2)
+		assert.Equal(t, int64(4), replay[0].ID)
+		assert.Equal(t, int64(5), replay[1].ID)
+	})
+
+	t.Run("replays whole buffer when contiguous", func(t *testing.T) {
+		_, replay, err := bus.Subscribe(sub, 2)
+		assert.NoError(t, err)
+		assert.Len(t, replay, 3)
+		assert.Equal(t, int64(3), replay[0].ID)
+	})
+
+	t.Run("sends reset when events were evicted", func(t *testing.T) {
+		_, replay, err := bus.Subscribe(sub, 1)
+		assert.NoError(t, err)
+		assert.Len(t, replay, 1)
+		assert.Equal(t, TypeReset, replay[0].Type)
+		assert.Equal(t, int64(5), replay[0].ID)
+	})
+
+	t.Run("unknown id does not replay", func(t *testing.T) {
+		_, replay, err := bus.Subscribe(sub, 99)
+		assert.NoError(t, err)
+		assert.Empty(t, replay)
+	})
+}
+
+func TestBusDropsSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	bus := NewBus(0)
+
+	sub, _, err := bus.Subscribe(Subscriber{UserID: 1}, 0)
+	assert.NoError(t, err)
+
+	for i := 0; i <= subscriptionBuffer; i++ {
+		bus.Publish(ctx, &Event{Type: TypeJobCreated})
+	}
+
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	assert.Equal(t, subscriptionBuffer, received)
+}
+
+func TestBusClose(t *testing.T) {
+	bus := NewBus(0)
+
+	sub, _, err := bus.Subscribe(Subscriber{UserID: 1}, 0)
+	assert.NoError(t, err)
+
+	bus.Close()
+
+	_, open := <-sub.Events()
+	assert.False(t, open)
+
+	sub.Close()
+	bus.Publish(context.Background(), &Event{Type: TypeJobCreated})
+
+	_, _, err = bus.Subscribe(Subscriber{UserID: 1}, 0)
+	assert.Equal(t, ErrBusClosed, err)
+}
@@ -0,0 +1,45 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina el registro del archivo y sus variantes, y luego los objetos almacenados.
+// Un fallo al borrar el objeto solo se registra: el archivo ya no es accesible desde la API.
+func (uc *UseCase) Delete(ctx context.Context, id int64) error {
+	f, err := uc.getVisible(ctx, id)
+	if err != nil {
+		return err
+	}
+	uc.loadVariants(ctx, f)
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete file",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	keys := make([]string, 0, len(f.Variants)+1)
+	if f.Path != nil && *f.Path != "" {
+		keys = append(keys, *f.Path)
+	}
+	for _, v := range f.Variants {
+		keys = append(keys, v.Path)
+	}
+
+	for _, key := range keys {
+		if err := uc.store.Delete(ctx, key); err != nil {
+			slog.WarnContext(ctx, "Failed to delete stored file",
+				slog.Int64("id", id),
+				slog.String("path", key),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	slog.InfoContext(ctx, "File deleted successfully",
+		slog.Int64("id", id))
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package file
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileableTypes relaciona los alias que acepta la API con el fileable_type polimórfico de Laravel
+var FileableTypes = map[string]string{
+	"job":            `App\Models\Job`,
+	"job_visit":      `App\Models\JobVisit`,
+	"warranty":       `App\Models\Warranty`,
+	"warranty_claim": `App\Models\WarrantyClaim`,
+}
+
+// ResolveFileableType retorna el fileable_type y su alias a partir de un alias o del nombre de clase
+func ResolveFileableType(value string) (fileableType, alias string, ok bool) {
+	for a, t := range FileableTypes {
+		if value == a || value == t {
+			return t, a, true
+		}
+	}
+	return "", "", false
+}
+
+// Categorías de archivo que se guardan en type cuando el cliente no indica una
+const (
+	TypeImage    = "image"
+	TypeVideo    = "video"
+	TypeDocument = "document"
+)
+
+// AllowedContentTypes son los tipos de contenido aceptados en la subida
+var AllowedContentTypes = map[string]string{
+	"image/jpeg":      TypeImage,
+	"image/png":       TypeImage,
+	"image/gif":       TypeImage,
+	"image/webp":      TypeImage,
+	"image/heic":      TypeImage,
+	"image/heif":      TypeImage,
+	"video/mp4":       TypeVideo,
+	"video/quicktime": TypeVideo,
+	"application/pdf": TypeDocument,
+	"text/plain":      TypeDocument,
+}
+
+// extensionTypes cubre formatos que http.DetectContentType no reconoce (fotos HEIC de iPhone)
+var extensionTypes = map[string]string{
+	".heic": "image/heic",
+	".heif": "image/heif",
+	".mov":  "video/quicktime",
+}
+
+// File representa un archivo adjunto a un job, visita, garantía o reclamación
+type File struct {
+	ID           int64      `json:"id"`
+	Type         *string    `json:"type,omitempty"`
+	Path         *string    `json:"path,omitempty"`
+	URL          string     `json:"url"`
+	FileableID   int64      `json:"fileableId"`
+	FileableType string     `json:"fileableType"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+
+	// Variants son las versiones reducidas de una imagen (miniatura y web)
+	Variants []*Variant `json:"variants,omitempty"`
+
+	// DownloadURL es una URL temporal firmada; no se persiste
+	DownloadURL string `json:"downloadUrl,omitempty"`
+}
+
+// Nombres de las variantes que se generan para las imágenes
+const (
+	VariantThumbnail = "thumbnail"
+	VariantWeb       = "web"
+)
+
+// Variant representa una versión reducida de una imagen, guardada en file_variants.
+// Se genera ya orientada y sin metadatos EXIF.
+type Variant struct {
+	ID          int64      `json:"id"`
+	FileID      int64      `json:"fileId"`
+	Name        string     `json:"name"`
+	Path        string     `json:"path"`
+	URL         string     `json:"url"`
+	ContentType string     `json:"contentType"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	Size        int64      `json:"size"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+
+	// DownloadURL es una URL temporal firmada; no se persiste
+	DownloadURL string `json:"downloadUrl,omitempty"`
+}
+
+// Variant retorna la variante con el nombre indicado, o nil si no existe
+func (f *File) Variant(name string) *Variant {
+	for _, v := range f.Variants {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// UploadRequest representa la subida de un archivo
+type UploadRequest struct {
+	FileableType string
+	FileableID   int64
+	Type         *string
+	Filename     string
+	Size         int64
+	Body         io.Reader
+}
+
+// detectContentType determina el tipo de contenido a partir de los primeros bytes y,
+// si no se reconoce, de la extensión del nombre original
+func detectContentType(head []byte, filename string) string {
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+
+	if _, ok := AllowedContentTypes[contentType]; !ok {
+		if byExt, ok := extensionTypes[strings.ToLower(filepath.Ext(filename))]; ok {
+			return byExt
+		}
+	}
+	return contentType
+}
@@ -0,0 +1,26 @@
+package file
+
+import "errors"
+
+var (
+	// ErrFileNotFound indica que el archivo no existe
+	ErrFileNotFound = errors.New("file not found")
+
+	// ErrInvalidFileableType indica que el tipo de entidad no admite archivos
+	ErrInvalidFileableType = errors.New("fileable type must be job, job_visit, warranty or warranty_claim")
+
+	// ErrFileableNotFound indica que la entidad a la que se adjunta el archivo no existe
+	ErrFileableNotFound = errors.New("fileable entity not found")
+
+	// ErrFileRequired indica que no se envió un archivo
+	ErrFileRequired = errors.New("file is required")
+
+	// ErrFileTooLarge indica que el archivo supera el tamaño máximo permitido
+	ErrFileTooLarge = errors.New("file exceeds maximum upload size")
+
+	// ErrUnsupportedType indica que el tipo de contenido del archivo no está permitido
+	ErrUnsupportedType = errors.New("unsupported file type")
+
+	// ErrVariantNotFound indica que el archivo no tiene la variante solicitada
+	ErrVariantNotFound = errors.New("file variant not found")
+)
@@ -0,0 +1,61 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+)
+
+// GetByID obtiene un archivo y sus variantes con URLs de descarga firmadas
+func (uc *UseCase) GetByID(ctx context.Context, id int64) (*File, error) {
+	f, err := uc.getVisible(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.loadVariants(ctx, f)
+	uc.sign(ctx, f)
+	return f, nil
+}
+
+// GetDownloadURL retorna la URL firmada del original o, si se indica, de una de sus variantes
+func (uc *UseCase) GetDownloadURL(ctx context.Context, id int64, variant string) (string, error) {
+	f, err := uc.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if variant == "" {
+		return f.DownloadURL, nil
+	}
+	v := f.Variant(variant)
+	if v == nil {
+		return "", ErrVariantNotFound
+	}
+	return v.DownloadURL, nil
+}
+
+// sign completa DownloadURL del archivo y de sus variantes. Los registros antiguos
+// sin path solo tienen la URL original.
+func (uc *UseCase) sign(ctx context.Context, f *File) {
+	f.DownloadURL = f.URL
+	if f.Path != nil && *f.Path != "" {
+		f.DownloadURL = uc.signedURL(ctx, f.ID, *f.Path, f.URL)
+	}
+
+	for _, v := range f.Variants {
+		v.DownloadURL = uc.signedURL(ctx, f.ID, v.Path, v.URL)
+	}
+}
+
+// signedURL firma la clave indicada; si falla retorna fallback
+func (uc *UseCase) signedURL(ctx context.Context, id int64, key, fallback string) string {
+	signed, err := uc.store.SignedURL(ctx, key, uc.config.URLExpiry)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to sign file URL",
+			slog.Int64("id", id),
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return fallback
+	}
+	return signed
+}
@@ -0,0 +1,32 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByFileable obtiene los archivos de una entidad y sus variantes con URLs de descarga firmadas
+func (uc *UseCase) ListByFileable(ctx context.Context, fileableType string, fileableID int64) ([]*File, error) {
+	resolved, _, ok := ResolveFileableType(fileableType)
+	if !ok {
+		return nil, ErrInvalidFileableType
+	}
+	if err := uc.checkVisible(ctx, resolved, fileableID); err != nil {
+		return nil, err
+	}
+
+	files, err := uc.repo.ListByFileable(ctx, resolved, fileableID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list files",
+			slog.String("fileableType", resolved),
+			slog.Int64("fileableId", fileableID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	uc.loadVariants(ctx, files...)
+	for _, f := range files {
+		uc.sign(ctx, f)
+	}
+	return files, nil
+}
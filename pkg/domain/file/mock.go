@@ -0,0 +1,123 @@
+package file
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de archivos
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, file *File) error {
+	args := m.Called(ctx, file)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*File, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*File), args.Error(1)
+}
+
+func (m *MockRepository) ListByFileable(ctx context.Context, fileableType string, fileableID int64) ([]*File, error) {
+	args := m.Called(ctx, fileableType, fileableID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*File), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateVariant(ctx context.Context, variant *Variant) error {
+	args := m.Called(ctx, variant)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListVariants(ctx context.Context, fileIDs []int64) ([]*Variant, error) {
+	args := m.Called(ctx, fileIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Variant), args.Error(1)
+}
+
+// MockFileableChecker es un mock del verificador de entidades con archivos
+type MockFileableChecker struct {
+	mock.Mock
+}
+
+func (m *MockFileableChecker) Exists(ctx context.Context, fileableType string, id int64) (bool, error) {
+	args := m.Called(ctx, fileableType, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFileableChecker) JobID(ctx context.Context, fileableType string, id int64) (int64, error) {
+	args := m.Called(ctx, fileableType, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockJobChecker es un mock del verificador de jobs
+type MockJobChecker struct {
+	mock.Mock
+}
+
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
+
+// MockStorage es un mock del almacenamiento de archivos.
+// Objects guarda el contenido recibido en Put por clave.
+type MockStorage struct {
+	mock.Mock
+	Objects map[string][]byte
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	// Se consume el cuerpo como lo haría un almacenamiento real
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if m.Objects == nil {
+		m.Objects = map[string][]byte{}
+	}
+	m.Objects[key] = data
+
+	args := m.Called(ctx, key, size, contentType)
+	return args.Error(0)
+}
+
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockStorage) URL(key string) string {
+	args := m.Called(key)
+	return args.String(0)
+}
+
+func (m *MockStorage) SignedURL(ctx context.Context, key string, expires time.Duration) (string, error) {
+	args := m.Called(ctx, key, expires)
+	return args.String(0), args.Error(1)
+}
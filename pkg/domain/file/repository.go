@@ -0,0 +1,13 @@
+package file
+
+import "context"
+
+// Repository define las operaciones de persistencia de archivos
+type Repository interface {
+	Create(ctx context.Context, file *File) error
+	GetByID(ctx context.Context, id int64) (*File, error)
+	ListByFileable(ctx context.Context, fileableType string, fileableID int64) ([]*File, error)
+	Delete(ctx context.Context, id int64) error
+	CreateVariant(ctx context.Context, variant *Variant) error
+	ListVariants(ctx context.Context, fileIDs []int64) ([]*Variant, error)
+}
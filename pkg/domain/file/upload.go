@@ -0,0 +1,165 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/imaging"
+)
+
+// Upload valida el archivo, lo guarda en el almacenamiento y lo registra en la tabla files.
+// Si el registro falla se elimina el objeto subido para no dejar huérfanos.
+func (uc *UseCase) Upload(ctx context.Context, req *UploadRequest) (*File, error) {
+	fileableType, alias, ok := ResolveFileableType(req.FileableType)
+	if !ok {
+		return nil, ErrInvalidFileableType
+	}
+
+	if req.Body == nil || req.Size == 0 {
+		return nil, ErrFileRequired
+	}
+	if req.Size > uc.config.MaxSize {
+		return nil, ErrFileTooLarge
+	}
+
+	exists, err := uc.fileableCheck.Exists(ctx, fileableType, req.FileableID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to check fileable entity",
+			slog.String("fileableType", fileableType),
+			slog.Int64("fileableId", req.FileableID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrFileableNotFound
+	}
+	if err := uc.checkVisible(ctx, fileableType, req.FileableID); err != nil {
+		return nil, err
+	}
+
+	// Se detecta el tipo por contenido sin consumir el cuerpo
+	body := bufio.NewReaderSize(req.Body, 512)
+	head, _ := body.Peek(512)
+	contentType := detectContentType(head, req.Filename)
+	category, ok := AllowedContentTypes[contentType]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	key, err := newKey(alias, req.FileableID, req.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	// Se limita la lectura por si el tamaño declarado no coincide con el contenido
+	limited := &limitedReader{r: body, remaining: uc.config.MaxSize}
+	var content io.Reader = limited
+	size := req.Size
+
+	// Las imágenes que se pueden procesar se leen completas para quitarles la
+	// ubicación antes de guardarlas y generar después sus variantes
+	var imageData []byte
+	if imaging.Supported(contentType) {
+		imageData, err = io.ReadAll(limited)
+		if err != nil {
+			if limited.exceeded {
+				return nil, ErrFileTooLarge
+			}
+			return nil, err
+		}
+		if stripped, ok := imaging.StripGPS(imageData); ok {
+			slog.InfoContext(ctx, "Removed GPS metadata from uploaded image",
+				slog.String("key", key))
+			imageData = stripped
+		}
+		content = bytes.NewReader(imageData)
+		size = int64(len(imageData))
+	}
+
+	if err := uc.store.Put(ctx, key, content, size, contentType); err != nil {
+		if limited.exceeded {
+			return nil, ErrFileTooLarge
+		}
+		slog.ErrorContext(ctx, "Failed to store file",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	fileType := category
+	if req.Type != nil && strings.TrimSpace(*req.Type) != "" {
+		fileType = strings.TrimSpace(*req.Type)
+	}
+
+	f := &File{
+		Type:         &fileType,
+		Path:         &key,
+		URL:          uc.store.URL(key),
+		FileableID:   req.FileableID,
+		FileableType: fileableType,
+	}
+
+	if err := uc.repo.Create(ctx, f); err != nil {
+		slog.ErrorContext(ctx, "Failed to create file",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		if delErr := uc.store.Delete(ctx, key); delErr != nil {
+			slog.WarnContext(ctx, "Failed to remove orphan stored file",
+				slog.String("key", key),
+				slog.String("error", delErr.Error()))
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "File uploaded successfully",
+		slog.Int64("id", f.ID),
+		slog.String("fileableType", fileableType),
+		slog.Int64("fileableId", req.FileableID))
+
+	if imageData != nil {
+		f.Variants = uc.createVariants(ctx, f, imageData, contentType)
+	}
+
+	uc.sign(ctx, f)
+	return f, nil
+}
+
+// newKey arma la clave del objeto: <alias>/<id>/<aleatorio><extensión>
+func newKey(alias string, fileableID int64, filename string) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	if len(ext) > 10 || strings.ContainsAny(ext, "/\\ ") {
+		ext = ""
+	}
+
+	return fmt.Sprintf("%s/%d/%s%s", alias, fileableID, hex.EncodeToString(random), ext), nil
+}
+
+// limitedReader falla si se leen más de remaining bytes
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		l.exceeded = true
+		return n, ErrFileTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,127 @@
+package file
+
+import (
+	"context"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/storage"
+)
+
+const (
+	// DefaultMaxSize es el tamaño máximo de subida por defecto (25 MB)
+	DefaultMaxSize = 25 << 20
+
+	// DefaultURLExpiry es la vigencia por defecto de las URLs de descarga firmadas
+	DefaultURLExpiry = 15 * time.Minute
+
+	// DefaultThumbnailSize es el lado máximo en píxeles de la miniatura
+	DefaultThumbnailSize = 320
+
+	// DefaultWebSize es el lado máximo en píxeles de la variante web
+	DefaultWebSize = 1600
+)
+
+// Service define la interfaz del servicio de archivos
+type Service interface {
+	Upload(ctx context.Context, req *UploadRequest) (*File, error)
+	GetByID(ctx context.Context, id int64) (*File, error)
+	GetDownloadURL(ctx context.Context, id int64, variant string) (string, error)
+	ListByFileable(ctx context.Context, fileableType string, fileableID int64) ([]*File, error)
+	Delete(ctx context.Context, id int64) error
+	MaxSize() int64
+}
+
+// FileableChecker verifica existencia de la entidad a la que se adjunta un archivo
+// y resuelve el job al que pertenece
+type FileableChecker interface {
+	Exists(ctx context.Context, fileableType string, id int64) (bool, error)
+	JobID(ctx context.Context, fileableType string, id int64) (int64, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
+// Config almacena los límites de subida, la vigencia de las URLs firmadas y
+// el tamaño de las variantes de imagen
+type Config struct {
+	MaxSize       int64
+	URLExpiry     time.Duration
+	ThumbnailSize int
+	WebSize       int
+}
+
+// UseCase implementa la lógica de negocio de archivos
+type UseCase struct {
+	repo          Repository
+	fileableCheck FileableChecker
+	jobRepo       JobChecker
+	store         storage.Storage
+	config        Config
+	jobOwner      JobOwnerResolver
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de archivos
+func NewUseCase(repo Repository, fileableCheck FileableChecker, jobRepo JobChecker, store storage.Storage, config Config, jobOwner JobOwnerResolver) *UseCase {
+	if config.MaxSize <= 0 {
+		config.MaxSize = DefaultMaxSize
+	}
+	if config.URLExpiry <= 0 {
+		config.URLExpiry = DefaultURLExpiry
+	}
+	if config.ThumbnailSize <= 0 {
+		config.ThumbnailSize = DefaultThumbnailSize
+	}
+	if config.WebSize <= 0 {
+		config.WebSize = DefaultWebSize
+	}
+
+	return &UseCase{
+		repo:          repo,
+		fileableCheck: fileableCheck,
+		jobRepo:       jobRepo,
+		store:         store,
+		config:        config,
+		jobOwner:      jobOwner,
+	}
+}
+
+// MaxSize retorna el tamaño máximo de subida en bytes
+func (uc *UseCase) MaxSize() int64 {
+	return uc.config.MaxSize
+}
+
+// checkVisible verifica que el job de la entidad con archivos sea visible para el usuario autenticado
+func (uc *UseCase) checkVisible(ctx context.Context, fileableType string, fileableID int64) error {
+	if uc.jobOwner == nil {
+		return nil
+	}
+	if _, restricted := uc.jobOwner(ctx); !restricted {
+		return nil
+	}
+
+	jobID, err := uc.fileableCheck.JobID(ctx, fileableType, fileableID)
+	if err != nil {
+		return ErrFileableNotFound
+	}
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		return ErrFileableNotFound
+	}
+	return nil
+}
+
+// getVisible obtiene el archivo verificando que su job sea visible para el usuario autenticado
+func (uc *UseCase) getVisible(ctx context.Context, id int64) (*File, error) {
+	f, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrFileNotFound
+	}
+	if err := uc.checkVisible(ctx, f.FileableType, f.FileableID); err != nil {
+		return nil, ErrFileNotFound
+	}
+	return f, nil
+}
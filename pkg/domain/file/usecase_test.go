@@ -0,0 +1,390 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	stdjpeg "image/jpeg"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/imaging"
+)
+
+var jpeg = append([]byte{0xFF, 0xD8, 0xFF, 0xE0}, bytes.Repeat([]byte{0}, 60)...)
+
+func strPtr(s string) *string { return &s }
+
+func uploadRequest(body []byte, filename string) *UploadRequest {
+	return &UploadRequest{
+		FileableType: "warranty_claim",
+		FileableID:   3,
+		Filename:     filename,
+		Size:         int64(len(body)),
+		Body:         bytes.NewReader(body),
+	}
+}
+
+func TestResolveFileableType(t *testing.T) {
+	fileableType, alias, ok := ResolveFileableType("job")
+	assert.True(t, ok)
+	assert.Equal(t, `App\Models\Job`, fileableType)
+	assert.Equal(t, "job", alias)
+
+	_, alias, ok = ResolveFileableType(`App\Models\JobVisit`)
+	assert.True(t, ok)
+	assert.Equal(t, "job_visit", alias)
+
+	_, _, ok = ResolveFileableType("customer")
+	assert.False(t, ok)
+}
+
+func TestUpload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores and records file", func(t *testing.T) {
+		repo := new(MockRepository)
+		checker := new(MockFileableChecker)
+		store := new(MockStorage)
+		uc := NewUseCase(repo, checker, nil, store, Config{}, nil)
+
+		checker.On("Exists", ctx, `App\Models\WarrantyClaim`, int64(3)).Return(true, nil)
+		store.On("Put", ctx, mock.AnythingOfType("string"), int64(len(jpeg)), "image/jpeg").Return(nil)
+		store.On("URL", mock.AnythingOfType("string")).Return("http://files/key")
+		repo.On("Create", ctx, mock.AnythingOfType("*file.File")).Run(func(args mock.Arguments) {
+			args.Get(1).(*File).ID = 9
+		}).Return(nil)
+		store.On("SignedURL", ctx, mock.AnythingOfType("string"), DefaultURLExpiry).Return("http://files/key?signature=x", nil)
+
+		f, err := uc.Upload(ctx, uploadRequest(jpeg, "Nameplate.JPG"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), f.ID)
+		assert.Equal(t, TypeImage, *f.Type)
+		assert.Regexp(t, regexp.MustCompile(`^warranty_claim/3/[0-9a-f]{32}\.jpg$`), *f.Path)
+		assert.Equal(t, "http://files/key", f.URL)
+		assert.Equal(t, "http://files/key?signature=x", f.DownloadURL)
+	})
+
+	t.Run("uses requested type and heic extension", func(t *testing.T) {
+		repo := new(MockRepository)
+		checker := new(MockFileableChecker)
+		store := new(MockStorage)
+		uc := NewUseCase(repo, checker, nil, store, Config{}, nil)
+		heic := bytes.Repeat([]byte{1}, 64)
+
+		checker.On("Exists", ctx, mock.Anything, int64(3)).Return(true, nil)
+		store.On("Put", ctx, mock.Anything, mock.Anything, "image/heic").Return(nil)
+		store.On("URL", mock.Anything).Return("u")
+		repo.On("Create", ctx, mock.Anything).Return(nil)
+		store.On("SignedURL", ctx, mock.Anything, mock.Anything).Return("s", nil)
+
+		req := uploadRequest(heic, "IMG_0001.HEIC")
+		req.Type = strPtr("nameplate")
+		f, err := uc.Upload(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "nameplate", *f.Type)
+	})
+
+	t.Run("removes stored object when insert fails", func(t *testing.T) {
+		repo := new(MockRepository)
+		checker := new(MockFileableChecker)
+		store := new(MockStorage)
+		uc := NewUseCase(repo, checker, nil, store, Config{}, nil)
+
+		checker.On("Exists", ctx, mock.Anything, int64(3)).Return(true, nil)
+		store.On("Put", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		store.On("URL", mock.Anything).Return("u")
+		repo.On("Create", ctx, mock.Anything).Return(errors.New("db down"))
+		store.On("Delete", ctx, mock.AnythingOfType("string")).Return(nil)
+
+		_, err := uc.Upload(ctx, uploadRequest(jpeg, "a.jpg"))
+
+		assert.EqualError(t, err, "db down")
+		store.AssertCalled(t, "Delete", ctx, mock.AnythingOfType("string"))
+	})
+
+	t.Run("rejects unsupported content", func(t *testing.T) {
+		checker := new(MockFileableChecker)
+		store := new(MockStorage)
+		uc := NewUseCase(new(MockRepository), checker, nil, store, Config{}, nil)
+
+		checker.On("Exists", ctx, mock.Anything, int64(3)).Return(true, nil)
+
+		_, err := uc.Upload(ctx, uploadRequest([]byte("MZ\x90\x00binary"), "setup.exe"))
+
+		assert.Equal(t, ErrUnsupportedType, err)
+		store.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects files over max size", func(t *testing.T) {
+		uc := NewUseCase(new(MockRepository), new(MockFileableChecker), nil, new(MockStorage), Config{MaxSize: 10}, nil)
+
+		_, err := uc.Upload(ctx, uploadRequest(jpeg, "a.jpg"))
+
+		assert.Equal(t, ErrFileTooLarge, err)
+	})
+
+	t.Run("invalid fileable", func(t *testing.T) {
+		checker := new(MockFileableChecker)
+		uc := NewUseCase(new(MockRepository), checker, nil, new(MockStorage), Config{}, nil)
+
+		_, err := uc.Upload(ctx, &UploadRequest{FileableType: "customer", FileableID: 1, Size: 1, Body: strings.NewReader("x")})
+		assert.Equal(t, ErrInvalidFileableType, err)
+
+		checker.On("Exists", ctx, mock.Anything, int64(3)).Return(false, nil)
+		_, err = uc.Upload(ctx, uploadRequest(jpeg, "a.jpg"))
+		assert.Equal(t, ErrFileableNotFound, err)
+	})
+}
+
+// photo genera un JPEG de 40x20 con EXIF: orientación 6 (girada 90°) y latitud GPS
+func photo(t *testing.T) []byte {
+	var buf bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	draw.Draw(img, image.Rect(0, 0, 20, 20), &image.Uniform{C: color.RGBA{R: 255, A: 255}}, image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(20, 0, 40, 20), &image.Uniform{C: color.RGBA{B: 255, A: 255}}, image.Point{}, draw.Src)
+	if err := stdjpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	be := binary.BigEndian
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	// IFD0: Orientation=6 y GPSInfo apuntando al IFD de GPS en el desplazamiento 38
+	tiff = be.AppendUint16(tiff, 2)
+	tiff = append(tiff, 0x01, 0x12, 0x00, 0x03, 0, 0, 0, 1, 0, 6, 0, 0)
+	tiff = append(tiff, 0x88, 0x25, 0x00, 0x04, 0, 0, 0, 1, 0, 0, 0, 38)
+	tiff = be.AppendUint32(tiff, 0)
+	// IFD de GPS: GPSLatitude con tres racionales en el desplazamiento 56
+	tiff = be.AppendUint16(tiff, 1)
+	tiff = append(tiff, 0x00, 0x02, 0x00, 0x05, 0, 0, 0, 3, 0, 0, 0, 56)
+	tiff = be.AppendUint32(tiff, 0)
+	for _, v := range []uint32{25, 1, 46, 1, 3000, 100} {
+		tiff = be.AppendUint32(tiff, v)
+	}
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := be.AppendUint16([]byte{0xFF, 0xE1}, uint16(len(payload)+2))
+	app1 = append(app1, payload...)
+
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func TestUpload_ImageVariants(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	checker := new(MockFileableChecker)
+	store := new(MockStorage)
+	uc := NewUseCase(repo, checker, nil, store, Config{ThumbnailSize: 10, WebSize: 100}, nil)
+	original := photo(t)
+	assert.Equal(t, 6, imaging.ReadOrientation(original))
+
+	checker.On("Exists", ctx, mock.Anything, int64(3)).Return(true, nil)
+	store.On("Put", ctx, mock.Anything, mock.Anything, "image/jpeg").Return(nil)
+	store.On("URL", mock.Anything).Return("u")
+	store.On("SignedURL", ctx, mock.Anything, mock.Anything).Return("s", nil)
+	repo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*File).ID = 9
+	}).Return(nil)
+	repo.On("CreateVariant", ctx, mock.AnythingOfType("*file.Variant")).Return(nil)
+
+	f, err := uc.Upload(ctx, uploadRequest(original, "unit.jpg"))
+
+	assert.NoError(t, err)
+	if assert.Len(t, f.Variants, 2) {
+		thumb, web := f.Variant(VariantThumbnail), f.Variant(VariantWeb)
+
+		// 40x20 girada 90° queda en vertical; la miniatura se reduce a 10 de lado mayor
+		assert.Equal(t, [2]int{5, 10}, [2]int{thumb.Width, thumb.Height})
+		assert.Equal(t, [2]int{20, 40}, [2]int{web.Width, web.Height})
+		assert.Equal(t, int64(9), web.FileID)
+		assert.Equal(t, strings.TrimSuffix(*f.Path, ".jpg")+"_web.jpg", web.Path)
+
+		// La variante ya no lleva EXIF y la mitad roja del original queda arriba
+		decoded, err := stdjpeg.Decode(bytes.NewReader(store.Objects[web.Path]))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, imaging.ReadOrientation(store.Objects[web.Path]))
+		r, _, b, _ := decoded.At(10, 5).RGBA()
+		assert.Greater(t, r, b)
+	}
+
+	// El original conserva la orientación pero no la latitud
+	stored := store.Objects[*f.Path]
+	assert.Len(t, stored, len(original))
+	assert.Equal(t, 6, imaging.ReadOrientation(stored))
+	assert.False(t, bytes.Contains(stored, []byte{0, 0, 0x0b, 0xb8, 0, 0, 0, 0x64}))
+	assert.True(t, bytes.Contains(original, []byte{0, 0, 0x0b, 0xb8, 0, 0, 0, 0x64}))
+
+	t.Run("keeps original when variants fail", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, checker, nil, store, Config{}, nil)
+
+		repo.On("Create", ctx, mock.Anything).Return(nil)
+		repo.On("CreateVariant", ctx, mock.Anything).Return(errors.New("db down"))
+		store.On("Delete", ctx, mock.AnythingOfType("string")).Return(nil)
+
+		f, err := uc.Upload(ctx, uploadRequest(original, "unit.jpg"))
+
+		assert.NoError(t, err)
+		assert.Empty(t, f.Variants)
+		store.AssertNumberOfCalls(t, "Delete", 2)
+	})
+}
+
+func TestGetDownloadURL(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	store := new(MockStorage)
+	uc := NewUseCase(repo, nil, nil, store, Config{}, nil)
+
+	repo.On("GetByID", ctx, int64(1)).Return(&File{ID: 1, Path: strPtr("job/5/a.jpg")}, nil)
+	repo.On("ListVariants", ctx, []int64{1}).Return([]*Variant{
+		{FileID: 1, Name: VariantThumbnail, Path: "job/5/a_thumbnail.jpg"},
+	}, nil)
+	store.On("SignedURL", ctx, "job/5/a.jpg", DefaultURLExpiry).Return("original", nil)
+	store.On("SignedURL", ctx, "job/5/a_thumbnail.jpg", DefaultURLExpiry).Return("thumbnail", nil)
+
+	url, err := uc.GetDownloadURL(ctx, 1, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "original", url)
+
+	url, err = uc.GetDownloadURL(ctx, 1, VariantThumbnail)
+	assert.NoError(t, err)
+	assert.Equal(t, "thumbnail", url)
+
+	_, err = uc.GetDownloadURL(ctx, 1, VariantWeb)
+	assert.Equal(t, ErrVariantNotFound, err)
+}
+
+func TestListByFileable(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	store := new(MockStorage)
+	uc := NewUseCase(repo, nil, nil, store, Config{}, nil)
+
+	files := []*File{
+		{ID: 1, Path: strPtr("job/5/a.jpg"), URL: "http://files/job/5/a.jpg"},
+		{ID: 2, URL: "https://legacy-bucket.s3.amazonaws.com/old.jpg"},
+	}
+	repo.On("ListByFileable", ctx, `App\Models\Job`, int64(5)).Return(files, nil)
+	repo.On("ListVariants", ctx, []int64{1, 2}).Return([]*Variant{
+		{FileID: 1, Name: VariantThumbnail, Path: "job/5/a_thumbnail.jpg"},
+	}, nil)
+	store.On("SignedURL", ctx, "job/5/a.jpg", DefaultURLExpiry).Return("http://files/job/5/a.jpg?signature=x", nil)
+	store.On("SignedURL", ctx, "job/5/a_thumbnail.jpg", DefaultURLExpiry).Return("http://files/job/5/a_thumbnail.jpg?signature=y", nil)
+
+	result, err := uc.ListByFileable(ctx, "job", 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "http://files/job/5/a.jpg?signature=x", result[0].DownloadURL)
+	assert.Equal(t, "http://files/job/5/a_thumbnail.jpg?signature=y", result[0].Variant(VariantThumbnail).DownloadURL)
+	assert.Equal(t, "https://legacy-bucket.s3.amazonaws.com/old.jpg", result[1].DownloadURL)
+	assert.Empty(t, result[1].Variants)
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes record and object", func(t *testing.T) {
+		repo := new(MockRepository)
+		store := new(MockStorage)
+		uc := NewUseCase(repo, nil, nil, store, Config{}, nil)
+
+		repo.On("GetByID", ctx, int64(1)).Return(&File{ID: 1, Path: strPtr("job/5/a.jpg")}, nil)
+		repo.On("ListVariants", ctx, []int64{1}).Return([]*Variant{{FileID: 1, Path: "job/5/a_web.jpg"}}, nil)
+		repo.On("Delete", ctx, int64(1)).Return(nil)
+		store.On("Delete", ctx, "job/5/a.jpg").Return(errors.New("timeout"))
+		store.On("Delete", ctx, "job/5/a_web.jpg").Return(nil)
+
+		err := uc.Delete(ctx, 1)
+
+		assert.NoError(t, err)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, new(MockStorage), Config{}, nil)
+
+		repo.On("GetByID", ctx, int64(1)).Return(nil, ErrFileNotFound)
+
+		assert.Equal(t, ErrFileNotFound, uc.Delete(ctx, 1))
+	})
+}
+
+func TestJobScope(t *testing.T) {
+	ctx := context.Background()
+	restricted := func(ctx context.Context) (int64, bool) { return 9, true }
+
+	newUseCase := func() (*UseCase, *MockRepository, *MockFileableChecker, *MockJobChecker) {
+		repo := new(MockRepository)
+		checker := new(MockFileableChecker)
+		jobs := new(MockJobChecker)
+		return NewUseCase(repo, checker, jobs, new(MockStorage), Config{}, restricted), repo, checker, jobs
+	}
+	hidden := &File{ID: 1, FileableType: `App\Models\JobVisit`, FileableID: 4}
+
+	t.Run("get by id outside scope", func(t *testing.T) {
+		uc, repo, checker, jobs := newUseCase()
+		repo.On("GetByID", ctx, int64(1)).Return(hidden, nil)
+		checker.On("JobID", ctx, `App\Models\JobVisit`, int64(4)).Return(int64(5), nil)
+		jobs.On("GetByID", ctx, int64(5)).Return(nil, errors.New("job not found"))
+
+		_, err := uc.GetByID(ctx, 1)
+		assert.Equal(t, ErrFileNotFound, err)
+
+		_, err = uc.GetDownloadURL(ctx, 1, "")
+		assert.Equal(t, ErrFileNotFound, err)
+	})
+
+	t.Run("delete outside scope", func(t *testing.T) {
+		uc, repo, checker, jobs := newUseCase()
+		repo.On("GetByID", ctx, int64(1)).Return(hidden, nil)
+		checker.On("JobID", ctx, `App\Models\JobVisit`, int64(4)).Return(int64(5), nil)
+		jobs.On("GetByID", ctx, int64(5)).Return(nil, errors.New("job not found"))
+
+		assert.Equal(t, ErrFileNotFound, uc.Delete(ctx, 1))
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("list outside scope", func(t *testing.T) {
+		uc, repo, checker, jobs := newUseCase()
+		checker.On("JobID", ctx, `App\Models\Job`, int64(5)).Return(int64(5), nil)
+		jobs.On("GetByID", ctx, int64(5)).Return(nil, errors.New("job not found"))
+
+		_, err := uc.ListByFileable(ctx, "job", 5)
+		assert.Equal(t, ErrFileableNotFound, err)
+		repo.AssertNotCalled(t, "ListByFileable", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("upload outside scope", func(t *testing.T) {
+		uc, _, checker, jobs := newUseCase()
+		checker.On("Exists", ctx, `App\Models\WarrantyClaim`, int64(3)).Return(true, nil)
+		checker.On("JobID", ctx, `App\Models\WarrantyClaim`, int64(3)).Return(int64(5), nil)
+		jobs.On("GetByID", ctx, int64(5)).Return(nil, errors.New("job not found"))
+
+		_, err := uc.Upload(ctx, uploadRequest(jpeg, "a.jpg"))
+		assert.Equal(t, ErrFileableNotFound, err)
+	})
+
+	t.Run("get by id within scope", func(t *testing.T) {
+		uc, repo, checker, jobs := newUseCase()
+		repo.On("GetByID", ctx, int64(1)).Return(&File{ID: 1, FileableType: `App\Models\Job`, FileableID: 5, URL: "u"}, nil)
+		repo.On("ListVariants", ctx, []int64{1}).Return([]*Variant{}, nil)
+		checker.On("JobID", ctx, `App\Models\Job`, int64(5)).Return(int64(5), nil)
+		jobs.On("GetByID", ctx, int64(5)).Return(true, nil)
+
+		f, err := uc.GetByID(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "u", f.DownloadURL)
+	})
+}
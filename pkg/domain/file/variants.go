@@ -0,0 +1,122 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/imaging"
+)
+
+// createVariants genera la miniatura y la variante web de una imagen ya registrada.
+// Un fallo solo se registra: el archivo original sigue disponible sin variantes.
+func (uc *UseCase) createVariants(ctx context.Context, f *File, data []byte, contentType string) []*Variant {
+	img, orientation, err := imaging.Decode(data, imaging.DefaultMaxPixels)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to decode image for variants",
+			slog.Int64("id", f.ID),
+			slog.String("error", err.Error()))
+		return nil
+	}
+
+	sizes := []struct {
+		name    string
+		maxSide int
+	}{
+		{VariantThumbnail, uc.config.ThumbnailSize},
+		{VariantWeb, uc.config.WebSize},
+	}
+
+	// Se reduce una sola vez al tamaño mayor y de ahí salen todas las variantes
+	img = imaging.Fit(img, max(uc.config.ThumbnailSize, uc.config.WebSize))
+
+	variants := []*Variant{}
+	for _, s := range sizes {
+		v, err := uc.createVariant(ctx, f, imaging.Variant(img, orientation, s.maxSide), s.name, contentType)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to create image variant",
+				slog.Int64("id", f.ID),
+				slog.String("variant", s.name),
+				slog.String("error", err.Error()))
+			continue
+		}
+		variants = append(variants, v)
+	}
+	return variants
+}
+
+// createVariant codifica, guarda y registra una variante
+func (uc *UseCase) createVariant(ctx context.Context, f *File, img image.Image, name, sourceContentType string) (*Variant, error) {
+	var buf bytes.Buffer
+	contentType, err := imaging.Encode(&buf, img, sourceContentType, imaging.DefaultQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	key := variantKey(*f.Path, name, contentType)
+	size := int64(buf.Len())
+	if err := uc.store.Put(ctx, key, &buf, size, contentType); err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	v := &Variant{
+		FileID:      f.ID,
+		Name:        name,
+		Path:        key,
+		URL:         uc.store.URL(key),
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Size:        size,
+	}
+	if err := uc.repo.CreateVariant(ctx, v); err != nil {
+		if delErr := uc.store.Delete(ctx, key); delErr != nil {
+			slog.WarnContext(ctx, "Failed to remove orphan stored variant",
+				slog.String("key", key),
+				slog.String("error", delErr.Error()))
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+// variantKey arma la clave de una variante junto al original: job/5/abc.jpg -> job/5/abc_thumbnail.jpg
+func variantKey(original, name, contentType string) string {
+	ext := ".jpg"
+	if contentType == "image/png" {
+		ext = ".png"
+	}
+	return strings.TrimSuffix(original, path.Ext(original)) + "_" + name + ext
+}
+
+// loadVariants completa las variantes de los archivos con una sola consulta
+func (uc *UseCase) loadVariants(ctx context.Context, files ...*File) {
+	if len(files) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(files))
+	byID := make(map[int64]*File, len(files))
+	for _, f := range files {
+		ids = append(ids, f.ID)
+		byID[f.ID] = f
+	}
+
+	variants, err := uc.repo.ListVariants(ctx, ids)
+	if err != nil {
+		// Sin variantes los clientes usan el original
+		slog.WarnContext(ctx, "Failed to list file variants",
+			slog.String("error", err.Error()))
+		return
+	}
+
+	for _, v := range variants {
+		if f, ok := byID[v.FileID]; ok {
+			f.Variants = append(f.Variants, v)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package invoice
+
+import "context"
+
+// Checker es el contrato con el que los recursos hijos verifican la existencia de una factura
+type Checker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// VisibilityChecker decora el Checker de los pagos para que las facturas de jobs fuera del
+// scope del usuario autenticado se reporten como inexistentes
+type VisibilityChecker struct {
+	inner Checker
+	uc    *UseCase
+}
+
+// NewVisibilityChecker crea el verificador de facturas visibles
+func NewVisibilityChecker(inner Checker, uc *UseCase) *VisibilityChecker {
+	return &VisibilityChecker{inner: inner, uc: uc}
+}
+
+// GetByID verifica que la factura existe y que su job es visible para el usuario autenticado
+func (c *VisibilityChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	if c.uc.jobOwner == nil {
+		return c.inner.GetByID(ctx, id)
+	}
+	if _, restricted := c.uc.jobOwner(ctx); !restricted {
+		return c.inner.GetByID(ctx, id)
+	}
+
+	inv, err := c.uc.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
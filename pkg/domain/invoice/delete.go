@@ -7,7 +7,7 @@ import (
 
 // Delete elimina una factura (soft delete)
 func (uc *UseCase) Delete(ctx context.Context, id int64) error {
-	existing, err := uc.repo.GetByID(ctx, id)
+	existing, err := uc.getVisible(ctx, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Invoice not found for delete",
 			slog.Int64("id", id),
@@ -7,7 +7,7 @@ import (
 
 // GetByID obtiene una factura por su ID
 func (uc *UseCase) GetByID(ctx context.Context, id int64) (*Invoice, error) {
-	inv, err := uc.repo.GetByID(ctx, id)
+	inv, err := uc.getVisible(ctx, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get invoice",
 			slog.Int64("id", id),
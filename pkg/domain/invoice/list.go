@@ -14,6 +14,8 @@ func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, pag
 		pageSize = 10
 	}
 
+	filters = uc.scopeFilters(ctx, filters)
+
 	invoices, total, err := uc.repo.List(ctx, filters, page, pageSize)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to list invoices",
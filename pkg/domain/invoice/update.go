@@ -12,7 +12,7 @@ func (uc *UseCase) Update(ctx context.Context, inv *Invoice) error {
 	}
 
 	// Verificar que la factura existe
-	existing, err := uc.repo.GetByID(ctx, inv.ID)
+	existing, err := uc.getVisible(ctx, inv.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "Invoice not found for update",
 			slog.Int64("id", inv.ID),
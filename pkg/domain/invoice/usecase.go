@@ -16,16 +16,57 @@ type JobChecker interface {
 	GetByID(ctx context.Context, id int64) (interface{}, error)
 }
 
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
 // UseCase implementa la lógica de negocio de invoices
 type UseCase struct {
 	repo     Repository
 	jobCheck JobChecker
+	jobOwner JobOwnerResolver
 }
 
 // NewUseCase crea una nueva instancia del caso de uso de invoices
-func NewUseCase(repo Repository, jobCheck JobChecker) *UseCase {
+func NewUseCase(repo Repository, jobCheck JobChecker, jobOwner JobOwnerResolver) *UseCase {
 	return &UseCase{
 		repo:     repo,
 		jobCheck: jobCheck,
+		jobOwner: jobOwner,
+	}
+}
+
+// getVisible obtiene la factura verificando que su job sea visible para el usuario autenticado
+func (uc *UseCase) getVisible(ctx context.Context, id int64) (*Invoice, error) {
+	inv, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.jobOwner != nil {
+		if _, restricted := uc.jobOwner(ctx); restricted {
+			if _, err := uc.jobCheck.GetByID(ctx, inv.JobID); err != nil {
+				return nil, ErrInvoiceNotFound
+			}
+		}
+	}
+
+	return inv, nil
+}
+
+// scopeFilters limita el listado a los jobs visibles para el usuario autenticado
+func (uc *UseCase) scopeFilters(ctx context.Context, filters map[string]interface{}) map[string]interface{} {
+	if uc.jobOwner == nil {
+		return filters
+	}
+	ownerID, restricted := uc.jobOwner(ctx)
+	if !restricted {
+		return filters
+	}
+
+	scoped := make(map[string]interface{}, len(filters)+1)
+	for k, val := range filters {
+		scoped[k] = val
 	}
+	scoped["job_user_id"] = ownerID
+	return scoped
 }
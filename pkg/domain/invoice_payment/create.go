@@ -3,6 +3,8 @@ package invoice_payment
 import (
 	"context"
 	"log/slog"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
 )
 
 // Create crea un nuevo pago de factura
@@ -28,5 +30,14 @@ func (uc *UseCase) Create(ctx context.Context, payment *InvoicePayment) error {
 	slog.InfoContext(ctx, "Invoice payment created successfully",
 		slog.Int64("id", payment.ID))
 
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, &domainEvent.Event{
+			Type:     domainEvent.TypePaymentRecorded,
+			EntityID: payment.ID,
+			Data:     payment,
+			Ability:  domainEvent.AbilityInvoiceView,
+		})
+	}
+
 	return nil
 }
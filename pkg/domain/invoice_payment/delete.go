@@ -24,6 +24,11 @@ func (uc *UseCase) Delete(ctx context.Context, invoiceID, id int64) error {
 		return ErrPaymentNotFound
 	}
 
+	// La factura debe ser visible para el usuario autenticado
+	if _, err := uc.invoiceCheck.GetByID(ctx, invoiceID); err != nil {
+		return ErrPaymentNotFound
+	}
+
 	if err := uc.repo.Delete(ctx, id); err != nil {
 		slog.ErrorContext(ctx, "Failed to delete invoice payment",
 			slog.Int64("id", id),
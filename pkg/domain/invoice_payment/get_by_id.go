@@ -24,5 +24,10 @@ func (uc *UseCase) GetByID(ctx context.Context, invoiceID, id int64) (*InvoicePa
 		return nil, ErrPaymentNotFound
 	}
 
+	// La factura debe ser visible para el usuario autenticado
+	if _, err := uc.invoiceCheck.GetByID(ctx, invoiceID); err != nil {
+		return nil, ErrPaymentNotFound
+	}
+
 	return payment, nil
 }
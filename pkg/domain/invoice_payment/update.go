@@ -24,6 +24,11 @@ func (uc *UseCase) Update(ctx context.Context, payment *InvoicePayment) error {
 		return ErrPaymentNotFound
 	}
 
+	// La factura debe ser visible para el usuario autenticado
+	if _, err := uc.invoiceCheck.GetByID(ctx, existing.InvoiceID); err != nil {
+		return ErrPaymentNotFound
+	}
+
 	// Mantener el invoice_id original
 	payment.InvoiceID = existing.InvoiceID
 
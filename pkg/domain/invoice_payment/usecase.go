@@ -1,6 +1,10 @@
 package invoice_payment
 
-import "context"
+import (
+	"context"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+)
 
 // Service define la interfaz del servicio de invoice payments
 type Service interface {
@@ -20,12 +24,14 @@ type InvoiceChecker interface {
 type UseCase struct {
 	repo         Repository
 	invoiceCheck InvoiceChecker
+	publisher    domainEvent.Publisher
 }
 
 // NewUseCase crea una nueva instancia del caso de uso de invoice payments
-func NewUseCase(repo Repository, invoiceCheck InvoiceChecker) *UseCase {
+func NewUseCase(repo Repository, invoiceCheck InvoiceChecker, publisher domainEvent.Publisher) *UseCase {
 	return &UseCase{
 		repo:         repo,
 		invoiceCheck: invoiceCheck,
+		publisher:    publisher,
 	}
 }
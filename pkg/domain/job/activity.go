@@ -0,0 +1,101 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+)
+
+// logActivity registra una entrada en la bitácora del job.
+// Un fallo al registrar no interrumpe la operación principal, que ya fue persistida.
+func (uc *UseCase) logActivity(ctx context.Context, jobID int64, logType, message string) {
+	if uc.activityLogger == nil {
+		return
+	}
+
+	if err := uc.activityLogger.LogActivity(ctx, jobID, logType, message); err != nil {
+		slog.WarnContext(ctx, "Failed to log job activity",
+			slog.Int64("jobId", jobID),
+			slog.String("type", logType),
+			slog.String("error", err.Error()))
+	}
+}
+
+// recordHistory guarda el historial de cambios de campos del job.
+// Igual que la bitácora, un fallo no revierte la actualización ya persistida.
+func (uc *UseCase) recordHistory(ctx context.Context, jobID int64, changes []domainHistory.Change) {
+	if uc.historyRecorder == nil || len(changes) == 0 {
+		return
+	}
+
+	if err := uc.historyRecorder.RecordChanges(ctx, jobID, changes); err != nil {
+		slog.ErrorContext(ctx, "Failed to record job history",
+			slog.Int64("jobId", jobID),
+			slog.Int("changes", len(changes)),
+			slog.String("error", err.Error()))
+	}
+}
+
+// notifyTechnician genera las alertas del técnico asignado según lo que cambió en el job.
+// Se avisa al técnico cuando se le asigna el job (existing es nil en la creación) y cuando
+// se actualiza el registro de llamadas; un fallo al crear la alerta no revierte el cambio.
+func (uc *UseCase) notifyTechnician(ctx context.Context, existing, j *Job) {
+	if uc.alertNotifier == nil || j.UserID == nil || *j.UserID <= 0 {
+		return
+	}
+
+	userID := *j.UserID
+
+	if existing == nil || existing.UserID == nil || *existing.UserID != userID {
+		if err := uc.alertNotifier.NotifyJobAssigned(ctx, j.ID, userID, j.WorkOrder); err != nil {
+			slog.WarnContext(ctx, "Failed to create job assigned alert",
+				slog.Int64("jobId", j.ID),
+				slog.Int64("userId", userID),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	if existing != nil && j.CallLogs != nil && *j.CallLogs != "" && !equalStringPtr(existing.CallLogs, j.CallLogs) {
+		if err := uc.alertNotifier.NotifyCallLog(ctx, j.ID, userID, j.WorkOrder); err != nil {
+			slog.WarnContext(ctx, "Failed to create call log alert",
+				slog.Int64("jobId", j.ID),
+				slog.Int64("userId", userID),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+// publish difunde un cambio del job a los clientes conectados al stream de eventos.
+// previousUserID es el técnico anterior si el cambio reasignó el job, para que también
+// reciba el evento y lo quite de su vista.
+func (uc *UseCase) publish(ctx context.Context, eventType string, j *Job, previousUserID *int64, fields []string) {
+	if uc.publisher == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"id":                    j.ID,
+		"workOrder":             j.WorkOrder,
+		"jobStatusId":           j.JobStatusID,
+		"technicianJobStatusId": j.TechnicianJobStatusID,
+		"userId":                j.UserID,
+		"closed":                j.Closed,
+	}
+	if len(fields) > 0 {
+		data["fields"] = fields
+	}
+	if previousUserID != nil {
+		data["previousUserId"] = previousUserID
+	}
+
+	uc.publisher.Publish(ctx, &domainEvent.Event{
+		Type:           eventType,
+		EntityID:       j.ID,
+		Data:           data,
+		Ability:        domainEvent.AbilityJobView,
+		UserID:         j.UserID,
+		PreviousUserID: previousUserID,
+	})
+}
@@ -3,10 +3,15 @@ package job
 import (
 	"context"
 	"log/slog"
+	"strconv"
+	"strings"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
 )
 
-// Close cierra un job con un status específico
-func (uc *UseCase) Close(ctx context.Context, id int64, jobStatusID int64) error {
+// Close cierra un job con un status específico y aplica las opciones de cierre
+func (uc *UseCase) Close(ctx context.Context, id int64, jobStatusID int64, opts CloseOptions) error {
 	existing, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Job not found for close",
@@ -15,9 +20,12 @@ func (uc *UseCase) Close(ctx context.Context, id int64, jobStatusID int64) error
 		return ErrJobNotFound
 	}
 
-	if existing.IsDeleted() {
+	if existing.IsDeleted() || !uc.scope.CanView(ctx, existing) {
 		return ErrJobNotFound
 	}
+	if !uc.scope.Can(ctx, AbilityEdit, existing) {
+		return ErrJobForbidden
+	}
 
 	if existing.IsClosed() {
 		return ErrJobAlreadyClosed
@@ -30,7 +38,22 @@ func (uc *UseCase) Close(ctx context.Context, id int64, jobStatusID int64) error
 		}
 	}
 
-	if err := uc.repo.Close(ctx, id, jobStatusID); err != nil {
+	if opts.Warranty != nil {
+		if err := opts.Warranty.Validate(); err != nil {
+			return err
+		}
+
+		// Sin número explícito se usa la orden de trabajo o, en su defecto, el ID del job
+		if strings.TrimSpace(opts.Warranty.WarrantyNumber) == "" {
+			if existing.WorkOrder != nil && strings.TrimSpace(*existing.WorkOrder) != "" {
+				opts.Warranty.WarrantyNumber = *existing.WorkOrder
+			} else {
+				opts.Warranty.WarrantyNumber = strconv.FormatInt(id, 10)
+			}
+		}
+	}
+
+	if err := uc.repo.Close(ctx, id, jobStatusID, opts); err != nil {
 		slog.ErrorContext(ctx, "Failed to close job",
 			slog.Int64("id", id),
 			slog.String("error", err.Error()))
@@ -38,7 +61,24 @@ func (uc *UseCase) Close(ctx context.Context, id int64, jobStatusID int64) error
 	}
 
 	slog.InfoContext(ctx, "Job closed successfully",
-		slog.Int64("id", id))
+		slog.Int64("id", id),
+		slog.Bool("updatePropertyEquipment", opts.UpdatePropertyEquipment),
+		slog.Bool("createWarranty", opts.Warranty != nil))
+
+	message := "Job closed"
+	if opts.UpdatePropertyEquipment {
+		message += "; property equipment updated"
+	}
+	if opts.Warranty != nil {
+		message += "; warranty " + opts.Warranty.WarrantyNumber + " created"
+	}
+	uc.logActivity(ctx, id, domainActivity.TypeJobClosed, message)
+
+	existing.Closed = true
+	if jobStatusID > 0 {
+		existing.JobStatusID = jobStatusID
+	}
+	uc.publish(ctx, domainEvent.TypeJobClosed, existing, nil, nil)
 
 	return nil
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 	"log/slog"
 	"time"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
 )
 
 // Create crea un nuevo job
@@ -66,6 +69,11 @@ func (uc *UseCase) Create(ctx context.Context, j *Job) error {
 		}
 	}
 
+	// Un job nuevo no puede tener reclamaciones de garantía todavía
+	if j.WarrantyClaim {
+		return ErrWarrantyClaimRequired
+	}
+
 	// Establecer fecha de recepción si no se proporcionó
 	if j.DateReceived.IsZero() {
 		j.DateReceived = time.Now()
@@ -80,5 +88,9 @@ func (uc *UseCase) Create(ctx context.Context, j *Job) error {
 	slog.InfoContext(ctx, "Job created successfully",
 		slog.Int64("id", j.ID))
 
+	uc.logActivity(ctx, j.ID, domainActivity.TypeJobCreated, "Job created")
+	uc.notifyTechnician(ctx, nil, j)
+	uc.publish(ctx, domainEvent.TypeJobCreated, j, nil, nil)
+
 	return nil
 }
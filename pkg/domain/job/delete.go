@@ -15,9 +15,12 @@ func (uc *UseCase) Delete(ctx context.Context, id int64) error {
 		return ErrJobNotFound
 	}
 
-	if existing.IsDeleted() {
+	if existing.IsDeleted() || !uc.scope.CanView(ctx, existing) {
 		return ErrJobNotFound
 	}
+	if !uc.scope.Can(ctx, AbilityDelete, existing) {
+		return ErrJobForbidden
+	}
 
 	if err := uc.repo.Delete(ctx, id); err != nil {
 		slog.ErrorContext(ctx, "Failed to delete job",
@@ -0,0 +1,88 @@
+package job
+
+import (
+	"strconv"
+	"time"
+
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+)
+
+const historyDateFormat = "2006-01-02"
+
+// diffJobs compara la versión anterior y la nueva de un job y devuelve los campos
+// auditados que cambiaron: status, status de técnico, usuario asignado, fechas,
+// notas y precios. Los valores se serializan como texto para guardarlos en el historial.
+func diffJobs(old, updated *Job) []domainHistory.Change {
+	var changes []domainHistory.Change
+
+	add := func(field string, oldValue, newValue *string) {
+		if equalStringPtr(oldValue, newValue) {
+			return
+		}
+		changes = append(changes, domainHistory.Change{
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	// Status
+	add("job_status_id", formatInt64(&old.JobStatusID), formatInt64(&updated.JobStatusID))
+	add("technician_job_status_id", formatInt64(old.TechnicianJobStatusID), formatInt64(updated.TechnicianJobStatusID))
+
+	// Asignación
+	add("user_id", formatInt64(old.UserID), formatInt64(updated.UserID))
+	add("supervisor_ids", old.SupervisorIDs, updated.SupervisorIDs)
+
+	// Fechas
+	add("date_received", formatDate(&old.DateReceived), formatDate(&updated.DateReceived))
+	add("dispatch_date", formatDate(old.DispatchDate), formatDate(updated.DispatchDate))
+	add("completion_date", formatDate(old.CompletionDate), formatDate(updated.CompletionDate))
+	add("due_date", formatDate(old.DueDate), formatDate(updated.DueDate))
+	add("installation_due_date", formatDate(old.InstallationDueDate), formatDate(updated.InstallationDueDate))
+	add("scheduled_time_type", old.ScheduledTimeType, updated.ScheduledTimeType)
+	add("scheduled_time", old.ScheduledTime, updated.ScheduledTime)
+
+	// Notas
+	add("internal_job_notes", old.InternalJobNotes, updated.InternalJobNotes)
+	add("quick_notes", old.QuickNotes, updated.QuickNotes)
+	add("dispatch_notes", old.DispatchNotes, updated.DispatchNotes)
+	add("job_report", old.JobReport, updated.JobReport)
+
+	// Precios
+	add("job_sales_price", formatMoney(old.JobSalesPrice), formatMoney(updated.JobSalesPrice))
+	add("money_turned_in", formatMoney(old.MoneyTurnedIn), formatMoney(updated.MoneyTurnedIn))
+
+	return changes
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func formatInt64(v *int64) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*v, 10)
+	return &s
+}
+
+func formatDate(v *time.Time) *string {
+	if v == nil || v.IsZero() {
+		return nil
+	}
+	s := v.Format(historyDateFormat)
+	return &s
+}
+
+func formatMoney(v *float64) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.FormatFloat(*v, 'f', 2, 64)
+	return &s
+}
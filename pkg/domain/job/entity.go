@@ -2,8 +2,11 @@ package job
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
 )
 
 // Job representa un trabajo en el sistema
@@ -42,6 +45,9 @@ type Job struct {
 	CreatedAt             *time.Time `json:"createdAt,omitempty"`
 	UpdatedAt             *time.Time `json:"updatedAt,omitempty"`
 	DeletedAt             *time.Time `json:"deletedAt,omitempty"`
+
+	// Residents solo se carga en el detalle del job (GetByID)
+	Residents []*domainResident.JobResident `json:"residents,omitempty"`
 }
 
 // ValidateCreate valida los campos requeridos para crear un job
@@ -83,3 +89,58 @@ func (j *Job) IsDeleted() bool {
 func (j *Job) IsClosed() bool {
 	return j.Closed
 }
+
+// SupervisorIDList interpreta supervisor_ids, que se guarda como arreglo JSON
+// (["1","2"] o [1,2]) o, en datos antiguos, como lista separada por comas
+func (j *Job) SupervisorIDList() []int64 {
+	if j.SupervisorIDs == nil {
+		return nil
+	}
+
+	raw := strings.NewReplacer("[", "", "]", "", `"`, "").Replace(*j.SupervisorIDs)
+
+	var ids []int64
+	seen := make(map[int64]bool)
+	for _, part := range strings.Split(raw, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil || id <= 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// CloseOptions define las acciones adicionales que se ejecutan al cerrar un job.
+// Todas se aplican en la misma transacción que el cierre.
+type CloseOptions struct {
+	// UpdatePropertyEquipment copia los equipos "new" del job a los equipos de la propiedad
+	UpdatePropertyEquipment bool
+	// Warranty, si no es nil, crea una garantía con los equipos "new" del job
+	Warranty *CloseWarranty
+}
+
+// CloseWarranty contiene los datos de la garantía creada al cerrar un job
+type CloseWarranty struct {
+	ID               int64
+	WarrantyNumber   string
+	WarrantyTypeID   int64
+	WarrantyStatusID int64
+	DateSubmitted    *time.Time
+	AgreementNumber  *string
+	Notes            *string
+}
+
+// Validate valida los campos requeridos de la garantía a crear
+func (w *CloseWarranty) Validate() error {
+	if w.WarrantyTypeID == 0 {
+		return fmt.Errorf("warranty_type_id is required")
+	}
+
+	if w.WarrantyStatusID == 0 {
+		return fmt.Errorf("warranty_status_id is required")
+	}
+
+	return nil
+}
@@ -6,6 +6,9 @@ var (
 	// ErrJobNotFound indica que el job no fue encontrado
 	ErrJobNotFound = errors.New("job not found")
 
+	// ErrJobForbidden indica que el usuario no tiene la habilidad sobre el job
+	ErrJobForbidden = errors.New("not allowed to perform this action on the job")
+
 	// ErrJobDeleted indica que el job está eliminado
 	ErrJobDeleted = errors.New("job is deleted")
 
@@ -35,4 +38,13 @@ var (
 
 	// ErrWorkflowHasNoStatuses indica que el workflow no tiene statuses configurados
 	ErrWorkflowHasNoStatuses = errors.New("workflow has no statuses configured")
+
+	// ErrInvalidWarrantyType indica que el tipo de garantía no es válido
+	ErrInvalidWarrantyType = errors.New("invalid warranty type")
+
+	// ErrInvalidWarrantyStatus indica que el estado de garantía no es válido
+	ErrInvalidWarrantyStatus = errors.New("invalid warranty status")
+
+	// ErrWarrantyClaimRequired indica que el job no tiene reclamaciones de garantía registradas
+	ErrWarrantyClaimRequired = errors.New("job has no warranty claims")
 )
@@ -5,7 +5,7 @@ import (
 	"log/slog"
 )
 
-// GetByID obtiene un job por su ID
+// GetByID obtiene un job por su ID junto con sus residentes
 func (uc *UseCase) GetByID(ctx context.Context, id int64) (*Job, error) {
 	j, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
@@ -15,9 +15,23 @@ func (uc *UseCase) GetByID(ctx context.Context, id int64) (*Job, error) {
 		return nil, err
 	}
 
-	if j.IsDeleted() {
+	if j.IsDeleted() || !uc.scope.CanView(ctx, j) {
 		return nil, ErrJobNotFound
 	}
+	if !uc.scope.Can(ctx, AbilityView, j) {
+		return nil, ErrJobForbidden
+	}
+
+	if uc.residentRepo != nil {
+		residents, err := uc.residentRepo.ListByJobID(ctx, id)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to get job residents",
+				slog.Int64("id", id),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		j.Residents = residents
+	}
 
 	return j, nil
 }
@@ -14,6 +14,19 @@ func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, pag
 		pageSize = 10
 	}
 
+	// Un usuario limitado a sus propios jobs no puede ampliar el filtro por usuario
+	if ownerID, restricted := uc.scope.OwnerID(ctx); restricted {
+		if ownerID <= 0 {
+			return []*Job{}, 0, nil
+		}
+		scoped := make(map[string]interface{}, len(filters)+1)
+		for k, v := range filters {
+			scoped[k] = v
+		}
+		scoped["user_id"] = ownerID
+		filters = scoped
+	}
+
 	jobs, total, err := uc.repo.List(ctx, filters, page, pageSize)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to list jobs",
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/mock"
+
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
 )
 
 // MockRepository es un mock del repositorio de jobs
@@ -42,8 +45,8 @@ func (m *MockRepository) Delete(ctx context.Context, id int64) error {
 	return args.Error(0)
 }
 
-func (m *MockRepository) Close(ctx context.Context, id int64, jobStatusID int64) error {
-	args := m.Called(ctx, id, jobStatusID)
+func (m *MockRepository) Close(ctx context.Context, id int64, jobStatusID int64, opts CloseOptions) error {
+	args := m.Called(ctx, id, jobStatusID, opts)
 	return args.Error(0)
 }
 
@@ -134,3 +137,61 @@ func (m *MockTechnicianJobStatusChecker) GetLinkedJobStatusID(ctx context.Contex
 	}
 	return args.Get(0).(*int64), args.Error(1)
 }
+
+// MockActivityLogger es un mock del registro de actividad de jobs
+type MockActivityLogger struct {
+	mock.Mock
+}
+
+func (m *MockActivityLogger) LogActivity(ctx context.Context, jobID int64, logType, message string) error {
+	args := m.Called(ctx, jobID, logType, message)
+	return args.Error(0)
+}
+
+// MockHistoryRecorder es un mock del registro de historial de jobs
+type MockHistoryRecorder struct {
+	mock.Mock
+}
+
+func (m *MockHistoryRecorder) RecordChanges(ctx context.Context, jobID int64, changes []domainHistory.Change) error {
+	args := m.Called(ctx, jobID, changes)
+	return args.Error(0)
+}
+
+// MockWarrantyClaimChecker es un mock del checker de reclamaciones de garantía
+type MockWarrantyClaimChecker struct {
+	mock.Mock
+}
+
+func (m *MockWarrantyClaimChecker) HasClaims(ctx context.Context, jobID int64) (bool, error) {
+	args := m.Called(ctx, jobID)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockResidentLister es un mock del listado de residentes de jobs
+type MockResidentLister struct {
+	mock.Mock
+}
+
+func (m *MockResidentLister) ListByJobID(ctx context.Context, jobID int64) ([]*domainResident.JobResident, error) {
+	args := m.Called(ctx, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainResident.JobResident), args.Error(1)
+}
+
+// MockAlertNotifier es un mock del generador de alertas de técnicos
+type MockAlertNotifier struct {
+	mock.Mock
+}
+
+func (m *MockAlertNotifier) NotifyJobAssigned(ctx context.Context, jobID, userID int64, workOrder *string) error {
+	args := m.Called(ctx, jobID, userID, workOrder)
+	return args.Error(0)
+}
+
+func (m *MockAlertNotifier) NotifyCallLog(ctx context.Context, jobID, userID int64, workOrder *string) error {
+	args := m.Called(ctx, jobID, userID, workOrder)
+	return args.Error(0)
+}
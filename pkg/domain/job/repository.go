@@ -19,6 +19,6 @@ type Repository interface {
 	// Delete elimina un job (soft delete)
 	Delete(ctx context.Context, id int64) error
 
-	// Close cierra un job
-	Close(ctx context.Context, id int64, jobStatusID int64) error
+	// Close cierra un job y aplica las opciones de cierre en una sola transacción
+	Close(ctx context.Context, id int64, jobStatusID int64, opts CloseOptions) error
 }
@@ -0,0 +1,162 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
+)
+
+// Habilidades que determinan la visibilidad de jobs y las acciones sobre ellos
+const (
+	// AbilityView, AbilityEdit y AbilityDelete son las habilidades de la policy de jobs
+	AbilityView   = "job_view"
+	AbilityEdit   = "job_edit"
+	AbilityDelete = "job_delete"
+
+	// AbilityViewUserOnly limita los jobs visibles a los asignados al usuario (forCurrentUser de Laravel)
+	AbilityViewUserOnly = "job_view_user_only"
+	// AbilityAll es el comodín de Bouncer que anula cualquier restricción
+	AbilityAll = "*"
+)
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// AbilityChecker verifica si el usuario autenticado tiene una habilidad
+type AbilityChecker func(ctx context.Context, ability string) bool
+
+// EntityChecker verifica si el usuario autenticado puede realizar una habilidad sobre un
+// modelo, por la habilidad general o por un permiso ligado al modelo
+type EntityChecker func(ctx context.Context, ability string, entity *authorization.Entity) bool
+
+// EntityAbilityResolver obtiene la habilidad de la petición en curso que solo se concedió
+// por un permiso ligado a un modelo y que debe evaluarse al cargar el job
+type EntityAbilityResolver func(ctx context.Context) (string, bool)
+
+// Scope restringe los jobs visibles para el usuario autenticado.
+// Un usuario con job_view_user_only (y sin "*") solo ve los jobs donde user_id es él mismo.
+// Sin usuario ni habilidades en el contexto (procesos internos) no se aplica restricción.
+type Scope struct {
+	userResolver   UserIDResolver
+	abilityChecker AbilityChecker
+	entityChecker  EntityChecker
+	entityAbility  EntityAbilityResolver
+}
+
+// NewScope crea el scope de visibilidad de jobs
+func NewScope(userResolver UserIDResolver, abilityChecker AbilityChecker, entityChecker EntityChecker, entityAbility EntityAbilityResolver) *Scope {
+	return &Scope{
+		userResolver:   userResolver,
+		abilityChecker: abilityChecker,
+		entityChecker:  entityChecker,
+		entityAbility:  entityAbility,
+	}
+}
+
+// OwnerID retorna el usuario al que se limitan los jobs visibles.
+// El segundo valor es false cuando el usuario autenticado puede ver todos los jobs.
+func (s *Scope) OwnerID(ctx context.Context) (int64, bool) {
+	if s == nil || s.abilityChecker == nil {
+		return 0, false
+	}
+	if !s.abilityChecker(ctx, AbilityViewUserOnly) || s.abilityChecker(ctx, AbilityAll) {
+		return 0, false
+	}
+
+	// Sin usuario resuelto se restringe a un ID inexistente para no exponer jobs ajenos
+	if s.userResolver == nil {
+		return 0, true
+	}
+	userID, ok := s.userResolver(ctx)
+	if !ok {
+		return 0, true
+	}
+	return userID, true
+}
+
+// CanView indica si el usuario autenticado puede ver el job
+func (s *Scope) CanView(ctx context.Context, j *Job) bool {
+	ownerID, restricted := s.OwnerID(ctx)
+	if !restricted {
+		return true
+	}
+	return j.UserID != nil && *j.UserID == ownerID
+}
+
+// Can indica si el usuario autenticado puede realizar ability sobre el job, evaluando los
+// permisos ligados al job (un registro concreto o los propios vía only_owned)
+func (s *Scope) Can(ctx context.Context, ability string, j *Job) bool {
+	if s == nil || s.entityChecker == nil {
+		return true
+	}
+	return s.entityChecker(ctx, ability, Entity(j))
+}
+
+// pendingAbility retorna la habilidad de la petición que falta evaluar sobre el job
+func (s *Scope) pendingAbility(ctx context.Context) (string, bool) {
+	if s == nil || s.entityAbility == nil {
+		return "", false
+	}
+	return s.entityAbility(ctx)
+}
+
+// Entity retorna el modelo con el que se evalúan las habilidades sobre el job
+func Entity(j *Job) *authorization.Entity {
+	return &authorization.Entity{
+		Type:    authorization.JobType,
+		ID:      j.ID,
+		OwnerID: j.UserID,
+	}
+}
+
+// JobChecker es el contrato con el que los recursos hijos verifican la existencia de un job
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// ScopedJobChecker decora el JobChecker de un recurso hijo para que los jobs fuera del
+// scope del usuario autenticado se reporten como inexistentes y los jobs sobre los que no
+// tiene la habilidad que la ruta solo concedió por un permiso ligado a un job, como prohibidos
+type ScopedJobChecker struct {
+	inner JobChecker
+	repo  Repository
+	scope *Scope
+}
+
+// NewScopedJobChecker crea un JobChecker que respeta el scope de visibilidad de jobs
+func NewScopedJobChecker(inner JobChecker, repo Repository, scope *Scope) *ScopedJobChecker {
+	return &ScopedJobChecker{
+		inner: inner,
+		repo:  repo,
+		scope: scope,
+	}
+}
+
+// GetByID verifica que el job existe y que el usuario autenticado puede verlo
+func (c *ScopedJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	ownerID, restricted := c.scope.OwnerID(ctx)
+	ability, pending := c.scope.pendingAbility(ctx)
+	if !restricted && !pending {
+		return c.inner.GetByID(ctx, id)
+	}
+
+	j, err := c.repo.GetByID(ctx, id)
+	if err != nil || j.IsDeleted() {
+		return nil, ErrJobNotFound
+	}
+	if restricted && (j.UserID == nil || *j.UserID != ownerID) {
+		slog.WarnContext(ctx, "Job outside of user scope",
+			slog.Int64("jobId", id),
+			slog.Int64("userId", ownerID))
+		return nil, ErrJobNotFound
+	}
+	if pending && !c.scope.Can(ctx, ability, j) {
+		slog.WarnContext(ctx, "Job ability not granted",
+			slog.Int64("jobId", id),
+			slog.String("ability", ability))
+		return nil, ErrJobForbidden
+	}
+
+	return true, nil
+}
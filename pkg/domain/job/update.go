@@ -3,6 +3,10 @@ package job
 import (
 	"context"
 	"log/slog"
+	"strings"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
 )
 
 // Update actualiza un job existente
@@ -20,9 +24,12 @@ func (uc *UseCase) Update(ctx context.Context, j *Job) error {
 		return ErrJobNotFound
 	}
 
-	if existing.IsDeleted() {
+	if existing.IsDeleted() || !uc.scope.CanView(ctx, existing) {
 		return ErrJobNotFound
 	}
+	if !uc.scope.Can(ctx, AbilityEdit, existing) {
+		return ErrJobForbidden
+	}
 
 	// Verificar categoría si cambió
 	if j.JobCategoryID > 0 && j.JobCategoryID != existing.JobCategoryID {
@@ -61,6 +68,20 @@ func (uc *UseCase) Update(ctx context.Context, j *Job) error {
 		}
 	}
 
+	// Solo se puede marcar warranty_claim si existe al menos una reclamación
+	if j.WarrantyClaim && !existing.WarrantyClaim {
+		hasClaims, err := uc.warrantyClaimRepo.HasClaims(ctx, j.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to check warranty claims",
+				slog.Int64("id", j.ID),
+				slog.String("error", err.Error()))
+			return err
+		}
+		if !hasClaims {
+			return ErrWarrantyClaimRequired
+		}
+	}
+
 	// Lógica de tech_status -> job_status automática (fiel al original)
 	if j.TechnicianJobStatusID != nil && *j.TechnicianJobStatusID > 0 {
 		techChanged := existing.TechnicianJobStatusID == nil || *j.TechnicianJobStatusID != *existing.TechnicianJobStatusID
@@ -78,6 +99,9 @@ func (uc *UseCase) Update(ctx context.Context, j *Job) error {
 		}
 	}
 
+	// Calcular los cambios sobre el valor final, incluyendo el status derivado del técnico
+	changes := diffJobs(existing, j)
+
 	if err := uc.repo.Update(ctx, j); err != nil {
 		slog.ErrorContext(ctx, "Failed to update job",
 			slog.Int64("id", j.ID),
@@ -88,5 +112,30 @@ func (uc *UseCase) Update(ctx context.Context, j *Job) error {
 	slog.InfoContext(ctx, "Job updated successfully",
 		slog.Int64("id", j.ID))
 
+	uc.recordHistory(ctx, j.ID, changes)
+
+	message := "Job updated"
+	fields := make([]string, len(changes))
+	for i, c := range changes {
+		fields[i] = c.Field
+	}
+	if len(fields) > 0 {
+		message = "Job updated: " + strings.Join(fields, ", ")
+	}
+	uc.logActivity(ctx, j.ID, domainActivity.TypeJobUpdated, message)
+	uc.notifyTechnician(ctx, existing, j)
+	uc.publish(ctx, domainEvent.TypeJobUpdated, j, reassignedFrom(existing, j), fields)
+
 	return nil
 }
+
+// reassignedFrom retorna el técnico anterior si la actualización cambió el asignado del job
+func reassignedFrom(existing, j *Job) *int64 {
+	if existing.UserID == nil || *existing.UserID == 0 {
+		return nil
+	}
+	if j.UserID != nil && *j.UserID == *existing.UserID {
+		return nil
+	}
+	return existing.UserID
+}
@@ -1,6 +1,12 @@
 package job
 
-import "context"
+import (
+	"context"
+
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
 
 // Service define la interfaz del servicio de jobs
 type Service interface {
@@ -9,7 +15,7 @@ type Service interface {
 	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Job, int, error)
 	Update(ctx context.Context, job *Job) error
 	Delete(ctx context.Context, id int64) error
-	Close(ctx context.Context, id int64, jobStatusID int64) error
+	Close(ctx context.Context, id int64, jobStatusID int64, opts CloseOptions) error
 }
 
 // UseCase implementa la lógica de negocio de jobs
@@ -22,6 +28,13 @@ type UseCase struct {
 	propertyRepo            PropertyChecker
 	userRepo                UserChecker
 	technicianJobStatusRepo TechnicianJobStatusChecker
+	activityLogger          ActivityLogger
+	historyRecorder         HistoryRecorder
+	warrantyClaimRepo       WarrantyClaimChecker
+	residentRepo            ResidentLister
+	alertNotifier           AlertNotifier
+	publisher               domainEvent.Publisher
+	scope                   *Scope
 }
 
 // JobCategoryChecker verifica existencia de categorías de trabajo
@@ -62,6 +75,32 @@ type TechnicianJobStatusChecker interface {
 	GetLinkedJobStatusID(ctx context.Context, id int64) (*int64, error)
 }
 
+// ActivityLogger registra entradas en la bitácora de actividad del job
+type ActivityLogger interface {
+	LogActivity(ctx context.Context, jobID int64, logType, message string) error
+}
+
+// HistoryRecorder guarda el historial de cambios a nivel de campo del job
+type HistoryRecorder interface {
+	RecordChanges(ctx context.Context, jobID int64, changes []domainHistory.Change) error
+}
+
+// WarrantyClaimChecker verifica si el job tiene reclamaciones de garantía registradas
+type WarrantyClaimChecker interface {
+	HasClaims(ctx context.Context, jobID int64) (bool, error)
+}
+
+// ResidentLister obtiene los residentes (contactos en sitio) de un job
+type ResidentLister interface {
+	ListByJobID(ctx context.Context, jobID int64) ([]*domainResident.JobResident, error)
+}
+
+// AlertNotifier genera alertas en la bandeja de los técnicos del job
+type AlertNotifier interface {
+	NotifyJobAssigned(ctx context.Context, jobID, userID int64, workOrder *string) error
+	NotifyCallLog(ctx context.Context, jobID, userID int64, workOrder *string) error
+}
+
 // NewUseCase crea una nueva instancia del caso de uso de jobs
 func NewUseCase(
 	repo Repository,
@@ -72,6 +111,13 @@ func NewUseCase(
 	propertyRepo PropertyChecker,
 	userRepo UserChecker,
 	technicianJobStatusRepo TechnicianJobStatusChecker,
+	activityLogger ActivityLogger,
+	historyRecorder HistoryRecorder,
+	warrantyClaimRepo WarrantyClaimChecker,
+	residentRepo ResidentLister,
+	alertNotifier AlertNotifier,
+	publisher domainEvent.Publisher,
+	scope *Scope,
 ) *UseCase {
 	return &UseCase{
 		repo:                    repo,
@@ -82,5 +128,12 @@ func NewUseCase(
 		propertyRepo:            propertyRepo,
 		userRepo:                userRepo,
 		technicianJobStatusRepo: technicianJobStatusRepo,
+		activityLogger:          activityLogger,
+		historyRecorder:         historyRecorder,
+		warrantyClaimRepo:       warrantyClaimRepo,
+		residentRepo:            residentRepo,
+		alertNotifier:           alertNotifier,
+		publisher:               publisher,
+		scope:                   scope,
 	}
 }
@@ -7,6 +7,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
+	domainEvent "github.com/your-org/jvairv2/pkg/domain/event"
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
 )
 
 func newTestUseCase() (*UseCase, *MockRepository, *MockJobCategoryChecker, *MockJobPriorityChecker, *MockJobStatusChecker, *MockWorkflowChecker, *MockPropertyChecker, *MockUserChecker, *MockTechnicianJobStatusChecker) {
@@ -19,7 +25,19 @@ func newTestUseCase() (*UseCase, *MockRepository, *MockJobCategoryChecker, *Mock
 	userChecker := new(MockUserChecker)
 	techChecker := new(MockTechnicianJobStatusChecker)
 
-	uc := NewUseCase(repo, catChecker, prioChecker, statusChecker, wfChecker, propChecker, userChecker, techChecker)
+	activityLogger := new(MockActivityLogger)
+	activityLogger.On("LogActivity", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	historyRecorder := new(MockHistoryRecorder)
+	historyRecorder.On("RecordChanges", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	claimChecker := new(MockWarrantyClaimChecker)
+	claimChecker.On("HasClaims", mock.Anything, mock.Anything).Return(true, nil).Maybe()
+
+	residentLister := new(MockResidentLister)
+	residentLister.On("ListByJobID", mock.Anything, mock.Anything).Return([]*domainResident.JobResident{}, nil).Maybe()
+
+	uc := NewUseCase(repo, catChecker, prioChecker, statusChecker, wfChecker, propChecker, userChecker, techChecker, activityLogger, historyRecorder, claimChecker, residentLister, nil, nil, nil)
 	return uc, repo, catChecker, prioChecker, statusChecker, wfChecker, propChecker, userChecker, techChecker
 }
 
@@ -176,6 +194,27 @@ func TestCreate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, ErrInvalidUser, err)
 	})
+
+	t.Run("warranty claim on create", func(t *testing.T) {
+		uc, _, catChecker, prioChecker, _, wfChecker, propChecker, _, _ := newTestUseCase()
+
+		j := &Job{
+			JobCategoryID: 1,
+			JobPriorityID: 2,
+			PropertyID:    3,
+			WarrantyClaim: true,
+		}
+
+		catChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		prioChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		propChecker.On("GetByID", ctx, int64(3)).Return(true, nil)
+		propChecker.On("GetWorkflowID", ctx, int64(3)).Return(int64(10), nil)
+		wfChecker.On("GetInitialStatusID", ctx, int64(10)).Return(int64(20), nil)
+
+		err := uc.Create(ctx, j)
+
+		assert.Equal(t, ErrWarrantyClaimRequired, err)
+	})
 }
 
 func TestGetByID(t *testing.T) {
@@ -205,6 +244,21 @@ func TestGetByID(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
+	t.Run("includes residents", func(t *testing.T) {
+		repo := new(MockRepository)
+		residentLister := new(MockResidentLister)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, residentLister, nil, nil, nil)
+
+		residents := []*domainResident.JobResident{{ID: 7, JobID: 1, Name: "Jane Doe"}}
+		repo.On("GetByID", ctx, int64(1)).Return(&Job{ID: 1, DateReceived: now}, nil)
+		residentLister.On("ListByJobID", ctx, int64(1)).Return(residents, nil)
+
+		j, err := uc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, residents, j.Residents)
+	})
+
 	t.Run("not found", func(t *testing.T) {
 		uc, repo, _, _, _, _, _, _, _ := newTestUseCase()
 
@@ -395,6 +449,255 @@ func TestUpdate(t *testing.T) {
 		techChecker.AssertExpectations(t)
 	})
 
+	t.Run("records field-level history", func(t *testing.T) {
+		repo := new(MockRepository)
+		userChecker := new(MockUserChecker)
+		historyRecorder := new(MockHistoryRecorder)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, userChecker, nil, nil, historyRecorder, nil, nil, nil, nil, nil)
+
+		oldUser := int64(7)
+		oldPrice := 100.0
+		existing := &Job{
+			ID:            1,
+			JobStatusID:   3,
+			UserID:        &oldUser,
+			DateReceived:  now,
+			JobSalesPrice: &oldPrice,
+			QuickNotes:    strPtr("same"),
+		}
+
+		newUser := int64(8)
+		newPrice := 125.5
+		dispatch := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+		updated := &Job{
+			ID:            1,
+			JobStatusID:   3,
+			UserID:        &newUser,
+			DateReceived:  now,
+			JobSalesPrice: &newPrice,
+			QuickNotes:    strPtr("same"),
+			DispatchDate:  &dispatch,
+		}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		userChecker.On("GetByID", ctx, int64(8)).Return(true, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		historyRecorder.On("RecordChanges", ctx, int64(1), []domainHistory.Change{
+			{Field: "user_id", OldValue: strPtr("7"), NewValue: strPtr("8")},
+			{Field: "dispatch_date", OldValue: nil, NewValue: strPtr("2026-03-04")},
+			{Field: "job_sales_price", OldValue: strPtr("100.00"), NewValue: strPtr("125.50")},
+		}).Return(nil)
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		historyRecorder.AssertExpectations(t)
+	})
+
+	t.Run("no history when nothing audited changed", func(t *testing.T) {
+		repo := new(MockRepository)
+		historyRecorder := new(MockHistoryRecorder)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, historyRecorder, nil, nil, nil, nil, nil)
+
+		existing := &Job{ID: 1, DateReceived: now, CageRequired: false}
+		updated := &Job{ID: 1, DateReceived: now, CageRequired: true}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		historyRecorder.AssertNotCalled(t, "RecordChanges", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("warranty claim without claims", func(t *testing.T) {
+		repo := new(MockRepository)
+		claimChecker := new(MockWarrantyClaimChecker)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, claimChecker, nil, nil, nil, nil)
+
+		existing := &Job{ID: 1, DateReceived: now}
+		updated := &Job{ID: 1, DateReceived: now, WarrantyClaim: true}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		claimChecker.On("HasClaims", ctx, int64(1)).Return(false, nil)
+
+		err := uc.Update(ctx, updated)
+
+		assert.Equal(t, ErrWarrantyClaimRequired, err)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("warranty claim with claims", func(t *testing.T) {
+		repo := new(MockRepository)
+		claimChecker := new(MockWarrantyClaimChecker)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, claimChecker, nil, nil, nil, nil)
+
+		existing := &Job{ID: 1, DateReceived: now}
+		updated := &Job{ID: 1, DateReceived: now, WarrantyClaim: true}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		claimChecker.On("HasClaims", ctx, int64(1)).Return(true, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		claimChecker.AssertExpectations(t)
+	})
+
+	t.Run("alerts new technician on assignment", func(t *testing.T) {
+		repo := new(MockRepository)
+		userChecker := new(MockUserChecker)
+		notifier := new(MockAlertNotifier)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, userChecker, nil, nil, nil, nil, nil, notifier, nil, nil)
+
+		oldUser, newUser := int64(7), int64(8)
+		existing := &Job{ID: 1, DateReceived: now, UserID: &oldUser}
+		updated := &Job{ID: 1, DateReceived: now, UserID: &newUser, WorkOrder: strPtr("WO-1")}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		userChecker.On("GetByID", ctx, int64(8)).Return(true, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		notifier.On("NotifyJobAssigned", ctx, int64(1), int64(8), updated.WorkOrder).Return(nil)
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		notifier.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "NotifyCallLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("alerts technician on call log change", func(t *testing.T) {
+		repo := new(MockRepository)
+		notifier := new(MockAlertNotifier)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, notifier, nil, nil)
+
+		user := int64(7)
+		existing := &Job{ID: 1, DateReceived: now, UserID: &user, CallLogs: strPtr("first call")}
+		updated := &Job{ID: 1, DateReceived: now, UserID: &user, CallLogs: strPtr("first call\nsecond call")}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		notifier.On("NotifyCallLog", ctx, int64(1), int64(7), (*string)(nil)).Return(nil)
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		notifier.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "NotifyJobAssigned", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("publishes updated event with changed fields", func(t *testing.T) {
+		repo := new(MockRepository)
+		publisher := new(domainEvent.MockPublisher)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, publisher, nil)
+
+		existing := &Job{ID: 1, DateReceived: now, QuickNotes: strPtr("old")}
+		updated := &Job{ID: 1, DateReceived: now, QuickNotes: strPtr("new")}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		publisher.On("Publish", ctx, mock.AnythingOfType("*event.Event")).Return()
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		e := publisher.Calls[0].Arguments.Get(1).(*domainEvent.Event)
+		assert.Equal(t, domainEvent.TypeJobUpdated, e.Type)
+		assert.Equal(t, []string{"quick_notes"}, e.Data.(map[string]interface{})["fields"])
+	})
+
+	t.Run("reassignment event reaches previous technician", func(t *testing.T) {
+		repo := new(MockRepository)
+		userChecker := new(MockUserChecker)
+		publisher := new(domainEvent.MockPublisher)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, userChecker, nil, nil, nil, nil, nil, nil, publisher, nil)
+
+		previous, next := int64(7), int64(8)
+		existing := &Job{ID: 1, DateReceived: now, UserID: &previous}
+		updated := &Job{ID: 1, DateReceived: now, UserID: &next}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		userChecker.On("GetByID", ctx, int64(8)).Return(true, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		publisher.On("Publish", ctx, mock.AnythingOfType("*event.Event")).Return()
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		publisher.AssertNumberOfCalls(t, "Publish", 1)
+		e := publisher.Calls[0].Arguments.Get(1).(*domainEvent.Event)
+		assert.Equal(t, &next, e.UserID)
+		assert.Equal(t, &previous, e.PreviousUserID)
+		assert.Equal(t, &previous, e.Data.(map[string]interface{})["previousUserId"])
+		assert.True(t, e.VisibleTo(&domainEvent.Subscriber{UserID: 7, AssignedOnly: true}))
+		assert.True(t, e.VisibleTo(&domainEvent.Subscriber{UserID: 8, AssignedOnly: true}))
+	})
+
+	t.Run("unassignment event reaches previous technician", func(t *testing.T) {
+		repo := new(MockRepository)
+		publisher := new(domainEvent.MockPublisher)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, publisher, nil)
+
+		previous := int64(7)
+		existing := &Job{ID: 1, DateReceived: now, UserID: &previous}
+		updated := &Job{ID: 1, DateReceived: now}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		publisher.On("Publish", ctx, mock.AnythingOfType("*event.Event")).Return()
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		e := publisher.Calls[0].Arguments.Get(1).(*domainEvent.Event)
+		assert.Nil(t, e.UserID)
+		assert.Equal(t, &previous, e.PreviousUserID)
+	})
+
+	t.Run("same technician has no previous assignee", func(t *testing.T) {
+		repo := new(MockRepository)
+		publisher := new(domainEvent.MockPublisher)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, publisher, nil)
+
+		tech := int64(7)
+		existing := &Job{ID: 1, DateReceived: now, UserID: &tech, QuickNotes: strPtr("old")}
+		updated := &Job{ID: 1, DateReceived: now, UserID: &tech, QuickNotes: strPtr("new")}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		publisher.On("Publish", ctx, mock.AnythingOfType("*event.Event")).Return()
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		e := publisher.Calls[0].Arguments.Get(1).(*domainEvent.Event)
+		assert.Nil(t, e.PreviousUserID)
+		assert.NotContains(t, e.Data.(map[string]interface{}), "previousUserId")
+	})
+
+	t.Run("alert failure does not fail update", func(t *testing.T) {
+		repo := new(MockRepository)
+		userChecker := new(MockUserChecker)
+		notifier := new(MockAlertNotifier)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, userChecker, nil, nil, nil, nil, nil, notifier, nil, nil)
+
+		user := int64(8)
+		existing := &Job{ID: 1, DateReceived: now}
+		updated := &Job{ID: 1, DateReceived: now, UserID: &user}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		userChecker.On("GetByID", ctx, int64(8)).Return(true, nil)
+		repo.On("Update", ctx, updated).Return(nil)
+		notifier.On("NotifyJobAssigned", ctx, int64(1), int64(8), (*string)(nil)).Return(errors.New("db down"))
+
+		err := uc.Update(ctx, updated)
+
+		assert.NoError(t, err)
+		notifier.AssertExpectations(t)
+	})
+
 	t.Run("missing id", func(t *testing.T) {
 		uc, _, _, _, _, _, _, _, _ := newTestUseCase()
 
@@ -474,15 +777,95 @@ func TestClose(t *testing.T) {
 
 		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
 		statusChecker.On("GetByID", ctx, int64(5)).Return(true, nil)
-		repo.On("Close", ctx, int64(1), int64(5)).Return(nil)
+		repo.On("Close", ctx, int64(1), int64(5), CloseOptions{}).Return(nil)
 
-		err := uc.Close(ctx, 1, 5)
+		err := uc.Close(ctx, 1, 5, CloseOptions{})
 
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
 		statusChecker.AssertExpectations(t)
 	})
 
+	t.Run("logs activity and tolerates logger failure", func(t *testing.T) {
+		repo := new(MockRepository)
+		statusChecker := new(MockJobStatusChecker)
+		activityLogger := new(MockActivityLogger)
+		uc := NewUseCase(repo, nil, nil, statusChecker, nil, nil, nil, nil, activityLogger, nil, nil, nil, nil, nil, nil)
+
+		existing := &Job{ID: 1, DateReceived: now, CreatedAt: &now}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		statusChecker.On("GetByID", ctx, int64(5)).Return(true, nil)
+		repo.On("Close", ctx, int64(1), int64(5), CloseOptions{}).Return(nil)
+		activityLogger.On("LogActivity", ctx, int64(1), "job_closed", "Job closed").Return(errors.New("no user"))
+
+		err := uc.Close(ctx, 1, 5, CloseOptions{})
+
+		assert.NoError(t, err)
+		activityLogger.AssertExpectations(t)
+	})
+
+	t.Run("publishes closed event", func(t *testing.T) {
+		repo := new(MockRepository)
+		statusChecker := new(MockJobStatusChecker)
+		publisher := new(domainEvent.MockPublisher)
+		uc := NewUseCase(repo, nil, nil, statusChecker, nil, nil, nil, nil, nil, nil, nil, nil, nil, publisher, nil)
+
+		tech := int64(7)
+		existing := &Job{ID: 1, DateReceived: now, UserID: &tech}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		statusChecker.On("GetByID", ctx, int64(5)).Return(true, nil)
+		repo.On("Close", ctx, int64(1), int64(5), CloseOptions{}).Return(nil)
+		publisher.On("Publish", ctx, mock.AnythingOfType("*event.Event")).Return()
+
+		err := uc.Close(ctx, 1, 5, CloseOptions{})
+
+		assert.NoError(t, err)
+		e := publisher.Calls[0].Arguments.Get(1).(*domainEvent.Event)
+		assert.Equal(t, domainEvent.TypeJobClosed, e.Type)
+		assert.Equal(t, int64(1), e.EntityID)
+		assert.Equal(t, domainEvent.AbilityJobView, e.Ability)
+		assert.Equal(t, &tech, e.UserID)
+		assert.Equal(t, true, e.Data.(map[string]interface{})["closed"])
+	})
+
+	t.Run("with warranty defaults number to work order", func(t *testing.T) {
+		uc, repo, _, _, statusChecker, _, _, _, _ := newTestUseCase()
+
+		existing := &Job{ID: 1, WorkOrder: strPtr("WO-100"), DateReceived: now, CreatedAt: &now}
+		opts := CloseOptions{
+			UpdatePropertyEquipment: true,
+			Warranty:                &CloseWarranty{WarrantyTypeID: 2, WarrantyStatusID: 3},
+		}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		statusChecker.On("GetByID", ctx, int64(5)).Return(true, nil)
+		repo.On("Close", ctx, int64(1), int64(5), opts).Return(nil)
+
+		err := uc.Close(ctx, 1, 5, opts)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "WO-100", opts.Warranty.WarrantyNumber)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("with warranty missing type", func(t *testing.T) {
+		uc, repo, _, _, statusChecker, _, _, _, _ := newTestUseCase()
+
+		existing := &Job{ID: 1, DateReceived: now, CreatedAt: &now}
+		opts := CloseOptions{Warranty: &CloseWarranty{WarrantyStatusID: 3}}
+
+		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		statusChecker.On("GetByID", ctx, int64(5)).Return(true, nil)
+
+		err := uc.Close(ctx, 1, 5, opts)
+
+		assert.Error(t, err)
+		assert.Equal(t, "warranty_type_id is required", err.Error())
+		repo.AssertNotCalled(t, "Close", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("already closed", func(t *testing.T) {
 		uc, repo, _, _, _, _, _, _, _ := newTestUseCase()
 
@@ -495,7 +878,7 @@ func TestClose(t *testing.T) {
 
 		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
 
-		err := uc.Close(ctx, 1, 5)
+		err := uc.Close(ctx, 1, 5, CloseOptions{})
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrJobAlreadyClosed, err)
@@ -506,7 +889,7 @@ func TestClose(t *testing.T) {
 
 		repo.On("GetByID", ctx, int64(999)).Return(nil, ErrJobNotFound)
 
-		err := uc.Close(ctx, 999, 5)
+		err := uc.Close(ctx, 999, 5, CloseOptions{})
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrJobNotFound, err)
@@ -525,7 +908,7 @@ func TestClose(t *testing.T) {
 		repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
 		statusChecker.On("GetByID", ctx, int64(999)).Return(nil, ErrInvalidJobStatus)
 
-		err := uc.Close(ctx, 1, 999)
+		err := uc.Close(ctx, 1, 999, CloseOptions{})
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrInvalidJobStatus, err)
@@ -535,3 +918,239 @@ func TestClose(t *testing.T) {
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestSupervisorIDList(t *testing.T) {
+	cases := map[string][]int64{
+		`["3","5"]`: {3, 5},
+		`[3, 5, 3]`: {3, 5},
+		"7, 9":      {7, 9},
+		"":          nil,
+		`["x"]`:     nil,
+	}
+	for raw, want := range cases {
+		raw := raw
+		j := &Job{SupervisorIDs: &raw}
+		assert.Equal(t, want, j.SupervisorIDList(), raw)
+	}
+	assert.Nil(t, (&Job{}).SupervisorIDList())
+}
+
+// userOnlyScope simula un usuario autenticado con job_view_user_only
+func userOnlyScope(userID int64, abilities ...string) *Scope {
+	return NewScope(
+		func(ctx context.Context) (int64, bool) { return userID, true },
+		func(ctx context.Context, ability string) bool {
+			for _, a := range abilities {
+				if a == ability {
+					return true
+				}
+			}
+			return false
+		},
+		nil,
+		nil,
+	)
+}
+
+// entityScope simula un usuario cuyas habilidades se evalúan con el evaluador de Bouncer;
+// pending es la habilidad que la ruta solo concedió por un permiso ligado a un job
+func entityScope(userID int64, grants []authorization.Grant, pending string) *Scope {
+	evaluator := authorization.NewEvaluator(userID, grants, nil)
+	return NewScope(
+		func(ctx context.Context) (int64, bool) { return userID, true },
+		func(ctx context.Context, ability string) bool { return evaluator.Can(ability, nil) },
+		func(ctx context.Context, ability string, entity *authorization.Entity) bool {
+			return evaluator.CanAccess(ability, entity)
+		},
+		func(ctx context.Context) (string, bool) { return pending, pending != "" },
+	)
+}
+
+func TestScope_EntityAbilities(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	owner := int64(5)
+	other := int64(6)
+	jobID := int64(42)
+	onJob := authorization.Grant{Name: AbilityEdit, EntityType: authorization.JobType, EntityID: &jobID}
+	owned := authorization.Grant{Name: AbilityDelete, EntityType: authorization.JobType, OnlyOwned: true}
+
+	t.Run("ability on one job allows updating it", func(t *testing.T) {
+		repo := new(MockRepository)
+		scope := entityScope(owner, []authorization.Grant{onJob}, AbilityEdit)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, scope)
+
+		repo.On("GetByID", ctx, jobID).Return(&Job{ID: jobID, DateReceived: now}, nil)
+		repo.On("Update", ctx, mock.Anything).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, &Job{ID: jobID}))
+	})
+
+	t.Run("ability on one job does not allow another", func(t *testing.T) {
+		repo := new(MockRepository)
+		scope := entityScope(owner, []authorization.Grant{onJob}, AbilityEdit)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, scope)
+
+		repo.On("GetByID", ctx, int64(43)).Return(&Job{ID: 43, DateReceived: now}, nil)
+
+		assert.Equal(t, ErrJobForbidden, uc.Update(ctx, &Job{ID: 43}))
+		assert.Equal(t, ErrJobForbidden, uc.Close(ctx, 43, 0, CloseOptions{}))
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "Close", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("only owned ability", func(t *testing.T) {
+		repo := new(MockRepository)
+		scope := entityScope(owner, []authorization.Grant{owned}, AbilityDelete)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, scope)
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Job{ID: 1, UserID: &owner, DateReceived: now}, nil)
+		repo.On("GetByID", ctx, int64(2)).Return(&Job{ID: 2, UserID: &other, DateReceived: now}, nil)
+		repo.On("Delete", ctx, int64(1)).Return(nil)
+
+		assert.NoError(t, uc.Delete(ctx, 1))
+		assert.Equal(t, ErrJobForbidden, uc.Delete(ctx, 2))
+		repo.AssertNotCalled(t, "Delete", mock.Anything, int64(2))
+	})
+
+	t.Run("forbidden job overrides simple ability", func(t *testing.T) {
+		repo := new(MockRepository)
+		forbidden := authorization.Grant{Name: AbilityView, EntityType: authorization.JobType, EntityID: &jobID, Forbidden: true}
+		scope := entityScope(owner, []authorization.Grant{{Name: AbilityView}, forbidden}, "")
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, scope)
+
+		repo.On("GetByID", ctx, jobID).Return(&Job{ID: jobID, DateReceived: now}, nil)
+
+		_, err := uc.GetByID(ctx, jobID)
+		assert.Equal(t, ErrJobForbidden, err)
+	})
+
+	t.Run("child resource checks the pending ability", func(t *testing.T) {
+		repo := new(MockRepository)
+		inner := new(MockJobStatusChecker)
+		checker := NewScopedJobChecker(inner, repo, entityScope(owner, []authorization.Grant{onJob}, AbilityEdit))
+
+		repo.On("GetByID", ctx, jobID).Return(&Job{ID: jobID, DateReceived: now}, nil)
+		repo.On("GetByID", ctx, int64(43)).Return(&Job{ID: 43, DateReceived: now}, nil)
+
+		_, err := checker.GetByID(ctx, jobID)
+		assert.NoError(t, err)
+
+		_, err = checker.GetByID(ctx, 43)
+		assert.Equal(t, ErrJobForbidden, err)
+		inner.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestScope(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	owner := int64(5)
+	other := int64(6)
+
+	t.Run("owner filter overrides requested user", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, userOnlyScope(owner, AbilityViewUserOnly))
+
+		filters := map[string]interface{}{"user_id": other, "closed": false}
+		repo.On("List", ctx, map[string]interface{}{"user_id": owner, "closed": false}, 1, 10).Return([]*Job{}, 0, nil)
+
+		_, _, err := uc.List(ctx, filters, 1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, other, filters["user_id"])
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("wildcard ability sees every job", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, userOnlyScope(owner, AbilityViewUserOnly, AbilityAll))
+
+		filters := map[string]interface{}{}
+		repo.On("List", ctx, filters, 1, 10).Return([]*Job{}, 0, nil)
+
+		_, _, err := uc.List(ctx, filters, 1, 10)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("other user's job is not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, userOnlyScope(owner, AbilityViewUserOnly))
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Job{ID: 1, UserID: &other, DateReceived: now}, nil)
+
+		j, err := uc.GetByID(ctx, 1)
+
+		assert.Nil(t, j)
+		assert.Equal(t, ErrJobNotFound, err)
+	})
+
+	t.Run("other user's job cannot be deleted", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, userOnlyScope(owner, AbilityViewUserOnly))
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Job{ID: 1, DateReceived: now}, nil)
+
+		assert.Equal(t, ErrJobNotFound, uc.Delete(ctx, 1))
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("own job is visible", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, userOnlyScope(owner, AbilityViewUserOnly))
+
+		expected := &Job{ID: 1, UserID: &owner, DateReceived: now}
+		repo.On("GetByID", ctx, int64(1)).Return(expected, nil)
+
+		j, err := uc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, j)
+	})
+}
+
+func TestScopedJobChecker(t *testing.T) {
+	ctx := context.Background()
+	owner := int64(5)
+	other := int64(6)
+
+	t.Run("unrestricted delegates to inner checker", func(t *testing.T) {
+		repo := new(MockRepository)
+		inner := new(MockJobStatusChecker)
+		checker := NewScopedJobChecker(inner, repo, userOnlyScope(owner))
+
+		inner.On("GetByID", ctx, int64(1)).Return(true, nil)
+
+		_, err := checker.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("restricted rejects other user's job", func(t *testing.T) {
+		repo := new(MockRepository)
+		inner := new(MockJobStatusChecker)
+		checker := NewScopedJobChecker(inner, repo, userOnlyScope(owner, AbilityViewUserOnly))
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Job{ID: 1, UserID: &other}, nil)
+
+		_, err := checker.GetByID(ctx, 1)
+
+		assert.Equal(t, ErrJobNotFound, err)
+		inner.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("restricted accepts own job", func(t *testing.T) {
+		repo := new(MockRepository)
+		inner := new(MockJobStatusChecker)
+		checker := NewScopedJobChecker(inner, repo, userOnlyScope(owner, AbilityViewUserOnly))
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Job{ID: 1, UserID: &owner}, nil)
+
+		_, err := checker.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,42 @@
+package job_activity_log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create crea una nota manual en la bitácora del job usando el usuario autenticado
+func (uc *UseCase) Create(ctx context.Context, activity *JobActivityLog) error {
+	if activity.Type == "" {
+		activity.Type = TypeNote
+	}
+
+	if err := activity.ValidateCreate(); err != nil {
+		return err
+	}
+
+	userID, ok := uc.currentUserID(ctx)
+	if !ok {
+		return ErrUserRequired
+	}
+	activity.UserID = userID
+
+	// Verificar que el job existe
+	if _, err := uc.jobCheck.GetByID(ctx, activity.JobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job",
+			slog.Int64("jobId", activity.JobID),
+			slog.String("error", err.Error()))
+		return ErrInvalidJob
+	}
+
+	if err := uc.repo.Create(ctx, activity); err != nil {
+		slog.ErrorContext(ctx, "Failed to create job activity",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job activity created successfully",
+		slog.Int64("id", activity.ID))
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package job_activity_log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una entrada de actividad, verificando que pertenece al job indicado
+func (uc *UseCase) Delete(ctx context.Context, jobID, id int64) error {
+	existing, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Activity not found for delete",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return ErrActivityNotFound
+	}
+
+	// Verificar que la actividad pertenece al job indicado
+	if existing.JobID != jobID {
+		return ErrActivityNotFound
+	}
+
+	// El job debe existir y ser visible para el usuario autenticado
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		return ErrActivityNotFound
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job activity",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job activity deleted successfully",
+		slog.Int64("id", id))
+
+	return nil
+}
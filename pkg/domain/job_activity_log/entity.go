@@ -0,0 +1,44 @@
+package job_activity_log
+
+import (
+	"fmt"
+	"time"
+)
+
+// Tipos de entradas de la bitácora de actividad
+const (
+	// TypeNote es una nota creada manualmente por un usuario
+	TypeNote = "note"
+	// TypeJobCreated se registra al crear el job
+	TypeJobCreated = "job_created"
+	// TypeJobUpdated se registra al actualizar el job
+	TypeJobUpdated = "job_updated"
+	// TypeJobClosed se registra al cerrar el job
+	TypeJobClosed = "job_closed"
+)
+
+// JobActivityLog representa una entrada en la bitácora de actividad de un job
+type JobActivityLog struct {
+	ID        int64      `json:"id"`
+	JobID     int64      `json:"jobId"`
+	Type      string     `json:"type"`
+	Log       string     `json:"log"`
+	UserID    int64      `json:"userId"`
+	UserName  *string    `json:"userName,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ValidateCreate valida los campos requeridos para crear una entrada
+func (a *JobActivityLog) ValidateCreate() error {
+	if a.JobID == 0 {
+		return fmt.Errorf("job_id is required")
+	}
+	if a.Type == "" {
+		return fmt.Errorf("type is required")
+	}
+	if a.Log == "" {
+		return fmt.Errorf("log is required")
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package job_activity_log
+
+import "errors"
+
+var (
+	// ErrActivityNotFound indica que la entrada de actividad no fue encontrada
+	ErrActivityNotFound = errors.New("job activity not found")
+
+	// ErrInvalidJob indica que el job no es válido
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrUserRequired indica que no hay un usuario autenticado para registrar la actividad
+	ErrUserRequired = errors.New("authenticated user is required")
+)
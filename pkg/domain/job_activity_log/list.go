@@ -0,0 +1,39 @@
+package job_activity_log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene una lista paginada de actividades de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobActivityLog, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	// Verificar que el job existe
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job for listing activities",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, 0, ErrInvalidJob
+	}
+
+	activities, total, err := uc.repo.ListByJobID(ctx, jobID, filters, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job activities",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	slog.InfoContext(ctx, "Job activities listed successfully",
+		slog.Int64("jobId", jobID),
+		slog.Int("total", total),
+		slog.Int("page", page),
+		slog.Int("pageSize", pageSize))
+
+	return activities, total, nil
+}
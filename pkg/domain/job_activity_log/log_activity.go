@@ -0,0 +1,37 @@
+package job_activity_log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogActivity registra una entrada automática en la bitácora del job.
+// Es usado por otros casos de uso (jobs) y no verifica la existencia del job,
+// ya que quien lo invoca acaba de operar sobre él.
+func (uc *UseCase) LogActivity(ctx context.Context, jobID int64, logType, message string) error {
+	userID, ok := uc.currentUserID(ctx)
+	if !ok {
+		return ErrUserRequired
+	}
+
+	activity := &JobActivityLog{
+		JobID:  jobID,
+		Type:   logType,
+		Log:    message,
+		UserID: userID,
+	}
+
+	if err := activity.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(ctx, activity); err != nil {
+		slog.ErrorContext(ctx, "Failed to log job activity",
+			slog.Int64("jobId", jobID),
+			slog.String("type", logType),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
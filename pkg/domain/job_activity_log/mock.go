@@ -0,0 +1,48 @@
+package job_activity_log
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de actividades de jobs
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, activity *JobActivityLog) error {
+	args := m.Called(ctx, activity)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*JobActivityLog, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*JobActivityLog), args.Error(1)
+}
+
+func (m *MockRepository) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobActivityLog, int, error) {
+	args := m.Called(ctx, jobID, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*JobActivityLog), args.Int(1), args.Error(2)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockJobChecker es un mock del checker de jobs
+type MockJobChecker struct {
+	mock.Mock
+}
+
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
@@ -0,0 +1,18 @@
+package job_activity_log
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento de actividades de jobs
+type Repository interface {
+	// Create crea una nueva entrada de actividad
+	Create(ctx context.Context, activity *JobActivityLog) error
+
+	// GetByID obtiene una entrada de actividad por su ID
+	GetByID(ctx context.Context, id int64) (*JobActivityLog, error)
+
+	// ListByJobID obtiene las actividades de un job con paginación
+	ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobActivityLog, int, error)
+
+	// Delete elimina una entrada de actividad (la tabla no tiene soft delete)
+	Delete(ctx context.Context, id int64) error
+}
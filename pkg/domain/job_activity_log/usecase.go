@@ -0,0 +1,47 @@
+package job_activity_log
+
+import "context"
+
+// Service define la interfaz del servicio de actividades de jobs
+type Service interface {
+	Create(ctx context.Context, activity *JobActivityLog) error
+	ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobActivityLog, int, error)
+	Delete(ctx context.Context, jobID, id int64) error
+	LogActivity(ctx context.Context, jobID int64, logType, message string) error
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// UseCase implementa la lógica de negocio de actividades de jobs
+type UseCase struct {
+	repo         Repository
+	jobCheck     JobChecker
+	userResolver UserIDResolver
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de actividades de jobs
+func NewUseCase(repo Repository, jobCheck JobChecker, userResolver UserIDResolver) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		jobCheck:     jobCheck,
+		userResolver: userResolver,
+	}
+}
+
+// currentUserID obtiene el usuario autenticado del contexto
+func (uc *UseCase) currentUserID(ctx context.Context) (int64, bool) {
+	if uc.userResolver == nil {
+		return 0, false
+	}
+	id, ok := uc.userResolver(ctx)
+	if !ok || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
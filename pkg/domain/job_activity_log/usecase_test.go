@@ -0,0 +1,163 @@
+package job_activity_log
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func fixedUser(id int64) UserIDResolver {
+	return func(ctx context.Context) (int64, bool) {
+		return id, id > 0
+	}
+}
+
+func newTestUseCase(userID int64) (*UseCase, *MockRepository, *MockJobChecker) {
+	repo := new(MockRepository)
+	jobCheck := new(MockJobChecker)
+	uc := NewUseCase(repo, jobCheck, fixedUser(userID))
+	return uc, repo, jobCheck
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success defaults to note", func(t *testing.T) {
+		uc, repo, jobCheck := newTestUseCase(7)
+
+		activity := &JobActivityLog{JobID: 1, Log: "Called the resident"}
+
+		jobCheck.On("GetByID", ctx, int64(1)).Return(true, nil)
+		repo.On("Create", ctx, activity).Return(nil)
+
+		err := uc.Create(ctx, activity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, TypeNote, activity.Type)
+		assert.Equal(t, int64(7), activity.UserID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("missing log", func(t *testing.T) {
+		uc, _, _ := newTestUseCase(7)
+
+		err := uc.Create(ctx, &JobActivityLog{JobID: 1})
+
+		assert.Error(t, err)
+		assert.Equal(t, "log is required", err.Error())
+	})
+
+	t.Run("no authenticated user", func(t *testing.T) {
+		uc, _, _ := newTestUseCase(0)
+
+		err := uc.Create(ctx, &JobActivityLog{JobID: 1, Log: "note"})
+
+		assert.Equal(t, ErrUserRequired, err)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, _, jobCheck := newTestUseCase(7)
+
+		jobCheck.On("GetByID", ctx, int64(99)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, &JobActivityLog{JobID: 99, Log: "note"})
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
+
+func TestLogActivity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(3)
+
+		repo.On("Create", ctx, mock.MatchedBy(func(a *JobActivityLog) bool {
+			return a.JobID == 5 && a.Type == TypeJobClosed && a.UserID == 3
+		})).Return(nil)
+
+		err := uc.LogActivity(ctx, 5, TypeJobClosed, "Job closed")
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("no authenticated user", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(0)
+
+		err := uc.LogActivity(ctx, 5, TypeJobClosed, "Job closed")
+
+		assert.Equal(t, ErrUserRequired, err)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListByJobID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobCheck := newTestUseCase(1)
+		filters := map[string]interface{}{}
+		expected := []*JobActivityLog{{ID: 1, JobID: 2}}
+
+		jobCheck.On("GetByID", ctx, int64(2)).Return(true, nil)
+		repo.On("ListByJobID", ctx, int64(2), filters, 1, 10).Return(expected, 1, nil)
+
+		result, total, err := uc.ListByJobID(ctx, 2, filters, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, _, jobCheck := newTestUseCase(1)
+
+		jobCheck.On("GetByID", ctx, int64(2)).Return(nil, errors.New("not found"))
+
+		_, _, err := uc.ListByJobID(ctx, 2, nil, 1, 10)
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobCheck := newTestUseCase(1)
+
+		repo.On("GetByID", ctx, int64(4)).Return(&JobActivityLog{ID: 4, JobID: 2}, nil)
+		jobCheck.On("GetByID", ctx, int64(2)).Return(true, nil)
+		repo.On("Delete", ctx, int64(4)).Return(nil)
+
+		err := uc.Delete(ctx, 2, 4)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("belongs to another job", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(1)
+
+		repo.On("GetByID", ctx, int64(4)).Return(&JobActivityLog{ID: 4, JobID: 3}, nil)
+
+		err := uc.Delete(ctx, 2, 4)
+
+		assert.Equal(t, ErrActivityNotFound, err)
+		repo.AssertNotCalled(t, "Delete", ctx, int64(4))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(1)
+
+		repo.On("GetByID", ctx, int64(4)).Return(nil, ErrActivityNotFound)
+
+		err := uc.Delete(ctx, 2, 4)
+
+		assert.Equal(t, ErrActivityNotFound, err)
+	})
+}
@@ -0,0 +1,149 @@
+package job_email
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/placeholder"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+)
+
+// Dispatch envía el correo de despacho al técnico asignado (TypeDispatch) o a los
+// supervisores del job (TypeDispatchSupervisor) y lo registra en el historial
+func (uc *UseCase) Dispatch(ctx context.Context, req *DispatchRequest) (*JobEmail, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkJob(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+
+	data, err := uc.dataLoader.Load(ctx, req.JobID)
+	if err != nil {
+		if errors.Is(err, domainPlaceholder.ErrJobNotFound) {
+			return nil, ErrInvalidJob
+		}
+		return nil, err
+	}
+
+	var recipients []string
+	if req.Type == TypeDispatch {
+		recipients, err = technicianRecipients(data)
+	} else {
+		recipients, err = uc.supervisorRecipients(ctx, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	subject, body, err := uc.messageText(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	values := data.Values()
+	msg := &mail.Message{To: recipients}
+	msg.Subject, _ = placeholder.Render(subject, values)
+	msg.Body, _ = placeholder.Render(body, values)
+
+	if err := uc.sender.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "Failed to send job email",
+			slog.Int64("jobId", req.JobID),
+			slog.String("type", req.Type),
+			slog.String("error", err.Error()))
+		return nil, ErrSendFailed
+	}
+
+	record := &JobEmail{
+		JobID:      req.JobID,
+		Recipients: recipients,
+		Type:       req.Type,
+	}
+	if err := uc.repo.Create(ctx, record); err != nil {
+		slog.ErrorContext(ctx, "Failed to record job email",
+			slog.Int64("jobId", req.JobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Job email dispatched",
+		slog.Int64("jobId", req.JobID),
+		slog.String("type", req.Type),
+		slog.Int("recipients", len(recipients)))
+
+	return record, nil
+}
+
+// technicianRecipients retorna el email del técnico asignado al job
+func technicianRecipients(data *domainPlaceholder.Data) ([]string, error) {
+	if data.Technician == nil {
+		return nil, ErrNoTechnician
+	}
+	email := strings.TrimSpace(data.Technician.Email)
+	if !mail.ValidAddress(email) {
+		return nil, ErrNoTechnician
+	}
+	return []string{email}, nil
+}
+
+// supervisorRecipients resuelve los emails de los supervisores de Job.SupervisorIDs
+func (uc *UseCase) supervisorRecipients(ctx context.Context, data *domainPlaceholder.Data) ([]string, error) {
+	seen := make(map[string]bool)
+	var recipients []string
+
+	for _, id := range data.Job.SupervisorIDList() {
+		s, err := uc.supervisorRepo.GetByID(ctx, id)
+		if err != nil || s == nil || s.DeletedAt != nil || s.Email == nil {
+			slog.WarnContext(ctx, "Skipping supervisor without email",
+				slog.Int64("jobId", data.Job.ID),
+				slog.Int64("supervisorId", id))
+			continue
+		}
+
+		email := strings.TrimSpace(*s.Email)
+		key := strings.ToLower(email)
+		if !mail.ValidAddress(email) || seen[key] {
+			continue
+		}
+		seen[key] = true
+		recipients = append(recipients, email)
+	}
+
+	if len(recipients) == 0 {
+		return nil, ErrNoSupervisors
+	}
+	return recipients, nil
+}
+
+// checkJob verifica con el JobChecker que el job existe y que el usuario puede editarlo;
+// la carga de datos del job solo exige poder verlo
+func (uc *UseCase) checkJob(ctx context.Context, jobID int64) error {
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		if errors.Is(err, domainJob.ErrJobForbidden) {
+			return ErrForbidden
+		}
+		return ErrInvalidJob
+	}
+	return nil
+}
+
+// messageText obtiene el asunto y el cuerpo sin renderizar
+func (uc *UseCase) messageText(ctx context.Context, req *DispatchRequest) (string, string, error) {
+	if req.TemplateID != nil {
+		template, err := uc.templateRepo.GetByID(ctx, *req.TemplateID)
+		if err != nil || !template.IsActive {
+			return "", "", ErrTemplateNotFound
+		}
+		return template.Subject, template.Body, nil
+	}
+
+	if req.Type == TypeDispatchSupervisor {
+		return defaultSupervisorSubject, defaultSupervisorBody, nil
+	}
+	return defaultDispatchSubject, defaultDispatchBody, nil
+}
@@ -0,0 +1,65 @@
+package job_email
+
+import "time"
+
+// Tipos de correo de un job
+const (
+	TypeDispatch           = "dispatch"
+	TypeDispatchSupervisor = "dispatch_supervisor"
+)
+
+// JobEmail registra un correo enviado desde un job
+type JobEmail struct {
+	ID         int64      `json:"id"`
+	JobID      int64      `json:"jobId"`
+	Recipients []string   `json:"recipients"`
+	Type       string     `json:"type"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DispatchRequest contiene los datos para enviar un correo de despacho.
+// Si TemplateID es nil se usa el texto por defecto del tipo.
+type DispatchRequest struct {
+	JobID      int64
+	Type       string
+	TemplateID *int64
+}
+
+// Validate verifica el tipo de correo
+func (r *DispatchRequest) Validate() error {
+	if r.Type != TypeDispatch && r.Type != TypeDispatchSupervisor {
+		return ErrInvalidType
+	}
+	return nil
+}
+
+// Textos por defecto de los correos de despacho (mismos marcadores que las plantillas de email)
+const (
+	defaultDispatchSubject = "New job dispatched: {{job.work_order}}"
+	defaultDispatchBody    = `Hello {{technician.name}},
+
+You have been dispatched to work order {{job.work_order}}.
+
+Property: {{property.name}} ({{property.code}})
+Address: {{property.address}}
+Customer: {{customer.name}}
+Dispatch date: {{job.dispatch_date}} {{scheduled_time}}
+Due date: {{job.due_date}}
+
+Dispatch notes:
+{{job.dispatch_notes}}
+`
+
+	defaultSupervisorSubject = "Work order {{job.work_order}} has been dispatched"
+	defaultSupervisorBody    = `Hello,
+
+Work order {{job.work_order}} for {{property.name}} ({{property.address}}) has been dispatched to {{technician.name}}.
+
+Dispatch date: {{job.dispatch_date}} {{scheduled_time}}
+Due date: {{job.due_date}}
+
+Dispatch notes:
+{{job.dispatch_notes}}
+`
+)
@@ -0,0 +1,26 @@
+package job_email
+
+import "errors"
+
+var (
+	// ErrInvalidJob indica que el job no existe
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrForbidden indica que el usuario no tiene permitido editar el job
+	ErrForbidden = errors.New("not allowed to dispatch this job")
+
+	// ErrInvalidType indica que el tipo de correo no es válido
+	ErrInvalidType = errors.New("type must be dispatch or dispatch_supervisor")
+
+	// ErrTemplateNotFound indica que la plantilla de email no existe o está inactiva
+	ErrTemplateNotFound = errors.New("email template not found")
+
+	// ErrNoTechnician indica que el job no tiene técnico asignado con email
+	ErrNoTechnician = errors.New("job has no assigned technician with an email")
+
+	// ErrNoSupervisors indica que ninguno de los supervisores del job tiene email
+	ErrNoSupervisors = errors.New("job has no supervisors with an email")
+
+	// ErrSendFailed indica que el correo no pudo enviarse
+	ErrSendFailed = errors.New("email could not be sent")
+)
@@ -0,0 +1,30 @@
+package job_email
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene una lista paginada de los correos enviados de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobEmail, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		return nil, 0, ErrInvalidJob
+	}
+
+	emails, total, err := uc.repo.ListByJobID(ctx, jobID, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job emails",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return emails, total, nil
+}
@@ -0,0 +1,63 @@
+package job_email
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	domainTemplate "github.com/your-org/jvairv2/pkg/domain/email_template"
+	domainSupervisor "github.com/your-org/jvairv2/pkg/domain/supervisor"
+)
+
+// MockRepository es un mock del repositorio de correos de jobs
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, email *JobEmail) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobEmail, int, error) {
+	args := m.Called(ctx, jobID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*JobEmail), args.Int(1), args.Error(2)
+}
+
+// MockJobChecker es un mock del verificador de jobs
+type MockJobChecker struct {
+	mock.Mock
+}
+
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
+
+// MockTemplateGetter es un mock para obtener plantillas de email
+type MockTemplateGetter struct {
+	mock.Mock
+}
+
+func (m *MockTemplateGetter) GetByID(ctx context.Context, id int64) (*domainTemplate.EmailTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainTemplate.EmailTemplate), args.Error(1)
+}
+
+// MockSupervisorGetter es un mock para obtener supervisores
+type MockSupervisorGetter struct {
+	mock.Mock
+}
+
+func (m *MockSupervisorGetter) GetByID(ctx context.Context, id int64) (*domainSupervisor.Supervisor, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainSupervisor.Supervisor), args.Error(1)
+}
@@ -0,0 +1,11 @@
+package job_email
+
+import "context"
+
+// Repository define los métodos para interactuar con el historial de correos de jobs
+type Repository interface {
+	// Create registra un correo enviado
+	Create(ctx context.Context, email *JobEmail) error
+	// ListByJobID obtiene los correos enviados de un job, del más reciente al más antiguo
+	ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobEmail, int, error)
+}
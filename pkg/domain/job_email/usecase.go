@@ -0,0 +1,53 @@
+package job_email
+
+import (
+	"context"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	domainTemplate "github.com/your-org/jvairv2/pkg/domain/email_template"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+	domainSupervisor "github.com/your-org/jvairv2/pkg/domain/supervisor"
+)
+
+// Service define la interfaz del servicio de correos de jobs
+type Service interface {
+	Dispatch(ctx context.Context, req *DispatchRequest) (*JobEmail, error)
+	ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobEmail, int, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// TemplateGetter obtiene plantillas de email
+type TemplateGetter interface {
+	GetByID(ctx context.Context, id int64) (*domainTemplate.EmailTemplate, error)
+}
+
+// SupervisorGetter obtiene supervisores
+type SupervisorGetter interface {
+	GetByID(ctx context.Context, id int64) (*domainSupervisor.Supervisor, error)
+}
+
+// UseCase implementa la lógica de negocio de correos de jobs
+type UseCase struct {
+	repo           Repository
+	jobCheck       JobChecker
+	dataLoader     domainPlaceholder.Service
+	templateRepo   TemplateGetter
+	supervisorRepo SupervisorGetter
+	sender         mail.Sender
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de correos de jobs
+func NewUseCase(repo Repository, jobCheck JobChecker, dataLoader domainPlaceholder.Service, templateRepo TemplateGetter, supervisorRepo SupervisorGetter, sender mail.Sender) *UseCase {
+	return &UseCase{
+		repo:           repo,
+		jobCheck:       jobCheck,
+		dataLoader:     dataLoader,
+		templateRepo:   templateRepo,
+		supervisorRepo: supervisorRepo,
+		sender:         sender,
+	}
+}
@@ -0,0 +1,197 @@
+package job_email
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	domainTemplate "github.com/your-org/jvairv2/pkg/domain/email_template"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+	domainSupervisor "github.com/your-org/jvairv2/pkg/domain/supervisor"
+	domainUser "github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+func strPtr(s string) *string { return &s }
+func int64Ptr(i int64) *int64 { return &i }
+
+type fixture struct {
+	repo        *MockRepository
+	jobCheck    *MockJobChecker
+	loader      *domainPlaceholder.MockService
+	templates   *MockTemplateGetter
+	supervisors *MockSupervisorGetter
+	sender      *mail.MemorySender
+	uc          *UseCase
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		repo:        new(MockRepository),
+		jobCheck:    new(MockJobChecker),
+		loader:      new(domainPlaceholder.MockService),
+		templates:   new(MockTemplateGetter),
+		supervisors: new(MockSupervisorGetter),
+		sender:      mail.NewMemorySender(),
+	}
+	f.uc = NewUseCase(f.repo, f.jobCheck, f.loader, f.templates, f.supervisors, f.sender)
+	return f
+}
+
+func jobData(technician *domainUser.User, supervisorIDs *string) *domainPlaceholder.Data {
+	return &domainPlaceholder.Data{
+		Job:        &domainJob.Job{ID: 7, WorkOrder: strPtr("WO-7"), SupervisorIDs: supervisorIDs},
+		Technician: technician,
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	ctx := context.Background()
+	tech := &domainUser.User{ID: 3, Name: "Tom", Email: "tom@example.com"}
+
+	t.Run("technician with default text", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(tech, nil), nil)
+		f.repo.On("Create", ctx, mock.AnythingOfType("*job_email.JobEmail")).Return(nil)
+
+		email, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"tom@example.com"}, email.Recipients)
+		assert.Equal(t, TypeDispatch, email.Type)
+
+		sent := f.sender.Messages()
+		assert.Len(t, sent, 1)
+		assert.Equal(t, "New job dispatched: WO-7", sent[0].Subject)
+		assert.Contains(t, sent[0].Body, "Hello Tom,")
+	})
+
+	t.Run("supervisors from job", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(nil, strPtr(`["1","2","4"]`)), nil)
+		f.supervisors.On("GetByID", ctx, int64(1)).Return(&domainSupervisor.Supervisor{ID: 1, Email: strPtr("ann@example.com")}, nil)
+		f.supervisors.On("GetByID", ctx, int64(2)).Return(&domainSupervisor.Supervisor{ID: 2}, nil)
+		f.supervisors.On("GetByID", ctx, int64(4)).Return(nil, errors.New("not found"))
+		f.repo.On("Create", ctx, mock.AnythingOfType("*job_email.JobEmail")).Return(nil)
+
+		email, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatchSupervisor})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ann@example.com"}, email.Recipients)
+		assert.Equal(t, "Work order WO-7 has been dispatched", f.sender.Messages()[0].Subject)
+	})
+
+	t.Run("template overrides default text", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(tech, nil), nil)
+		f.templates.On("GetByID", ctx, int64(5)).Return(&domainTemplate.EmailTemplate{ID: 5, Subject: "Job {{job.work_order}}", Body: "Hi {{technician.name}}", IsActive: true}, nil)
+		f.repo.On("Create", ctx, mock.AnythingOfType("*job_email.JobEmail")).Return(nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch, TemplateID: int64Ptr(5)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Job WO-7", f.sender.Messages()[0].Subject)
+		assert.Equal(t, "Hi Tom", f.sender.Messages()[0].Body)
+	})
+
+	t.Run("no technician", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(nil, nil), nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch})
+
+		assert.Equal(t, ErrNoTechnician, err)
+		assert.Empty(t, f.sender.Messages())
+	})
+
+	t.Run("no supervisors", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(tech, nil), nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatchSupervisor})
+
+		assert.Equal(t, ErrNoSupervisors, err)
+	})
+
+	t.Run("send failure is not recorded", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.sender.Err = errors.New("connection refused")
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(tech, nil), nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch})
+
+		assert.Equal(t, ErrSendFailed, err)
+		f.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := newFixture().uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: "other"})
+		assert.Equal(t, ErrInvalidType, err)
+	})
+
+	t.Run("view only grant cannot dispatch", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(nil, domainJob.ErrJobForbidden)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch})
+
+		assert.Equal(t, ErrForbidden, err)
+		f.loader.AssertNotCalled(t, "Load", mock.Anything, mock.Anything)
+		assert.Empty(t, f.sender.Messages())
+	})
+
+	t.Run("job outside of scope", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(nil, domainJob.ErrJobNotFound)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch})
+
+		assert.Equal(t, ErrInvalidJob, err)
+		f.loader.AssertNotCalled(t, "Load", mock.Anything, mock.Anything)
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(nil, domainPlaceholder.ErrJobNotFound)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeDispatch})
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
+
+func TestListByJobID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success with defaults", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.repo.On("ListByJobID", ctx, int64(7), 1, 10).Return([]*JobEmail{{ID: 1, JobID: 7}}, 1, nil)
+
+		emails, total, err := f.uc.ListByJobID(ctx, 7, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, emails, 1)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(nil, ErrInvalidJob)
+
+		_, _, err := f.uc.ListByJobID(ctx, 7, 1, 10)
+
+		assert.Equal(t, ErrInvalidJob, err)
+		f.repo.AssertNotCalled(t, "ListByJobID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -24,6 +24,11 @@ func (uc *UseCase) Delete(ctx context.Context, id int64, jobID int64) error {
 		return errors.New("equipment does not belong to this job")
 	}
 
+	// El job debe ser visible para el usuario autenticado
+	if _, err := uc.jobChecker.GetByID(ctx, jobID); err != nil {
+		return errors.New("job equipment not found")
+	}
+
 	if err := uc.repo.Delete(ctx, id); err != nil {
 		slog.ErrorContext(ctx, "Failed to delete job equipment",
 			slog.String("error", err.Error()),
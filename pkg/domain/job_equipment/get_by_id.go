@@ -2,10 +2,11 @@ package job_equipment
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 )
 
-func (uc *UseCase) GetByID(ctx context.Context, id int64) (*JobEquipment, error) {
+func (uc *UseCase) GetByID(ctx context.Context, id, jobID int64) (*JobEquipment, error) {
 	equipment, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get job equipment by ID",
@@ -14,6 +15,17 @@ func (uc *UseCase) GetByID(ctx context.Context, id int64) (*JobEquipment, error)
 		return nil, err
 	}
 
+	// El equipo debe pertenecer al job y el job debe ser visible para el usuario autenticado
+	if equipment.JobID != jobID {
+		return nil, errors.New("job equipment not found")
+	}
+	if _, err := uc.jobChecker.GetByID(ctx, jobID); err != nil {
+		slog.WarnContext(ctx, "Job not visible for equipment",
+			slog.Int64("equipment_id", id),
+			slog.Int64("job_id", jobID))
+		return nil, errors.New("job equipment not found")
+	}
+
 	slog.InfoContext(ctx, "Job equipment retrieved successfully",
 		slog.Int64("equipment_id", id))
 
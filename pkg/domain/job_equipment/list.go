@@ -8,18 +8,12 @@ import (
 
 func (uc *UseCase) List(ctx context.Context, jobID int64, equipmentType string) ([]*JobEquipment, error) {
 	// Validar que el job existe
-	exists, err := uc.jobChecker.JobExists(jobID)
-	if err != nil {
-		slog.WarnContext(ctx, "Invalid job_id for listing equipment",
+	if _, err := uc.jobChecker.GetByID(ctx, jobID); err != nil {
+		slog.WarnContext(ctx, "Invalid job_id",
 			slog.Int64("job_id", jobID),
 			slog.String("error", err.Error()))
 		return nil, errors.New("invalid job_id")
 	}
-	if !exists {
-		slog.WarnContext(ctx, "Job not found",
-			slog.Int64("job_id", jobID))
-		return nil, errors.New("invalid job_id")
-	}
 
 	// Validar type si se proporciona
 	if equipmentType != "" && !isValidType(equipmentType) {
@@ -47,7 +47,7 @@ type MockJobChecker struct {
 	mock.Mock
 }
 
-func (m *MockJobChecker) JobExists(id int64) (bool, error) {
-	args := m.Called(id)
-	return args.Bool(0), args.Error(1)
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
 }
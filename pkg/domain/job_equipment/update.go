@@ -25,17 +25,10 @@ func (uc *UseCase) Update(ctx context.Context, equipment *JobEquipment) error {
 	}
 
 	// Validar que el job existe
-	exists, err := uc.jobChecker.JobExists(equipment.JobID)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to validate job",
-			slog.String("error", err.Error()),
-			slog.Int64("job_id", equipment.JobID))
-		return errors.New("invalid job_id")
-	}
-
-	if !exists {
-		slog.WarnContext(ctx, "Job not found",
-			slog.Int64("job_id", equipment.JobID))
+	if _, err := uc.jobChecker.GetByID(ctx, equipment.JobID); err != nil {
+		slog.WarnContext(ctx, "Invalid job_id",
+			slog.Int64("job_id", equipment.JobID),
+			slog.String("error", err.Error()))
 		return errors.New("invalid job_id")
 	}
 
@@ -1,8 +1,10 @@
 package job_equipment
 
-// JobChecker verifica la existencia de un job
+import "context"
+
+// JobChecker verifica la existencia de un job visible para el usuario autenticado
 type JobChecker interface {
-	JobExists(id int64) (bool, error)
+	GetByID(ctx context.Context, id int64) (interface{}, error)
 }
 
 // UseCase orquesta las operaciones de negocio para equipos de trabajo
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func strPtr(s string) *string {
@@ -27,7 +28,7 @@ func TestCreate(t *testing.T) {
 			Area:  strPtr("Main Floor"),
 		}
 
-		mockJobChecker.On("JobExists", int64(1)).Return(true, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 		mockRepo.On("Create", ctx, eq).Return(nil)
 
 		err := uc.Create(ctx, eq)
@@ -48,7 +49,7 @@ func TestCreate(t *testing.T) {
 			Area:  strPtr("Main Floor"),
 		}
 
-		mockJobChecker.On("JobExists", int64(999)).Return(false, nil)
+		mockJobChecker.On("GetByID", ctx, int64(999)).Return(nil, errors.New("job not found"))
 
 		err := uc.Create(ctx, eq)
 
@@ -68,7 +69,7 @@ func TestCreate(t *testing.T) {
 			Area:  strPtr("Main Floor"),
 		}
 
-		mockJobChecker.On("JobExists", int64(1)).Return(false, errors.New("database error"))
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("database error"))
 
 		err := uc.Create(ctx, eq)
 
@@ -88,7 +89,7 @@ func TestCreate(t *testing.T) {
 			Area:  strPtr("Main Floor"),
 		}
 
-		mockJobChecker.On("JobExists", int64(1)).Return(true, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 		mockRepo.On("Create", ctx, eq).Return(errors.New("database error"))
 
 		err := uc.Create(ctx, eq)
@@ -118,14 +119,43 @@ func TestGetByID(t *testing.T) {
 		}
 
 		mockRepo.On("GetByID", ctx, int64(1)).Return(expected, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 
-		eq, err := uc.GetByID(ctx, 1)
+		eq, err := uc.GetByID(ctx, 1, 1)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expected, eq)
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("job outside user scope", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockJobChecker := new(MockJobChecker)
+		uc := NewUseCase(mockRepo, mockJobChecker)
+
+		mockRepo.On("GetByID", ctx, int64(1)).Return(&JobEquipment{ID: 1, JobID: 1, Type: "current"}, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("job not found"))
+
+		eq, err := uc.GetByID(ctx, 1, 1)
+
+		assert.EqualError(t, err, "job equipment not found")
+		assert.Nil(t, eq)
+	})
+
+	t.Run("belongs to another job", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockJobChecker := new(MockJobChecker)
+		uc := NewUseCase(mockRepo, mockJobChecker)
+
+		mockRepo.On("GetByID", ctx, int64(1)).Return(&JobEquipment{ID: 1, JobID: 2, Type: "current"}, nil)
+
+		eq, err := uc.GetByID(ctx, 1, 1)
+
+		assert.EqualError(t, err, "job equipment not found")
+		assert.Nil(t, eq)
+		mockJobChecker.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
 	t.Run("not found", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockJobChecker := new(MockJobChecker)
@@ -133,7 +163,7 @@ func TestGetByID(t *testing.T) {
 
 		mockRepo.On("GetByID", ctx, int64(999)).Return(nil, errors.New("job equipment not found"))
 
-		eq, err := uc.GetByID(ctx, 999)
+		eq, err := uc.GetByID(ctx, 999, 1)
 
 		assert.Error(t, err)
 		assert.Nil(t, eq)
@@ -160,7 +190,7 @@ func TestList(t *testing.T) {
 			},
 		}
 
-		mockJobChecker.On("JobExists", int64(1)).Return(true, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 		mockRepo.On("List", ctx, int64(1), "").Return(expected, nil)
 
 		equipment, err := uc.List(ctx, 1, "")
@@ -186,7 +216,7 @@ func TestList(t *testing.T) {
 			},
 		}
 
-		mockJobChecker.On("JobExists", int64(1)).Return(true, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 		mockRepo.On("List", ctx, int64(1), "current").Return(expected, nil)
 
 		equipment, err := uc.List(ctx, 1, "current")
@@ -202,7 +232,7 @@ func TestList(t *testing.T) {
 		mockJobChecker := new(MockJobChecker)
 		uc := NewUseCase(mockRepo, mockJobChecker)
 
-		mockJobChecker.On("JobExists", int64(999)).Return(false, nil)
+		mockJobChecker.On("GetByID", ctx, int64(999)).Return(nil, errors.New("job not found"))
 
 		equipment, err := uc.List(ctx, 999, "")
 
@@ -217,7 +247,7 @@ func TestList(t *testing.T) {
 		mockJobChecker := new(MockJobChecker)
 		uc := NewUseCase(mockRepo, mockJobChecker)
 
-		mockJobChecker.On("JobExists", int64(1)).Return(true, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 
 		equipment, err := uc.List(ctx, 1, "invalid")
 
@@ -254,7 +284,7 @@ func TestUpdate(t *testing.T) {
 		}
 
 		mockRepo.On("GetByID", ctx, int64(1)).Return(existing, nil)
-		mockJobChecker.On("JobExists", int64(1)).Return(true, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 		mockRepo.On("Update", ctx, updated).Return(nil)
 
 		err := uc.Update(ctx, updated)
@@ -334,7 +364,7 @@ func TestUpdate(t *testing.T) {
 		}
 
 		mockRepo.On("GetByID", ctx, int64(1)).Return(existing, nil)
-		mockJobChecker.On("JobExists", int64(1)).Return(false, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("job not found"))
 
 		err := uc.Update(ctx, updated)
 
@@ -363,6 +393,7 @@ func TestDelete(t *testing.T) {
 		}
 
 		mockRepo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		mockJobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
 		mockRepo.On("Delete", ctx, int64(1)).Return(nil)
 
 		err := uc.Delete(ctx, 1, 1)
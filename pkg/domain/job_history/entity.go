@@ -0,0 +1,22 @@
+package job_history
+
+import "time"
+
+// Change representa el cambio de un campo del job entre dos versiones
+type Change struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue"`
+	NewValue *string `json:"newValue"`
+}
+
+// JobHistory representa una entrada de auditoría de un campo modificado en un job
+type JobHistory struct {
+	ID        int64      `json:"id"`
+	JobID     int64      `json:"jobId"`
+	UserID    *int64     `json:"userId,omitempty"`
+	UserName  *string    `json:"userName,omitempty"`
+	Field     string     `json:"field"`
+	OldValue  *string    `json:"oldValue"`
+	NewValue  *string    `json:"newValue"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
@@ -0,0 +1,8 @@
+package job_history
+
+import "errors"
+
+var (
+	// ErrInvalidJob indica que el job no es válido
+	ErrInvalidJob = errors.New("invalid job")
+)
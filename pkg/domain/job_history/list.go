@@ -0,0 +1,33 @@
+package job_history
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene una lista paginada del historial de cambios de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobHistory, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	// Verificar que el job existe
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job for listing history",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, 0, ErrInvalidJob
+	}
+
+	entries, total, err := uc.repo.ListByJobID(ctx, jobID, filters, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job history",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,35 @@
+package job_history
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de historial de jobs
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateBatch(ctx context.Context, entries []*JobHistory) error {
+	args := m.Called(ctx, entries)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobHistory, int, error) {
+	args := m.Called(ctx, jobID, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*JobHistory), args.Int(1), args.Error(2)
+}
+
+// MockJobChecker es un mock del checker de jobs
+type MockJobChecker struct {
+	mock.Mock
+}
+
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
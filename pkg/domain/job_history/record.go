@@ -0,0 +1,45 @@
+package job_history
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RecordChanges guarda una entrada de historial por cada campo modificado,
+// atribuida al usuario autenticado (o sin usuario si el cambio lo hizo el sistema)
+func (uc *UseCase) RecordChanges(ctx context.Context, jobID int64, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var userID *int64
+	if uc.userResolver != nil {
+		if id, ok := uc.userResolver(ctx); ok && id > 0 {
+			userID = &id
+		}
+	}
+
+	now := time.Now()
+	entries := make([]*JobHistory, len(changes))
+	for i, c := range changes {
+		entries[i] = &JobHistory{
+			JobID:     jobID,
+			UserID:    userID,
+			Field:     c.Field,
+			OldValue:  c.OldValue,
+			NewValue:  c.NewValue,
+			CreatedAt: &now,
+		}
+	}
+
+	if err := uc.repo.CreateBatch(ctx, entries); err != nil {
+		slog.ErrorContext(ctx, "Failed to record job history",
+			slog.Int64("jobId", jobID),
+			slog.Int("changes", len(changes)),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
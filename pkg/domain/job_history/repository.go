@@ -0,0 +1,12 @@
+package job_history
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento del historial de jobs
+type Repository interface {
+	// CreateBatch inserta varias entradas de historial en una sola operación
+	CreateBatch(ctx context.Context, entries []*JobHistory) error
+
+	// ListByJobID obtiene el historial de un job con paginación
+	ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobHistory, int, error)
+}
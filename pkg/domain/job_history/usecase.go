@@ -0,0 +1,33 @@
+package job_history
+
+import "context"
+
+// Service define la interfaz del servicio de historial de jobs
+type Service interface {
+	RecordChanges(ctx context.Context, jobID int64, changes []Change) error
+	ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobHistory, int, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// UseCase implementa la lógica de negocio del historial de jobs
+type UseCase struct {
+	repo         Repository
+	jobCheck     JobChecker
+	userResolver UserIDResolver
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de historial de jobs
+func NewUseCase(repo Repository, jobCheck JobChecker, userResolver UserIDResolver) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		jobCheck:     jobCheck,
+		userResolver: userResolver,
+	}
+}
@@ -0,0 +1,107 @@
+package job_history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestUseCase(userID int64) (*UseCase, *MockRepository, *MockJobChecker) {
+	repo := new(MockRepository)
+	jobCheck := new(MockJobChecker)
+	resolver := func(ctx context.Context) (int64, bool) {
+		return userID, userID > 0
+	}
+	return NewUseCase(repo, jobCheck, resolver), repo, jobCheck
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestRecordChanges(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records one entry per change with acting user", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(9)
+
+		changes := []Change{
+			{Field: "user_id", OldValue: strPtr("3"), NewValue: strPtr("4")},
+			{Field: "job_sales_price", OldValue: nil, NewValue: strPtr("150.00")},
+		}
+
+		repo.On("CreateBatch", ctx, mock.MatchedBy(func(entries []*JobHistory) bool {
+			return len(entries) == 2 &&
+				entries[0].JobID == 1 && *entries[0].UserID == 9 && entries[0].Field == "user_id" &&
+				entries[1].OldValue == nil && *entries[1].NewValue == "150.00" &&
+				entries[0].CreatedAt != nil
+		})).Return(nil)
+
+		err := uc.RecordChanges(ctx, 1, changes)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("system change without user", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(0)
+
+		repo.On("CreateBatch", ctx, mock.MatchedBy(func(entries []*JobHistory) bool {
+			return len(entries) == 1 && entries[0].UserID == nil
+		})).Return(nil)
+
+		err := uc.RecordChanges(ctx, 1, []Change{{Field: "job_status_id", NewValue: strPtr("2")}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(9)
+
+		err := uc.RecordChanges(ctx, 1, nil)
+
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase(9)
+
+		repo.On("CreateBatch", ctx, mock.Anything).Return(errors.New("db error"))
+
+		err := uc.RecordChanges(ctx, 1, []Change{{Field: "quick_notes"}})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestListByJobID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobCheck := newTestUseCase(1)
+		expected := []*JobHistory{{ID: 1, JobID: 2, Field: "user_id"}}
+
+		jobCheck.On("GetByID", ctx, int64(2)).Return(true, nil)
+		repo.On("ListByJobID", ctx, int64(2), mock.Anything, 1, 10).Return(expected, 1, nil)
+
+		result, total, err := uc.ListByJobID(ctx, 2, nil, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, _, jobCheck := newTestUseCase(1)
+
+		jobCheck.On("GetByID", ctx, int64(2)).Return(nil, errors.New("not found"))
+
+		_, _, err := uc.ListByJobID(ctx, 2, nil, 1, 10)
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
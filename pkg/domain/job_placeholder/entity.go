@@ -0,0 +1,108 @@
+package job_placeholder
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	domainCustomer "github.com/your-org/jvairv2/pkg/domain/customer"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainProperty "github.com/your-org/jvairv2/pkg/domain/property"
+	domainUser "github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+// dateFormat es el formato de las fechas que se insertan en las plantillas
+const dateFormat = "01/02/2006"
+
+// Keys contiene los marcadores disponibles para las plantillas de jobs
+var Keys = []string{
+	"job.id",
+	"job.work_order",
+	"job.date_received",
+	"job.dispatch_date",
+	"job.due_date",
+	"job.completion_date",
+	"job.dispatch_notes",
+	"job.quick_notes",
+	"property.code",
+	"property.name",
+	"property.address",
+	"property.street",
+	"property.city",
+	"property.state",
+	"property.zip",
+	"customer.name",
+	"customer.email",
+	"customer.phone",
+	"customer.contact_name",
+	"technician.name",
+	"technician.email",
+	"scheduled_time",
+}
+
+// Data agrupa las entidades con las que se rellenan las plantillas de un job.
+// Property, Customer y Technician pueden ser nil; sus marcadores quedan vacíos.
+type Data struct {
+	Job        *domainJob.Job
+	Property   *domainProperty.Property
+	Customer   *domainCustomer.Customer
+	Technician *domainUser.User
+}
+
+// Values retorna el valor de cada marcador de Keys
+func (d *Data) Values() map[string]string {
+	values := make(map[string]string, len(Keys))
+	for _, k := range Keys {
+		values[k] = ""
+	}
+
+	if j := d.Job; j != nil {
+		values["job.id"] = fmt.Sprintf("%d", j.ID)
+		values["job.work_order"] = str(j.WorkOrder)
+		values["job.date_received"] = j.DateReceived.Format(dateFormat)
+		values["job.dispatch_date"] = date(j.DispatchDate)
+		values["job.due_date"] = date(j.DueDate)
+		values["job.completion_date"] = date(j.CompletionDate)
+		values["job.dispatch_notes"] = str(j.DispatchNotes)
+		values["job.quick_notes"] = str(j.QuickNotes)
+		values["scheduled_time"] = strings.TrimSpace(str(j.ScheduledTimeType) + " " + str(j.ScheduledTime))
+	}
+
+	if p := d.Property; p != nil {
+		values["property.code"] = str(p.PropertyCode)
+		values["property.name"] = p.GetName()
+		values["property.address"] = p.GetAddress()
+		values["property.street"] = p.Street
+		values["property.city"] = p.City
+		values["property.state"] = p.State
+		values["property.zip"] = p.Zip
+	}
+
+	if c := d.Customer; c != nil {
+		values["customer.name"] = c.Name
+		values["customer.email"] = str(c.Email)
+		values["customer.phone"] = str(c.Phone)
+		values["customer.contact_name"] = str(c.ContactName)
+	}
+
+	if t := d.Technician; t != nil {
+		values["technician.name"] = t.Name
+		values["technician.email"] = t.Email
+	}
+
+	return values
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func date(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateFormat)
+}
@@ -0,0 +1,8 @@
+package job_placeholder
+
+import "errors"
+
+var (
+	// ErrJobNotFound indica que el job con el que se renderiza la plantilla no existe
+	ErrJobNotFound = errors.New("job not found")
+)
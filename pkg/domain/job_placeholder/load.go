@@ -0,0 +1,39 @@
+package job_placeholder
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+)
+
+// Load obtiene el job con su propiedad, cliente y técnico asignado.
+// Si alguna de las entidades relacionadas no existe, se deja en nil.
+func (uc *UseCase) Load(ctx context.Context, jobID int64) (*Data, error) {
+	job, err := uc.jobRepo.GetByID(ctx, jobID)
+	if err != nil || job == nil || job.DeletedAt != nil {
+		return nil, ErrJobNotFound
+	}
+
+	data := &Data{Job: job}
+
+	if property, err := uc.propertyRepo.GetByID(ctx, job.PropertyID); err == nil {
+		data.Property = property
+		if customer, err := uc.customerRepo.GetByID(ctx, property.CustomerID); err == nil {
+			data.Customer = customer
+		} else {
+			slog.WarnContext(ctx, "Customer not found for template data",
+				slog.Int64("customerId", property.CustomerID))
+		}
+	} else {
+		slog.WarnContext(ctx, "Property not found for template data",
+			slog.Int64("propertyId", job.PropertyID))
+	}
+
+	if job.UserID != nil {
+		if tech, err := uc.userRepo.GetByID(ctx, strconv.FormatInt(*job.UserID, 10)); err == nil {
+			data.Technician = tech
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,76 @@
+package job_placeholder
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	domainCustomer "github.com/your-org/jvairv2/pkg/domain/customer"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainProperty "github.com/your-org/jvairv2/pkg/domain/property"
+	domainUser "github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+// MockService es un mock del servicio de datos de plantillas
+type MockService struct {
+	mock.Mock
+}
+
+func (m *MockService) Load(ctx context.Context, jobID int64) (*Data, error) {
+	args := m.Called(ctx, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Data), args.Error(1)
+}
+
+// MockJobGetter es un mock para obtener jobs
+type MockJobGetter struct {
+	mock.Mock
+}
+
+func (m *MockJobGetter) GetByID(ctx context.Context, id int64) (*domainJob.Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainJob.Job), args.Error(1)
+}
+
+// MockPropertyGetter es un mock para obtener propiedades
+type MockPropertyGetter struct {
+	mock.Mock
+}
+
+func (m *MockPropertyGetter) GetByID(ctx context.Context, id int64) (*domainProperty.Property, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainProperty.Property), args.Error(1)
+}
+
+// MockCustomerGetter es un mock para obtener clientes
+type MockCustomerGetter struct {
+	mock.Mock
+}
+
+func (m *MockCustomerGetter) GetByID(ctx context.Context, id int64) (*domainCustomer.Customer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainCustomer.Customer), args.Error(1)
+}
+
+// MockUserGetter es un mock para obtener usuarios
+type MockUserGetter struct {
+	mock.Mock
+}
+
+func (m *MockUserGetter) GetByID(ctx context.Context, id string) (*domainUser.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainUser.User), args.Error(1)
+}
@@ -0,0 +1,53 @@
+package job_placeholder
+
+import (
+	"context"
+
+	domainCustomer "github.com/your-org/jvairv2/pkg/domain/customer"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainProperty "github.com/your-org/jvairv2/pkg/domain/property"
+	domainUser "github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+// Service define la interfaz del servicio que reúne los datos de un job para las plantillas
+type Service interface {
+	Load(ctx context.Context, jobID int64) (*Data, error)
+}
+
+// JobGetter obtiene jobs
+type JobGetter interface {
+	GetByID(ctx context.Context, id int64) (*domainJob.Job, error)
+}
+
+// PropertyGetter obtiene propiedades
+type PropertyGetter interface {
+	GetByID(ctx context.Context, id int64) (*domainProperty.Property, error)
+}
+
+// CustomerGetter obtiene clientes
+type CustomerGetter interface {
+	GetByID(ctx context.Context, id int64) (*domainCustomer.Customer, error)
+}
+
+// UserGetter obtiene usuarios
+type UserGetter interface {
+	GetByID(ctx context.Context, id string) (*domainUser.User, error)
+}
+
+// UseCase implementa la carga de datos de un job para las plantillas
+type UseCase struct {
+	jobRepo      JobGetter
+	propertyRepo PropertyGetter
+	customerRepo CustomerGetter
+	userRepo     UserGetter
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de datos de plantillas
+func NewUseCase(jobRepo JobGetter, propertyRepo PropertyGetter, customerRepo CustomerGetter, userRepo UserGetter) *UseCase {
+	return &UseCase{
+		jobRepo:      jobRepo,
+		propertyRepo: propertyRepo,
+		customerRepo: customerRepo,
+		userRepo:     userRepo,
+	}
+}
@@ -0,0 +1,81 @@
+package job_placeholder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/jvairv2/pkg/common/placeholder"
+	domainCustomer "github.com/your-org/jvairv2/pkg/domain/customer"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainProperty "github.com/your-org/jvairv2/pkg/domain/property"
+	domainUser "github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+func strPtr(s string) *string { return &s }
+func int64Ptr(i int64) *int64 { return &i }
+
+func testJob() *domainJob.Job {
+	return &domainJob.Job{
+		ID:                100,
+		WorkOrder:         strPtr("WO-2024-001"),
+		DateReceived:      time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		PropertyID:        7,
+		UserID:            int64Ptr(5),
+		ScheduledTimeType: strPtr("Between"),
+		ScheduledTime:     strPtr("8am - 10am"),
+	}
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("loads related entities", func(t *testing.T) {
+		jobs, props, customers, users := new(MockJobGetter), new(MockPropertyGetter), new(MockCustomerGetter), new(MockUserGetter)
+		uc := NewUseCase(jobs, props, customers, users)
+
+		jobs.On("GetByID", ctx, int64(100)).Return(testJob(), nil)
+		props.On("GetByID", ctx, int64(7)).Return(&domainProperty.Property{ID: 7, CustomerID: 3, Street: "123 Main St", City: "Springfield", State: "IL", Zip: "62701"}, nil)
+		customers.On("GetByID", ctx, int64(3)).Return(&domainCustomer.Customer{ID: 3, Name: "Acme Housing"}, nil)
+		users.On("GetByID", ctx, "5").Return(&domainUser.User{ID: 5, Name: "John Tech"}, nil)
+
+		data, err := uc.Load(ctx, 100)
+		assert.NoError(t, err)
+
+		out, missing := placeholder.Render(
+			"WO {{job.work_order}} at {{ property.address }} for {{customer.name}}, tech {{technician.name}} ({{scheduled_time}}) {{job.bogus}}",
+			data.Values())
+
+		assert.Equal(t, "WO WO-2024-001 at 123 Main St, Springfield, IL 62701 for Acme Housing, tech John Tech (Between 8am - 10am) {{job.bogus}}", out)
+		assert.Equal(t, []string{"job.bogus"}, missing)
+	})
+
+	t.Run("missing relations leave blanks", func(t *testing.T) {
+		jobs, props, customers, users := new(MockJobGetter), new(MockPropertyGetter), new(MockCustomerGetter), new(MockUserGetter)
+		uc := NewUseCase(jobs, props, customers, users)
+		job := testJob()
+		job.UserID = nil
+
+		jobs.On("GetByID", ctx, int64(100)).Return(job, nil)
+		props.On("GetByID", ctx, int64(7)).Return(nil, errors.New("not found"))
+
+		data, err := uc.Load(ctx, 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "", data.Values()["customer.name"])
+		assert.Equal(t, "01/15/2024", data.Values()["job.date_received"])
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		jobs := new(MockJobGetter)
+		uc := NewUseCase(jobs, nil, nil, nil)
+
+		jobs.On("GetByID", ctx, int64(100)).Return(nil, errors.New("not found"))
+
+		_, err := uc.Load(ctx, 100)
+
+		assert.Equal(t, ErrJobNotFound, err)
+	})
+}
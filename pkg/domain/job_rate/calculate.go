@@ -0,0 +1,15 @@
+package job_rate
+
+import (
+	"context"
+)
+
+// Calculate calcula el pago de una tarifa sin guardarla
+func (uc *UseCase) Calculate(ctx context.Context, in PaymentInput) (*PaymentBreakdown, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	breakdown := CalculatePayment(in)
+	return &breakdown, nil
+}
@@ -0,0 +1,41 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create registra una tarifa para un job; el pago se calcula a partir de los montos
+func (uc *UseCase) Create(ctx context.Context, rate *JobRate) error {
+	if err := rate.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := uc.jobRepo.GetByID(ctx, rate.JobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job",
+			slog.Int64("jobId", rate.JobID),
+			slog.String("error", err.Error()))
+		return ErrInvalidJob
+	}
+
+	if err := uc.validateReferences(ctx, rate); err != nil {
+		return err
+	}
+
+	rate.Payment = CalculatePayment(rate.PaymentInput()).Payment
+	rate.Paid = false
+	rate.Held = false
+
+	if err := uc.repo.Create(ctx, rate); err != nil {
+		slog.ErrorContext(ctx, "Failed to create job rate",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job rate created successfully",
+		slog.Int64("id", rate.ID),
+		slog.Int64("jobId", rate.JobID),
+		slog.String("payment", rate.Payment.String()))
+
+	return nil
+}
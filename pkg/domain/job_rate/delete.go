@@ -0,0 +1,30 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una tarifa de un job (soft delete). Las tarifas pagadas no se eliminan.
+func (uc *UseCase) Delete(ctx context.Context, jobID, id int64) error {
+	existing, err := uc.getOwned(ctx, jobID, id)
+	if err != nil {
+		return err
+	}
+
+	if existing.Paid {
+		return ErrRateAlreadyPaid
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job rate",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job rate deleted successfully",
+		slog.Int64("id", id))
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package job_rate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/money"
+)
+
+// maxRatePercent es el porcentaje de comisión máximo permitido (100.00%)
+var maxRatePercent = money.MustParse("100")
+
+// JobRate representa lo que gana un técnico por un job
+type JobRate struct {
+	ID                 int64        `json:"id"`
+	JobID              int64        `json:"jobId"`
+	UserID             int64        `json:"userId"`
+	UserName           *string      `json:"userName,omitempty"`
+	JobRateStatusID    int64        `json:"jobRateStatusId"`
+	JobRateStatusLabel *string      `json:"jobRateStatusLabel,omitempty"`
+	JobRateStatusClass *string      `json:"jobRateStatusClass,omitempty"`
+	SalePrice          money.Amount `json:"salePrice"`
+	RatePercent        money.Amount `json:"ratePercent"`
+	RateFlat           money.Amount `json:"rateFlat"`
+	TechParts          money.Amount `json:"techParts"`
+	CompanyParts       money.Amount `json:"companyParts"`
+	PartsReplaced      *string      `json:"partsReplaced,omitempty"`
+	Deduction          money.Amount `json:"deduction"`
+	Payment            money.Amount `json:"payment"`
+	Paid               bool         `json:"paid"`
+	Held               bool         `json:"held"`
+	PaidAt             *time.Time   `json:"paidAt,omitempty"`
+	Notes              *string      `json:"notes,omitempty"`
+	CreatedAt          *time.Time   `json:"createdAt,omitempty"`
+	UpdatedAt          *time.Time   `json:"updatedAt,omitempty"`
+	DeletedAt          *time.Time   `json:"deletedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos y los montos de la tarifa
+func (r *JobRate) Validate() error {
+	if r.JobID == 0 {
+		return fmt.Errorf("job_id is required")
+	}
+
+	if r.UserID == 0 {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if r.JobRateStatusID == 0 {
+		return fmt.Errorf("job_rate_status_id is required")
+	}
+
+	return r.PaymentInput().Validate()
+}
+
+// IsDeleted verifica si la tarifa está eliminada
+func (r *JobRate) IsDeleted() bool {
+	return r.DeletedAt != nil
+}
+
+// PaymentInput retorna los montos de la tarifa que intervienen en el cálculo del pago
+func (r *JobRate) PaymentInput() PaymentInput {
+	return PaymentInput{
+		SalePrice:    r.SalePrice,
+		RatePercent:  r.RatePercent,
+		RateFlat:     r.RateFlat,
+		TechParts:    r.TechParts,
+		CompanyParts: r.CompanyParts,
+		Deduction:    r.Deduction,
+	}
+}
+
+// PaymentInput contiene los montos con los que se calcula el pago de un técnico
+type PaymentInput struct {
+	SalePrice    money.Amount `json:"salePrice"`
+	RatePercent  money.Amount `json:"ratePercent"`
+	RateFlat     money.Amount `json:"rateFlat"`
+	TechParts    money.Amount `json:"techParts"`
+	CompanyParts money.Amount `json:"companyParts"`
+	Deduction    money.Amount `json:"deduction"`
+}
+
+// Validate verifica que los montos no sean negativos y que el porcentaje no supere 100
+func (in PaymentInput) Validate() error {
+	fields := []struct {
+		name  string
+		value money.Amount
+	}{
+		{"sale_price", in.SalePrice},
+		{"rate_percent", in.RatePercent},
+		{"rate_flat", in.RateFlat},
+		{"tech_parts", in.TechParts},
+		{"company_parts", in.CompanyParts},
+		{"deduction", in.Deduction},
+	}
+	for _, f := range fields {
+		if f.value.IsNegative() {
+			return fmt.Errorf("%s cannot be negative", f.name)
+		}
+	}
+
+	if in.RatePercent > maxRatePercent {
+		return fmt.Errorf("rate_percent cannot be greater than 100")
+	}
+
+	return nil
+}
+
+// PaymentBreakdown detalla cómo se obtuvo el pago de una tarifa
+type PaymentBreakdown struct {
+	NetSale    money.Amount `json:"netSale"`
+	Commission money.Amount `json:"commission"`
+	RateFlat   money.Amount `json:"rateFlat"`
+	Deduction  money.Amount `json:"deduction"`
+	Payment    money.Amount `json:"payment"`
+}
+
+// CalculatePayment calcula el pago del técnico (fiel al original):
+// ((sale_price - tech_parts - company_parts) * rate_percent / 100) + rate_flat - deduction.
+// La comisión se redondea a centavos; el pago puede ser negativo si la deducción supera lo ganado.
+func CalculatePayment(in PaymentInput) PaymentBreakdown {
+	netSale := in.SalePrice - in.TechParts - in.CompanyParts
+	commission := netSale.MulPercent(in.RatePercent)
+
+	return PaymentBreakdown{
+		NetSale:    netSale,
+		Commission: commission,
+		RateFlat:   in.RateFlat,
+		Deduction:  in.Deduction,
+		Payment:    commission + in.RateFlat - in.Deduction,
+	}
+}
@@ -0,0 +1,20 @@
+package job_rate
+
+import "errors"
+
+var (
+	// ErrRateNotFound indica que la tarifa no fue encontrada
+	ErrRateNotFound = errors.New("job rate not found")
+
+	// ErrRateAlreadyPaid indica que la tarifa ya fue pagada y no puede modificarse
+	ErrRateAlreadyPaid = errors.New("job rate is already paid")
+
+	// ErrInvalidJob indica que el job no es válido
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrInvalidUser indica que el usuario no es válido
+	ErrInvalidUser = errors.New("invalid user")
+
+	// ErrInvalidJobRateStatus indica que el estado de tarifa no es válido
+	ErrInvalidJobRateStatus = errors.New("invalid job rate status")
+)
@@ -0,0 +1,10 @@
+package job_rate
+
+import (
+	"context"
+)
+
+// GetByID obtiene una tarifa de un job por su ID
+func (uc *UseCase) GetByID(ctx context.Context, jobID, id int64) (*JobRate, error) {
+	return uc.getOwned(ctx, jobID, id)
+}
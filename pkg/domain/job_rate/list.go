@@ -0,0 +1,25 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene las tarifas de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64) ([]*JobRate, error) {
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job for listing rates",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, ErrInvalidJob
+	}
+
+	rates, err := uc.repo.ListByJobID(ctx, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job rates",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return rates, nil
+}
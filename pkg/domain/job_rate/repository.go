@@ -0,0 +1,21 @@
+package job_rate
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento de tarifas de jobs
+type Repository interface {
+	// Create crea una nueva tarifa
+	Create(ctx context.Context, rate *JobRate) error
+
+	// GetByID obtiene una tarifa por su ID
+	GetByID(ctx context.Context, id int64) (*JobRate, error)
+
+	// ListByJobID obtiene las tarifas de un job
+	ListByJobID(ctx context.Context, jobID int64) ([]*JobRate, error)
+
+	// Update actualiza una tarifa existente (no modifica paid ni held)
+	Update(ctx context.Context, rate *JobRate) error
+
+	// Delete elimina una tarifa (soft delete)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,43 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza una tarifa y recalcula su pago. Las tarifas pagadas no se modifican.
+func (uc *UseCase) Update(ctx context.Context, rate *JobRate) error {
+	existing, err := uc.getOwned(ctx, rate.JobID, rate.ID)
+	if err != nil {
+		return err
+	}
+
+	if existing.Paid {
+		return ErrRateAlreadyPaid
+	}
+
+	if err := rate.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.validateReferences(ctx, rate); err != nil {
+		return err
+	}
+
+	rate.Payment = CalculatePayment(rate.PaymentInput()).Payment
+	rate.Paid = existing.Paid
+	rate.Held = existing.Held
+
+	if err := uc.repo.Update(ctx, rate); err != nil {
+		slog.ErrorContext(ctx, "Failed to update job rate",
+			slog.Int64("id", rate.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job rate updated successfully",
+		slog.Int64("id", rate.ID),
+		slog.String("payment", rate.Payment.String()))
+
+	return nil
+}
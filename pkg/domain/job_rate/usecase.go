@@ -0,0 +1,78 @@
+package job_rate
+
+import "context"
+
+// Service define la interfaz del servicio de tarifas de jobs
+type Service interface {
+	Create(ctx context.Context, rate *JobRate) error
+	GetByID(ctx context.Context, jobID, id int64) (*JobRate, error)
+	ListByJobID(ctx context.Context, jobID int64) ([]*JobRate, error)
+	Update(ctx context.Context, rate *JobRate) error
+	Delete(ctx context.Context, jobID, id int64) error
+	Calculate(ctx context.Context, in PaymentInput) (*PaymentBreakdown, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UserChecker verifica existencia de usuarios
+type UserChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// JobRateStatusChecker verifica existencia de estados de tarifa
+type JobRateStatusChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UseCase implementa la lógica de negocio de tarifas de jobs
+type UseCase struct {
+	repo       Repository
+	jobRepo    JobChecker
+	userRepo   UserChecker
+	statusRepo JobRateStatusChecker
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de tarifas de jobs
+func NewUseCase(repo Repository, jobRepo JobChecker, userRepo UserChecker, statusRepo JobRateStatusChecker) *UseCase {
+	return &UseCase{
+		repo:       repo,
+		jobRepo:    jobRepo,
+		userRepo:   userRepo,
+		statusRepo: statusRepo,
+	}
+}
+
+// getOwned obtiene una tarifa verificando que pertenezca al job indicado
+func (uc *UseCase) getOwned(ctx context.Context, jobID, id int64) (*JobRate, error) {
+	rate, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrRateNotFound
+	}
+
+	if rate.IsDeleted() || rate.JobID != jobID {
+		return nil, ErrRateNotFound
+	}
+
+	// El job debe existir y ser visible para el usuario autenticado
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		return nil, ErrRateNotFound
+	}
+
+	return rate, nil
+}
+
+// validateReferences verifica el usuario y el estado de tarifa
+func (uc *UseCase) validateReferences(ctx context.Context, rate *JobRate) error {
+	if _, err := uc.userRepo.GetByID(ctx, rate.UserID); err != nil {
+		return ErrInvalidUser
+	}
+
+	if _, err := uc.statusRepo.GetByID(ctx, rate.JobRateStatusID); err != nil {
+		return ErrInvalidJobRateStatus
+	}
+
+	return nil
+}
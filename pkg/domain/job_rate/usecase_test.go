@@ -0,0 +1,193 @@
+package job_rate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/money"
+)
+
+type testDeps struct {
+	repo   *MockRepository
+	job    *MockChecker
+	user   *MockChecker
+	status *MockChecker
+}
+
+func newTestUseCase() (*UseCase, *testDeps) {
+	d := &testDeps{
+		repo:   new(MockRepository),
+		job:    new(MockChecker),
+		user:   new(MockChecker),
+		status: new(MockChecker),
+	}
+	return NewUseCase(d.repo, d.job, d.user, d.status), d
+}
+
+func validRate() *JobRate {
+	return &JobRate{
+		JobID:           10,
+		UserID:          5,
+		JobRateStatusID: 1,
+		SalePrice:       money.MustParse("1250.00"),
+		RatePercent:     money.MustParse("12.5"),
+		RateFlat:        money.MustParse("25"),
+		TechParts:       money.MustParse("100.10"),
+		CompanyParts:    money.MustParse("49.90"),
+		Deduction:       money.MustParse("10"),
+	}
+}
+
+func TestCalculatePayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         PaymentInput
+		commission string
+		payment    string
+	}{
+		{
+			name:       "percent of net sale plus flat minus deduction",
+			in:         validRate().PaymentInput(),
+			commission: "137.50",
+			payment:    "152.50",
+		},
+		{
+			name: "rounds commission half away from zero",
+			in: PaymentInput{
+				SalePrice:   money.MustParse("0.10"),
+				RatePercent: money.MustParse("5"),
+			},
+			commission: "0.01",
+			payment:    "0.01",
+		},
+		{
+			name: "no float drift",
+			in: PaymentInput{
+				SalePrice:   money.MustParse("0.30"),
+				RatePercent: money.MustParse("100"),
+				RateFlat:    money.MustParse("0.10"),
+				Deduction:   money.MustParse("0.20"),
+			},
+			commission: "0.30",
+			payment:    "0.20",
+		},
+		{
+			name: "deduction can exceed earnings",
+			in: PaymentInput{
+				RateFlat:  money.MustParse("5"),
+				Deduction: money.MustParse("20"),
+			},
+			commission: "0.00",
+			payment:    "-15.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := CalculatePayment(tt.in)
+			assert.Equal(t, tt.commission, b.Commission.String())
+			assert.Equal(t, tt.payment, b.Payment.String())
+		})
+	}
+}
+
+func TestPaymentInputValidate(t *testing.T) {
+	assert.NoError(t, validRate().PaymentInput().Validate())
+
+	err := PaymentInput{Deduction: money.MustParse("-1")}.Validate()
+	assert.EqualError(t, err, "deduction cannot be negative")
+
+	err = PaymentInput{RatePercent: money.MustParse("100.01")}.Validate()
+	assert.EqualError(t, err, "rate_percent cannot be greater than 100")
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("computes payment", func(t *testing.T) {
+		uc, d := newTestUseCase()
+		rate := validRate()
+		rate.Payment = money.MustParse("999")
+		rate.Paid = true
+
+		d.job.On("GetByID", ctx, int64(10)).Return(true, nil)
+		d.user.On("GetByID", ctx, int64(5)).Return(true, nil)
+		d.status.On("GetByID", ctx, int64(1)).Return(true, nil)
+		d.repo.On("Create", ctx, rate).Return(nil)
+
+		assert.NoError(t, uc.Create(ctx, rate))
+		assert.Equal(t, "152.50", rate.Payment.String())
+		assert.False(t, rate.Paid)
+		d.repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		uc, d := newTestUseCase()
+
+		d.job.On("GetByID", ctx, int64(10)).Return(true, nil)
+		d.user.On("GetByID", ctx, int64(5)).Return(true, nil)
+		d.status.On("GetByID", ctx, int64(1)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, validRate())
+
+		assert.Equal(t, ErrInvalidJobRateStatus, err)
+		d.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("recalculates payment and keeps hold", func(t *testing.T) {
+		uc, d := newTestUseCase()
+		rate := validRate()
+		rate.ID = 3
+
+		d.repo.On("GetByID", ctx, int64(3)).Return(&JobRate{ID: 3, JobID: 10, Held: true}, nil)
+		d.job.On("GetByID", ctx, int64(10)).Return(true, nil)
+		d.user.On("GetByID", ctx, int64(5)).Return(true, nil)
+		d.status.On("GetByID", ctx, int64(1)).Return(true, nil)
+		d.repo.On("Update", ctx, rate).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, rate))
+		assert.Equal(t, "152.50", rate.Payment.String())
+		assert.True(t, rate.Held)
+	})
+
+	t.Run("paid rate is locked", func(t *testing.T) {
+		uc, d := newTestUseCase()
+		rate := validRate()
+		rate.ID = 3
+
+		d.repo.On("GetByID", ctx, int64(3)).Return(&JobRate{ID: 3, JobID: 10, Paid: true}, nil)
+		d.job.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		assert.Equal(t, ErrRateAlreadyPaid, uc.Update(ctx, rate))
+		d.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("belongs to another job", func(t *testing.T) {
+		uc, d := newTestUseCase()
+
+		d.repo.On("GetByID", ctx, int64(3)).Return(&JobRate{ID: 3, JobID: 11}, nil)
+
+		assert.Equal(t, ErrRateNotFound, uc.Delete(ctx, 10, 3))
+		d.repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("paid rate is locked", func(t *testing.T) {
+		uc, d := newTestUseCase()
+
+		d.repo.On("GetByID", ctx, int64(3)).Return(&JobRate{ID: 3, JobID: 10, Paid: true}, nil)
+		d.job.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		assert.Equal(t, ErrRateAlreadyPaid, uc.Delete(ctx, 10, 3))
+	})
+}
@@ -0,0 +1,44 @@
+package job_rate_status
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (uc *UseCase) Delete(ctx context.Context, id int64) error {
+	// Validar que el estado existe
+	_, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get job rate status for deletion",
+			slog.String("error", err.Error()),
+			slog.Int64("job_rate_status_id", id))
+		return err
+	}
+
+	// Verificar que no tenga job_rates asociados
+	hasJobRates, err := uc.repo.HasJobRates(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to check job rate status job rates",
+			slog.String("error", err.Error()),
+			slog.Int64("job_rate_status_id", id))
+		return err
+	}
+
+	if hasJobRates {
+		slog.WarnContext(ctx, "Cannot delete job rate status with job rates",
+			slog.Int64("job_rate_status_id", id))
+		return ErrJobRateStatusInUse
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job rate status",
+			slog.String("error", err.Error()),
+			slog.Int64("job_rate_status_id", id))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job rate status deleted successfully",
+		slog.Int64("job_rate_status_id", id))
+
+	return nil
+}
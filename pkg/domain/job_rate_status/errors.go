@@ -0,0 +1,8 @@
+package job_rate_status
+
+import "errors"
+
+var (
+	ErrJobRateStatusNotFound = errors.New("job rate status not found")
+	ErrJobRateStatusInUse    = errors.New("cannot delete job rate status that is still in use")
+)
@@ -0,0 +1,21 @@
+package job_rate_status
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, status *JobRateStatus) error
+	GetByID(ctx context.Context, id int64) (*JobRateStatus, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*JobRateStatus, int, error)
+	Update(ctx context.Context, status *JobRateStatus) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type UseCase struct {
+	repo Repository
+}
+
+func NewUseCase(repo Repository) *UseCase {
+	return &UseCase{
+		repo: repo,
+	}
+}
@@ -0,0 +1,32 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create registra un residente para un job
+func (uc *UseCase) Create(ctx context.Context, resident *JobResident) error {
+	if err := resident.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := uc.jobRepo.GetByID(ctx, resident.JobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job",
+			slog.Int64("jobId", resident.JobID),
+			slog.String("error", err.Error()))
+		return ErrInvalidJob
+	}
+
+	if err := uc.repo.Create(ctx, resident); err != nil {
+		slog.ErrorContext(ctx, "Failed to create job resident",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job resident created successfully",
+		slog.Int64("id", resident.ID),
+		slog.Int64("jobId", resident.JobID))
+
+	return nil
+}
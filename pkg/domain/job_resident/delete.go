@@ -0,0 +1,25 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina un residente de un job (soft delete)
+func (uc *UseCase) Delete(ctx context.Context, jobID, id int64) error {
+	if _, err := uc.getOwned(ctx, jobID, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job resident",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job resident deleted successfully",
+		slog.Int64("id", id))
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package job_resident
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// JobResident representa el contacto en sitio (residente o inquilino) de un job
+type JobResident struct {
+	ID          int64      `json:"id"`
+	JobID       int64      `json:"jobId"`
+	Name        string     `json:"name"`
+	MobilePhone *string    `json:"mobilePhone,omitempty"`
+	HomePhone   *string    `json:"homePhone,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos del residente y normaliza los opcionales vacíos
+func (r *JobResident) Validate() error {
+	if r.JobID == 0 {
+		return fmt.Errorf("job_id is required")
+	}
+
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	r.MobilePhone = trimToNil(r.MobilePhone)
+	r.HomePhone = trimToNil(r.HomePhone)
+	r.Email = trimToNil(r.Email)
+
+	if r.Email != nil {
+		if _, err := mail.ParseAddress(*r.Email); err != nil {
+			return fmt.Errorf("email is invalid")
+		}
+	}
+
+	return nil
+}
+
+// IsDeleted verifica si el residente está eliminado
+func (r *JobResident) IsDeleted() bool {
+	return r.DeletedAt != nil
+}
+
+func trimToNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,11 @@
+package job_resident
+
+import "errors"
+
+var (
+	// ErrResidentNotFound indica que el residente no fue encontrado
+	ErrResidentNotFound = errors.New("job resident not found")
+
+	// ErrInvalidJob indica que el job no es válido
+	ErrInvalidJob = errors.New("invalid job")
+)
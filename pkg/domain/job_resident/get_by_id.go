@@ -0,0 +1,10 @@
+package job_resident
+
+import (
+	"context"
+)
+
+// GetByID obtiene un residente de un job por su ID
+func (uc *UseCase) GetByID(ctx context.Context, jobID, id int64) (*JobResident, error) {
+	return uc.getOwned(ctx, jobID, id)
+}
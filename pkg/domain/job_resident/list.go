@@ -0,0 +1,25 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene los residentes de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64) ([]*JobResident, error) {
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job for listing residents",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, ErrInvalidJob
+	}
+
+	residents, err := uc.repo.ListByJobID(ctx, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job residents",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return residents, nil
+}
@@ -0,0 +1,21 @@
+package job_resident
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento de residentes de jobs
+type Repository interface {
+	// Create crea un nuevo residente
+	Create(ctx context.Context, resident *JobResident) error
+
+	// GetByID obtiene un residente por su ID
+	GetByID(ctx context.Context, id int64) (*JobResident, error)
+
+	// ListByJobID obtiene los residentes de un job
+	ListByJobID(ctx context.Context, jobID int64) ([]*JobResident, error)
+
+	// Update actualiza un residente existente
+	Update(ctx context.Context, resident *JobResident) error
+
+	// Delete elimina un residente (soft delete)
+	Delete(ctx context.Context, id int64) error
+}
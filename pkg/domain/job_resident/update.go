@@ -0,0 +1,29 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza un residente existente de un job
+func (uc *UseCase) Update(ctx context.Context, resident *JobResident) error {
+	if _, err := uc.getOwned(ctx, resident.JobID, resident.ID); err != nil {
+		return err
+	}
+
+	if err := resident.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Update(ctx, resident); err != nil {
+		slog.ErrorContext(ctx, "Failed to update job resident",
+			slog.Int64("id", resident.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job resident updated successfully",
+		slog.Int64("id", resident.ID))
+
+	return nil
+}
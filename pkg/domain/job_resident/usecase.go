@@ -0,0 +1,50 @@
+package job_resident
+
+import "context"
+
+// Service define la interfaz del servicio de residentes de jobs
+type Service interface {
+	Create(ctx context.Context, resident *JobResident) error
+	GetByID(ctx context.Context, jobID, id int64) (*JobResident, error)
+	ListByJobID(ctx context.Context, jobID int64) ([]*JobResident, error)
+	Update(ctx context.Context, resident *JobResident) error
+	Delete(ctx context.Context, jobID, id int64) error
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UseCase implementa la lógica de negocio de residentes de jobs
+type UseCase struct {
+	repo    Repository
+	jobRepo JobChecker
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de residentes de jobs
+func NewUseCase(repo Repository, jobRepo JobChecker) *UseCase {
+	return &UseCase{
+		repo:    repo,
+		jobRepo: jobRepo,
+	}
+}
+
+// getOwned obtiene un residente verificando que pertenezca al job indicado
+func (uc *UseCase) getOwned(ctx context.Context, jobID, id int64) (*JobResident, error) {
+	resident, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrResidentNotFound
+	}
+
+	if resident.IsDeleted() || resident.JobID != jobID {
+		return nil, ErrResidentNotFound
+	}
+
+	// El job debe existir y ser visible para el usuario autenticado
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		return nil, ErrResidentNotFound
+	}
+
+	return resident, nil
+}
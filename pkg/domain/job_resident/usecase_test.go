@@ -0,0 +1,132 @@
+package job_resident
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func strPtr(s string) *string { return &s }
+
+func newTestUseCase() (*UseCase, *MockRepository, *MockJobChecker) {
+	repo := new(MockRepository)
+	jobChecker := new(MockJobChecker)
+	return NewUseCase(repo, jobChecker), repo, jobChecker
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("normalizes optional fields", func(t *testing.T) {
+		r := &JobResident{JobID: 1, Name: "  Jane Doe ", MobilePhone: strPtr(" 555-1234 "), HomePhone: strPtr("  ")}
+
+		assert.NoError(t, r.Validate())
+		assert.Equal(t, "Jane Doe", r.Name)
+		assert.Equal(t, "555-1234", *r.MobilePhone)
+		assert.Nil(t, r.HomePhone)
+	})
+
+	t.Run("name required", func(t *testing.T) {
+		err := (&JobResident{JobID: 1, Name: " "}).Validate()
+		assert.EqualError(t, err, "name is required")
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		err := (&JobResident{JobID: 1, Name: "Jane", Email: strPtr("not-an-email")}).Validate()
+		assert.EqualError(t, err, "email is invalid")
+	})
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker := newTestUseCase()
+		resident := &JobResident{JobID: 10, Name: "Jane Doe", Email: strPtr("jane@example.com")}
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		repo.On("Create", ctx, resident).Return(nil)
+
+		assert.NoError(t, uc.Create(ctx, resident))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, repo, jobChecker := newTestUseCase()
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, &JobResident{JobID: 10, Name: "Jane Doe"})
+
+		assert.Equal(t, ErrInvalidJob, err)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("belongs to another job", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(1)).Return(&JobResident{ID: 1, JobID: 11, Name: "Jane"}, nil)
+
+		result, err := uc.GetByID(ctx, 10, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, ErrResidentNotFound, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker := newTestUseCase()
+		resident := &JobResident{ID: 1, JobID: 10, Name: "Jane Smith"}
+
+		repo.On("GetByID", ctx, int64(1)).Return(&JobResident{ID: 1, JobID: 10, Name: "Jane"}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		repo.On("Update", ctx, resident).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, resident))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(1)).Return(nil, ErrResidentNotFound)
+
+		err := uc.Update(ctx, &JobResident{ID: 1, JobID: 10, Name: "Jane"})
+
+		assert.Equal(t, ErrResidentNotFound, err)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(1)).Return(&JobResident{ID: 1, JobID: 10, Name: "Jane"}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		repo.On("Delete", ctx, int64(1)).Return(nil)
+
+		assert.NoError(t, uc.Delete(ctx, 10, 1))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("job outside user scope", func(t *testing.T) {
+		uc, repo, jobChecker := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(1)).Return(&JobResident{ID: 1, JobID: 10, Name: "Jane"}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(nil, errors.New("job not found"))
+
+		assert.Equal(t, ErrResidentNotFound, uc.Delete(ctx, 10, 1))
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,195 @@
+package job_sms
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/placeholder"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+)
+
+// Dispatch envía el SMS de despacho de un job al técnico o a los residentes
+// y registra el envío en el historial con los destinatarios a los que llegó
+func (uc *UseCase) Dispatch(ctx context.Context, req *DispatchRequest) (*DispatchResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkJob(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+
+	data, err := uc.dataLoader.Load(ctx, req.JobID)
+	if err != nil {
+		if errors.Is(err, domainPlaceholder.ErrJobNotFound) {
+			return nil, ErrInvalidJob
+		}
+		return nil, err
+	}
+
+	text, err := uc.messageText(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	message, _ := placeholder.Render(text, data.Values())
+
+	numbers, err := uc.candidateNumbers(ctx, req, data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DispatchResult{Failed: []FailedRecipient{}}
+	recipients := normalizeRecipients(numbers, result)
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	sender, err := uc.sender(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sent []string
+	for _, to := range recipients {
+		if _, err := sender.Send(ctx, sms.Message{To: to, Body: message}); err != nil {
+			slog.WarnContext(ctx, "Failed to send job sms",
+				slog.Int64("jobId", req.JobID),
+				slog.String("to", to),
+				slog.String("error", err.Error()))
+			result.Failed = append(result.Failed, FailedRecipient{To: to, Error: err.Error()})
+			continue
+		}
+		sent = append(sent, to)
+	}
+
+	if len(sent) == 0 {
+		return result, ErrSendFailed
+	}
+
+	record := &JobSMS{
+		JobID:      req.JobID,
+		Recipients: sent,
+		Type:       req.Type,
+		Message:    message,
+	}
+	if err := uc.repo.Create(ctx, record); err != nil {
+		slog.ErrorContext(ctx, "Failed to record job sms",
+			slog.Int64("jobId", req.JobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	result.SMS = record
+
+	slog.InfoContext(ctx, "Job sms dispatched",
+		slog.Int64("jobId", req.JobID),
+		slog.String("type", req.Type),
+		slog.Int("sent", len(sent)),
+		slog.Int("failed", len(result.Failed)))
+
+	return result, nil
+}
+
+// checkJob verifica con el JobChecker que el job existe y que el usuario puede editarlo;
+// la carga de datos del job solo exige poder verlo
+func (uc *UseCase) checkJob(ctx context.Context, jobID int64) error {
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		if errors.Is(err, domainJob.ErrJobForbidden) {
+			return ErrForbidden
+		}
+		return ErrInvalidJob
+	}
+	return nil
+}
+
+// messageText obtiene el texto sin renderizar desde la plantilla o el mensaje libre
+func (uc *UseCase) messageText(ctx context.Context, req *DispatchRequest) (string, error) {
+	if req.TemplateID == nil {
+		return *req.Message, nil
+	}
+
+	template, err := uc.templateRepo.GetByID(ctx, *req.TemplateID)
+	if err != nil || !template.IsActive {
+		return "", ErrTemplateNotFound
+	}
+	return template.Message, nil
+}
+
+// candidateNumbers reúne los números indicados y, para residentes, sus celulares.
+// El envío al técnico exige que el job tenga uno asignado; su número lo indica
+// quien envía porque los usuarios no tienen teléfono registrado.
+func (uc *UseCase) candidateNumbers(ctx context.Context, req *DispatchRequest, data *domainPlaceholder.Data) ([]string, error) {
+	numbers := append([]string{}, req.PhoneNumbers...)
+	if req.Type == TypeTechnician {
+		if data.Technician == nil {
+			return nil, ErrNoTechnician
+		}
+		return numbers, nil
+	}
+
+	residents, err := uc.residentRepo.ListByJobID(ctx, req.JobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job residents for sms",
+			slog.Int64("jobId", req.JobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	selected := make(map[int64]bool, len(req.ResidentIDs))
+	for _, id := range req.ResidentIDs {
+		selected[id] = true
+	}
+
+	for _, r := range residents {
+		if len(selected) > 0 && !selected[r.ID] {
+			continue
+		}
+		if r.MobilePhone != nil && strings.TrimSpace(*r.MobilePhone) != "" {
+			numbers = append(numbers, *r.MobilePhone)
+		}
+	}
+
+	return numbers, nil
+}
+
+// normalizeRecipients normaliza y deduplica los números; los inválidos se reportan como fallidos
+func normalizeRecipients(numbers []string, result *DispatchResult) []string {
+	seen := make(map[string]bool, len(numbers))
+	var recipients []string
+	for _, n := range numbers {
+		normalized, ok := sms.NormalizeNumber(n)
+		if !ok {
+			result.Failed = append(result.Failed, FailedRecipient{To: n, Error: "invalid phone number"})
+			continue
+		}
+		if !seen[normalized] {
+			seen[normalized] = true
+			recipients = append(recipients, normalized)
+		}
+	}
+	return recipients
+}
+
+// sender construye el sender con las credenciales de Twilio de la configuración
+func (uc *UseCase) sender(ctx context.Context) (sms.Sender, error) {
+	s, err := uc.settings.Get(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load settings for sms",
+			slog.String("error", err.Error()))
+		return nil, ErrSMSDisabled
+	}
+
+	if !s.IsTwilioEnabled || s.TwilioSID == nil || s.TwilioAuthToken == nil || s.TwilioFromNumber == nil ||
+		*s.TwilioSID == "" || *s.TwilioAuthToken == "" || *s.TwilioFromNumber == "" {
+		return nil, ErrSMSDisabled
+	}
+
+	return uc.senderFactory(sms.TwilioConfig{
+		AccountSID: *s.TwilioSID,
+		AuthToken:  *s.TwilioAuthToken,
+		From:       *s.TwilioFromNumber,
+	}), nil
+}
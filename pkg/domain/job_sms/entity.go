@@ -0,0 +1,68 @@
+package job_sms
+
+import (
+	"strings"
+	"time"
+)
+
+// Tipos de envío de SMS de un job
+const (
+	TypeTechnician = "technician"
+	TypeResidents  = "residents"
+)
+
+// JobSMS registra un SMS enviado desde un job
+type JobSMS struct {
+	ID         int64      `json:"id"`
+	JobID      int64      `json:"jobId"`
+	Recipients []string   `json:"recipients"`
+	Type       string     `json:"type"`
+	Message    string     `json:"message"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DispatchRequest contiene los datos para enviar el SMS de despacho de un job.
+// El mensaje sale de la plantilla (TemplateID) o del texto libre (Message); en ambos
+// casos se rellenan los marcadores con los datos del job.
+type DispatchRequest struct {
+	JobID      int64
+	Type       string
+	TemplateID *int64
+	Message    *string
+	// ResidentIDs limita el envío a esos residentes (solo para TypeResidents; vacío = todos)
+	ResidentIDs []int64
+	// PhoneNumbers son números adicionales. Los usuarios no tienen teléfono registrado,
+	// por lo que el envío al técnico usa estos números y son obligatorios.
+	PhoneNumbers []string
+}
+
+// Validate verifica el tipo de envío, que haya una fuente para el mensaje y,
+// para el técnico, que se indiquen sus números
+func (r *DispatchRequest) Validate() error {
+	if r.Type != TypeTechnician && r.Type != TypeResidents {
+		return ErrInvalidType
+	}
+
+	if r.TemplateID == nil && (r.Message == nil || strings.TrimSpace(*r.Message) == "") {
+		return ErrMessageRequired
+	}
+
+	if r.Type == TypeTechnician && len(r.PhoneNumbers) == 0 {
+		return ErrPhoneNumbersRequired
+	}
+
+	return nil
+}
+
+// FailedRecipient es un destinatario al que no se pudo enviar el SMS
+type FailedRecipient struct {
+	To    string `json:"to"`
+	Error string `json:"error"`
+}
+
+// DispatchResult es el resultado de un envío: el registro guardado y los destinatarios fallidos
+type DispatchResult struct {
+	SMS    *JobSMS           `json:"sms"`
+	Failed []FailedRecipient `json:"failed"`
+}
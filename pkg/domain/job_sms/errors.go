@@ -0,0 +1,35 @@
+package job_sms
+
+import "errors"
+
+var (
+	// ErrInvalidJob indica que el job no existe
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrForbidden indica que el usuario no tiene permitido editar el job
+	ErrForbidden = errors.New("not allowed to dispatch this job")
+
+	// ErrInvalidType indica que el tipo de envío no es válido
+	ErrInvalidType = errors.New("type must be technician or residents")
+
+	// ErrMessageRequired indica que no se indicó plantilla ni mensaje
+	ErrMessageRequired = errors.New("template_id or message is required")
+
+	// ErrPhoneNumbersRequired indica que el envío al técnico no indicó números
+	ErrPhoneNumbersRequired = errors.New("phoneNumbers is required for technician sms")
+
+	// ErrNoTechnician indica que el job no tiene técnico asignado
+	ErrNoTechnician = errors.New("job has no assigned technician")
+
+	// ErrTemplateNotFound indica que la plantilla de SMS no existe o está inactiva
+	ErrTemplateNotFound = errors.New("sms template not found")
+
+	// ErrNoRecipients indica que no hay números válidos a los cuales enviar
+	ErrNoRecipients = errors.New("no valid recipients")
+
+	// ErrSMSDisabled indica que el envío de SMS no está habilitado o configurado
+	ErrSMSDisabled = errors.New("sms sending is not enabled")
+
+	// ErrSendFailed indica que el SMS no pudo enviarse a ningún destinatario
+	ErrSendFailed = errors.New("sms could not be sent to any recipient")
+)
@@ -0,0 +1,30 @@
+package job_sms
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene una lista paginada de los SMS enviados de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobSMS, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	if _, err := uc.jobCheck.GetByID(ctx, jobID); err != nil {
+		return nil, 0, ErrInvalidJob
+	}
+
+	items, total, err := uc.repo.ListByJobID(ctx, jobID, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job sms",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
@@ -0,0 +1,77 @@
+package job_sms
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+	domainSettings "github.com/your-org/jvairv2/pkg/domain/settings"
+	domainTemplate "github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+// MockRepository es un mock del repositorio de SMS de jobs
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, sms *JobSMS) error {
+	args := m.Called(ctx, sms)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobSMS, int, error) {
+	args := m.Called(ctx, jobID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*JobSMS), args.Int(1), args.Error(2)
+}
+
+// MockJobChecker es un mock del verificador de jobs
+type MockJobChecker struct {
+	mock.Mock
+}
+
+func (m *MockJobChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
+
+// MockTemplateGetter es un mock para obtener plantillas de SMS
+type MockTemplateGetter struct {
+	mock.Mock
+}
+
+func (m *MockTemplateGetter) GetByID(ctx context.Context, id int64) (*domainTemplate.SMSTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainTemplate.SMSTemplate), args.Error(1)
+}
+
+// MockResidentLister es un mock para listar residentes de un job
+type MockResidentLister struct {
+	mock.Mock
+}
+
+func (m *MockResidentLister) ListByJobID(ctx context.Context, jobID int64) ([]*domainResident.JobResident, error) {
+	args := m.Called(ctx, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainResident.JobResident), args.Error(1)
+}
+
+// MockSettingsProvider es un mock del proveedor de configuración
+type MockSettingsProvider struct {
+	mock.Mock
+}
+
+func (m *MockSettingsProvider) Get(ctx context.Context) (*domainSettings.Settings, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainSettings.Settings), args.Error(1)
+}
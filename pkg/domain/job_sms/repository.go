@@ -0,0 +1,11 @@
+package job_sms
+
+import "context"
+
+// Repository define los métodos para interactuar con el historial de SMS de jobs
+type Repository interface {
+	// Create registra un SMS enviado
+	Create(ctx context.Context, sms *JobSMS) error
+	// ListByJobID obtiene los SMS enviados de un job, del más reciente al más antiguo
+	ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobSMS, int, error)
+}
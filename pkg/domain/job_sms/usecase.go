@@ -0,0 +1,70 @@
+package job_sms
+
+import (
+	"context"
+
+	"github.com/your-org/jvairv2/pkg/common/sms"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+	domainSettings "github.com/your-org/jvairv2/pkg/domain/settings"
+	domainTemplate "github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+// Service define la interfaz del servicio de SMS de jobs
+type Service interface {
+	Dispatch(ctx context.Context, req *DispatchRequest) (*DispatchResult, error)
+	ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*JobSMS, int, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// TemplateGetter obtiene plantillas de SMS
+type TemplateGetter interface {
+	GetByID(ctx context.Context, id int64) (*domainTemplate.SMSTemplate, error)
+}
+
+// ResidentLister obtiene los residentes de un job
+type ResidentLister interface {
+	ListByJobID(ctx context.Context, jobID int64) ([]*domainResident.JobResident, error)
+}
+
+// SettingsProvider obtiene la configuración del sistema (credenciales de Twilio)
+type SettingsProvider interface {
+	Get(ctx context.Context) (*domainSettings.Settings, error)
+}
+
+// SenderFactory construye el sender de SMS con las credenciales configuradas
+type SenderFactory func(config sms.TwilioConfig) sms.Sender
+
+// UseCase implementa la lógica de negocio de SMS de jobs
+type UseCase struct {
+	repo          Repository
+	jobCheck      JobChecker
+	dataLoader    domainPlaceholder.Service
+	templateRepo  TemplateGetter
+	residentRepo  ResidentLister
+	settings      SettingsProvider
+	senderFactory SenderFactory
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de SMS de jobs.
+// Si senderFactory es nil se usa la API REST de Twilio.
+func NewUseCase(repo Repository, jobCheck JobChecker, dataLoader domainPlaceholder.Service, templateRepo TemplateGetter, residentRepo ResidentLister, settings SettingsProvider, senderFactory SenderFactory) *UseCase {
+	if senderFactory == nil {
+		senderFactory = func(config sms.TwilioConfig) sms.Sender {
+			return sms.NewTwilioSender(config, nil)
+		}
+	}
+	return &UseCase{
+		repo:          repo,
+		jobCheck:      jobCheck,
+		dataLoader:    dataLoader,
+		templateRepo:  templateRepo,
+		residentRepo:  residentRepo,
+		settings:      settings,
+		senderFactory: senderFactory,
+	}
+}
@@ -0,0 +1,281 @@
+package job_sms
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+	domainSettings "github.com/your-org/jvairv2/pkg/domain/settings"
+	domainTemplate "github.com/your-org/jvairv2/pkg/domain/sms_template"
+	domainUser "github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+func strPtr(s string) *string { return &s }
+func int64Ptr(i int64) *int64 { return &i }
+
+type fixture struct {
+	repo      *MockRepository
+	jobCheck  *MockJobChecker
+	loader    *domainPlaceholder.MockService
+	templates *MockTemplateGetter
+	residents *MockResidentLister
+	settings  *MockSettingsProvider
+	sender    *sms.FakeSender
+	config    sms.TwilioConfig
+	uc        *UseCase
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		repo:      new(MockRepository),
+		jobCheck:  new(MockJobChecker),
+		loader:    new(domainPlaceholder.MockService),
+		templates: new(MockTemplateGetter),
+		residents: new(MockResidentLister),
+		settings:  new(MockSettingsProvider),
+		sender:    sms.NewFakeSender(),
+	}
+	f.uc = NewUseCase(f.repo, f.jobCheck, f.loader, f.templates, f.residents, f.settings, func(config sms.TwilioConfig) sms.Sender {
+		f.config = config
+		return f.sender
+	})
+	return f
+}
+
+func enabledSettings() *domainSettings.Settings {
+	return &domainSettings.Settings{
+		IsTwilioEnabled:  true,
+		TwilioSID:        strPtr("AC123"),
+		TwilioAuthToken:  strPtr("secret"),
+		TwilioFromNumber: strPtr("+15550000000"),
+	}
+}
+
+func jobData() *domainPlaceholder.Data {
+	return &domainPlaceholder.Data{
+		Job:        &domainJob.Job{ID: 7, WorkOrder: strPtr("WO-7")},
+		Technician: &domainUser.User{ID: 4, Name: "Tech"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Equal(t, ErrInvalidType, (&DispatchRequest{Type: "email", Message: strPtr("hi")}).Validate())
+	assert.Equal(t, ErrMessageRequired, (&DispatchRequest{Type: TypeTechnician, Message: strPtr("  ")}).Validate())
+	assert.Equal(t, ErrPhoneNumbersRequired, (&DispatchRequest{Type: TypeTechnician, Message: strPtr("hi")}).Validate())
+	assert.NoError(t, (&DispatchRequest{Type: TypeResidents, TemplateID: int64Ptr(1)}).Validate())
+}
+
+func TestDispatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("technician with rendered message", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.settings.On("Get", ctx).Return(enabledSettings(), nil)
+		f.repo.On("Create", ctx, mock.AnythingOfType("*job_sms.JobSMS")).Return(nil)
+
+		result, err := f.uc.Dispatch(ctx, &DispatchRequest{
+			JobID:        7,
+			Type:         TypeTechnician,
+			Message:      strPtr("New job {{job.work_order}}"),
+			PhoneNumbers: []string{"(555) 123-4567", "5551234567"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"+15551234567"}, result.SMS.Recipients)
+		assert.Equal(t, "New job WO-7", result.SMS.Message)
+		assert.Equal(t, TypeTechnician, result.SMS.Type)
+		assert.Empty(t, result.Failed)
+		assert.Equal(t, "AC123", f.config.AccountSID)
+		assert.Len(t, f.sender.Messages(), 1)
+		f.residents.AssertNotCalled(t, "ListByJobID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("selected residents from template", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.templates.On("GetByID", ctx, int64(3)).Return(&domainTemplate.SMSTemplate{ID: 3, Message: "Visit for {{job.work_order}}", IsActive: true}, nil)
+		f.residents.On("ListByJobID", ctx, int64(7)).Return([]*domainResident.JobResident{
+			{ID: 1, MobilePhone: strPtr("555-111-2222")},
+			{ID: 2, MobilePhone: strPtr("555-333-4444")},
+			{ID: 3},
+		}, nil)
+		f.settings.On("Get", ctx).Return(enabledSettings(), nil)
+		f.repo.On("Create", ctx, mock.AnythingOfType("*job_sms.JobSMS")).Return(nil)
+
+		result, err := f.uc.Dispatch(ctx, &DispatchRequest{
+			JobID:       7,
+			Type:        TypeResidents,
+			TemplateID:  int64Ptr(3),
+			ResidentIDs: []int64{2},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"+15553334444"}, result.SMS.Recipients)
+		assert.Equal(t, "Visit for WO-7", result.SMS.Message)
+	})
+
+	t.Run("partial failure records only delivered numbers", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.sender.FailFor = map[string]error{"+15553334444": errors.New("unreachable")}
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.residents.On("ListByJobID", ctx, int64(7)).Return([]*domainResident.JobResident{
+			{ID: 1, MobilePhone: strPtr("555-111-2222")},
+			{ID: 2, MobilePhone: strPtr("555-333-4444")},
+		}, nil)
+		f.settings.On("Get", ctx).Return(enabledSettings(), nil)
+		f.repo.On("Create", ctx, mock.AnythingOfType("*job_sms.JobSMS")).Return(nil)
+
+		result, err := f.uc.Dispatch(ctx, &DispatchRequest{
+			JobID:        7,
+			Type:         TypeResidents,
+			Message:      strPtr("Hello"),
+			PhoneNumbers: []string{"12"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"+15551112222"}, result.SMS.Recipients)
+		assert.Equal(t, []FailedRecipient{
+			{To: "12", Error: "invalid phone number"},
+			{To: "+15553334444", Error: "unreachable"},
+		}, result.Failed)
+	})
+
+	t.Run("all sends fail", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.sender.FailFor = map[string]error{"+15551234567": errors.New("unreachable")}
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.settings.On("Get", ctx).Return(enabledSettings(), nil)
+
+		result, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeTechnician, Message: strPtr("Hi"), PhoneNumbers: []string{"5551234567"}})
+
+		assert.Equal(t, ErrSendFailed, err)
+		assert.Len(t, result.Failed, 1)
+		f.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("twilio disabled", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.settings.On("Get", ctx).Return(&domainSettings.Settings{IsTwilioEnabled: false}, nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeTechnician, Message: strPtr("Hi"), PhoneNumbers: []string{"5551234567"}})
+
+		assert.Equal(t, ErrSMSDisabled, err)
+		assert.Empty(t, f.sender.Messages())
+	})
+
+	t.Run("no recipients", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.residents.On("ListByJobID", ctx, int64(7)).Return([]*domainResident.JobResident{{ID: 1}}, nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeResidents, Message: strPtr("Hi")})
+
+		assert.Equal(t, ErrNoRecipients, err)
+		f.settings.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("technician without phone numbers", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeTechnician, Message: strPtr("Hi")})
+
+		assert.Equal(t, ErrPhoneNumbersRequired, err)
+		f.jobCheck.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		f.loader.AssertNotCalled(t, "Load", mock.Anything, mock.Anything)
+	})
+
+	t.Run("job without technician", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		data := jobData()
+		data.Technician = nil
+		f.loader.On("Load", ctx, int64(7)).Return(data, nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeTechnician, Message: strPtr("Hi"), PhoneNumbers: []string{"5551234567"}})
+
+		assert.Equal(t, ErrNoTechnician, err)
+		assert.Empty(t, f.sender.Messages())
+		f.settings.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("inactive template", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(jobData(), nil)
+		f.templates.On("GetByID", ctx, int64(3)).Return(&domainTemplate.SMSTemplate{ID: 3, IsActive: false}, nil)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeTechnician, TemplateID: int64Ptr(3), PhoneNumbers: []string{"5551234567"}})
+
+		assert.Equal(t, ErrTemplateNotFound, err)
+	})
+
+	t.Run("view only grant cannot dispatch", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(nil, domainJob.ErrJobForbidden)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeResidents, Message: strPtr("Hi")})
+
+		assert.Equal(t, ErrForbidden, err)
+		f.loader.AssertNotCalled(t, "Load", mock.Anything, mock.Anything)
+		assert.Empty(t, f.sender.Messages())
+	})
+
+	t.Run("job outside of scope", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(nil, domainJob.ErrJobNotFound)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeResidents, Message: strPtr("Hi")})
+
+		assert.Equal(t, ErrInvalidJob, err)
+		f.loader.AssertNotCalled(t, "Load", mock.Anything, mock.Anything)
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.loader.On("Load", ctx, int64(7)).Return(nil, domainPlaceholder.ErrJobNotFound)
+
+		_, err := f.uc.Dispatch(ctx, &DispatchRequest{JobID: 7, Type: TypeResidents, Message: strPtr("Hi")})
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
+
+func TestListByJobID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success with defaults", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(true, nil)
+		f.repo.On("ListByJobID", ctx, int64(7), 1, 10).Return([]*JobSMS{{ID: 1, JobID: 7}}, 1, nil)
+
+		items, total, err := f.uc.ListByJobID(ctx, 7, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		f := newFixture()
+		f.jobCheck.On("GetByID", ctx, int64(7)).Return(nil, ErrInvalidJob)
+
+		_, _, err := f.uc.ListByJobID(ctx, 7, 1, 10)
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+}
@@ -0,0 +1,55 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create crea una nueva tarea en un job
+func (uc *UseCase) Create(ctx context.Context, task *JobTask) error {
+	if err := task.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.checkReferences(ctx, task); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(ctx, task); err != nil {
+		slog.ErrorContext(ctx, "Failed to create job task",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job task created successfully",
+		slog.Int64("id", task.ID),
+		slog.Int64("jobId", task.JobID))
+
+	return nil
+}
+
+// checkReferences verifica que el job, el usuario asignado y el estado existan
+func (uc *UseCase) checkReferences(ctx context.Context, task *JobTask) error {
+	if _, err := uc.jobRepo.GetByID(ctx, task.JobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job",
+			slog.Int64("jobId", task.JobID),
+			slog.String("error", err.Error()))
+		return ErrInvalidJob
+	}
+
+	if _, err := uc.userRepo.GetByID(ctx, task.UserID); err != nil {
+		slog.ErrorContext(ctx, "Invalid user",
+			slog.Int64("userId", task.UserID),
+			slog.String("error", err.Error()))
+		return ErrInvalidUser
+	}
+
+	if _, err := uc.taskStatusRepo.GetByID(ctx, task.TaskStatusID); err != nil {
+		slog.ErrorContext(ctx, "Invalid task status",
+			slog.Int64("taskStatusId", task.TaskStatusID),
+			slog.String("error", err.Error()))
+		return ErrInvalidTaskStatus
+	}
+
+	return nil
+}
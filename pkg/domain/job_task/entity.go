@@ -0,0 +1,67 @@
+package job_task
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// JobTask representa una tarea asignada a un usuario dentro de un job
+type JobTask struct {
+	ID              int64      `json:"id"`
+	JobID           int64      `json:"jobId"`
+	UserID          int64      `json:"userId"`
+	UserName        *string    `json:"userName,omitempty"`
+	Task            string     `json:"task"`
+	TaskStatusID    int64      `json:"taskStatusId"`
+	TaskStatusLabel *string    `json:"taskStatusLabel,omitempty"`
+	TaskStatusClass *string    `json:"taskStatusClass,omitempty"`
+	DueDate         *time.Time `json:"dueDate,omitempty"`
+	JobWorkOrder    *string    `json:"jobWorkOrder,omitempty"`
+	LastNotifiedAt  *time.Time `json:"lastNotifiedAt,omitempty"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
+	DeletedAt       *time.Time `json:"deletedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos de la tarea
+func (t *JobTask) Validate() error {
+	if t.JobID == 0 {
+		return fmt.Errorf("job_id is required")
+	}
+
+	if t.UserID == 0 {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if strings.TrimSpace(t.Task) == "" {
+		return fmt.Errorf("task is required")
+	}
+
+	if t.TaskStatusID == 0 {
+		return fmt.Errorf("task_status_id is required")
+	}
+
+	return nil
+}
+
+// IsOverdue indica si la tarea tiene fecha límite y ya venció respecto a now
+func (t *JobTask) IsOverdue(now time.Time) bool {
+	return t.DueDate != nil && t.DueDate.Before(now)
+}
+
+// IsDeleted verifica si la tarea está eliminada
+func (t *JobTask) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// TaskNotification registra el envío de una notificación de tarea a un usuario
+type TaskNotification struct {
+	ID         int64      `json:"id"`
+	JobTaskID  int64      `json:"jobTaskId"`
+	UserID     int64      `json:"userId"`
+	UserName   *string    `json:"userName,omitempty"`
+	SentByID   *int64     `json:"sentById,omitempty"`
+	SentByName *string    `json:"sentByName,omitempty"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+}
@@ -0,0 +1,23 @@
+package job_task
+
+import "errors"
+
+var (
+	// ErrTaskNotFound indica que la tarea no fue encontrada
+	ErrTaskNotFound = errors.New("job task not found")
+
+	// ErrInvalidJob indica que el job no es válido
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrInvalidUser indica que el usuario asignado no es válido
+	ErrInvalidUser = errors.New("invalid user")
+
+	// ErrInvalidTaskStatus indica que el estado de tarea no es válido
+	ErrInvalidTaskStatus = errors.New("invalid task status")
+
+	// ErrNotifierUnavailable indica que no hay un canal configurado para entregar notificaciones
+	ErrNotifierUnavailable = errors.New("task notifier is not configured")
+
+	// ErrUserRequired indica que no hay un usuario autenticado
+	ErrUserRequired = errors.New("authenticated user is required")
+)
@@ -0,0 +1,10 @@
+package job_task
+
+import (
+	"context"
+)
+
+// GetByID obtiene una tarea de un job por su ID
+func (uc *UseCase) GetByID(ctx context.Context, jobID, id int64) (*JobTask, error) {
+	return uc.getOwned(ctx, jobID, id)
+}
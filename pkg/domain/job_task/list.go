@@ -0,0 +1,71 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene una lista paginada de las tareas de un job
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobTask, int, error) {
+	// Verificar que el job existe
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job for listing tasks",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, 0, ErrInvalidJob
+	}
+
+	if filters == nil {
+		filters = make(map[string]interface{})
+	}
+	filters["job_id"] = jobID
+
+	return uc.List(ctx, filters, page, pageSize)
+}
+
+// List obtiene una lista paginada de tareas de todos los jobs.
+// El filtro "mine" restringe la lista a las tareas asignadas al usuario autenticado.
+func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*JobTask, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 15
+	}
+
+	if filters == nil {
+		filters = make(map[string]interface{})
+	}
+
+	if mine, ok := filters["mine"].(bool); ok {
+		delete(filters, "mine")
+		if mine {
+			userID, ok := uc.currentUserID(ctx)
+			if !ok {
+				return nil, 0, ErrUserRequired
+			}
+			filters["user_id"] = userID
+		}
+	}
+
+	// Un usuario limitado a sus propios jobs solo ve las tareas de esos jobs
+	if uc.jobOwner != nil {
+		if ownerID, restricted := uc.jobOwner(ctx); restricted {
+			filters["job_user_id"] = ownerID
+		}
+	}
+
+	tasks, total, err := uc.repo.List(ctx, filters, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job tasks",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	slog.InfoContext(ctx, "Job tasks listed successfully",
+		slog.Int("total", total),
+		slog.Int("page", page),
+		slog.Int("pageSize", pageSize))
+
+	return tasks, total, nil
+}
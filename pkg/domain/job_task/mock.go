@@ -0,0 +1,76 @@
+package job_task
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de tareas de jobs
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, task *JobTask) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*JobTask, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*JobTask), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*JobTask, int, error) {
+	args := m.Called(ctx, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*JobTask), args.Int(1), args.Error(2)
+}
+
+func (m *MockRepository) Update(ctx context.Context, task *JobTask) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateNotification(ctx context.Context, notification *TaskNotification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListNotifications(ctx context.Context, taskID int64) ([]*TaskNotification, error) {
+	args := m.Called(ctx, taskID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*TaskNotification), args.Error(1)
+}
+
+// MockChecker es un mock genérico para los checkers de job, usuario y estado de tarea
+type MockChecker struct {
+	mock.Mock
+}
+
+func (m *MockChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
+
+// MockTaskNotifier es un mock del canal de notificaciones de tareas
+type MockTaskNotifier struct {
+	mock.Mock
+}
+
+func (m *MockTaskNotifier) NotifyJobTask(ctx context.Context, jobID, userID int64, workOrder *string, task string) error {
+	args := m.Called(ctx, jobID, userID, workOrder, task)
+	return args.Error(0)
+}
@@ -0,0 +1,27 @@
+package job_task
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento de tareas de jobs
+type Repository interface {
+	// Create crea una nueva tarea
+	Create(ctx context.Context, task *JobTask) error
+
+	// GetByID obtiene una tarea por su ID
+	GetByID(ctx context.Context, id int64) (*JobTask, error)
+
+	// List obtiene tareas con filtros (job_id, user_id, task_status_id, overdue, search) y paginación
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*JobTask, int, error)
+
+	// Update actualiza una tarea existente
+	Update(ctx context.Context, task *JobTask) error
+
+	// Delete elimina una tarea (soft delete)
+	Delete(ctx context.Context, id int64) error
+
+	// CreateNotification registra una notificación enviada para una tarea
+	CreateNotification(ctx context.Context, notification *TaskNotification) error
+
+	// ListNotifications obtiene las notificaciones enviadas para una tarea
+	ListNotifications(ctx context.Context, taskID int64) ([]*TaskNotification, error)
+}
@@ -0,0 +1,65 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SendNotification notifica al usuario asignado de la tarea con una alerta en su bandeja
+// y, solo si se entregó, registra quién fue notificado y quién envió la notificación
+func (uc *UseCase) SendNotification(ctx context.Context, jobID, id int64) (*TaskNotification, error) {
+	task, err := uc.getOwned(ctx, jobID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.notifier == nil {
+		return nil, ErrNotifierUnavailable
+	}
+	if err := uc.notifier.NotifyJobTask(ctx, task.JobID, task.UserID, task.JobWorkOrder, task.Task); err != nil {
+		slog.ErrorContext(ctx, "Failed to deliver job task notification",
+			slog.Int64("taskId", task.ID),
+			slog.Int64("userId", task.UserID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	notification := &TaskNotification{
+		JobTaskID: task.ID,
+		UserID:    task.UserID,
+		UserName:  task.UserName,
+	}
+	if senderID, ok := uc.currentUserID(ctx); ok {
+		notification.SentByID = &senderID
+	}
+
+	if err := uc.repo.CreateNotification(ctx, notification); err != nil {
+		slog.ErrorContext(ctx, "Failed to record job task notification",
+			slog.Int64("taskId", task.ID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Job task notification sent",
+		slog.Int64("taskId", task.ID),
+		slog.Int64("userId", task.UserID))
+
+	return notification, nil
+}
+
+// ListNotifications obtiene el historial de notificaciones de una tarea
+func (uc *UseCase) ListNotifications(ctx context.Context, jobID, id int64) ([]*TaskNotification, error) {
+	if _, err := uc.getOwned(ctx, jobID, id); err != nil {
+		return nil, err
+	}
+
+	notifications, err := uc.repo.ListNotifications(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job task notifications",
+			slog.Int64("taskId", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,33 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza una tarea existente de un job
+func (uc *UseCase) Update(ctx context.Context, task *JobTask) error {
+	if _, err := uc.getOwned(ctx, task.JobID, task.ID); err != nil {
+		return err
+	}
+
+	if err := task.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.checkReferences(ctx, task); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Update(ctx, task); err != nil {
+		slog.ErrorContext(ctx, "Failed to update job task",
+			slog.Int64("id", task.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job task updated successfully",
+		slog.Int64("id", task.ID))
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package job_task
+
+import "context"
+
+// Service define la interfaz del servicio de tareas de jobs
+type Service interface {
+	Create(ctx context.Context, task *JobTask) error
+	GetByID(ctx context.Context, jobID, id int64) (*JobTask, error)
+	ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobTask, int, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*JobTask, int, error)
+	Update(ctx context.Context, task *JobTask) error
+	Delete(ctx context.Context, jobID, id int64) error
+	SendNotification(ctx context.Context, jobID, id int64) (*TaskNotification, error)
+	ListNotifications(ctx context.Context, jobID, id int64) ([]*TaskNotification, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UserChecker verifica existencia de usuarios
+type UserChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// TaskStatusChecker verifica existencia de estados de tarea
+type TaskStatusChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// TaskNotifier entrega al usuario asignado el aviso de su tarea
+type TaskNotifier interface {
+	NotifyJobTask(ctx context.Context, jobID, userID int64, workOrder *string, task string) error
+}
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
+// UseCase implementa la lógica de negocio de tareas de jobs
+type UseCase struct {
+	repo           Repository
+	jobRepo        JobChecker
+	userRepo       UserChecker
+	taskStatusRepo TaskStatusChecker
+	notifier       TaskNotifier
+	userResolver   UserIDResolver
+	jobOwner       JobOwnerResolver
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de tareas de jobs
+func NewUseCase(repo Repository, jobRepo JobChecker, userRepo UserChecker, taskStatusRepo TaskStatusChecker, notifier TaskNotifier, userResolver UserIDResolver, jobOwner JobOwnerResolver) *UseCase {
+	return &UseCase{
+		repo:           repo,
+		jobRepo:        jobRepo,
+		userRepo:       userRepo,
+		taskStatusRepo: taskStatusRepo,
+		notifier:       notifier,
+		userResolver:   userResolver,
+		jobOwner:       jobOwner,
+	}
+}
+
+// currentUserID obtiene el usuario autenticado del contexto
+func (uc *UseCase) currentUserID(ctx context.Context) (int64, bool) {
+	if uc.userResolver == nil {
+		return 0, false
+	}
+	id, ok := uc.userResolver(ctx)
+	if !ok || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// getOwned obtiene una tarea verificando que pertenece al job indicado
+func (uc *UseCase) getOwned(ctx context.Context, jobID, id int64) (*JobTask, error) {
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrTaskNotFound
+	}
+
+	if task.IsDeleted() || task.JobID != jobID {
+		return nil, ErrTaskNotFound
+	}
+
+	// El job debe existir y ser visible para el usuario autenticado
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		return nil, ErrTaskNotFound
+	}
+
+	return task, nil
+}
@@ -0,0 +1,231 @@
+package job_task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestUseCase(userID int64) (*UseCase, *MockRepository, *MockChecker, *MockChecker, *MockChecker) {
+	repo := new(MockRepository)
+	jobChecker := new(MockChecker)
+	userChecker := new(MockChecker)
+	statusChecker := new(MockChecker)
+	resolver := func(ctx context.Context) (int64, bool) {
+		return userID, userID > 0
+	}
+	uc := NewUseCase(repo, jobChecker, userChecker, statusChecker, nil, resolver, nil)
+	return uc, repo, jobChecker, userChecker, statusChecker
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker, userChecker, statusChecker := newTestUseCase(1)
+		task := &JobTask{JobID: 10, UserID: 2, Task: "Call resident", TaskStatusID: 3}
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		userChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		statusChecker.On("GetByID", ctx, int64(3)).Return(true, nil)
+		repo.On("Create", ctx, task).Return(nil)
+
+		err := uc.Create(ctx, task)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("missing task", func(t *testing.T) {
+		uc, _, _, _, _ := newTestUseCase(1)
+
+		err := uc.Create(ctx, &JobTask{JobID: 10, UserID: 2, TaskStatusID: 3})
+
+		assert.Error(t, err)
+		assert.Equal(t, "task is required", err.Error())
+	})
+
+	t.Run("invalid user", func(t *testing.T) {
+		uc, repo, jobChecker, userChecker, _ := newTestUseCase(1)
+		task := &JobTask{JobID: 10, UserID: 2, Task: "Call resident", TaskStatusID: 3}
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		userChecker.On("GetByID", ctx, int64(2)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, task)
+
+		assert.Equal(t, ErrInvalidUser, err)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid task status", func(t *testing.T) {
+		uc, _, jobChecker, userChecker, statusChecker := newTestUseCase(1)
+		task := &JobTask{JobID: 10, UserID: 2, Task: "Call resident", TaskStatusID: 3}
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		userChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		statusChecker.On("GetByID", ctx, int64(3)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, task)
+
+		assert.Equal(t, ErrInvalidTaskStatus, err)
+	})
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("by job sets job filter", func(t *testing.T) {
+		uc, repo, jobChecker, _, _ := newTestUseCase(1)
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		repo.On("List", ctx, map[string]interface{}{"job_id": int64(10)}, 1, 15).Return([]*JobTask{{ID: 1}}, 1, nil)
+
+		tasks, total, err := uc.ListByJobID(ctx, 10, nil, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 1)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("by job invalid job", func(t *testing.T) {
+		uc, _, jobChecker, _, _ := newTestUseCase(1)
+
+		jobChecker.On("GetByID", ctx, int64(10)).Return(nil, errors.New("not found"))
+
+		_, _, err := uc.ListByJobID(ctx, 10, nil, 1, 15)
+
+		assert.Equal(t, ErrInvalidJob, err)
+	})
+
+	t.Run("mine resolves current user", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase(7)
+
+		repo.On("List", ctx, map[string]interface{}{"user_id": int64(7), "overdue": true}, 1, 15).Return([]*JobTask{}, 0, nil)
+
+		_, _, err := uc.List(ctx, map[string]interface{}{"mine": true, "overdue": true}, 1, 15)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("mine without user", func(t *testing.T) {
+		uc, _, _, _, _ := newTestUseCase(0)
+
+		_, _, err := uc.List(ctx, map[string]interface{}{"mine": true}, 1, 15)
+
+		assert.Equal(t, ErrUserRequired, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("task of another job", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase(1)
+
+		repo.On("GetByID", ctx, int64(5)).Return(&JobTask{ID: 5, JobID: 99}, nil)
+
+		err := uc.Update(ctx, &JobTask{ID: 5, JobID: 10, UserID: 2, Task: "x", TaskStatusID: 3})
+
+		assert.Equal(t, ErrTaskNotFound, err)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker, _, _ := newTestUseCase(1)
+
+		repo.On("GetByID", ctx, int64(5)).Return(&JobTask{ID: 5, JobID: 10}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		repo.On("Delete", ctx, int64(5)).Return(nil)
+
+		err := uc.Delete(ctx, 10, 5)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase(1)
+
+		repo.On("GetByID", ctx, int64(5)).Return(nil, errors.New("sql: no rows"))
+
+		err := uc.Delete(ctx, 10, 5)
+
+		assert.Equal(t, ErrTaskNotFound, err)
+	})
+}
+
+func TestSendNotification(t *testing.T) {
+	ctx := context.Background()
+
+	workOrder := "WO-7"
+	newNotifyingUseCase := func() (*UseCase, *MockRepository, *MockChecker, *MockTaskNotifier) {
+		repo := new(MockRepository)
+		jobChecker := new(MockChecker)
+		notifier := new(MockTaskNotifier)
+		resolver := func(ctx context.Context) (int64, bool) { return 4, true }
+		uc := NewUseCase(repo, jobChecker, new(MockChecker), new(MockChecker), notifier, resolver, nil)
+		return uc, repo, jobChecker, notifier
+	}
+
+	t.Run("delivers and records assignee and sender", func(t *testing.T) {
+		uc, repo, jobChecker, notifier := newNotifyingUseCase()
+
+		repo.On("GetByID", ctx, int64(5)).Return(&JobTask{ID: 5, JobID: 10, UserID: 2, Task: "Check filters", JobWorkOrder: &workOrder}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		notifier.On("NotifyJobTask", ctx, int64(10), int64(2), &workOrder, "Check filters").Return(nil)
+		repo.On("CreateNotification", ctx, mock.MatchedBy(func(n *TaskNotification) bool {
+			return n.JobTaskID == 5 && n.UserID == 2 && n.SentByID != nil && *n.SentByID == 4
+		})).Return(nil)
+
+		notification, err := uc.SendNotification(ctx, 10, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), notification.UserID)
+		notifier.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("failed delivery is not recorded", func(t *testing.T) {
+		uc, repo, jobChecker, notifier := newNotifyingUseCase()
+
+		repo.On("GetByID", ctx, int64(5)).Return(&JobTask{ID: 5, JobID: 10, UserID: 2, Task: "Check filters"}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		notifier.On("NotifyJobTask", ctx, int64(10), int64(2), (*string)(nil), "Check filters").Return(errors.New("db down"))
+
+		_, err := uc.SendNotification(ctx, 10, 5)
+
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "CreateNotification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without notifier nothing is recorded", func(t *testing.T) {
+		uc, repo, jobChecker, _, _ := newTestUseCase(4)
+
+		repo.On("GetByID", ctx, int64(5)).Return(&JobTask{ID: 5, JobID: 10, UserID: 2}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		_, err := uc.SendNotification(ctx, 10, 5)
+
+		assert.Equal(t, ErrNotifierUnavailable, err)
+		repo.AssertNotCalled(t, "CreateNotification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("task not found", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase(4)
+
+		repo.On("GetByID", ctx, int64(5)).Return(&JobTask{ID: 5, JobID: 11, UserID: 2}, nil)
+
+		_, err := uc.SendNotification(ctx, 10, 5)
+
+		assert.Equal(t, ErrTaskNotFound, err)
+	})
+}
@@ -0,0 +1,53 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Create registra una visita a nombre del usuario autenticado; solo un usuario con
+// AbilityViewAll puede indicar otro técnico
+func (uc *UseCase) Create(ctx context.Context, visit *JobVisit) error {
+	if uc.userResolver != nil {
+		if userID, ok := uc.userResolver(ctx); ok && userID > 0 {
+			if visit.UserID == 0 || (visit.UserID != userID && !uc.canAssignOthers(ctx)) {
+				visit.UserID = userID
+			}
+		}
+	}
+
+	if err := visit.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := uc.jobRepo.GetByID(ctx, visit.JobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job",
+			slog.Int64("jobId", visit.JobID),
+			slog.String("error", err.Error()))
+		return ErrInvalidJob
+	}
+
+	if _, err := uc.userRepo.GetByID(ctx, visit.UserID); err != nil {
+		slog.ErrorContext(ctx, "Invalid user",
+			slog.Int64("userId", visit.UserID),
+			slog.String("error", err.Error()))
+		return ErrInvalidUser
+	}
+
+	if visit.Date.IsZero() {
+		visit.Date = time.Now()
+	}
+
+	if err := uc.repo.Create(ctx, visit); err != nil {
+		slog.ErrorContext(ctx, "Failed to create job visit",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job visit created successfully",
+		slog.Int64("id", visit.ID),
+		slog.Int64("jobId", visit.JobID))
+
+	return nil
+}
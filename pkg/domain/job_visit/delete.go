@@ -0,0 +1,25 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una visita de un job (soft delete)
+func (uc *UseCase) Delete(ctx context.Context, jobID, id int64) error {
+	if _, err := uc.getEditable(ctx, jobID, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job visit",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job visit deleted successfully",
+		slog.Int64("id", id))
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package job_visit
+
+import (
+	"fmt"
+	"time"
+
+	domainJobEquip "github.com/your-org/jvairv2/pkg/domain/job_equipment"
+)
+
+// AbilityViewAll permite ver todas las visitas sin importar viewable_by
+const AbilityViewAll = "job_visit_view_all"
+
+// JobVisit representa el reporte de una visita de un técnico a un job
+type JobVisit struct {
+	ID         int64      `json:"id"`
+	JobID      int64      `json:"jobId"`
+	UserID     int64      `json:"userId"`
+	UserName   *string    `json:"userName,omitempty"`
+	ViewableBy []string   `json:"viewableBy,omitempty"`
+	Date       time.Time  `json:"date"`
+	Report     *string    `json:"report,omitempty"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+	DeletedAt  *time.Time `json:"deletedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos de la visita
+func (v *JobVisit) Validate() error {
+	if v.JobID == 0 {
+		return fmt.Errorf("job_id is required")
+	}
+
+	if v.UserID == 0 {
+		return fmt.Errorf("user_id is required")
+	}
+
+	return nil
+}
+
+// IsDeleted verifica si la visita está eliminada
+func (v *JobVisit) IsDeleted() bool {
+	return v.DeletedAt != nil
+}
+
+// IsViewableBy indica si el usuario puede ver la visita.
+// Una visita sin viewable_by es visible para todos; el autor y quien tenga
+// AbilityViewAll siempre la ven; en otro caso el usuario debe tener alguno
+// de los roles (por ID o nombre) listados en viewable_by.
+func (v *JobVisit) IsViewableBy(viewer *Viewer) bool {
+	if viewer == nil {
+		return false
+	}
+
+	if viewer.ViewAll || len(v.ViewableBy) == 0 || v.UserID == viewer.UserID {
+		return true
+	}
+
+	for _, allowed := range v.ViewableBy {
+		for _, role := range viewer.Roles {
+			if allowed == role {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Viewer representa al usuario que consulta las visitas
+type Viewer struct {
+	UserID  int64
+	Roles   []string
+	ViewAll bool
+}
+
+// ReportData reúne la información necesaria para generar el reporte descargable de una visita
+type ReportData struct {
+	Visit        *JobVisit
+	WorkOrder    *string
+	DateReceived *time.Time
+	JobCategory  *string
+	JobStatus    *string
+	CustomerName *string
+	PropertyCode *string
+	Street       string
+	City         string
+	State        string
+	Zip          string
+	Equipment    []*domainJobEquip.JobEquipment
+}
+
+// PropertyAddress retorna la dirección completa de la propiedad del job
+func (r *ReportData) PropertyAddress() string {
+	return fmt.Sprintf("%s, %s, %s %s", r.Street, r.City, r.State, r.Zip)
+}
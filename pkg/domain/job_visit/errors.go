@@ -0,0 +1,24 @@
+package job_visit
+
+import "errors"
+
+var (
+	// ErrVisitNotFound indica que la visita no fue encontrada
+	ErrVisitNotFound = errors.New("job visit not found")
+
+	// ErrVisitForbidden indica que el usuario no tiene permiso para ver la visita
+	ErrVisitForbidden = errors.New("job visit is not viewable by the current user")
+
+	// ErrVisitNotEditable indica que solo el autor de la visita o un usuario con
+	// AbilityViewAll pueden modificarla o eliminarla
+	ErrVisitNotEditable = errors.New("job visit can only be modified by its author")
+
+	// ErrInvalidJob indica que el job no es válido
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrInvalidUser indica que el usuario no es válido
+	ErrInvalidUser = errors.New("invalid user")
+
+	// ErrUserRequired indica que no hay un usuario autenticado
+	ErrUserRequired = errors.New("authenticated user is required")
+)
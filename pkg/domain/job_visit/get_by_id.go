@@ -0,0 +1,10 @@
+package job_visit
+
+import (
+	"context"
+)
+
+// GetByID obtiene una visita de un job si el usuario autenticado puede verla
+func (uc *UseCase) GetByID(ctx context.Context, jobID, id int64) (*JobVisit, error) {
+	return uc.getViewable(ctx, jobID, id)
+}
@@ -0,0 +1,37 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByJobID obtiene una lista paginada de las visitas de un job visibles para el usuario autenticado
+func (uc *UseCase) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobVisit, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job for listing visits",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, 0, ErrInvalidJob
+	}
+
+	viewer, err := uc.currentViewer(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	visits, total, err := uc.repo.ListByJobID(ctx, jobID, viewer, filters, page, pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job visits",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return visits, total, nil
+}
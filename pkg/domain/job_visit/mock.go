@@ -0,0 +1,74 @@
+package job_visit
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de visitas de jobs
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, visit *JobVisit) error {
+	args := m.Called(ctx, visit)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*JobVisit, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*JobVisit), args.Error(1)
+}
+
+func (m *MockRepository) ListByJobID(ctx context.Context, jobID int64, viewer *Viewer, filters map[string]interface{}, page, pageSize int) ([]*JobVisit, int, error) {
+	args := m.Called(ctx, jobID, viewer, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*JobVisit), args.Int(1), args.Error(2)
+}
+
+func (m *MockRepository) Update(ctx context.Context, visit *JobVisit) error {
+	args := m.Called(ctx, visit)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetReportData(ctx context.Context, jobID int64) (*ReportData, error) {
+	args := m.Called(ctx, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReportData), args.Error(1)
+}
+
+// MockChecker es un mock genérico para los checkers de job y usuario
+type MockChecker struct {
+	mock.Mock
+}
+
+func (m *MockChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
+
+// MockRoleProvider es un mock del proveedor de roles
+type MockRoleProvider struct {
+	mock.Mock
+}
+
+func (m *MockRoleProvider) GetUserRoleKeys(ctx context.Context, userID int64) ([]string, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
@@ -0,0 +1,25 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+)
+
+// GetReport obtiene los datos del reporte descargable de una visita
+func (uc *UseCase) GetReport(ctx context.Context, jobID, id int64) (*ReportData, error) {
+	visit, err := uc.getViewable(ctx, jobID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := uc.repo.GetReportData(ctx, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get job visit report data",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	data.Visit = visit
+
+	return data, nil
+}
@@ -0,0 +1,24 @@
+package job_visit
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento de visitas de jobs
+type Repository interface {
+	// Create crea una nueva visita
+	Create(ctx context.Context, visit *JobVisit) error
+
+	// GetByID obtiene una visita por su ID
+	GetByID(ctx context.Context, id int64) (*JobVisit, error)
+
+	// ListByJobID obtiene las visitas de un job visibles para el viewer, con paginación
+	ListByJobID(ctx context.Context, jobID int64, viewer *Viewer, filters map[string]interface{}, page, pageSize int) ([]*JobVisit, int, error)
+
+	// Update actualiza una visita existente
+	Update(ctx context.Context, visit *JobVisit) error
+
+	// Delete elimina una visita (soft delete)
+	Delete(ctx context.Context, id int64) error
+
+	// GetReportData obtiene los datos del job, la propiedad y los equipos para el reporte
+	GetReportData(ctx context.Context, jobID int64) (*ReportData, error)
+}
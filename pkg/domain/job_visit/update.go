@@ -0,0 +1,44 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza una visita existente de un job
+func (uc *UseCase) Update(ctx context.Context, visit *JobVisit) error {
+	existing, err := uc.getEditable(ctx, visit.JobID, visit.ID)
+	if err != nil {
+		return err
+	}
+
+	// Solo AbilityViewAll puede reasignar la visita; el autor no puede cederla a otro técnico
+	if visit.UserID == 0 || (visit.UserID != existing.UserID && !uc.canAssignOthers(ctx)) {
+		visit.UserID = existing.UserID
+	}
+	if visit.Date.IsZero() {
+		visit.Date = existing.Date
+	}
+
+	if err := visit.Validate(); err != nil {
+		return err
+	}
+
+	if visit.UserID != existing.UserID {
+		if _, err := uc.userRepo.GetByID(ctx, visit.UserID); err != nil {
+			return ErrInvalidUser
+		}
+	}
+
+	if err := uc.repo.Update(ctx, visit); err != nil {
+		slog.ErrorContext(ctx, "Failed to update job visit",
+			slog.Int64("id", visit.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Job visit updated successfully",
+		slog.Int64("id", visit.ID))
+
+	return nil
+}
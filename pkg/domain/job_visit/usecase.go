@@ -0,0 +1,162 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Service define la interfaz del servicio de visitas de jobs
+type Service interface {
+	Create(ctx context.Context, visit *JobVisit) error
+	GetByID(ctx context.Context, jobID, id int64) (*JobVisit, error)
+	ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*JobVisit, int, error)
+	Update(ctx context.Context, visit *JobVisit) error
+	Delete(ctx context.Context, jobID, id int64) error
+	GetReport(ctx context.Context, jobID, id int64) (*ReportData, error)
+}
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// UserChecker verifica existencia de usuarios
+type UserChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// RoleProvider obtiene los roles asignados a un usuario
+type RoleProvider interface {
+	GetUserRoleKeys(ctx context.Context, userID int64) ([]string, error)
+}
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// AbilityChecker verifica si el usuario autenticado tiene una habilidad
+type AbilityChecker func(ctx context.Context, ability string) bool
+
+// UseCase implementa la lógica de negocio de visitas de jobs
+type UseCase struct {
+	repo           Repository
+	jobRepo        JobChecker
+	userRepo       UserChecker
+	roleRepo       RoleProvider
+	userResolver   UserIDResolver
+	abilityChecker AbilityChecker
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de visitas de jobs
+func NewUseCase(
+	repo Repository,
+	jobRepo JobChecker,
+	userRepo UserChecker,
+	roleRepo RoleProvider,
+	userResolver UserIDResolver,
+	abilityChecker AbilityChecker,
+) *UseCase {
+	return &UseCase{
+		repo:           repo,
+		jobRepo:        jobRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		userResolver:   userResolver,
+		abilityChecker: abilityChecker,
+	}
+}
+
+// currentViewer construye el viewer a partir del usuario autenticado
+func (uc *UseCase) currentViewer(ctx context.Context) (*Viewer, error) {
+	if uc.userResolver == nil {
+		return nil, ErrUserRequired
+	}
+	userID, ok := uc.userResolver(ctx)
+	if !ok || userID <= 0 {
+		return nil, ErrUserRequired
+	}
+
+	viewer := &Viewer{UserID: userID}
+	if uc.abilityChecker != nil && uc.abilityChecker(ctx, AbilityViewAll) {
+		viewer.ViewAll = true
+		return viewer, nil
+	}
+
+	roles, err := uc.roleRepo.GetUserRoleKeys(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get user roles for visit visibility",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	viewer.Roles = roles
+
+	return viewer, nil
+}
+
+// getViewable obtiene una visita del job verificando que el usuario autenticado pueda verla
+func (uc *UseCase) getViewable(ctx context.Context, jobID, id int64) (*JobVisit, error) {
+	visit, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, ErrVisitNotFound
+	}
+
+	if visit.IsDeleted() || visit.JobID != jobID {
+		return nil, ErrVisitNotFound
+	}
+
+	// El job debe existir y ser visible para el usuario autenticado
+	if _, err := uc.jobRepo.GetByID(ctx, jobID); err != nil {
+		return nil, ErrVisitNotFound
+	}
+
+	// Las visitas sin restricción no requieren consultar los roles del usuario
+	if len(visit.ViewableBy) == 0 {
+		return visit, nil
+	}
+
+	viewer, err := uc.currentViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visit.IsViewableBy(viewer) {
+		return nil, ErrVisitForbidden
+	}
+
+	return visit, nil
+}
+
+// getEditable obtiene una visita del job verificando que el usuario autenticado pueda
+// modificarla: debe ser su autor o tener AbilityViewAll
+func (uc *UseCase) getEditable(ctx context.Context, jobID, id int64) (*JobVisit, error) {
+	visit, err := uc.getViewable(ctx, jobID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.abilityChecker != nil && uc.abilityChecker(ctx, AbilityViewAll) {
+		return visit, nil
+	}
+
+	if uc.userResolver == nil {
+		return nil, ErrUserRequired
+	}
+	userID, ok := uc.userResolver(ctx)
+	if !ok || userID <= 0 {
+		return nil, ErrUserRequired
+	}
+	if visit.UserID != userID {
+		slog.WarnContext(ctx, "Job visit modification by non-author rejected",
+			slog.Int64("id", id),
+			slog.Int64("userId", userID))
+		return nil, ErrVisitNotEditable
+	}
+
+	return visit, nil
+}
+
+// canAssignOthers indica si el usuario autenticado puede registrar visitas a nombre de otro
+// técnico o reasignarlas; solo AbilityViewAll lo permite
+func (uc *UseCase) canAssignOthers(ctx context.Context) bool {
+	return uc.abilityChecker != nil && uc.abilityChecker(ctx, AbilityViewAll)
+}
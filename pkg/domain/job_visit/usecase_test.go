@@ -0,0 +1,330 @@
+package job_visit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testDeps struct {
+	repo        *MockRepository
+	jobChecker  *MockChecker
+	userChecker *MockChecker
+	roles       *MockRoleProvider
+}
+
+func newTestUseCase(userID int64, viewAll bool) (*UseCase, *testDeps) {
+	deps := &testDeps{
+		repo:        new(MockRepository),
+		jobChecker:  new(MockChecker),
+		userChecker: new(MockChecker),
+		roles:       new(MockRoleProvider),
+	}
+	resolver := func(ctx context.Context) (int64, bool) {
+		return userID, userID > 0
+	}
+	abilities := func(ctx context.Context, ability string) bool {
+		return viewAll && ability == AbilityViewAll
+	}
+	uc := NewUseCase(deps.repo, deps.jobChecker, deps.userChecker, deps.roles, resolver, abilities)
+	return uc, deps
+}
+
+func TestIsViewableBy(t *testing.T) {
+	tests := []struct {
+		name   string
+		visit  JobVisit
+		viewer *Viewer
+		want   bool
+	}{
+		{"no restriction", JobVisit{UserID: 1}, &Viewer{UserID: 2}, true},
+		{"author", JobVisit{UserID: 2, ViewableBy: []string{"5"}}, &Viewer{UserID: 2}, true},
+		{"view all", JobVisit{UserID: 1, ViewableBy: []string{"5"}}, &Viewer{UserID: 2, ViewAll: true}, true},
+		{"matching role id", JobVisit{UserID: 1, ViewableBy: []string{"3", "5"}}, &Viewer{UserID: 2, Roles: []string{"5", "technician"}}, true},
+		{"matching role name", JobVisit{UserID: 1, ViewableBy: []string{"office"}}, &Viewer{UserID: 2, Roles: []string{"4", "office"}}, true},
+		{"no matching role", JobVisit{UserID: 1, ViewableBy: []string{"3"}}, &Viewer{UserID: 2, Roles: []string{"5"}}, false},
+		{"nil viewer", JobVisit{UserID: 1}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.visit.IsViewableBy(tt.viewer))
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults user and date", func(t *testing.T) {
+		uc, deps := newTestUseCase(7, false)
+		visit := &JobVisit{JobID: 10}
+
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.userChecker.On("GetByID", ctx, int64(7)).Return(true, nil)
+		deps.repo.On("Create", ctx, visit).Return(nil)
+
+		err := uc.Create(ctx, visit)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), visit.UserID)
+		assert.False(t, visit.Date.IsZero())
+		deps.repo.AssertExpectations(t)
+	})
+
+	t.Run("other user is replaced by the author", func(t *testing.T) {
+		uc, deps := newTestUseCase(7, false)
+		visit := &JobVisit{JobID: 10, UserID: 8}
+
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.userChecker.On("GetByID", ctx, int64(7)).Return(true, nil)
+		deps.repo.On("Create", ctx, visit).Return(nil)
+
+		assert.NoError(t, uc.Create(ctx, visit))
+		assert.Equal(t, int64(7), visit.UserID)
+		deps.userChecker.AssertNotCalled(t, "GetByID", ctx, int64(8))
+	})
+
+	t.Run("view all can file for another user", func(t *testing.T) {
+		uc, deps := newTestUseCase(7, true)
+		visit := &JobVisit{JobID: 10, UserID: 8}
+
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.userChecker.On("GetByID", ctx, int64(8)).Return(true, nil)
+		deps.repo.On("Create", ctx, visit).Return(nil)
+
+		assert.NoError(t, uc.Create(ctx, visit))
+		assert.Equal(t, int64(8), visit.UserID)
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, deps := newTestUseCase(7, false)
+
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, &JobVisit{JobID: 10})
+
+		assert.Equal(t, ErrInvalidJob, err)
+		deps.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing user", func(t *testing.T) {
+		uc, _ := newTestUseCase(0, false)
+
+		err := uc.Create(ctx, &JobVisit{JobID: 10})
+
+		assert.Error(t, err)
+		assert.Equal(t, "user_id is required", err.Error())
+	})
+}
+
+func TestGetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("viewable through role", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+		visit := &JobVisit{ID: 1, JobID: 10, UserID: 1, ViewableBy: []string{"5"}}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(visit, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.roles.On("GetUserRoleKeys", ctx, int64(2)).Return([]string{"5", "technician"}, nil)
+
+		result, err := uc.GetByID(ctx, 10, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, visit, result)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+		visit := &JobVisit{ID: 1, JobID: 10, UserID: 1, ViewableBy: []string{"3"}}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(visit, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.roles.On("GetUserRoleKeys", ctx, int64(2)).Return([]string{"5"}, nil)
+
+		result, err := uc.GetByID(ctx, 10, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, ErrVisitForbidden, err)
+	})
+
+	t.Run("view all skips role lookup", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, true)
+		visit := &JobVisit{ID: 1, JobID: 10, UserID: 1, ViewableBy: []string{"3"}}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(visit, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		result, err := uc.GetByID(ctx, 10, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, visit, result)
+		deps.roles.AssertNotCalled(t, "GetUserRoleKeys", mock.Anything, mock.Anything)
+	})
+
+	t.Run("belongs to another job", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(&JobVisit{ID: 1, JobID: 11, UserID: 1}, nil)
+
+		result, err := uc.GetByID(ctx, 10, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, ErrVisitNotFound, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("author can update", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+		existing := &JobVisit{ID: 1, JobID: 10, UserID: 2}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.repo.On("Update", ctx, mock.Anything).Return(nil)
+
+		err := uc.Update(ctx, &JobVisit{ID: 1, JobID: 10})
+
+		assert.NoError(t, err)
+		deps.repo.AssertCalled(t, "Update", ctx, mock.Anything)
+	})
+
+	t.Run("non-author is rejected", func(t *testing.T) {
+		uc, deps := newTestUseCase(3, false)
+		existing := &JobVisit{ID: 1, JobID: 10, UserID: 2}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		err := uc.Update(ctx, &JobVisit{ID: 1, JobID: 10})
+
+		assert.Equal(t, ErrVisitNotEditable, err)
+		deps.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("author cannot hand the visit to another user", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+		existing := &JobVisit{ID: 1, JobID: 10, UserID: 2}
+		visit := &JobVisit{ID: 1, JobID: 10, UserID: 8}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.repo.On("Update", ctx, visit).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, visit))
+		assert.Equal(t, int64(2), visit.UserID)
+		deps.userChecker.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("view all can reassign the visit", func(t *testing.T) {
+		uc, deps := newTestUseCase(3, true)
+		existing := &JobVisit{ID: 1, JobID: 10, UserID: 2}
+		visit := &JobVisit{ID: 1, JobID: 10, UserID: 8}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.userChecker.On("GetByID", ctx, int64(8)).Return(true, nil)
+		deps.repo.On("Update", ctx, visit).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, visit))
+		assert.Equal(t, int64(8), visit.UserID)
+	})
+
+	t.Run("view all can update any visit", func(t *testing.T) {
+		uc, deps := newTestUseCase(3, true)
+		existing := &JobVisit{ID: 1, JobID: 10, UserID: 2}
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(existing, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.repo.On("Update", ctx, mock.Anything).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, &JobVisit{ID: 1, JobID: 10}))
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("author can delete", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(&JobVisit{ID: 1, JobID: 10, UserID: 2}, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.repo.On("Delete", ctx, int64(1)).Return(nil)
+
+		assert.NoError(t, uc.Delete(ctx, 10, 1))
+	})
+
+	t.Run("non-author is rejected", func(t *testing.T) {
+		uc, deps := newTestUseCase(3, false)
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(&JobVisit{ID: 1, JobID: 10, UserID: 2}, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		assert.Equal(t, ErrVisitNotEditable, uc.Delete(ctx, 10, 1))
+		deps.repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without authenticated user", func(t *testing.T) {
+		uc, deps := newTestUseCase(0, false)
+
+		deps.repo.On("GetByID", ctx, int64(1)).Return(&JobVisit{ID: 1, JobID: 10, UserID: 2}, nil)
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		assert.Equal(t, ErrUserRequired, uc.Delete(ctx, 10, 1))
+	})
+}
+
+func TestListByJobID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("passes viewer to repository", func(t *testing.T) {
+		uc, deps := newTestUseCase(2, false)
+		filters := map[string]interface{}{}
+		expected := &Viewer{UserID: 2, Roles: []string{"5"}}
+
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+		deps.roles.On("GetUserRoleKeys", ctx, int64(2)).Return([]string{"5"}, nil)
+		deps.repo.On("ListByJobID", ctx, int64(10), expected, filters, 1, 10).
+			Return([]*JobVisit{{ID: 1}}, 1, nil)
+
+		visits, total, err := uc.ListByJobID(ctx, 10, filters, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, visits, 1)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("requires authenticated user", func(t *testing.T) {
+		uc, deps := newTestUseCase(0, false)
+
+		deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		_, _, err := uc.ListByJobID(ctx, 10, nil, 1, 10)
+
+		assert.Equal(t, ErrUserRequired, err)
+	})
+}
+
+func TestGetReport(t *testing.T) {
+	ctx := context.Background()
+
+	uc, deps := newTestUseCase(1, false)
+	visit := &JobVisit{ID: 1, JobID: 10, UserID: 1}
+
+	deps.repo.On("GetByID", ctx, int64(1)).Return(visit, nil)
+	deps.jobChecker.On("GetByID", ctx, int64(10)).Return(true, nil)
+	deps.repo.On("GetReportData", ctx, int64(10)).Return(&ReportData{Street: "1 Main St"}, nil)
+
+	data, err := uc.GetReport(ctx, 10, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, visit, data.Visit)
+	assert.Equal(t, "1 Main St", data.Street)
+}
@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+	domainSettings "github.com/your-org/jvairv2/pkg/domain/settings"
+)
+
+// ErrSMSDisabled indica que Twilio no está habilitado al momento de entregar un SMS
+var ErrSMSDisabled = errors.New("sms sending is not enabled")
+
+// EmailDeliverer entrega los correos de la cola con un mail.Sender
+type EmailDeliverer struct {
+	sender mail.Sender
+}
+
+// NewEmailDeliverer crea un nuevo deliverer de correos
+func NewEmailDeliverer(sender mail.Sender) *EmailDeliverer {
+	return &EmailDeliverer{sender: sender}
+}
+
+// Deliver decodifica y envía el correo
+func (d *EmailDeliverer) Deliver(ctx context.Context, msg *Message) (string, error) {
+	var m mail.Message
+	if err := json.Unmarshal(msg.Payload, &m); err != nil {
+		return "", Permanent(err)
+	}
+	if err := m.Validate(); err != nil {
+		return "", Permanent(err)
+	}
+	return "", d.sender.Send(ctx, &m)
+}
+
+// SettingsProvider obtiene la configuración del sistema (credenciales de Twilio)
+type SettingsProvider interface {
+	Get(ctx context.Context) (*domainSettings.Settings, error)
+}
+
+// SMSDeliverer entrega los SMS de la cola con las credenciales de Twilio vigentes
+type SMSDeliverer struct {
+	settings SettingsProvider
+	factory  func(config sms.TwilioConfig) sms.Sender
+}
+
+// NewSMSDeliverer crea un nuevo deliverer de SMS. Si factory es nil se usa la API REST de Twilio.
+func NewSMSDeliverer(settings SettingsProvider, factory func(config sms.TwilioConfig) sms.Sender) *SMSDeliverer {
+	if factory == nil {
+		factory = func(config sms.TwilioConfig) sms.Sender {
+			return sms.NewTwilioSender(config, nil)
+		}
+	}
+	return &SMSDeliverer{settings: settings, factory: factory}
+}
+
+// Deliver decodifica y envía el SMS
+func (d *SMSDeliverer) Deliver(ctx context.Context, msg *Message) (string, error) {
+	var m sms.Message
+	if err := json.Unmarshal(msg.Payload, &m); err != nil {
+		return "", Permanent(err)
+	}
+
+	s, err := d.settings.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !s.IsTwilioEnabled || s.TwilioSID == nil || s.TwilioAuthToken == nil || s.TwilioFromNumber == nil ||
+		*s.TwilioSID == "" || *s.TwilioAuthToken == "" || *s.TwilioFromNumber == "" {
+		return "", ErrSMSDisabled
+	}
+
+	return d.factory(sms.TwilioConfig{
+		AccountSID: *s.TwilioSID,
+		AuthToken:  *s.TwilioAuthToken,
+		From:       *s.TwilioFromNumber,
+	}).Send(ctx, m)
+}
@@ -0,0 +1,59 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+)
+
+// EnqueueEmail valida y encola un correo para entregarse en segundo plano
+func (uc *UseCase) EnqueueEmail(ctx context.Context, kind string, referenceID *int64, msg *mail.Message) (*Message, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.enqueue(ctx, ChannelEmail, kind, referenceID, msg)
+}
+
+// EnqueueSMS valida y encola un SMS para entregarse en segundo plano
+func (uc *UseCase) EnqueueSMS(ctx context.Context, kind string, referenceID *int64, msg sms.Message) (*Message, error) {
+	if strings.TrimSpace(msg.To) == "" || strings.TrimSpace(msg.Body) == "" {
+		return nil, sms.ErrInvalidMessage
+	}
+	return uc.enqueue(ctx, ChannelSMS, kind, referenceID, msg)
+}
+
+func (uc *UseCase) enqueue(ctx context.Context, channel, kind string, referenceID *int64, payload interface{}) (*Message, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &Message{
+		Channel:       channel,
+		Kind:          kind,
+		ReferenceID:   referenceID,
+		Payload:       data,
+		Status:        StatusPending,
+		MaxAttempts:   uc.maxAttempts,
+		NextAttemptAt: uc.now(),
+	}
+
+	if err := uc.repo.Create(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "Failed to enqueue outbox message",
+			slog.String("channel", channel),
+			slog.String("kind", kind),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Outbox message enqueued",
+		slog.Int64("id", msg.ID),
+		slog.String("channel", channel),
+		slog.String("kind", kind))
+
+	return msg, nil
+}
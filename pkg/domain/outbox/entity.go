@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Canales de entrega
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+// Estados de un mensaje
+const (
+	StatusPending    = "pending"
+	StatusProcessing = "processing"
+	StatusSent       = "sent"
+	StatusDead       = "dead"
+)
+
+// DefaultMaxAttempts es el número de intentos antes de pasar un mensaje a dead
+const DefaultMaxAttempts = 5
+
+// Message es un mensaje saliente persistido en la cola
+type Message struct {
+	ID      int64  `json:"id"`
+	Channel string `json:"channel"`
+	// Kind identifica el origen del mensaje (p. ej. job_dispatch, paystub)
+	Kind string `json:"kind"`
+	// ReferenceID es el ID de la entidad de origen, si aplica
+	ReferenceID   *int64          `json:"referenceId,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	MaxAttempts   int             `json:"maxAttempts"`
+	NextAttemptAt time.Time       `json:"nextAttemptAt"`
+	LockedUntil   *time.Time      `json:"lockedUntil,omitempty"`
+	LastError     *string         `json:"lastError,omitempty"`
+	ProviderID    *string         `json:"providerId,omitempty"`
+	SentAt        *time.Time      `json:"sentAt,omitempty"`
+	CreatedAt     *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time      `json:"updatedAt,omitempty"`
+}
+
+// Backoff calcula la espera antes del siguiente intento: base * 2^(attempt-1), limitada a max
+func Backoff(base, max time.Duration, attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
@@ -0,0 +1,44 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMessageNotFound indica que el mensaje no existe
+	ErrMessageNotFound = errors.New("outbox message not found")
+
+	// ErrNotDead indica que solo se pueden reintentar mensajes en estado dead
+	ErrNotDead = errors.New("only dead messages can be retried")
+
+	// ErrInvalidStatus indica que el filtro de estado no es válido
+	ErrInvalidStatus = errors.New("invalid status")
+)
+
+// permanentError marca un error de entrega que no debe reintentarse
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marca err como definitivo: el mensaje pasa a dead sin más intentos
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent indica si el error fue marcado con Permanent
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// errNoDeliverer se usa cuando no hay deliverer registrado para el canal
+func errNoDeliverer(channel string) error {
+	return Permanent(fmt.Errorf("no deliverer registered for channel %q", channel))
+}
@@ -0,0 +1,12 @@
+package outbox
+
+import "context"
+
+// GetByID obtiene un mensaje de la cola por su ID
+func (uc *UseCase) GetByID(ctx context.Context, id int64) (*Message, error) {
+	msg, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
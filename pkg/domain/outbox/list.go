@@ -0,0 +1,26 @@
+package outbox
+
+import "context"
+
+// List obtiene una lista paginada de mensajes de la cola
+func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Message, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	if status, ok := filters["status"].(string); ok {
+		switch status {
+		case StatusPending, StatusProcessing, StatusSent, StatusDead:
+		default:
+			return nil, 0, ErrInvalidStatus
+		}
+	}
+
+	return uc.repo.List(ctx, filters, page, pageSize)
+}
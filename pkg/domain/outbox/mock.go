@@ -0,0 +1,109 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+)
+
+// MockRepository es un mock del repositorio de la cola
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, msg *Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*Message, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Message), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Message, int, error) {
+	args := m.Called(ctx, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*Message), args.Int(1), args.Error(2)
+}
+
+func (m *MockRepository) Claim(ctx context.Context, now, lockUntil time.Time, limit int) ([]*Message, error) {
+	args := m.Called(ctx, now, lockUntil, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Message), args.Error(1)
+}
+
+func (m *MockRepository) MarkSent(ctx context.Context, id int64, providerID string, sentAt time.Time) error {
+	args := m.Called(ctx, id, providerID, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	args := m.Called(ctx, id, nextAttemptAt, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Requeue(ctx context.Context, id int64, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, nextAttemptAt)
+	return args.Error(0)
+}
+
+// MockService es un mock del servicio de la cola
+type MockService struct {
+	mock.Mock
+}
+
+func (m *MockService) EnqueueEmail(ctx context.Context, kind string, referenceID *int64, msg *mail.Message) (*Message, error) {
+	args := m.Called(ctx, kind, referenceID, msg)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Message), args.Error(1)
+}
+
+func (m *MockService) EnqueueSMS(ctx context.Context, kind string, referenceID *int64, msg sms.Message) (*Message, error) {
+	args := m.Called(ctx, kind, referenceID, msg)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Message), args.Error(1)
+}
+
+func (m *MockService) GetByID(ctx context.Context, id int64) (*Message, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Message), args.Error(1)
+}
+
+func (m *MockService) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Message, int, error) {
+	args := m.Called(ctx, filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*Message), args.Int(1), args.Error(2)
+}
+
+func (m *MockService) Retry(ctx context.Context, id int64) (*Message, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Message), args.Error(1)
+}
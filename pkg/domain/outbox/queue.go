@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+)
+
+// MailQueue implementa mail.Sender encolando los correos en lugar de enviarlos.
+// Permite que los casos de uso existentes envíen en segundo plano sin cambios.
+type MailQueue struct {
+	service Service
+	kind    string
+}
+
+// NewMailQueue crea un mail.Sender que encola los correos con el kind indicado
+func NewMailQueue(service Service, kind string) *MailQueue {
+	return &MailQueue{service: service, kind: kind}
+}
+
+// Send encola el correo
+func (q *MailQueue) Send(ctx context.Context, msg *mail.Message) error {
+	_, err := q.service.EnqueueEmail(ctx, q.kind, nil, msg)
+	return err
+}
+
+// SMSQueue implementa sms.Sender encolando los mensajes en lugar de enviarlos
+type SMSQueue struct {
+	service Service
+	kind    string
+}
+
+// NewSMSQueue crea un sms.Sender que encola los mensajes con el kind indicado
+func NewSMSQueue(service Service, kind string) *SMSQueue {
+	return &SMSQueue{service: service, kind: kind}
+}
+
+// Send encola el mensaje y retorna el ID del mensaje en la cola
+func (q *SMSQueue) Send(ctx context.Context, msg sms.Message) (string, error) {
+	queued, err := q.service.EnqueueSMS(ctx, q.kind, nil, msg)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("outbox:%d", queued.ID), nil
+}
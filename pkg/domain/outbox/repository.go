@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Repository define los métodos para interactuar con la cola de mensajes
+type Repository interface {
+	// Create encola un mensaje
+	Create(ctx context.Context, msg *Message) error
+	// GetByID obtiene un mensaje por su ID
+	GetByID(ctx context.Context, id int64) (*Message, error)
+	// List obtiene una lista paginada de mensajes con filtros opcionales
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Message, int, error)
+	// Claim toma hasta limit mensajes listos para enviarse (pendientes vencidos o en proceso
+	// con bloqueo expirado), los marca como processing hasta lockUntil e incrementa sus intentos
+	Claim(ctx context.Context, now, lockUntil time.Time, limit int) ([]*Message, error)
+	// MarkSent marca el mensaje como entregado
+	MarkSent(ctx context.Context, id int64, providerID string, sentAt time.Time) error
+	// MarkRetry devuelve el mensaje a pending para reintentarse en nextAttemptAt
+	MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	// MarkDead marca el mensaje como dead (sin más intentos)
+	MarkDead(ctx context.Context, id int64, lastError string) error
+	// Requeue devuelve un mensaje dead a pending con sus intentos reiniciados
+	Requeue(ctx context.Context, id int64, nextAttemptAt time.Time) error
+}
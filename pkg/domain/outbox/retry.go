@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Retry devuelve un mensaje dead a la cola con sus intentos reiniciados
+func (uc *UseCase) Retry(ctx context.Context, id int64) (*Message, error) {
+	msg, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Status != StatusDead {
+		return nil, ErrNotDead
+	}
+
+	if err := uc.repo.Requeue(ctx, id, uc.now()); err != nil {
+		slog.ErrorContext(ctx, "Failed to requeue outbox message",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Outbox message requeued", slog.Int64("id", id))
+
+	return uc.repo.GetByID(ctx, id)
+}
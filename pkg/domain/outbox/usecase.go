@@ -0,0 +1,38 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+)
+
+// Service define la interfaz del servicio de la cola de mensajes salientes
+type Service interface {
+	EnqueueEmail(ctx context.Context, kind string, referenceID *int64, msg *mail.Message) (*Message, error)
+	EnqueueSMS(ctx context.Context, kind string, referenceID *int64, msg sms.Message) (*Message, error)
+	GetByID(ctx context.Context, id int64) (*Message, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Message, int, error)
+	Retry(ctx context.Context, id int64) (*Message, error)
+}
+
+// UseCase implementa la lógica de negocio de la cola de mensajes salientes
+type UseCase struct {
+	repo        Repository
+	maxAttempts int
+	now         func() time.Time
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de la cola.
+// Si maxAttempts es 0 se usa DefaultMaxAttempts.
+func NewUseCase(repo Repository, maxAttempts int) *UseCase {
+	if maxAttempts < 1 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	return &UseCase{
+		repo:        repo,
+		maxAttempts: maxAttempts,
+		now:         time.Now,
+	}
+}
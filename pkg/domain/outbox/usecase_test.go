@@ -0,0 +1,328 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/sms"
+	domainSettings "github.com/your-org/jvairv2/pkg/domain/settings"
+)
+
+var fixedNow = time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+func strPtr(s string) *string { return &s }
+
+// delivererFunc adapta una función a Deliverer
+type delivererFunc func(ctx context.Context, msg *Message) (string, error)
+
+func (f delivererFunc) Deliver(ctx context.Context, msg *Message) (string, error) {
+	return f(ctx, msg)
+}
+
+func TestBackoff(t *testing.T) {
+	base, max := 30*time.Second, 10*time.Minute
+	assert.Equal(t, 30*time.Second, Backoff(base, max, 0))
+	assert.Equal(t, 30*time.Second, Backoff(base, max, 1))
+	assert.Equal(t, 60*time.Second, Backoff(base, max, 2))
+	assert.Equal(t, 4*time.Minute, Backoff(base, max, 4))
+	assert.Equal(t, 10*time.Minute, Backoff(base, max, 6))
+	assert.Equal(t, 10*time.Minute, Backoff(base, max, 60))
+}
+
+func TestEnqueueEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, 0)
+		uc.now = func() time.Time { return fixedNow }
+
+		repo.On("Create", ctx, mock.AnythingOfType("*outbox.Message")).Run(func(args mock.Arguments) {
+			args.Get(1).(*Message).ID = 9
+		}).Return(nil)
+
+		ref := int64(4)
+		msg, err := uc.EnqueueEmail(ctx, "paystub", &ref, &mail.Message{To: []string{"a@example.com"}, Subject: "Hi", Body: "Body"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), msg.ID)
+		assert.Equal(t, ChannelEmail, msg.Channel)
+		assert.Equal(t, StatusPending, msg.Status)
+		assert.Equal(t, DefaultMaxAttempts, msg.MaxAttempts)
+		assert.Equal(t, fixedNow, msg.NextAttemptAt)
+		assert.Equal(t, &ref, msg.ReferenceID)
+
+		var payload mail.Message
+		assert.NoError(t, json.Unmarshal(msg.Payload, &payload))
+		assert.Equal(t, "Hi", payload.Subject)
+	})
+
+	t.Run("invalid recipients are rejected", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, 3)
+
+		_, err := uc.EnqueueEmail(ctx, "job_dispatch", nil, &mail.Message{To: []string{"nope"}})
+
+		assert.ErrorIs(t, err, mail.ErrInvalidAddress)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEnqueueSMS(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo, 3)
+
+	_, err := uc.EnqueueSMS(ctx, "job_dispatch", nil, sms.Message{To: "+15551112222"})
+	assert.Equal(t, sms.ErrInvalidMessage, err)
+
+	repo.On("Create", ctx, mock.AnythingOfType("*outbox.Message")).Return(nil)
+	msg, err := uc.EnqueueSMS(ctx, "job_dispatch", nil, sms.Message{To: "+15551112222", Body: "Hi"})
+	assert.NoError(t, err)
+	assert.Equal(t, ChannelSMS, msg.Channel)
+	assert.Equal(t, 3, msg.MaxAttempts)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo, 0)
+
+	_, _, err := uc.List(ctx, map[string]interface{}{"status": "lost"}, 1, 10)
+	assert.Equal(t, ErrInvalidStatus, err)
+
+	filters := map[string]interface{}{"status": StatusDead}
+	repo.On("List", ctx, filters, 1, 100).Return([]*Message{{ID: 1}}, 1, nil)
+	items, total, err := uc.List(ctx, filters, 0, 500)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Len(t, items, 1)
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requeues dead message", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, 0)
+		uc.now = func() time.Time { return fixedNow }
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Message{ID: 1, Status: StatusDead}, nil).Once()
+		repo.On("Requeue", ctx, int64(1), fixedNow).Return(nil)
+		repo.On("GetByID", ctx, int64(1)).Return(&Message{ID: 1, Status: StatusPending}, nil).Once()
+
+		msg, err := uc.Retry(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPending, msg.Status)
+	})
+
+	t.Run("rejects messages that are not dead", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, 0)
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Message{ID: 1, Status: StatusSent}, nil)
+
+		_, err := uc.Retry(ctx, 1)
+
+		assert.Equal(t, ErrNotDead, err)
+		repo.AssertNotCalled(t, "Requeue", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func newTestWorker(repo *MockRepository) *Worker {
+	w := NewWorker(repo, WorkerConfig{BaseBackoff: time.Minute, MaxBackoff: time.Hour})
+	w.now = func() time.Time { return fixedNow }
+	return w
+}
+
+func TestWorkerProcess(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sent", func(t *testing.T) {
+		repo := new(MockRepository)
+		w := newTestWorker(repo)
+		w.Register(ChannelSMS, delivererFunc(func(ctx context.Context, msg *Message) (string, error) {
+			return "SM1", nil
+		}))
+		var hooked *Message
+		w.OnResult("job_dispatch", func(ctx context.Context, msg *Message) { hooked = msg })
+		repo.On("MarkSent", mock.Anything, int64(1), "SM1", fixedNow).Return(nil)
+
+		w.process(ctx, &Message{ID: 1, Channel: ChannelSMS, Kind: "job_dispatch", Attempts: 1, MaxAttempts: 5})
+
+		repo.AssertExpectations(t)
+		assert.Equal(t, StatusSent, hooked.Status)
+		assert.Equal(t, "SM1", *hooked.ProviderID)
+	})
+
+	t.Run("transient failure retries with backoff", func(t *testing.T) {
+		repo := new(MockRepository)
+		w := newTestWorker(repo)
+		w.Register(ChannelEmail, delivererFunc(func(ctx context.Context, msg *Message) (string, error) {
+			return "", errors.New("timeout")
+		}))
+		repo.On("MarkRetry", mock.Anything, int64(1), fixedNow.Add(4*time.Minute), "timeout").Return(nil)
+
+		msg := &Message{ID: 1, Channel: ChannelEmail, Attempts: 3, MaxAttempts: 5}
+		w.process(ctx, msg)
+
+		repo.AssertExpectations(t)
+		assert.Equal(t, StatusPending, msg.Status)
+	})
+
+	t.Run("last attempt moves to dead letter", func(t *testing.T) {
+		repo := new(MockRepository)
+		w := newTestWorker(repo)
+		w.Register(ChannelEmail, delivererFunc(func(ctx context.Context, msg *Message) (string, error) {
+			return "", errors.New("timeout")
+		}))
+		repo.On("MarkDead", mock.Anything, int64(1), "timeout").Return(nil)
+
+		msg := &Message{ID: 1, Channel: ChannelEmail, Attempts: 5, MaxAttempts: 5}
+		w.process(ctx, msg)
+
+		repo.AssertExpectations(t)
+		assert.Equal(t, StatusDead, msg.Status)
+	})
+
+	t.Run("permanent failure skips retries", func(t *testing.T) {
+		repo := new(MockRepository)
+		w := newTestWorker(repo)
+		repo.On("MarkDead", mock.Anything, int64(1), `no deliverer registered for channel "fax"`).Return(nil)
+
+		w.process(ctx, &Message{ID: 1, Channel: "fax", Attempts: 1, MaxAttempts: 5})
+
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestWorkerStartStop(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	w := NewWorker(repo, WorkerConfig{Concurrency: 2, PollInterval: 10 * time.Millisecond})
+
+	var mu sync.Mutex
+	var delivered []int64
+	release := make(chan struct{})
+	w.Register(ChannelEmail, delivererFunc(func(ctx context.Context, msg *Message) (string, error) {
+		<-release
+		mu.Lock()
+		delivered = append(delivered, msg.ID)
+		mu.Unlock()
+		return "", nil
+	}))
+
+	claimed := make(chan struct{})
+	repo.On("Claim", mock.Anything, mock.Anything, mock.Anything, 2).Return([]*Message{
+		{ID: 1, Channel: ChannelEmail, Attempts: 1, MaxAttempts: 5},
+	}, nil).Run(func(mock.Arguments) { close(claimed) }).Once()
+	repo.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	repo.On("MarkSent", mock.Anything, int64(1), "", mock.Anything).Return(nil)
+
+	w.Start(ctx)
+	<-claimed
+
+	// Stop espera a la entrega en curso
+	stopped := make(chan error)
+	go func() { stopped <- w.Stop(ctx) }()
+	close(release)
+
+	assert.NoError(t, <-stopped)
+	assert.Equal(t, []int64{1}, delivered)
+	repo.AssertCalled(t, "MarkSent", mock.Anything, int64(1), "", mock.Anything)
+}
+
+func TestWorkerStopTimeout(t *testing.T) {
+	repo := new(MockRepository)
+	w := NewWorker(repo, WorkerConfig{Concurrency: 1, PollInterval: 10 * time.Millisecond})
+
+	claimed := make(chan struct{})
+	w.Register(ChannelEmail, delivererFunc(func(ctx context.Context, msg *Message) (string, error) {
+		close(claimed)
+		<-ctx.Done()
+		return "", ctx.Err()
+	}))
+	repo.On("Claim", mock.Anything, mock.Anything, mock.Anything, 1).Return([]*Message{
+		{ID: 1, Channel: ChannelEmail, Attempts: 1, MaxAttempts: 5},
+	}, nil).Once()
+	repo.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	repo.On("MarkRetry", mock.Anything, int64(1), mock.Anything, "context canceled").Return(nil)
+
+	w.Start(context.Background())
+	<-claimed
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, w.Stop(ctx), context.DeadlineExceeded)
+}
+
+func TestSMSDeliverer(t *testing.T) {
+	ctx := context.Background()
+	payload, _ := json.Marshal(sms.Message{To: "+15551112222", Body: "Hi"})
+	msg := &Message{ID: 1, Channel: ChannelSMS, Payload: payload}
+
+	t.Run("sends with current settings", func(t *testing.T) {
+		settings := new(mockSettings)
+		settings.On("Get", ctx).Return(&domainSettings.Settings{
+			IsTwilioEnabled: true, TwilioSID: strPtr("AC1"), TwilioAuthToken: strPtr("tok"), TwilioFromNumber: strPtr("+1555"),
+		}, nil)
+		fake := sms.NewFakeSender()
+		var config sms.TwilioConfig
+		d := NewSMSDeliverer(settings, func(c sms.TwilioConfig) sms.Sender { config = c; return fake })
+
+		id, err := d.Deliver(ctx, msg)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "FAKE1", id)
+		assert.Equal(t, "AC1", config.AccountSID)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		settings := new(mockSettings)
+		settings.On("Get", ctx).Return(&domainSettings.Settings{}, nil)
+		d := NewSMSDeliverer(settings, nil)
+
+		_, err := d.Deliver(ctx, msg)
+
+		assert.Equal(t, ErrSMSDisabled, err)
+	})
+
+	t.Run("bad payload is permanent", func(t *testing.T) {
+		d := NewSMSDeliverer(new(mockSettings), nil)
+		_, err := d.Deliver(ctx, &Message{Payload: []byte("{")})
+		assert.True(t, IsPermanent(err))
+	})
+}
+
+func TestQueues(t *testing.T) {
+	ctx := context.Background()
+	svc := new(MockService)
+	svc.On("EnqueueSMS", ctx, "job_dispatch", (*int64)(nil), sms.Message{To: "+1", Body: "x"}).Return(&Message{ID: 12}, nil)
+	svc.On("EnqueueEmail", ctx, "job_dispatch", (*int64)(nil), mock.AnythingOfType("*mail.Message")).Return(&Message{ID: 13}, nil)
+
+	id, err := NewSMSQueue(svc, "job_dispatch").Send(ctx, sms.Message{To: "+1", Body: "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, "outbox:12", id)
+
+	assert.NoError(t, NewMailQueue(svc, "job_dispatch").Send(ctx, &mail.Message{To: []string{"a@example.com"}}))
+}
+
+type mockSettings struct {
+	mock.Mock
+}
+
+func (m *mockSettings) Get(ctx context.Context) (*domainSettings.Settings, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainSettings.Settings), args.Error(1)
+}
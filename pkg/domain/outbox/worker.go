@@ -0,0 +1,240 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Deliverer entrega los mensajes de un canal. Retorna el identificador del proveedor
+// (si lo hay). Los errores marcados con Permanent no se reintentan.
+type Deliverer interface {
+	Deliver(ctx context.Context, msg *Message) (string, error)
+}
+
+// ResultHook recibe el mensaje tras cada intento de entrega, con Status, Attempts y
+// LastError actualizados (sent, pending si se reintentará o dead)
+type ResultHook func(ctx context.Context, msg *Message)
+
+// WorkerConfig configura el worker de la cola
+type WorkerConfig struct {
+	// Concurrency es el número máximo de entregas simultáneas
+	Concurrency int
+	// PollInterval es la espera entre consultas cuando no hay mensajes listos
+	PollInterval time.Duration
+	// DeliveryTimeout limita cada entrega; también define cuánto dura el bloqueo del mensaje
+	DeliveryTimeout time.Duration
+	// BaseBackoff y MaxBackoff definen la espera exponencial entre intentos
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+func (c *WorkerConfig) setDefaults() {
+	if c.Concurrency < 1 {
+		c.Concurrency = 4
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = 2 * time.Second
+	}
+	if c.DeliveryTimeout <= 0 {
+		c.DeliveryTimeout = 30 * time.Second
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = 30 * time.Second
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = time.Hour
+	}
+}
+
+// Worker procesa la cola en segundo plano con un número limitado de entregas simultáneas
+type Worker struct {
+	repo       Repository
+	config     WorkerConfig
+	deliverers map[string]Deliverer
+	hooks      map[string][]ResultHook
+	now        func() time.Time
+
+	mu       sync.Mutex
+	stop     chan struct{}
+	done     chan struct{}
+	inFlight sync.WaitGroup
+	cancel   context.CancelFunc
+}
+
+// NewWorker crea un nuevo worker de la cola
+func NewWorker(repo Repository, config WorkerConfig) *Worker {
+	config.setDefaults()
+	return &Worker{
+		repo:       repo,
+		config:     config,
+		deliverers: make(map[string]Deliverer),
+		hooks:      make(map[string][]ResultHook),
+		now:        time.Now,
+	}
+}
+
+// Register asigna el deliverer de un canal. Debe llamarse antes de Start.
+func (w *Worker) Register(channel string, d Deliverer) {
+	w.deliverers[channel] = d
+}
+
+// OnResult registra un hook para los mensajes de un kind. Debe llamarse antes de Start.
+func (w *Worker) OnResult(kind string, hook ResultHook) {
+	w.hooks[kind] = append(w.hooks[kind], hook)
+}
+
+// Start inicia el procesamiento en segundo plano. Las entregas en curso no se
+// cancelan al cancelar ctx; para detener el worker se usa Stop.
+func (w *Worker) Start(ctx context.Context) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.stop != nil {
+		return
+	}
+
+	deliveryCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	w.cancel = cancel
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	go w.loop(deliveryCtx, w.stop, w.done)
+
+	slog.InfoContext(ctx, "Outbox worker started",
+		slog.Int("concurrency", w.config.Concurrency),
+		slog.Duration("pollInterval", w.config.PollInterval))
+}
+
+// Stop deja de tomar mensajes y espera a que terminen las entregas en curso.
+// Si ctx vence antes, cancela las entregas pendientes y retorna ctx.Err(); esos
+// mensajes se retoman cuando expira su bloqueo.
+func (w *Worker) Stop(ctx context.Context) error {
+	w.mu.Lock()
+	stop, done, cancel := w.stop, w.done, w.cancel
+	w.stop = nil
+	w.mu.Unlock()
+
+	if stop == nil {
+		return nil
+	}
+
+	close(stop)
+	defer cancel()
+
+	select {
+	case <-done:
+		slog.InfoContext(ctx, "Outbox worker stopped")
+		return nil
+	case <-ctx.Done():
+		slog.WarnContext(ctx, "Outbox worker stop timed out, cancelling in-flight deliveries")
+		return ctx.Err()
+	}
+}
+
+// loop toma mensajes mientras haya capacidad libre y los entrega en goroutines
+func (w *Worker) loop(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	defer w.inFlight.Wait()
+
+	slots := make(chan struct{}, w.config.Concurrency)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-timer.C:
+		}
+
+		claimed := 0
+		if free := cap(slots) - len(slots); free > 0 {
+			now := w.now()
+			messages, err := w.repo.Claim(ctx, now, now.Add(w.config.DeliveryTimeout), free)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to claim outbox messages",
+					slog.String("error", err.Error()))
+			}
+			for _, msg := range messages {
+				slots <- struct{}{}
+				w.inFlight.Add(1)
+				go func(msg *Message) {
+					defer w.inFlight.Done()
+					defer func() { <-slots }()
+					w.process(ctx, msg)
+				}(msg)
+			}
+			claimed = len(messages)
+		}
+
+		// Si se llenó la capacidad se consulta de nuevo enseguida; si no, se espera
+		wait := w.config.PollInterval
+		if claimed > 0 && claimed == cap(slots) {
+			wait = 50 * time.Millisecond
+		}
+		timer.Reset(wait)
+	}
+}
+
+// process entrega un mensaje tomado de la cola y registra el resultado
+func (w *Worker) process(ctx context.Context, msg *Message) {
+	var providerID string
+	var err error
+
+	if d, ok := w.deliverers[msg.Channel]; ok {
+		deliverCtx, cancel := context.WithTimeout(ctx, w.config.DeliveryTimeout)
+		providerID, err = d.Deliver(deliverCtx, msg)
+		cancel()
+	} else {
+		err = errNoDeliverer(msg.Channel)
+	}
+
+	// El resultado se guarda aunque la entrega se haya cancelado
+	saveCtx := context.WithoutCancel(ctx)
+	now := w.now()
+
+	switch {
+	case err == nil:
+		msg.Status = StatusSent
+		msg.SentAt = &now
+		msg.LastError = nil
+		if providerID != "" {
+			msg.ProviderID = &providerID
+		}
+		err = w.repo.MarkSent(saveCtx, msg.ID, providerID, now)
+	case IsPermanent(err) || msg.Attempts >= msg.MaxAttempts:
+		lastError := err.Error()
+		msg.Status = StatusDead
+		msg.LastError = &lastError
+		slog.WarnContext(ctx, "Outbox message moved to dead letter",
+			slog.Int64("id", msg.ID),
+			slog.String("kind", msg.Kind),
+			slog.Int("attempts", msg.Attempts),
+			slog.String("error", lastError))
+		err = w.repo.MarkDead(saveCtx, msg.ID, lastError)
+	default:
+		lastError := err.Error()
+		msg.Status = StatusPending
+		msg.LastError = &lastError
+		msg.NextAttemptAt = now.Add(Backoff(w.config.BaseBackoff, w.config.MaxBackoff, msg.Attempts))
+		slog.InfoContext(ctx, "Outbox delivery failed, will retry",
+			slog.Int64("id", msg.ID),
+			slog.String("kind", msg.Kind),
+			slog.Int("attempts", msg.Attempts),
+			slog.Time("nextAttemptAt", msg.NextAttemptAt),
+			slog.String("error", lastError))
+		err = w.repo.MarkRetry(saveCtx, msg.ID, msg.NextAttemptAt, lastError)
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save outbox delivery result",
+			slog.Int64("id", msg.ID),
+			slog.String("error", err.Error()))
+	}
+
+	for _, hook := range w.hooks[msg.Kind] {
+		hook(saveCtx, msg)
+	}
+}
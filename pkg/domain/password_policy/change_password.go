@@ -0,0 +1,65 @@
+package password_policy
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ChangePassword cambia la contraseña del usuario autenticado verificando la actual.
+// La antigüedad mínima no se aplica si la contraseña venció o un administrador exige el cambio.
+func (uc *UseCase) ChangePassword(ctx context.Context, req *ChangePasswordRequest) error {
+	userID, ok := uc.userResolver(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	if req.Password != req.PasswordConfirmation {
+		return ErrPasswordMismatch
+	}
+
+	u, err := uc.userRepo.GetByID(ctx, strconv.FormatInt(userID, 10))
+	if err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.CurrentPassword)) != nil {
+		return ErrInvalidCurrentPassword
+	}
+	if req.Password == req.CurrentPassword {
+		return ErrPasswordReused
+	}
+
+	status, err := uc.Status(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !status.Blocked() && status.Policy.MinAgeDays > 0 && status.ChangedAt != nil &&
+		uc.now().Before(status.ChangedAt.AddDate(0, 0, status.Policy.MinAgeDays)) {
+		return ErrPasswordTooRecent
+	}
+
+	if err := uc.Validate(ctx, userID, req.Password); err != nil {
+		return err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := uc.userRepo.UpdatePassword(ctx, userID, string(hashed)); err != nil {
+		slog.ErrorContext(ctx, "Failed to update password",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+	if err := uc.RecordOwnChange(ctx, userID, string(hashed)); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Password changed successfully",
+		slog.Int64("userId", userID))
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package password_policy
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/your-org/jvairv2/pkg/domain/settings"
+)
+
+// DefaultMinLength se usa cuando no existe la fila de settings
+const DefaultMinLength = 8
+
+// Policy contiene las reglas de contraseña configuradas en settings
+type Policy struct {
+	MinLength      int  `json:"minLength"`
+	RequireNumbers bool `json:"requireNumbers"`
+	RequireSymbols bool `json:"requireSymbols"`
+	// HistoryCount es el número de contraseñas anteriores que no se pueden reutilizar
+	HistoryCount int `json:"historyCount"`
+	// ExpireDays es la vigencia de la contraseña; solo aplica con EnforceExpiry
+	ExpireDays    int  `json:"expireDays"`
+	EnforceExpiry bool `json:"enforceExpiry"`
+	// MinAgeDays son los días que deben pasar antes de que el usuario vuelva a cambiarla
+	MinAgeDays int `json:"minAgeDays"`
+}
+
+// FromSettings construye la política a partir de la configuración del sistema
+func FromSettings(s *settings.Settings) Policy {
+	if s == nil {
+		return Policy{MinLength: DefaultMinLength}
+	}
+	return Policy{
+		MinLength:      s.PasswordMinimumLength,
+		RequireNumbers: s.PasswordIncludeNumbers,
+		RequireSymbols: s.PasswordIncludeSymbols,
+		HistoryCount:   s.PasswordHistoryCount,
+		ExpireDays:     s.PasswordExpireDays,
+		EnforceExpiry:  s.IsEnforceRoutinePasswordReset,
+		MinAgeDays:     s.PasswordAge,
+	}
+}
+
+// Check verifica longitud, números y símbolos. El historial se revisa aparte.
+func (p Policy) Check(password string) error {
+	if strings.TrimSpace(password) == "" {
+		return ErrPasswordRequired
+	}
+	if len([]rune(password)) < p.MinLength {
+		return &LengthError{MinLength: p.MinLength}
+	}
+
+	var hasNumber, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsDigit(r):
+			hasNumber = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireNumbers && !hasNumber {
+		return ErrNumberRequired
+	}
+	if p.RequireSymbols && !hasSymbol {
+		return ErrSymbolRequired
+	}
+	return nil
+}
+
+// ExpiresAt retorna cuándo vence una contraseña cambiada en changedAt, o nil si no vence
+func (p Policy) ExpiresAt(changedAt *time.Time) *time.Time {
+	if !p.EnforceExpiry || p.ExpireDays <= 0 || changedAt == nil {
+		return nil
+	}
+	expires := changedAt.AddDate(0, 0, p.ExpireDays)
+	return &expires
+}
+
+// State es la información de contraseña guardada para un usuario
+type State struct {
+	// MustChange refleja users.is_change_password (cambio exigido por un administrador)
+	MustChange bool
+	// ChangedAt es el último cambio registrado en password_history o, si no hay, el alta del usuario
+	ChangedAt *time.Time
+}
+
+// Status indica si el usuario debe cambiar la contraseña antes de usar la API
+type Status struct {
+	PasswordExpired    bool       `json:"passwordExpired"`
+	MustChangePassword bool       `json:"mustChangePassword"`
+	ChangedAt          *time.Time `json:"changedAt,omitempty"`
+	ExpiresAt          *time.Time `json:"expiresAt,omitempty"`
+	Policy             Policy     `json:"policy"`
+}
+
+// Blocked indica si la contraseña debe cambiarse antes de continuar
+func (s *Status) Blocked() bool {
+	return s.PasswordExpired || s.MustChangePassword
+}
+
+// ChangePasswordRequest representa el cambio de contraseña del propio usuario
+type ChangePasswordRequest struct {
+	CurrentPassword      string `json:"currentPassword" example:"contraseña-actual"`
+	Password             string `json:"password" example:"Nueva-contraseña-1"`
+	PasswordConfirmation string `json:"passwordConfirmation" example:"Nueva-contraseña-1"`
+}
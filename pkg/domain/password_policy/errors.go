@@ -0,0 +1,54 @@
+package password_policy
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrPasswordRequired indica que no se envió la contraseña
+	ErrPasswordRequired = errors.New("password is required")
+
+	// ErrNumberRequired indica que la política exige al menos un número
+	ErrNumberRequired = errors.New("password must include at least one number")
+
+	// ErrSymbolRequired indica que la política exige al menos un símbolo
+	ErrSymbolRequired = errors.New("password must include at least one symbol")
+
+	// ErrPasswordReused indica que la contraseña coincide con la actual o con una del historial
+	ErrPasswordReused = errors.New("password was used recently")
+
+	// ErrPasswordTooRecent indica que aún no pasa la antigüedad mínima para volver a cambiarla
+	ErrPasswordTooRecent = errors.New("password was changed too recently")
+
+	// ErrPasswordMismatch indica que la confirmación no coincide con la contraseña
+	ErrPasswordMismatch = errors.New("password confirmation does not match")
+
+	// ErrInvalidCurrentPassword indica que la contraseña actual no es correcta
+	ErrInvalidCurrentPassword = errors.New("current password is incorrect")
+
+	// ErrUnauthenticated indica que no hay un usuario autenticado en el contexto
+	ErrUnauthenticated = errors.New("unauthenticated")
+)
+
+// LengthError indica que la contraseña no alcanza la longitud mínima
+type LengthError struct {
+	MinLength int
+}
+
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("password must be at least %d characters", e.MinLength)
+}
+
+// IsViolation indica si err es un incumplimiento de la política, que se
+// informa al cliente (en lugar de un error interno)
+func IsViolation(err error) bool {
+	var lengthErr *LengthError
+	return errors.As(err, &lengthErr) ||
+		errors.Is(err, ErrPasswordRequired) ||
+		errors.Is(err, ErrNumberRequired) ||
+		errors.Is(err, ErrSymbolRequired) ||
+		errors.Is(err, ErrPasswordReused) ||
+		errors.Is(err, ErrPasswordTooRecent) ||
+		errors.Is(err, ErrPasswordMismatch)
+}
@@ -0,0 +1,52 @@
+package password_policy
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/domain/settings"
+)
+
+// MockRepository es un mock del repositorio de password_history
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListRecent(ctx context.Context, userID int64, limit int) ([]string, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRepository) Record(ctx context.Context, userID int64, hashedPassword string) error {
+	args := m.Called(ctx, userID, hashedPassword)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ClearMustChange(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetState(ctx context.Context, userID int64) (*State, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*State), args.Error(1)
+}
+
+// MockSettingsGetter es un mock de la lectura de settings
+type MockSettingsGetter struct {
+	mock.Mock
+}
+
+func (m *MockSettingsGetter) Get(ctx context.Context) (*settings.Settings, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*settings.Settings), args.Error(1)
+}
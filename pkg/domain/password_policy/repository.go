@@ -0,0 +1,18 @@
+package password_policy
+
+import "context"
+
+// Repository define las operaciones de persistencia de password_history
+type Repository interface {
+	// ListRecent retorna los hashes de las últimas contraseñas del usuario, de la más reciente a la más antigua
+	ListRecent(ctx context.Context, userID int64, limit int) ([]string, error)
+
+	// Record guarda la contraseña en el historial
+	Record(ctx context.Context, userID int64, hashedPassword string) error
+
+	// ClearMustChange limpia users.is_change_password
+	ClearMustChange(ctx context.Context, userID int64) error
+
+	// GetState obtiene el último cambio de contraseña y si un administrador exige cambiarla
+	GetState(ctx context.Context, userID int64) (*State, error)
+}
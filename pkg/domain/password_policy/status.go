@@ -0,0 +1,39 @@
+package password_policy
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Status calcula si la contraseña del usuario venció o si un administrador exige cambiarla
+func (uc *UseCase) Status(ctx context.Context, userID int64) (*Status, error) {
+	state, err := uc.repo.GetState(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get password state",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	policy := uc.Policy(ctx)
+	status := &Status{
+		MustChangePassword: state.MustChange,
+		ChangedAt:          state.ChangedAt,
+		ExpiresAt:          policy.ExpiresAt(state.ChangedAt),
+		Policy:             policy,
+	}
+	if status.ExpiresAt != nil && !uc.now().Before(*status.ExpiresAt) {
+		status.PasswordExpired = true
+	}
+
+	return status, nil
+}
+
+// CurrentStatus calcula el estado de la contraseña del usuario autenticado
+func (uc *UseCase) CurrentStatus(ctx context.Context) (*Status, error) {
+	userID, ok := uc.userResolver(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.Status(ctx, userID)
+}
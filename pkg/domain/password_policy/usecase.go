@@ -0,0 +1,58 @@
+package password_policy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/domain/settings"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+// Service define la interfaz del servicio de política de contraseñas
+type Service interface {
+	Policy(ctx context.Context) Policy
+	Validate(ctx context.Context, userID int64, password string) error
+	Record(ctx context.Context, userID int64, hashedPassword string) error
+	RecordOwnChange(ctx context.Context, userID int64, hashedPassword string) error
+	Status(ctx context.Context, userID int64) (*Status, error)
+	CurrentStatus(ctx context.Context) (*Status, error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest) error
+}
+
+// SettingsGetter obtiene la configuración del sistema donde se guarda la política
+type SettingsGetter interface {
+	Get(ctx context.Context) (*settings.Settings, error)
+}
+
+// PolicyTTL es el tiempo que se reutiliza la política leída de settings. El estado de la
+// contraseña se calcula en cada solicitud, así que se evita leer settings cada vez; un
+// cambio de la política tarda a lo sumo este tiempo en aplicarse.
+const PolicyTTL = time.Minute
+
+// UserIDResolver obtiene el ID del usuario autenticado
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// UseCase implementa la política de contraseñas
+type UseCase struct {
+	repo         Repository
+	settings     SettingsGetter
+	userRepo     user.Repository
+	userResolver UserIDResolver
+	now          func() time.Time
+
+	mu           sync.Mutex
+	policy       *Policy
+	policyExpiry time.Time
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de política de contraseñas
+func NewUseCase(repo Repository, settingsGetter SettingsGetter, userRepo user.Repository, userResolver UserIDResolver) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		settings:     settingsGetter,
+		userRepo:     userRepo,
+		userResolver: userResolver,
+		now:          time.Now,
+	}
+}
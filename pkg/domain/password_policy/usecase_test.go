@@ -0,0 +1,304 @@
+package password_policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/domain/settings"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var now = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+type fixture struct {
+	repo     *MockRepository
+	settings *MockSettingsGetter
+	userRepo *user.MockRepository
+	uc       *UseCase
+}
+
+func setup(userID int64) *fixture {
+	f := &fixture{
+		repo:     new(MockRepository),
+		settings: new(MockSettingsGetter),
+		userRepo: new(user.MockRepository),
+	}
+	f.uc = NewUseCase(f.repo, f.settings, f.userRepo, func(ctx context.Context) (int64, bool) {
+		return userID, userID != 0
+	})
+	f.uc.now = func() time.Time { return now }
+	return f
+}
+
+func strictSettings() *settings.Settings {
+	return &settings.Settings{
+		PasswordMinimumLength:         10,
+		PasswordIncludeNumbers:        true,
+		PasswordIncludeSymbols:        true,
+		PasswordHistoryCount:          3,
+		PasswordExpireDays:            90,
+		PasswordAge:                   1,
+		IsEnforceRoutinePasswordReset: true,
+	}
+}
+
+func hash(t *testing.T, password string) string {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return string(hashed)
+}
+
+func daysAgo(days int) *time.Time {
+	t := now.AddDate(0, 0, -days)
+	return &t
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy := FromSettings(strictSettings())
+
+	tests := []struct {
+		name     string
+		password string
+		err      error
+	}{
+		{"empty", "  ", ErrPasswordRequired},
+		{"too short", "Ab1!", &LengthError{MinLength: 10}},
+		{"missing number", "Abcdefghij!", ErrNumberRequired},
+		{"missing symbol", "Abcdefghij1", ErrSymbolRequired},
+		{"valid", "Abcdefghi1!", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(tt.password)
+			assert.Equal(t, tt.err, err)
+			if err != nil {
+				assert.True(t, IsViolation(err))
+			}
+		})
+	}
+}
+
+func TestPolicy_DefaultsWhenSettingsMissing(t *testing.T) {
+	f := setup(0)
+	f.settings.On("Get", mock.Anything).Return(nil, settings.ErrSettingsNotFound)
+
+	policy := f.uc.Policy(context.Background())
+
+	assert.Equal(t, Policy{MinLength: DefaultMinLength}, policy)
+}
+
+func TestPolicy_CachesSettings(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0)
+	current := now
+	f.uc.now = func() time.Time { return current }
+
+	f.settings.On("Get", ctx).Return(strictSettings(), nil).Once()
+	assert.Equal(t, 10, f.uc.Policy(ctx).MinLength)
+	assert.Equal(t, 10, f.uc.Policy(ctx).MinLength)
+	f.settings.AssertNumberOfCalls(t, "Get", 1)
+
+	// Vencida la política, un error al releer settings mantiene la última conocida
+	current = now.Add(PolicyTTL)
+	f.settings.On("Get", ctx).Return(nil, errors.New("db down")).Once()
+	assert.Equal(t, 10, f.uc.Policy(ctx).MinLength)
+
+	f.settings.On("Get", ctx).Return(&settings.Settings{PasswordMinimumLength: 12}, nil).Once()
+	assert.Equal(t, 12, f.uc.Policy(ctx).MinLength)
+	f.settings.AssertNumberOfCalls(t, "Get", 3)
+}
+
+func TestValidate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects password from history", func(t *testing.T) {
+		f := setup(0)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+		f.repo.On("ListRecent", ctx, int64(7), 3).
+			Return([]string{hash(t, "Newer-pass-2"), hash(t, "Older-pass-1")}, nil)
+
+		err := f.uc.Validate(ctx, 7, "Older-pass-1")
+
+		assert.ErrorIs(t, err, ErrPasswordReused)
+	})
+
+	t.Run("accepts new password", func(t *testing.T) {
+		f := setup(0)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+		f.repo.On("ListRecent", ctx, int64(7), 3).Return([]string{hash(t, "Older-pass-1")}, nil)
+
+		assert.NoError(t, f.uc.Validate(ctx, 7, "Brand-new-1"))
+	})
+
+	t.Run("skips history for new users", func(t *testing.T) {
+		f := setup(0)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+
+		assert.NoError(t, f.uc.Validate(ctx, 0, "Brand-new-1"))
+		f.repo.AssertNotCalled(t, "ListRecent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("history error", func(t *testing.T) {
+		f := setup(0)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+		f.repo.On("ListRecent", ctx, int64(7), 3).Return(nil, errors.New("db down"))
+
+		err := f.uc.Validate(ctx, 7, "Brand-new-1")
+
+		assert.EqualError(t, err, "db down")
+		assert.False(t, IsViolation(err))
+	})
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		settings   *settings.Settings
+		state      *State
+		expired    bool
+		mustChange bool
+	}{
+		{"current", strictSettings(), &State{ChangedAt: daysAgo(30)}, false, false},
+		{"expired", strictSettings(), &State{ChangedAt: daysAgo(90)}, true, false},
+		{"admin requested change", strictSettings(), &State{MustChange: true, ChangedAt: daysAgo(1)}, false, true},
+		{"expiry not enforced", &settings.Settings{PasswordExpireDays: 90}, &State{ChangedAt: daysAgo(400)}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setup(7)
+			f.settings.On("Get", ctx).Return(tt.settings, nil)
+			f.repo.On("GetState", ctx, int64(7)).Return(tt.state, nil)
+
+			status, err := f.uc.CurrentStatus(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expired, status.PasswordExpired)
+			assert.Equal(t, tt.mustChange, status.MustChangePassword)
+			assert.Equal(t, tt.expired || tt.mustChange, status.Blocked())
+		})
+	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		f := setup(0)
+
+		_, err := f.uc.CurrentStatus(ctx)
+
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	ctx := context.Background()
+
+	current := func(t *testing.T) *user.User {
+		return &user.User{ID: 7, Email: "tech@example.com", Password: hash(t, "Current-pass-1"), IsActive: true}
+	}
+	request := &ChangePasswordRequest{
+		CurrentPassword:      "Current-pass-1",
+		Password:             "Brand-new-pass-2",
+		PasswordConfirmation: "Brand-new-pass-2",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		f := setup(7)
+		var stored string
+		f.userRepo.On("GetByID", ctx, "7").Return(current(t), nil)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+		f.repo.On("GetState", ctx, int64(7)).Return(&State{ChangedAt: daysAgo(30)}, nil)
+		f.repo.On("ListRecent", ctx, int64(7), 3).Return([]string{}, nil)
+		f.userRepo.On("UpdatePassword", ctx, int64(7), mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.String(2)
+		}).Return(nil)
+		f.repo.On("Record", ctx, int64(7), mock.Anything).Return(nil)
+		f.repo.On("ClearMustChange", ctx, int64(7)).Return(nil)
+
+		err := f.uc.ChangePassword(ctx, request)
+
+		assert.NoError(t, err)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(stored), []byte("Brand-new-pass-2")))
+		f.repo.AssertCalled(t, "Record", ctx, int64(7), stored)
+		f.repo.AssertCalled(t, "ClearMustChange", ctx, int64(7))
+	})
+
+	t.Run("wrong current password", func(t *testing.T) {
+		f := setup(7)
+		f.userRepo.On("GetByID", ctx, "7").Return(current(t), nil)
+
+		err := f.uc.ChangePassword(ctx, &ChangePasswordRequest{
+			CurrentPassword:      "nope",
+			Password:             "Brand-new-pass-2",
+			PasswordConfirmation: "Brand-new-pass-2",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidCurrentPassword)
+		f.userRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("confirmation mismatch", func(t *testing.T) {
+		f := setup(7)
+
+		err := f.uc.ChangePassword(ctx, &ChangePasswordRequest{
+			CurrentPassword:      "Current-pass-1",
+			Password:             "Brand-new-pass-2",
+			PasswordConfirmation: "Brand-new-pass-3",
+		})
+
+		assert.ErrorIs(t, err, ErrPasswordMismatch)
+	})
+
+	t.Run("same as current", func(t *testing.T) {
+		f := setup(7)
+		f.userRepo.On("GetByID", ctx, "7").Return(current(t), nil)
+
+		err := f.uc.ChangePassword(ctx, &ChangePasswordRequest{
+			CurrentPassword:      "Current-pass-1",
+			Password:             "Current-pass-1",
+			PasswordConfirmation: "Current-pass-1",
+		})
+
+		assert.ErrorIs(t, err, ErrPasswordReused)
+	})
+
+	t.Run("minimum age", func(t *testing.T) {
+		f := setup(7)
+		changed := now.Add(-time.Hour)
+		f.userRepo.On("GetByID", ctx, "7").Return(current(t), nil)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+		f.repo.On("GetState", ctx, int64(7)).Return(&State{ChangedAt: &changed}, nil)
+
+		err := f.uc.ChangePassword(ctx, request)
+
+		assert.ErrorIs(t, err, ErrPasswordTooRecent)
+	})
+
+	t.Run("minimum age skipped when change is required", func(t *testing.T) {
+		f := setup(7)
+		changed := now.Add(-time.Hour)
+		f.userRepo.On("GetByID", ctx, "7").Return(current(t), nil)
+		f.settings.On("Get", ctx).Return(strictSettings(), nil)
+		f.repo.On("GetState", ctx, int64(7)).Return(&State{MustChange: true, ChangedAt: &changed}, nil)
+		f.repo.On("ListRecent", ctx, int64(7), 3).Return([]string{}, nil)
+		f.userRepo.On("UpdatePassword", ctx, int64(7), mock.Anything).Return(nil)
+		f.repo.On("Record", ctx, int64(7), mock.Anything).Return(nil)
+		f.repo.On("ClearMustChange", ctx, int64(7)).Return(nil)
+
+		assert.NoError(t, f.uc.ChangePassword(ctx, request))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		f := setup(0)
+
+		err := f.uc.ChangePassword(ctx, request)
+
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
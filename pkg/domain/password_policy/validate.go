@@ -0,0 +1,94 @@
+package password_policy
+
+import (
+	"context"
+	"log/slog"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Policy obtiene la política vigente, reutilizándola durante PolicyTTL. Si no se puede
+// leer settings se usa la última política leída o, si no hay, la política por defecto,
+// para no dejar sin validar las contraseñas nuevas.
+func (uc *UseCase) Policy(ctx context.Context) Policy {
+	uc.mu.Lock()
+	cached, expiry := uc.policy, uc.policyExpiry
+	uc.mu.Unlock()
+
+	now := uc.now()
+	if cached != nil && now.Before(expiry) {
+		return *cached
+	}
+
+	s, err := uc.settings.Get(ctx)
+	if err != nil {
+		if cached != nil {
+			slog.WarnContext(ctx, "Failed to refresh password policy settings, using last known policy",
+				slog.String("error", err.Error()))
+			return *cached
+		}
+		slog.WarnContext(ctx, "Failed to load password policy settings, using defaults",
+			slog.String("error", err.Error()))
+		return FromSettings(nil)
+	}
+
+	policy := FromSettings(s)
+	uc.mu.Lock()
+	uc.policy, uc.policyExpiry = &policy, now.Add(PolicyTTL)
+	uc.mu.Unlock()
+	return policy
+}
+
+// Validate verifica que la contraseña cumpla la política y, si userID no es 0,
+// que no coincida con las últimas HistoryCount contraseñas del usuario
+func (uc *UseCase) Validate(ctx context.Context, userID int64, password string) error {
+	policy := uc.Policy(ctx)
+	if err := policy.Check(password); err != nil {
+		return err
+	}
+
+	if userID == 0 || policy.HistoryCount <= 0 {
+		return nil
+	}
+
+	hashes, err := uc.repo.ListRecent(ctx, userID, policy.HistoryCount)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load password history",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	for _, hash := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			return ErrPasswordReused
+		}
+	}
+	return nil
+}
+
+// Record guarda en el historial una contraseña asignada por un administrador
+func (uc *UseCase) Record(ctx context.Context, userID int64, hashedPassword string) error {
+	if err := uc.repo.Record(ctx, userID, hashedPassword); err != nil {
+		slog.ErrorContext(ctx, "Failed to record password history",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// RecordOwnChange guarda en el historial una contraseña elegida por el propio usuario
+// y retira la exigencia de cambiarla
+func (uc *UseCase) RecordOwnChange(ctx context.Context, userID int64, hashedPassword string) error {
+	if err := uc.Record(ctx, userID, hashedPassword); err != nil {
+		return err
+	}
+	if err := uc.repo.ClearMustChange(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "Failed to clear must change password flag",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package password_reset
+
+import "time"
+
+// PasswordReset representa una fila de password_resets. Token es el hash bcrypt del
+// token enviado por correo, igual que lo guarda el DatabaseTokenRepository de Laravel.
+type PasswordReset struct {
+	Email     string
+	Token     string
+	CreatedAt *time.Time
+}
+
+// Expired indica si la solicitud superó la vigencia indicada
+func (p *PasswordReset) Expired(now time.Time, expiry time.Duration) bool {
+	return p.CreatedAt == nil || now.After(p.CreatedAt.Add(expiry))
+}
+
+// ForgotPasswordRequest representa la solicitud de un enlace de restablecimiento
+type ForgotPasswordRequest struct {
+	Email string `json:"email" example:"tecnico@example.com"`
+}
+
+// ResetPasswordRequest representa el restablecimiento de la contraseña con el token recibido
+type ResetPasswordRequest struct {
+	Email                string `json:"email" example:"tecnico@example.com"`
+	Token                string `json:"token" example:"5f0c1e..."`
+	Password             string `json:"password" example:"nueva-contraseña"`
+	PasswordConfirmation string `json:"passwordConfirmation" example:"nueva-contraseña"`
+}
@@ -0,0 +1,14 @@
+package password_reset
+
+import "errors"
+
+var (
+	// ErrEmailRequired indica que no se envió el email
+	ErrEmailRequired = errors.New("email is required")
+
+	// ErrInvalidToken indica que el token no existe, no corresponde al email o expiró
+	ErrInvalidToken = errors.New("password reset token is invalid or expired")
+
+	// ErrPasswordMismatch indica que la confirmación no coincide con la contraseña
+	ErrPasswordMismatch = errors.New("password confirmation does not match")
+)
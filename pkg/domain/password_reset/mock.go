@@ -0,0 +1,66 @@
+package password_reset
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/mail"
+)
+
+// MockRepository es un mock del repositorio de password_resets
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, reset *PasswordReset) error {
+	args := m.Called(ctx, reset)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByEmail(ctx context.Context, email string) (*PasswordReset, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PasswordReset), args.Error(1)
+}
+
+func (m *MockRepository) DeleteByEmail(ctx context.Context, email string) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+// MockTokenRevoker es un mock del revocador de tokens
+type MockTokenRevoker struct {
+	mock.Mock
+}
+
+func (m *MockTokenRevoker) RevokeUserTokens(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockPasswordPolicy es un mock de la política de contraseñas
+type MockPasswordPolicy struct {
+	mock.Mock
+}
+
+func (m *MockPasswordPolicy) Validate(ctx context.Context, userID int64, password string) error {
+	args := m.Called(ctx, userID, password)
+	return args.Error(0)
+}
+
+func (m *MockPasswordPolicy) RecordOwnChange(ctx context.Context, userID int64, hashedPassword string) error {
+	args := m.Called(ctx, userID, hashedPassword)
+	return args.Error(0)
+}
+
+// MockSender es un mock del envío de correos
+type MockSender struct {
+	mock.Mock
+}
+
+func (m *MockSender) Send(ctx context.Context, msg *mail.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
@@ -0,0 +1,11 @@
+package password_reset
+
+import "context"
+
+// Repository define las operaciones de persistencia de password_resets
+type Repository interface {
+	// Create reemplaza la solicitud pendiente del email por una nueva
+	Create(ctx context.Context, reset *PasswordReset) error
+	GetByEmail(ctx context.Context, email string) (*PasswordReset, error)
+	DeleteByEmail(ctx context.Context, email string) error
+}
@@ -0,0 +1,85 @@
+package password_reset
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Reset valida el token, guarda la nueva contraseña y cierra todas las sesiones del usuario.
+// El token se consume al usarse y también se elimina si expiró.
+func (uc *UseCase) Reset(ctx context.Context, req *ResetPasswordRequest) error {
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return ErrEmailRequired
+	}
+	if req.Token == "" {
+		return ErrInvalidToken
+	}
+	if req.Password != req.PasswordConfirmation {
+		return ErrPasswordMismatch
+	}
+
+	reset, err := uc.repo.GetByEmail(ctx, email)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if reset.Expired(uc.now(), uc.config.Expiry) {
+		uc.consume(ctx, email)
+		return ErrInvalidToken
+	}
+	if bcrypt.CompareHashAndPassword([]byte(reset.Token), []byte(req.Token)) != nil {
+		return ErrInvalidToken
+	}
+
+	u, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil || !u.IsActive {
+		return ErrInvalidToken
+	}
+
+	// Un incumplimiento de la política no consume el token, para poder reintentar
+	if err := uc.policy.Validate(ctx, u.ID, req.Password); err != nil {
+		return err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := uc.userRepo.UpdatePassword(ctx, u.ID, string(hashed)); err != nil {
+		slog.ErrorContext(ctx, "Failed to update password",
+			slog.Int64("userId", u.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	uc.consume(ctx, email)
+
+	// La contraseña ya cambió; un fallo al registrarla o al revocar solo se registra
+	if err := uc.policy.RecordOwnChange(ctx, u.ID, string(hashed)); err != nil {
+		slog.ErrorContext(ctx, "Failed to record password history after password reset",
+			slog.Int64("userId", u.ID),
+			slog.String("error", err.Error()))
+	}
+	if err := uc.tokens.RevokeUserTokens(ctx, u.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to revoke user tokens after password reset",
+			slog.Int64("userId", u.ID),
+			slog.String("error", err.Error()))
+	}
+
+	slog.InfoContext(ctx, "Password reset successfully",
+		slog.Int64("userId", u.ID))
+
+	return nil
+}
+
+// consume elimina la solicitud de restablecimiento del email
+func (uc *UseCase) consume(ctx context.Context, email string) {
+	if err := uc.repo.DeleteByEmail(ctx, email); err != nil {
+		slog.WarnContext(ctx, "Failed to delete password reset token",
+			slog.String("email", email),
+			slog.String("error", err.Error()))
+	}
+}
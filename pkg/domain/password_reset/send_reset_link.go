@@ -0,0 +1,110 @@
+package password_reset
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// SendResetLink genera un token, guarda su hash y envía el enlace por correo.
+// Si el email no corresponde a un usuario activo no se informa al cliente, para
+// no revelar qué cuentas existen.
+func (uc *UseCase) SendResetLink(ctx context.Context, req *ForgotPasswordRequest) error {
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return ErrEmailRequired
+	}
+
+	u, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil || !u.IsActive {
+		slog.InfoContext(ctx, "Password reset requested for unknown or inactive email",
+			slog.String("email", email))
+		return nil
+	}
+
+	existing, err := uc.repo.GetByEmail(ctx, u.Email)
+	if err == nil && existing.CreatedAt != nil && uc.now().Before(existing.CreatedAt.Add(uc.config.Throttle)) {
+		slog.InfoContext(ctx, "Password reset throttled",
+			slog.Int64("userId", u.ID))
+		return nil
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(token), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	now := uc.now()
+	if err := uc.repo.Create(ctx, &PasswordReset{Email: u.Email, Token: string(hashed), CreatedAt: &now}); err != nil {
+		slog.ErrorContext(ctx, "Failed to store password reset token",
+			slog.Int64("userId", u.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if err := uc.sender.Send(ctx, uc.message(u.Name, u.Email, token)); err != nil {
+		slog.ErrorContext(ctx, "Failed to send password reset email",
+			slog.Int64("userId", u.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Password reset link sent",
+		slog.Int64("userId", u.ID))
+
+	return nil
+}
+
+// message arma el correo con el enlace al formulario de restablecimiento
+func (uc *UseCase) message(name, email, token string) *mail.Message {
+	link := uc.resetLink(email, token)
+	minutes := int(uc.config.Expiry.Minutes())
+
+	return &mail.Message{
+		To:      []string{email},
+		Subject: "Reset Password Notification",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"You are receiving this email because we received a password reset request for your account.\n\n"+
+			"Reset your password: %s\n\n"+
+			"This password reset link will expire in %d minutes.\n\n"+
+			"If you did not request a password reset, no further action is required.\n",
+			name, link, minutes),
+	}
+}
+
+// resetLink agrega token y email a la URL del formulario; sin URL configurada solo se envía el token
+func (uc *UseCase) resetLink(email, token string) string {
+	if uc.config.ResetURL == "" {
+		return token
+	}
+
+	query := url.Values{}
+	query.Set("token", token)
+	query.Set("email", email)
+
+	separator := "?"
+	if strings.Contains(uc.config.ResetURL, "?") {
+		separator = "&"
+	}
+	return uc.config.ResetURL + separator + query.Encode()
+}
+
+// newToken genera un token aleatorio de 64 caracteres hexadecimales
+func newToken() (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(random), nil
+}
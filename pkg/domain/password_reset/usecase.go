@@ -0,0 +1,74 @@
+package password_reset
+
+import (
+	"context"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+const (
+	// DefaultExpiry es la vigencia del token (60 minutos, como en Laravel)
+	DefaultExpiry = 60 * time.Minute
+
+	// DefaultThrottle es la espera mínima entre dos solicitudes del mismo email
+	DefaultThrottle = 60 * time.Second
+)
+
+// Service define la interfaz del servicio de restablecimiento de contraseña
+type Service interface {
+	SendResetLink(ctx context.Context, req *ForgotPasswordRequest) error
+	Reset(ctx context.Context, req *ResetPasswordRequest) error
+}
+
+// TokenRevoker revoca las sesiones abiertas de un usuario
+type TokenRevoker interface {
+	RevokeUserTokens(ctx context.Context, userID int64) error
+}
+
+// PasswordPolicy valida la nueva contraseña contra la política de settings y la
+// registra en el historial
+type PasswordPolicy interface {
+	Validate(ctx context.Context, userID int64, password string) error
+	RecordOwnChange(ctx context.Context, userID int64, hashedPassword string) error
+}
+
+// Config almacena la URL del formulario de restablecimiento, la vigencia del token
+// y la espera entre solicitudes
+type Config struct {
+	ResetURL string
+	Expiry   time.Duration
+	Throttle time.Duration
+}
+
+// UseCase implementa la lógica de negocio del restablecimiento de contraseña
+type UseCase struct {
+	repo     Repository
+	userRepo user.Repository
+	tokens   TokenRevoker
+	policy   PasswordPolicy
+	sender   mail.Sender
+	config   Config
+	now      func() time.Time
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de restablecimiento de contraseña
+func NewUseCase(repo Repository, userRepo user.Repository, tokens TokenRevoker, policy PasswordPolicy, sender mail.Sender, config Config) *UseCase {
+	if config.Expiry <= 0 {
+		config.Expiry = DefaultExpiry
+	}
+	if config.Throttle <= 0 {
+		config.Throttle = DefaultThrottle
+	}
+
+	return &UseCase{
+		repo:     repo,
+		userRepo: userRepo,
+		tokens:   tokens,
+		policy:   policy,
+		sender:   sender,
+		config:   config,
+		now:      time.Now,
+	}
+}
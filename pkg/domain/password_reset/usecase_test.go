@@ -0,0 +1,198 @@
+package password_reset
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var now = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+type fixture struct {
+	repo     *MockRepository
+	userRepo *user.MockRepository
+	tokens   *MockTokenRevoker
+	policy   *MockPasswordPolicy
+	sender   *MockSender
+	uc       *UseCase
+}
+
+func setup() *fixture {
+	f := &fixture{
+		repo:     new(MockRepository),
+		userRepo: new(user.MockRepository),
+		tokens:   new(MockTokenRevoker),
+		policy:   new(MockPasswordPolicy),
+		sender:   new(MockSender),
+	}
+	f.uc = NewUseCase(f.repo, f.userRepo, f.tokens, f.policy, f.sender, Config{
+		ResetURL: "https://app.example.com/password/reset",
+		Throttle: DefaultThrottle,
+	})
+	f.uc.now = func() time.Time { return now }
+	return f
+}
+
+func activeUser() *user.User {
+	return &user.User{ID: 7, Name: "Tech", Email: "tech@example.com", IsActive: true}
+}
+
+func TestSendResetLink(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores hashed token and mails link", func(t *testing.T) {
+		f := setup()
+		var stored *PasswordReset
+		var sent *mail.Message
+
+		f.userRepo.On("GetByEmail", ctx, "tech@example.com").Return(activeUser(), nil)
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(nil, errors.New("not found"))
+		f.repo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*PasswordReset)
+		}).Return(nil)
+		f.sender.On("Send", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(1).(*mail.Message)
+		}).Return(nil)
+
+		err := f.uc.SendResetLink(ctx, &ForgotPasswordRequest{Email: " tech@example.com "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"tech@example.com"}, sent.To)
+		assert.Equal(t, now, *stored.CreatedAt)
+
+		link := regexp.MustCompile(`https://\S+`).FindString(sent.Body)
+		parsed, err := url.Parse(link)
+		assert.NoError(t, err)
+		token := parsed.Query().Get("token")
+		assert.Len(t, token, 64)
+		assert.Equal(t, "tech@example.com", parsed.Query().Get("email"))
+
+		// Solo se guarda el hash del token
+		assert.NotContains(t, stored.Token, token)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(stored.Token), []byte(token)))
+	})
+
+	t.Run("unknown email is silent", func(t *testing.T) {
+		f := setup()
+		f.userRepo.On("GetByEmail", ctx, "nobody@example.com").Return(nil, user.ErrUserNotFound)
+
+		err := f.uc.SendResetLink(ctx, &ForgotPasswordRequest{Email: "nobody@example.com"})
+
+		assert.NoError(t, err)
+		f.sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("throttles repeated requests", func(t *testing.T) {
+		f := setup()
+		recent := now.Add(-30 * time.Second)
+		f.userRepo.On("GetByEmail", ctx, "tech@example.com").Return(activeUser(), nil)
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(&PasswordReset{Email: "tech@example.com", CreatedAt: &recent}, nil)
+
+		err := f.uc.SendResetLink(ctx, &ForgotPasswordRequest{Email: "tech@example.com"})
+
+		assert.NoError(t, err)
+		f.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		f.sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("requires email", func(t *testing.T) {
+		assert.Equal(t, ErrEmailRequired, setup().uc.SendResetLink(ctx, &ForgotPasswordRequest{}))
+	})
+}
+
+func TestReset(t *testing.T) {
+	ctx := context.Background()
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("plain-token"), bcrypt.MinCost)
+	created := now.Add(-10 * time.Minute)
+	pending := &PasswordReset{Email: "tech@example.com", Token: string(hashed), CreatedAt: &created}
+
+	request := func() *ResetPasswordRequest {
+		return &ResetPasswordRequest{
+			Email:                "tech@example.com",
+			Token:                "plain-token",
+			Password:             "new-secret-1",
+			PasswordConfirmation: "new-secret-1",
+		}
+	}
+
+	t.Run("updates password and revokes tokens", func(t *testing.T) {
+		f := setup()
+		var newHash string
+
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(pending, nil)
+		f.userRepo.On("GetByEmail", ctx, "tech@example.com").Return(activeUser(), nil)
+		f.policy.On("Validate", ctx, int64(7), "new-secret-1").Return(nil)
+		f.userRepo.On("UpdatePassword", ctx, int64(7), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+			newHash = args.String(2)
+		}).Return(nil)
+		f.repo.On("DeleteByEmail", ctx, "tech@example.com").Return(nil)
+		f.policy.On("RecordOwnChange", ctx, int64(7), mock.AnythingOfType("string")).Return(nil)
+		f.tokens.On("RevokeUserTokens", ctx, int64(7)).Return(nil)
+
+		err := f.uc.Reset(ctx, request())
+
+		assert.NoError(t, err)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte("new-secret-1")))
+		f.policy.AssertCalled(t, "RecordOwnChange", ctx, int64(7), newHash)
+		f.repo.AssertExpectations(t)
+		f.tokens.AssertExpectations(t)
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		f := setup()
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(pending, nil)
+
+		req := request()
+		req.Token = "other-token"
+
+		assert.Equal(t, ErrInvalidToken, f.uc.Reset(ctx, req))
+		f.userRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expired token is removed", func(t *testing.T) {
+		f := setup()
+		old := now.Add(-2 * time.Hour)
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(&PasswordReset{Email: "tech@example.com", Token: string(hashed), CreatedAt: &old}, nil)
+		f.repo.On("DeleteByEmail", ctx, "tech@example.com").Return(nil)
+
+		assert.Equal(t, ErrInvalidToken, f.uc.Reset(ctx, request()))
+		f.repo.AssertCalled(t, "DeleteByEmail", ctx, "tech@example.com")
+	})
+
+	t.Run("no pending request", func(t *testing.T) {
+		f := setup()
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(nil, errors.New("not found"))
+
+		assert.Equal(t, ErrInvalidToken, f.uc.Reset(ctx, request()))
+	})
+
+	t.Run("validates new password", func(t *testing.T) {
+		f := setup()
+
+		req := request()
+		req.PasswordConfirmation = "different-1"
+		assert.Equal(t, ErrPasswordMismatch, f.uc.Reset(ctx, req))
+	})
+
+	t.Run("policy violation keeps token", func(t *testing.T) {
+		f := setup()
+		policyErr := errors.New("password was used recently")
+
+		f.repo.On("GetByEmail", ctx, "tech@example.com").Return(pending, nil)
+		f.userRepo.On("GetByEmail", ctx, "tech@example.com").Return(activeUser(), nil)
+		f.policy.On("Validate", ctx, int64(7), "new-secret-1").Return(policyErr)
+
+		assert.Equal(t, policyErr, f.uc.Reset(ctx, request()))
+		f.userRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+		f.repo.AssertNotCalled(t, "DeleteByEmail", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,151 @@
+package payroll
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/money"
+)
+
+// Period representa un periodo de pago; ambos extremos son inclusivos
+type Period struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// Validate verifica que el periodo tenga ambas fechas y que From no sea posterior a To
+func (p Period) Validate() error {
+	if p.From.IsZero() || p.To.IsZero() {
+		return fmt.Errorf("from and to are required")
+	}
+
+	if p.From.After(p.To) {
+		return fmt.Errorf("from must be before or equal to to")
+	}
+
+	return nil
+}
+
+// End retorna el límite exclusivo del periodo (el día siguiente a To)
+func (p Period) End() time.Time {
+	return p.To.AddDate(0, 0, 1)
+}
+
+// Technician es el técnico al que pertenecen las tarifas
+type Technician struct {
+	ID    int64   `json:"id"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+}
+
+// UserSummary resume las tarifas pendientes de pago de un técnico en un periodo
+type UserSummary struct {
+	UserID         int64        `json:"userId"`
+	UserName       *string      `json:"userName,omitempty"`
+	RateCount      int          `json:"rateCount"`
+	HeldCount      int          `json:"heldCount"`
+	PayablePayment money.Amount `json:"payablePayment"`
+	HeldPayment    money.Amount `json:"heldPayment"`
+}
+
+// PayItem es una tarifa de un técnico con los datos del job al que pertenece
+type PayItem struct {
+	RateID       int64        `json:"rateId"`
+	JobID        int64        `json:"jobId"`
+	WorkOrder    *string      `json:"workOrder,omitempty"`
+	Street       string       `json:"street"`
+	City         string       `json:"city"`
+	State        string       `json:"state"`
+	Zip          string       `json:"zip"`
+	JobDate      time.Time    `json:"jobDate"`
+	SalePrice    money.Amount `json:"salePrice"`
+	TechParts    money.Amount `json:"techParts"`
+	CompanyParts money.Amount `json:"companyParts"`
+	Deduction    money.Amount `json:"deduction"`
+	Payment      money.Amount `json:"payment"`
+	Paid         bool         `json:"paid"`
+	Held         bool         `json:"held"`
+	PaidAt       *time.Time   `json:"paidAt,omitempty"`
+}
+
+// PropertyAddress retorna la dirección completa de la propiedad del job
+func (i *PayItem) PropertyAddress() string {
+	return fmt.Sprintf("%s, %s, %s %s", i.Street, i.City, i.State, i.Zip)
+}
+
+// Totals acumula los montos de las tarifas de un pago
+type Totals struct {
+	SalePrice    money.Amount `json:"salePrice"`
+	TechParts    money.Amount `json:"techParts"`
+	CompanyParts money.Amount `json:"companyParts"`
+	Deduction    money.Amount `json:"deduction"`
+	Payment      money.Amount `json:"payment"`
+	Payable      money.Amount `json:"payable"`
+	Held         money.Amount `json:"held"`
+	Paid         money.Amount `json:"paid"`
+}
+
+// Add suma una tarifa a los totales
+func (t *Totals) Add(item *PayItem) {
+	t.SalePrice += item.SalePrice
+	t.TechParts += item.TechParts
+	t.CompanyParts += item.CompanyParts
+	t.Deduction += item.Deduction
+	t.Payment += item.Payment
+
+	switch {
+	case item.Paid:
+		t.Paid += item.Payment
+	case item.Held:
+		t.Held += item.Payment
+	default:
+		t.Payable += item.Payment
+	}
+}
+
+// Pay es el detalle de pago de un técnico en un periodo
+type Pay struct {
+	UserID   int64      `json:"userId"`
+	UserName string     `json:"userName"`
+	Period   Period     `json:"period"`
+	Items    []*PayItem `json:"items"`
+	Totals   Totals     `json:"totals"`
+}
+
+// Payout resume un pago realizado a un técnico (las tarifas marcadas como pagadas el mismo día)
+type Payout struct {
+	PaidOn    time.Time    `json:"paidOn"`
+	RateCount int          `json:"rateCount"`
+	Payment   money.Amount `json:"payment"`
+}
+
+// Estados de entrega de un recibo de pago por email
+const (
+	DeliveryPending = "pending"
+	DeliverySent    = "sent"
+	DeliveryFailed  = "failed"
+)
+
+// PaystubKind identifica en la cola de mensajes los correos de recibos de pago
+const PaystubKind = "paystub"
+
+// PaystubDelivery registra una solicitud de envío de un recibo de pago por email
+type PaystubDelivery struct {
+	ID          int64      `json:"id"`
+	UserID      int64      `json:"userId"`
+	PeriodFrom  time.Time  `json:"periodFrom"`
+	PeriodTo    time.Time  `json:"periodTo"`
+	Email       string     `json:"email"`
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	LastError   *string    `json:"lastError,omitempty"`
+	RequestedBy *int64     `json:"requestedBy,omitempty"`
+	SentAt      *time.Time `json:"sentAt,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Period retorna el periodo del recibo solicitado
+func (d *PaystubDelivery) Period() Period {
+	return Period{From: d.PeriodFrom, To: d.PeriodTo}
+}
@@ -0,0 +1,20 @@
+package payroll
+
+import "errors"
+
+var (
+	// ErrInvalidUser indica que el usuario no es válido
+	ErrInvalidUser = errors.New("invalid user")
+
+	// ErrRateIDsRequired indica que no se indicaron tarifas para la operación
+	ErrRateIDsRequired = errors.New("rate_ids is required")
+
+	// ErrEmailRequired indica que no hay email al cual enviar el recibo de pago
+	ErrEmailRequired = errors.New("email is required")
+
+	// ErrInvalidEmail indica que el email indicado no es válido
+	ErrInvalidEmail = errors.New("email is invalid")
+
+	// ErrEmptyPaystub indica que el recibo de pago no tiene tarifas en el periodo
+	ErrEmptyPaystub = errors.New("paystub has no rates in the period")
+)
@@ -0,0 +1,38 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+)
+
+// GetPay obtiene las tarifas de un técnico en el periodo junto con sus totales
+func (uc *UseCase) GetPay(ctx context.Context, userID int64, period Period) (*Pay, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+
+	tech, err := uc.repo.GetTechnician(ctx, userID)
+	if err != nil {
+		return nil, ErrInvalidUser
+	}
+
+	items, err := uc.repo.ListItems(ctx, userID, period)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list pay items",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	pay := &Pay{
+		UserID:   userID,
+		UserName: tech.Name,
+		Period:   period,
+		Items:    items,
+	}
+	for _, item := range items {
+		pay.Totals.Add(item)
+	}
+
+	return pay, nil
+}
@@ -0,0 +1,23 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+)
+
+// History obtiene el historial de pagos del técnico, agrupado por día de pago
+func (uc *UseCase) History(ctx context.Context, userID int64) ([]*Payout, error) {
+	if _, err := uc.repo.GetTechnician(ctx, userID); err != nil {
+		return nil, ErrInvalidUser
+	}
+
+	payouts, err := uc.repo.ListPayouts(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list payouts",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return payouts, nil
+}
@@ -0,0 +1,32 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+)
+
+// MarkPaid marca como pagadas las tarifas pendientes del técnico en el periodo.
+// Las tarifas retenidas se omiten hasta que se liberen.
+func (uc *UseCase) MarkPaid(ctx context.Context, userID int64, period Period, rateIDs []int64) (int64, error) {
+	if err := period.Validate(); err != nil {
+		return 0, err
+	}
+
+	if _, err := uc.repo.GetTechnician(ctx, userID); err != nil {
+		return 0, ErrInvalidUser
+	}
+
+	count, err := uc.repo.MarkPaid(ctx, userID, period, rateIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark rates as paid",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "Rates marked as paid",
+		slog.Int64("userId", userID),
+		slog.Int64("count", count))
+
+	return count, nil
+}
@@ -0,0 +1,72 @@
+package payroll
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de nómina
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetTechnician(ctx context.Context, userID int64) (*Technician, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Technician), args.Error(1)
+}
+
+func (m *MockRepository) Summary(ctx context.Context, period Period) ([]*UserSummary, error) {
+	args := m.Called(ctx, period)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*UserSummary), args.Error(1)
+}
+
+func (m *MockRepository) ListItems(ctx context.Context, userID int64, period Period) ([]*PayItem, error) {
+	args := m.Called(ctx, userID, period)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*PayItem), args.Error(1)
+}
+
+func (m *MockRepository) MarkPaid(ctx context.Context, userID int64, period Period, rateIDs []int64) (int64, error) {
+	args := m.Called(ctx, userID, period, rateIDs)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) SetHeld(ctx context.Context, userID int64, rateIDs []int64, held bool) (int64, error) {
+	args := m.Called(ctx, userID, rateIDs, held)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListPayouts(ctx context.Context, userID int64) ([]*Payout, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Payout), args.Error(1)
+}
+
+func (m *MockRepository) CreateDelivery(ctx context.Context, delivery *PaystubDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpdateDelivery(ctx context.Context, id int64, status string, attempts int, lastError *string) error {
+	args := m.Called(ctx, id, status, attempts, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListDeliveries(ctx context.Context, userID int64) ([]*PaystubDelivery, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*PaystubDelivery), args.Error(1)
+}
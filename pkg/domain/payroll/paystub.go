@@ -0,0 +1,177 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	netmail "net/mail"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// GetPaystub obtiene el recibo de pago del técnico en el periodo: sus tarifas pagadas
+// y pendientes con los totales. Las tarifas retenidas no forman parte del recibo.
+func (uc *UseCase) GetPaystub(ctx context.Context, userID int64, period Period) (*Pay, error) {
+	pay, err := uc.GetPay(ctx, userID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	stub := &Pay{
+		UserID:   pay.UserID,
+		UserName: pay.UserName,
+		Period:   pay.Period,
+		Items:    make([]*PayItem, 0, len(pay.Items)),
+	}
+	for _, item := range pay.Items {
+		if item.Held {
+			continue
+		}
+		stub.Items = append(stub.Items, item)
+		stub.Totals.Add(item)
+	}
+
+	return stub, nil
+}
+
+// EmailPaystub registra el envío del recibo de pago por email y lo deja en la cola
+// de mensajes con el PDF adjunto. Si no se indica email se usa el del técnico.
+func (uc *UseCase) EmailPaystub(ctx context.Context, userID int64, period Period, email *string) (*PaystubDelivery, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+
+	tech, err := uc.repo.GetTechnician(ctx, userID)
+	if err != nil {
+		return nil, ErrInvalidUser
+	}
+
+	recipient := ""
+	if email != nil {
+		recipient = strings.TrimSpace(*email)
+	}
+	if recipient == "" && tech.Email != nil {
+		recipient = strings.TrimSpace(*tech.Email)
+	}
+	if recipient == "" {
+		return nil, ErrEmailRequired
+	}
+	if _, err := netmail.ParseAddress(recipient); err != nil {
+		return nil, ErrInvalidEmail
+	}
+
+	stub, err := uc.GetPaystub(ctx, userID, period)
+	if err != nil {
+		return nil, err
+	}
+	if len(stub.Items) == 0 {
+		return nil, ErrEmptyPaystub
+	}
+
+	delivery := &PaystubDelivery{
+		UserID:     userID,
+		PeriodFrom: period.From,
+		PeriodTo:   period.To,
+		Email:      recipient,
+		Status:     DeliveryPending,
+	}
+	if uc.userResolver != nil {
+		if requestedBy, ok := uc.userResolver(ctx); ok {
+			delivery.RequestedBy = &requestedBy
+		}
+	}
+
+	if err := uc.repo.CreateDelivery(ctx, delivery); err != nil {
+		slog.ErrorContext(ctx, "Failed to queue paystub delivery",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err := uc.enqueuePaystub(ctx, stub, delivery); err != nil {
+		slog.ErrorContext(ctx, "Failed to enqueue paystub email",
+			slog.Int64("id", delivery.ID),
+			slog.String("error", err.Error()))
+		lastError := err.Error()
+		if updateErr := uc.repo.UpdateDelivery(ctx, delivery.ID, DeliveryFailed, 0, &lastError); updateErr != nil {
+			slog.ErrorContext(ctx, "Failed to mark paystub delivery as failed",
+				slog.Int64("id", delivery.ID),
+				slog.String("error", updateErr.Error()))
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Paystub delivery queued",
+		slog.Int64("id", delivery.ID),
+		slog.Int64("userId", userID))
+
+	return delivery, nil
+}
+
+// enqueuePaystub genera el PDF del recibo y encola el correo del envío
+func (uc *UseCase) enqueuePaystub(ctx context.Context, stub *Pay, delivery *PaystubDelivery) error {
+	if uc.queue == nil {
+		return nil
+	}
+
+	content, err := RenderPaystub(stub).Bytes()
+	if err != nil {
+		return err
+	}
+
+	from, to := stub.Period.From.Format("01/02/2006"), stub.Period.To.Format("01/02/2006")
+	msg := &mail.Message{
+		To:      []string{delivery.Email},
+		Subject: fmt.Sprintf("Paystub %s - %s", from, to),
+		Body:    fmt.Sprintf("Hello %s,\n\nAttached is your paystub for %s - %s.\n", stub.UserName, from, to),
+		Attachments: []mail.Attachment{{
+			Filename:    fmt.Sprintf("paystub-%s-%s.pdf", stub.Period.From.Format("2006-01-02"), stub.Period.To.Format("2006-01-02")),
+			ContentType: "application/pdf",
+			Data:        content,
+		}},
+	}
+
+	_, err = uc.queue.EnqueueEmail(ctx, PaystubKind, &delivery.ID, msg)
+	return err
+}
+
+// OnDeliveryResult actualiza el envío del recibo con el resultado de cada intento
+// de entrega de la cola de mensajes
+func (uc *UseCase) OnDeliveryResult(ctx context.Context, msg *domainOutbox.Message) {
+	if msg.ReferenceID == nil {
+		return
+	}
+
+	status := DeliveryPending
+	switch msg.Status {
+	case domainOutbox.StatusSent:
+		status = DeliverySent
+	case domainOutbox.StatusDead:
+		status = DeliveryFailed
+	}
+
+	if err := uc.repo.UpdateDelivery(ctx, *msg.ReferenceID, status, msg.Attempts, msg.LastError); err != nil {
+		slog.ErrorContext(ctx, "Failed to update paystub delivery",
+			slog.Int64("id", *msg.ReferenceID),
+			slog.String("error", err.Error()))
+	}
+}
+
+// ListDeliveries obtiene los envíos de recibos de pago solicitados para el técnico
+func (uc *UseCase) ListDeliveries(ctx context.Context, userID int64) ([]*PaystubDelivery, error) {
+	if _, err := uc.repo.GetTechnician(ctx, userID); err != nil {
+		return nil, ErrInvalidUser
+	}
+
+	deliveries, err := uc.repo.ListDeliveries(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list paystub deliveries",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return deliveries, nil
+}
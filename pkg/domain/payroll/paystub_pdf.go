@@ -0,0 +1,76 @@
+package payroll
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/pdf"
+)
+
+const paystubDateFormat = "01/02/2006"
+
+// RenderPaystub construye el documento PDF de un recibo de pago. Vive en el dominio
+// para que tanto la descarga como el envío por email generen el mismo documento.
+func RenderPaystub(stub *Pay) *pdf.Document {
+	period := fmt.Sprintf("%s - %s", stub.Period.From.Format(paystubDateFormat), stub.Period.To.Format(paystubDateFormat))
+
+	doc := pdf.New(fmt.Sprintf("Paystub %s %s", stub.UserName, period))
+	doc.Footer = fmt.Sprintf("Generated %s", time.Now().Format("01/02/2006 03:04 PM"))
+
+	doc.Heading("Paystub", 18)
+	doc.SetFont(false, 10)
+	doc.KeyValue("Technician", stub.UserName)
+	doc.KeyValue("Pay Period", period)
+	doc.Space(8)
+
+	width := doc.ContentWidth()
+	columns := []float64{width * 0.1, width * 0.12, width * 0.3, width * 0.12, width * 0.12, width * 0.12, width * 0.12}
+
+	doc.SetFont(false, 8)
+	doc.Row(
+		pdf.Cell{Text: "Date", Width: columns[0], Bold: true},
+		pdf.Cell{Text: "Work Order", Width: columns[1], Bold: true},
+		pdf.Cell{Text: "Property", Width: columns[2], Bold: true},
+		pdf.Cell{Text: "Sale Price", Width: columns[3], Bold: true, Align: pdf.AlignRight},
+		pdf.Cell{Text: "Parts", Width: columns[4], Bold: true, Align: pdf.AlignRight},
+		pdf.Cell{Text: "Deduction", Width: columns[5], Bold: true, Align: pdf.AlignRight},
+		pdf.Cell{Text: "Payment", Width: columns[6], Bold: true, Align: pdf.AlignRight},
+	)
+	doc.Rule()
+
+	if len(stub.Items) == 0 {
+		doc.Paragraph("No rates in this pay period.")
+	}
+	for _, item := range stub.Items {
+		workOrder := fmt.Sprintf("Job #%d", item.JobID)
+		if item.WorkOrder != nil && *item.WorkOrder != "" {
+			workOrder = *item.WorkOrder
+		}
+		doc.Row(
+			pdf.Cell{Text: item.JobDate.Format(paystubDateFormat), Width: columns[0]},
+			pdf.Cell{Text: workOrder, Width: columns[1]},
+			pdf.Cell{Text: item.PropertyAddress(), Width: columns[2]},
+			pdf.Cell{Text: item.SalePrice.String(), Width: columns[3], Align: pdf.AlignRight},
+			pdf.Cell{Text: (item.TechParts + item.CompanyParts).String(), Width: columns[4], Align: pdf.AlignRight},
+			pdf.Cell{Text: item.Deduction.String(), Width: columns[5], Align: pdf.AlignRight},
+			pdf.Cell{Text: item.Payment.String(), Width: columns[6], Align: pdf.AlignRight},
+		)
+	}
+
+	t := stub.Totals
+	doc.Rule()
+	doc.Row(
+		pdf.Cell{Text: "Totals", Width: columns[0] + columns[1] + columns[2], Bold: true},
+		pdf.Cell{Text: t.SalePrice.String(), Width: columns[3], Bold: true, Align: pdf.AlignRight},
+		pdf.Cell{Text: (t.TechParts + t.CompanyParts).String(), Width: columns[4], Bold: true, Align: pdf.AlignRight},
+		pdf.Cell{Text: t.Deduction.String(), Width: columns[5], Bold: true, Align: pdf.AlignRight},
+		pdf.Cell{Text: t.Payment.String(), Width: columns[6], Bold: true, Align: pdf.AlignRight},
+	)
+	doc.Space(8)
+
+	doc.SetFont(false, 10)
+	doc.KeyValue("Paid", t.Paid.String())
+	doc.KeyValue("Pending", t.Payable.String())
+
+	return doc
+}
@@ -0,0 +1,36 @@
+package payroll
+
+import "context"
+
+// Repository define los métodos para consultar y liquidar tarifas de técnicos.
+// La fecha de una tarifa es la fecha de completado del job o, si no tiene, la de creación de la tarifa.
+type Repository interface {
+	// GetTechnician obtiene el técnico (nombre y email)
+	GetTechnician(ctx context.Context, userID int64) (*Technician, error)
+
+	// Summary agrupa por técnico las tarifas no pagadas del periodo
+	Summary(ctx context.Context, period Period) ([]*UserSummary, error)
+
+	// ListItems obtiene las tarifas de un técnico en el periodo
+	ListItems(ctx context.Context, userID int64, period Period) ([]*PayItem, error)
+
+	// MarkPaid marca como pagadas las tarifas no pagadas ni retenidas del técnico en el periodo.
+	// Si rateIDs no está vacío, solo considera esas tarifas. Retorna cuántas se marcaron.
+	MarkPaid(ctx context.Context, userID int64, period Period, rateIDs []int64) (int64, error)
+
+	// SetHeld retiene o libera tarifas no pagadas del técnico. Retorna cuántas cambiaron.
+	SetHeld(ctx context.Context, userID int64, rateIDs []int64, held bool) (int64, error)
+
+	// ListPayouts agrupa por día de pago las tarifas pagadas del técnico, más recientes primero
+	ListPayouts(ctx context.Context, userID int64) ([]*Payout, error)
+
+	// CreateDelivery registra una solicitud de envío de recibo de pago
+	CreateDelivery(ctx context.Context, delivery *PaystubDelivery) error
+
+	// UpdateDelivery actualiza el estado, los intentos y el último error de un envío.
+	// Al pasar a sent registra la fecha de envío.
+	UpdateDelivery(ctx context.Context, id int64, status string, attempts int, lastError *string) error
+
+	// ListDeliveries obtiene las solicitudes de envío de recibos del técnico, más recientes primero
+	ListDeliveries(ctx context.Context, userID int64) ([]*PaystubDelivery, error)
+}
@@ -0,0 +1,32 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SetHeld retiene (held=true) o libera (held=false) tarifas no pagadas del técnico
+func (uc *UseCase) SetHeld(ctx context.Context, userID int64, rateIDs []int64, held bool) (int64, error) {
+	if len(rateIDs) == 0 {
+		return 0, ErrRateIDsRequired
+	}
+
+	if _, err := uc.repo.GetTechnician(ctx, userID); err != nil {
+		return 0, ErrInvalidUser
+	}
+
+	count, err := uc.repo.SetHeld(ctx, userID, rateIDs, held)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update rate hold",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "Rate hold updated",
+		slog.Int64("userId", userID),
+		slog.Bool("held", held),
+		slog.Int64("count", count))
+
+	return count, nil
+}
@@ -0,0 +1,22 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Summary obtiene, por técnico, el total pendiente de pago del periodo
+func (uc *UseCase) Summary(ctx context.Context, period Period) ([]*UserSummary, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+
+	summaries, err := uc.repo.Summary(ctx, period)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get payroll summary",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,45 @@
+package payroll
+
+import (
+	"context"
+
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// Service define la interfaz del servicio de nómina
+type Service interface {
+	Summary(ctx context.Context, period Period) ([]*UserSummary, error)
+	GetPay(ctx context.Context, userID int64, period Period) (*Pay, error)
+	MarkPaid(ctx context.Context, userID int64, period Period, rateIDs []int64) (int64, error)
+	SetHeld(ctx context.Context, userID int64, rateIDs []int64, held bool) (int64, error)
+	GetPaystub(ctx context.Context, userID int64, period Period) (*Pay, error)
+	EmailPaystub(ctx context.Context, userID int64, period Period, email *string) (*PaystubDelivery, error)
+	ListDeliveries(ctx context.Context, userID int64) ([]*PaystubDelivery, error)
+	History(ctx context.Context, userID int64) ([]*Payout, error)
+}
+
+// UserIDResolver obtiene el ID del usuario autenticado a partir del contexto
+type UserIDResolver func(ctx context.Context) (int64, bool)
+
+// EmailQueue encola correos para entregarse en segundo plano
+type EmailQueue interface {
+	EnqueueEmail(ctx context.Context, kind string, referenceID *int64, msg *mail.Message) (*domainOutbox.Message, error)
+}
+
+// UseCase implementa la lógica de negocio de nómina
+type UseCase struct {
+	repo         Repository
+	userResolver UserIDResolver
+	queue        EmailQueue
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de nómina.
+// Si queue es nil los envíos de recibos solo quedan registrados.
+func NewUseCase(repo Repository, userResolver UserIDResolver, queue EmailQueue) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		userResolver: userResolver,
+		queue:        queue,
+	}
+}
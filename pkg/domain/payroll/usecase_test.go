@@ -0,0 +1,260 @@
+package payroll
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/common/mail"
+	"github.com/your-org/jvairv2/pkg/common/money"
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func strPtr(s string) *string { return &s }
+
+var (
+	period = Period{From: date("2024-01-01"), To: date("2024-01-15")}
+	tech   = &Technician{ID: 5, Name: "John Tech", Email: strPtr("john@example.com")}
+)
+
+func TestPeriodValidate(t *testing.T) {
+	assert.NoError(t, period.Validate())
+	assert.Equal(t, date("2024-01-16"), period.End())
+
+	assert.EqualError(t, Period{From: date("2024-01-01")}.Validate(), "from and to are required")
+	assert.EqualError(t, Period{From: period.To, To: period.From}.Validate(), "from must be before or equal to to")
+}
+
+func TestGetPay(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("splits totals by state", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil)
+		items := []*PayItem{
+			{RateID: 1, SalePrice: money.MustParse("100"), Payment: money.MustParse("10.10")},
+			{RateID: 2, SalePrice: money.MustParse("200"), Payment: money.MustParse("20.20"), Held: true},
+			{RateID: 3, SalePrice: money.MustParse("300"), Payment: money.MustParse("30.30"), Paid: true},
+			{RateID: 4, SalePrice: money.MustParse("0.10"), Payment: money.MustParse("0.20")},
+		}
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+		repo.On("ListItems", ctx, int64(5), period).Return(items, nil)
+
+		pay, err := uc.GetPay(ctx, 5, period)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "John Tech", pay.UserName)
+		assert.Equal(t, "600.10", pay.Totals.SalePrice.String())
+		assert.Equal(t, "60.80", pay.Totals.Payment.String())
+		assert.Equal(t, "10.30", pay.Totals.Payable.String())
+		assert.Equal(t, "20.20", pay.Totals.Held.String())
+		assert.Equal(t, "30.30", pay.Totals.Paid.String())
+	})
+
+	t.Run("invalid user", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(nil, errors.New("not found"))
+
+		pay, err := uc.GetPay(ctx, 5, period)
+
+		assert.Nil(t, pay)
+		assert.Equal(t, ErrInvalidUser, err)
+		repo.AssertNotCalled(t, "ListItems", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMarkPaid(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo, nil, nil)
+
+	repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+	repo.On("MarkPaid", ctx, int64(5), period, []int64(nil)).Return(int64(3), nil)
+
+	count, err := uc.MarkPaid(ctx, 5, period, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
+
+func TestSetHeld(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires rate ids", func(t *testing.T) {
+		uc := NewUseCase(new(MockRepository), nil, nil)
+
+		_, err := uc.SetHeld(ctx, 5, nil, true)
+
+		assert.Equal(t, ErrRateIDsRequired, err)
+	})
+
+	t.Run("releases rates", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+		repo.On("SetHeld", ctx, int64(5), []int64{1, 2}, false).Return(int64(2), nil)
+
+		count, err := uc.SetHeld(ctx, 5, []int64{1, 2}, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+}
+
+func TestGetPaystub(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo, nil, nil)
+	items := []*PayItem{
+		{RateID: 1, Payment: money.MustParse("10.10")},
+		{RateID: 2, Payment: money.MustParse("20.20"), Held: true},
+		{RateID: 3, Payment: money.MustParse("30.30"), Paid: true},
+	}
+
+	repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+	repo.On("ListItems", ctx, int64(5), period).Return(items, nil)
+
+	stub, err := uc.GetPaystub(ctx, 5, period)
+
+	assert.NoError(t, err)
+	assert.Len(t, stub.Items, 2)
+	assert.Equal(t, "40.40", stub.Totals.Payment.String())
+	assert.Equal(t, money.Zero, stub.Totals.Held)
+
+	content, err := RenderPaystub(stub).Bytes()
+	assert.NoError(t, err)
+	assert.True(t, len(content) > 0)
+}
+
+func TestEmailPaystub(t *testing.T) {
+	ctx := context.Background()
+	items := []*PayItem{{RateID: 1, Payment: money.MustParse("10.10")}}
+
+	t.Run("queues delivery to technician email", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, func(context.Context) (int64, bool) { return 1, true }, nil)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+		repo.On("ListItems", ctx, int64(5), period).Return(items, nil)
+		repo.On("CreateDelivery", ctx, mock.AnythingOfType("*payroll.PaystubDelivery")).Return(nil)
+
+		delivery, err := uc.EmailPaystub(ctx, 5, period, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "john@example.com", delivery.Email)
+		assert.Equal(t, DeliveryPending, delivery.Status)
+		assert.Equal(t, int64(1), *delivery.RequestedBy)
+	})
+
+	t.Run("enqueues email with pdf attachment", func(t *testing.T) {
+		repo := new(MockRepository)
+		queue := new(mockQueue)
+		uc := NewUseCase(repo, nil, queue)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+		repo.On("ListItems", ctx, int64(5), period).Return(items, nil)
+		repo.On("CreateDelivery", ctx, mock.AnythingOfType("*payroll.PaystubDelivery")).Run(func(args mock.Arguments) {
+			args.Get(1).(*PaystubDelivery).ID = 42
+		}).Return(nil)
+		queue.On("EnqueueEmail", ctx, PaystubKind, mock.Anything, mock.AnythingOfType("*mail.Message")).Return(&domainOutbox.Message{ID: 1}, nil)
+
+		_, err := uc.EmailPaystub(ctx, 5, period, nil)
+
+		assert.NoError(t, err)
+		ref := queue.Calls[0].Arguments.Get(2).(*int64)
+		msg := queue.Calls[0].Arguments.Get(3).(*mail.Message)
+		assert.Equal(t, int64(42), *ref)
+		assert.Equal(t, []string{"john@example.com"}, msg.To)
+		assert.Len(t, msg.Attachments, 1)
+		assert.Equal(t, "application/pdf", msg.Attachments[0].ContentType)
+	})
+
+	t.Run("enqueue failure marks delivery as failed", func(t *testing.T) {
+		repo := new(MockRepository)
+		queue := new(mockQueue)
+		uc := NewUseCase(repo, nil, queue)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+		repo.On("ListItems", ctx, int64(5), period).Return(items, nil)
+		repo.On("CreateDelivery", ctx, mock.AnythingOfType("*payroll.PaystubDelivery")).Run(func(args mock.Arguments) {
+			args.Get(1).(*PaystubDelivery).ID = 42
+		}).Return(nil)
+		queue.On("EnqueueEmail", ctx, PaystubKind, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+		repo.On("UpdateDelivery", ctx, int64(42), DeliveryFailed, 0, mock.Anything).Return(nil)
+
+		_, err := uc.EmailPaystub(ctx, 5, period, nil)
+
+		assert.EqualError(t, err, "db down")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid override email", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+
+		_, err := uc.EmailPaystub(ctx, 5, period, strPtr("nope"))
+
+		assert.Equal(t, ErrInvalidEmail, err)
+		repo.AssertNotCalled(t, "CreateDelivery", mock.Anything, mock.Anything)
+	})
+
+	t.Run("empty paystub", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil)
+
+		repo.On("GetTechnician", ctx, int64(5)).Return(tech, nil)
+		repo.On("ListItems", ctx, int64(5), period).Return([]*PayItem{}, nil)
+
+		_, err := uc.EmailPaystub(ctx, 5, period, nil)
+
+		assert.Equal(t, ErrEmptyPaystub, err)
+	})
+}
+
+func TestOnDeliveryResult(t *testing.T) {
+	ctx := context.Background()
+	ref := int64(42)
+
+	cases := map[string]string{
+		domainOutbox.StatusSent:    DeliverySent,
+		domainOutbox.StatusDead:    DeliveryFailed,
+		domainOutbox.StatusPending: DeliveryPending,
+	}
+	for outboxStatus, want := range cases {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo, nil, nil)
+		lastError := strPtr("timeout")
+
+		repo.On("UpdateDelivery", ctx, ref, want, 2, lastError).Return(nil)
+
+		uc.OnDeliveryResult(ctx, &domainOutbox.Message{ReferenceID: &ref, Status: outboxStatus, Attempts: 2, LastError: lastError})
+
+		repo.AssertExpectations(t)
+	}
+}
+
+type mockQueue struct {
+	mock.Mock
+}
+
+func (m *mockQueue) EnqueueEmail(ctx context.Context, kind string, referenceID *int64, msg *mail.Message) (*domainOutbox.Message, error) {
+	args := m.Called(ctx, kind, referenceID, msg)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainOutbox.Message), args.Error(1)
+}
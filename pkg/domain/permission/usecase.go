@@ -4,19 +4,23 @@ import (
 	"context"
 
 	"github.com/your-org/jvairv2/pkg/domain/ability"
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 )
 
 // UseCase define los casos de uso para la gestión de permisos
 type UseCase struct {
-	repo        Repository
-	abilityRepo ability.Repository
+	repo         Repository
+	abilityRepo  ability.Repository
+	abilityCache authorization.Invalidator
 }
 
-// NewUseCase crea una nueva instancia del caso de uso de permisos
-func NewUseCase(repo Repository, abilityRepo ability.Repository) *UseCase {
+// NewUseCase crea una nueva instancia del caso de uso de permisos.
+// abilityCache puede ser nil si los permisos no se guardan en caché.
+func NewUseCase(repo Repository, abilityRepo ability.Repository, abilityCache authorization.Invalidator) *UseCase {
 	return &UseCase{
-		repo:        repo,
-		abilityRepo: abilityRepo,
+		repo:         repo,
+		abilityRepo:  abilityRepo,
+		abilityCache: abilityCache,
 	}
 }
 
@@ -43,7 +47,12 @@ func (uc *UseCase) Create(ctx context.Context, permission *Permission) error {
 		return err
 	}
 
-	return uc.repo.Create(ctx, permission)
+	if err := uc.repo.Create(ctx, permission); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEntity(ctx, uc.abilityCache, permission.EntityType, permission.EntityID)
+	return nil
 }
 
 // Update actualiza un permiso existente
@@ -54,12 +63,28 @@ func (uc *UseCase) Update(ctx context.Context, permission *Permission) error {
 		return err
 	}
 
-	return uc.repo.Update(ctx, permission)
+	// El permiso puede cambiar de entidad; se descarta también la anterior
+	previous := uc.previous(ctx, permission.ID)
+
+	if err := uc.repo.Update(ctx, permission); err != nil {
+		return err
+	}
+
+	uc.invalidatePrevious(ctx, previous)
+	authorization.InvalidateEntity(ctx, uc.abilityCache, permission.EntityType, permission.EntityID)
+	return nil
 }
 
 // Delete elimina un permiso
 func (uc *UseCase) Delete(ctx context.Context, id int64) error {
-	return uc.repo.Delete(ctx, id)
+	previous := uc.previous(ctx, id)
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.invalidatePrevious(ctx, previous)
+	return nil
 }
 
 // Exists verifica si existe un permiso específico
@@ -71,3 +96,29 @@ func (uc *UseCase) Exists(ctx context.Context, abilityID, entityID int64, entity
 func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Permission, int, error) {
 	return uc.repo.List(ctx, filters, page, pageSize)
 }
+
+// previous obtiene el permiso antes de modificarlo para saber a quién afecta el cambio.
+// Solo se consulta si hay caché de permisos.
+func (uc *UseCase) previous(ctx context.Context, id int64) *Permission {
+	if uc.abilityCache == nil {
+		return nil
+	}
+	p, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+// invalidatePrevious descarta los permisos en caché de la entidad anterior.
+// Si no se pudo obtener se descartan todos.
+func (uc *UseCase) invalidatePrevious(ctx context.Context, previous *Permission) {
+	if uc.abilityCache == nil {
+		return
+	}
+	if previous == nil {
+		uc.abilityCache.InvalidateAll(ctx)
+		return
+	}
+	authorization.InvalidateEntity(ctx, uc.abilityCache, previous.EntityType, previous.EntityID)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/your-org/jvairv2/pkg/domain/ability"
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 )
 
 func TestUseCase_GetByID(t *testing.T) {
@@ -15,7 +16,7 @@ func TestUseCase_GetByID(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -54,7 +55,7 @@ func TestUseCase_GetByID_Error(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -82,7 +83,7 @@ func TestUseCase_GetByEntity(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -129,7 +130,7 @@ func TestUseCase_GetByAbility(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -175,7 +176,7 @@ func TestUseCase_Create_Success(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -213,7 +214,7 @@ func TestUseCase_Create_AbilityNotFound(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -252,7 +253,7 @@ func TestUseCase_Update_Success(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -292,7 +293,7 @@ func TestUseCase_Delete(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -317,7 +318,7 @@ func TestUseCase_Exists(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -345,7 +346,7 @@ func TestUseCase_List(t *testing.T) {
 	mockAbilityRepo := new(MockAbilityRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAbilityRepo)
+	useCase := NewUseCase(mockRepo, mockAbilityRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -389,3 +390,66 @@ func TestUseCase_List(t *testing.T) {
 	// Verificar que se llamó al método del repositorio con los argumentos correctos
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUseCase_InvalidatesAbilityCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create for user invalidates that user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAbilityRepo := new(MockAbilityRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, mockAbilityRepo, mockCache)
+
+		p := &Permission{AbilityID: 2, EntityID: 10, EntityType: "App\\Models\\User"}
+		mockAbilityRepo.On("GetByID", ctx, int64(2)).Return(&ability.Ability{ID: 2}, nil)
+		mockRepo.On("Create", ctx, p).Return(nil)
+		mockCache.On("InvalidateUser", ctx, int64(10)).Return()
+
+		assert.NoError(t, useCase.Create(ctx, p))
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("update moving from user to role invalidates everyone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAbilityRepo := new(MockAbilityRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, mockAbilityRepo, mockCache)
+
+		p := &Permission{ID: 1, AbilityID: 2, EntityID: 3, EntityType: "roles"}
+		mockAbilityRepo.On("GetByID", ctx, int64(2)).Return(&ability.Ability{ID: 2}, nil)
+		mockRepo.On("GetByID", ctx, int64(1)).Return(&Permission{ID: 1, AbilityID: 2, EntityID: 10, EntityType: "App\\Models\\User"}, nil)
+		mockRepo.On("Update", ctx, p).Return(nil)
+		mockCache.On("InvalidateUser", ctx, int64(10)).Return()
+		mockCache.On("InvalidateAll", ctx).Return()
+
+		assert.NoError(t, useCase.Update(ctx, p))
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("delete invalidates the previous user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, new(MockAbilityRepository), mockCache)
+
+		mockRepo.On("GetByID", ctx, int64(1)).Return(&Permission{ID: 1, EntityID: 10, EntityType: "App\\Models\\User"}, nil)
+		mockRepo.On("Delete", ctx, int64(1)).Return(nil)
+		mockCache.On("InvalidateUser", ctx, int64(10)).Return()
+
+		assert.NoError(t, useCase.Delete(ctx, 1))
+		mockCache.AssertExpectations(t)
+		mockCache.AssertNotCalled(t, "InvalidateAll", ctx)
+	})
+
+	t.Run("delete of unknown permission invalidates everyone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, new(MockAbilityRepository), mockCache)
+
+		mockRepo.On("GetByID", ctx, int64(1)).Return(nil, errors.New("not found"))
+		mockRepo.On("Delete", ctx, int64(1)).Return(nil)
+		mockCache.On("InvalidateAll", ctx).Return()
+
+		assert.NoError(t, useCase.Delete(ctx, 1))
+		mockCache.AssertExpectations(t)
+	})
+}
@@ -7,7 +7,7 @@ import (
 
 func (uc *UseCase) Delete(ctx context.Context, id int64) error {
 	// Verificar que la cotización existe
-	_, err := uc.repo.GetByID(ctx, id)
+	_, err := uc.getVisible(ctx, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get quote for deletion",
 			slog.String("error", err.Error()),
@@ -6,7 +6,7 @@ import (
 )
 
 func (uc *UseCase) GetByID(ctx context.Context, id int64) (*Quote, error) {
-	q, err := uc.repo.GetByID(ctx, id)
+	q, err := uc.getVisible(ctx, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get quote by ID",
 			slog.String("error", err.Error()),
@@ -13,6 +13,8 @@ func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, pag
 		pageSize = 15
 	}
 
+	filters = uc.scopeFilters(ctx, filters)
+
 	quotes, total, err := uc.repo.List(ctx, filters, page, pageSize)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to list quotes",
@@ -12,7 +12,7 @@ func (uc *UseCase) Update(ctx context.Context, q *Quote) error {
 	}
 
 	// Verificar que la cotización existe
-	existing, err := uc.repo.GetByID(ctx, q.ID)
+	existing, err := uc.getVisible(ctx, q.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get quote for update",
 			slog.String("error", err.Error()),
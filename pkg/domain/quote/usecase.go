@@ -7,6 +7,9 @@ type JobChecker interface {
 	GetByID(ctx context.Context, id int64) (interface{}, error)
 }
 
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
 // QuoteStatusChecker verifica existencia de estados de cotización
 type QuoteStatusChecker interface {
 	GetByID(ctx context.Context, id int64) (interface{}, error)
@@ -26,6 +29,7 @@ type UseCase struct {
 	repo            Repository
 	jobRepo         JobChecker
 	quoteStatusRepo QuoteStatusChecker
+	jobOwner        JobOwnerResolver
 }
 
 // NewUseCase crea una nueva instancia del caso de uso de cotizaciones
@@ -33,10 +37,48 @@ func NewUseCase(
 	repo Repository,
 	jobRepo JobChecker,
 	quoteStatusRepo QuoteStatusChecker,
+	jobOwner JobOwnerResolver,
 ) *UseCase {
 	return &UseCase{
 		repo:            repo,
 		jobRepo:         jobRepo,
 		quoteStatusRepo: quoteStatusRepo,
+		jobOwner:        jobOwner,
+	}
+}
+
+// getVisible obtiene la cotización verificando que su job sea visible para el usuario autenticado
+func (uc *UseCase) getVisible(ctx context.Context, id int64) (*Quote, error) {
+	q, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.jobOwner != nil {
+		if _, restricted := uc.jobOwner(ctx); restricted {
+			if _, err := uc.jobRepo.GetByID(ctx, q.JobID); err != nil {
+				return nil, ErrQuoteNotFound
+			}
+		}
+	}
+
+	return q, nil
+}
+
+// scopeFilters limita el listado a los jobs visibles para el usuario autenticado
+func (uc *UseCase) scopeFilters(ctx context.Context, filters map[string]interface{}) map[string]interface{} {
+	if uc.jobOwner == nil {
+		return filters
+	}
+	ownerID, restricted := uc.jobOwner(ctx)
+	if !restricted {
+		return filters
+	}
+
+	scoped := make(map[string]interface{}, len(filters)+1)
+	for k, val := range filters {
+		scoped[k] = val
 	}
+	scoped["job_user_id"] = ownerID
+	return scoped
 }
@@ -14,7 +14,7 @@ func newTestUseCase() (*UseCase, *MockRepository, *MockJobChecker, *MockQuoteSta
 	repo := new(MockRepository)
 	jobChecker := new(MockJobChecker)
 	quoteStatusChecker := new(MockQuoteStatusChecker)
-	uc := NewUseCase(repo, jobChecker, quoteStatusChecker)
+	uc := NewUseCase(repo, jobChecker, quoteStatusChecker, nil)
 	return uc, repo, jobChecker, quoteStatusChecker
 }
 
@@ -168,6 +168,21 @@ func TestGetByID(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Equal(t, ErrQuoteNotFound, err)
 	})
+
+	t.Run("job outside user scope", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobChecker := new(MockJobChecker)
+		jobOwner := func(ctx context.Context) (int64, bool) { return 5, true }
+		uc := NewUseCase(repo, jobChecker, new(MockQuoteStatusChecker), jobOwner)
+
+		repo.On("GetByID", ctx, int64(1)).Return(&Quote{ID: 1, JobID: 10}, nil)
+		jobChecker.On("GetByID", ctx, int64(10)).Return(nil, errors.New("job not found"))
+
+		result, err := uc.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, ErrQuoteNotFound, err)
+	})
 }
 
 func TestList(t *testing.T) {
@@ -2,17 +2,22 @@ package role
 
 import (
 	"context"
+
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 )
 
 // UseCase define los casos de uso para la gestión de roles
 type UseCase struct {
-	repo Repository
+	repo         Repository
+	abilityCache authorization.Invalidator
 }
 
-// NewUseCase crea una nueva instancia del caso de uso de roles
-func NewUseCase(repo Repository) *UseCase {
+// NewUseCase crea una nueva instancia del caso de uso de roles.
+// abilityCache puede ser nil si los permisos no se guardan en caché.
+func NewUseCase(repo Repository, abilityCache authorization.Invalidator) *UseCase {
 	return &UseCase{
-		repo: repo,
+		repo:         repo,
+		abilityCache: abilityCache,
 	}
 }
 
@@ -33,12 +38,22 @@ func (uc *UseCase) Create(ctx context.Context, role *Role) error {
 
 // Update actualiza un rol existente
 func (uc *UseCase) Update(ctx context.Context, role *Role) error {
-	return uc.repo.Update(ctx, role)
+	if err := uc.repo.Update(ctx, role); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEveryone(ctx, uc.abilityCache)
+	return nil
 }
 
 // Delete elimina un rol
 func (uc *UseCase) Delete(ctx context.Context, id int64) error {
-	return uc.repo.Delete(ctx, id)
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	authorization.InvalidateEveryone(ctx, uc.abilityCache)
+	return nil
 }
 
 // List obtiene una lista paginada de roles con filtros opcionales
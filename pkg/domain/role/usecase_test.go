@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 )
 
 func TestUseCase_GetByID(t *testing.T) {
@@ -14,7 +16,7 @@ func TestUseCase_GetByID(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -51,7 +53,7 @@ func TestUseCase_GetByID_Error(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -78,7 +80,7 @@ func TestUseCase_GetByName(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -115,7 +117,7 @@ func TestUseCase_Create(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -146,7 +148,7 @@ func TestUseCase_Update(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -178,7 +180,7 @@ func TestUseCase_Delete(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -202,7 +204,7 @@ func TestUseCase_List(t *testing.T) {
 	mockRepo := new(MockRepository)
 
 	// Crear el caso de uso con el mock
-	useCase := NewUseCase(mockRepo)
+	useCase := NewUseCase(mockRepo, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -250,3 +252,43 @@ func TestUseCase_List(t *testing.T) {
 	// Verificar que se llamó al método del repositorio con los argumentos correctos
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUseCase_InvalidatesAbilityCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update invalidates everyone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, mockCache)
+
+		r := &Role{ID: 1, Name: "admin"}
+		mockRepo.On("Update", ctx, r).Return(nil)
+		mockCache.On("InvalidateAll", ctx).Return()
+
+		assert.NoError(t, useCase.Update(ctx, r))
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("delete invalidates everyone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, mockCache)
+
+		mockRepo.On("Delete", ctx, int64(1)).Return(nil)
+		mockCache.On("InvalidateAll", ctx).Return()
+
+		assert.NoError(t, useCase.Delete(ctx, 1))
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("failed delete keeps cache", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockCache := new(authorization.MockInvalidator)
+		useCase := NewUseCase(mockRepo, mockCache)
+
+		mockRepo.On("Delete", ctx, int64(1)).Return(errors.New("db down"))
+
+		assert.Error(t, useCase.Delete(ctx, 1))
+		mockCache.AssertNotCalled(t, "InvalidateAll", ctx)
+	})
+}
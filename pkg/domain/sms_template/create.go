@@ -0,0 +1,26 @@
+package sms_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create crea una nueva plantilla de SMS
+func (uc *UseCase) Create(ctx context.Context, template *SMSTemplate) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(ctx, template); err != nil {
+		slog.ErrorContext(ctx, "Failed to create sms template",
+			slog.String("error", err.Error()),
+			slog.String("label", template.Label))
+		return err
+	}
+
+	slog.InfoContext(ctx, "SMS template created successfully",
+		slog.Int64("sms_template_id", template.ID),
+		slog.String("label", template.Label))
+
+	return nil
+}
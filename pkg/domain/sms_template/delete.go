@@ -0,0 +1,25 @@
+package sms_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una plantilla de SMS
+func (uc *UseCase) Delete(ctx context.Context, id int64) error {
+	if _, err := uc.repo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete sms template",
+			slog.String("error", err.Error()),
+			slog.Int64("sms_template_id", id))
+		return err
+	}
+
+	slog.InfoContext(ctx, "SMS template deleted successfully",
+		slog.Int64("sms_template_id", id))
+
+	return nil
+}
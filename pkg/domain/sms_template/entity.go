@@ -0,0 +1,39 @@
+package sms_template
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/placeholder"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+)
+
+// SMSTemplate representa una plantilla de SMS con marcadores del job
+type SMSTemplate struct {
+	ID        int64      `json:"id"`
+	Label     string     `json:"label"`
+	Message   string     `json:"message"`
+	IsActive  bool       `json:"isActive"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos y que los marcadores usados existan
+func (t *SMSTemplate) Validate() error {
+	t.Label = strings.TrimSpace(t.Label)
+
+	if t.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if strings.TrimSpace(t.Message) == "" {
+		return fmt.Errorf("message is required")
+	}
+
+	if unknown := placeholder.Unknown(t.Message, domainPlaceholder.Keys); len(unknown) > 0 {
+		return fmt.Errorf("unknown placeholders: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package sms_template
+
+import "errors"
+
+var (
+	// ErrSMSTemplateNotFound indica que la plantilla de SMS no fue encontrada
+	ErrSMSTemplateNotFound = errors.New("sms template not found")
+)
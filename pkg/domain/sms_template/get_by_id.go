@@ -0,0 +1,19 @@
+package sms_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// GetByID obtiene una plantilla de SMS por su ID
+func (uc *UseCase) GetByID(ctx context.Context, id int64) (*SMSTemplate, error) {
+	template, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get sms template by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("sms_template_id", id))
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,12 @@
+package sms_template
+
+import "context"
+
+// Repository define los métodos para interactuar con el almacenamiento de plantillas de SMS
+type Repository interface {
+	Create(ctx context.Context, template *SMSTemplate) error
+	GetByID(ctx context.Context, id int64) (*SMSTemplate, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*SMSTemplate, int, error)
+	Update(ctx context.Context, template *SMSTemplate) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,29 @@
+package sms_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza una plantilla de SMS existente
+func (uc *UseCase) Update(ctx context.Context, template *SMSTemplate) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := uc.repo.GetByID(ctx, template.ID); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Update(ctx, template); err != nil {
+		slog.ErrorContext(ctx, "Failed to update sms template",
+			slog.String("error", err.Error()),
+			slog.Int64("sms_template_id", template.ID))
+		return err
+	}
+
+	slog.InfoContext(ctx, "SMS template updated successfully",
+		slog.Int64("sms_template_id", template.ID))
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package sms_template
+
+import "context"
+
+// Service define la interfaz del servicio de plantillas de SMS
+type Service interface {
+	Create(ctx context.Context, template *SMSTemplate) error
+	GetByID(ctx context.Context, id int64) (*SMSTemplate, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*SMSTemplate, int, error)
+	Update(ctx context.Context, template *SMSTemplate) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// UseCase implementa la lógica de negocio de plantillas de SMS
+type UseCase struct {
+	repo Repository
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de plantillas de SMS
+func NewUseCase(repo Repository) *UseCase {
+	return &UseCase{
+		repo: repo,
+	}
+}
@@ -0,0 +1,65 @@
+package sms_template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		tpl := &SMSTemplate{Label: " Dispatch ", Message: "Job {{job.work_order}} at {{property.address}}"}
+		assert.NoError(t, tpl.Validate())
+		assert.Equal(t, "Dispatch", tpl.Label)
+	})
+
+	t.Run("message required", func(t *testing.T) {
+		err := (&SMSTemplate{Label: "Dispatch", Message: "  "}).Validate()
+		assert.EqualError(t, err, "message is required")
+	})
+
+	t.Run("unknown placeholders", func(t *testing.T) {
+		err := (&SMSTemplate{Label: "Dispatch", Message: "{{job.nope}}"}).Validate()
+		assert.EqualError(t, err, "unknown placeholders: job.nope")
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo)
+		tpl := &SMSTemplate{ID: 9, Label: "Dispatch", Message: "Hi"}
+
+		repo.On("GetByID", ctx, int64(9)).Return(nil, ErrSMSTemplateNotFound)
+
+		assert.Equal(t, ErrSMSTemplateNotFound, uc.Update(ctx, tpl))
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		repo := new(MockRepository)
+		uc := NewUseCase(repo)
+		tpl := &SMSTemplate{ID: 9, Label: "Dispatch", Message: "Hi {{customer.name}}"}
+
+		repo.On("GetByID", ctx, int64(9)).Return(&SMSTemplate{ID: 9}, nil)
+		repo.On("Update", ctx, tpl).Return(nil)
+
+		assert.NoError(t, uc.Update(ctx, tpl))
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	uc := NewUseCase(repo)
+
+	repo.On("GetByID", ctx, int64(9)).Return(&SMSTemplate{ID: 9}, nil)
+	repo.On("Delete", ctx, int64(9)).Return(errors.New("db down"))
+
+	assert.EqualError(t, uc.Delete(ctx, 9), "db down")
+}
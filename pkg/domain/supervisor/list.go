@@ -25,6 +25,19 @@ func (uc *UseCase) List(ctx context.Context, filters map[string]interface{}, pag
 		}
 	}
 
+	// scopeForCurrentUser: con job_view_user_only solo se listan supervisores de clientes
+	// que tengan jobs asignados al usuario
+	if uc.jobOwner != nil {
+		if ownerID, restricted := uc.jobOwner(ctx); restricted {
+			scoped := make(map[string]interface{}, len(filters)+1)
+			for k, v := range filters {
+				scoped[k] = v
+			}
+			scoped["job_user_id"] = ownerID
+			filters = scoped
+		}
+	}
+
 	supervisors, total, err := uc.repo.List(ctx, filters, page, pageSize)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to list supervisors",
@@ -14,14 +14,19 @@ type Service interface {
 	Delete(ctx context.Context, id int64) error
 }
 
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
 type UseCase struct {
 	repo         Repository
 	customerRepo customer.Repository
+	jobOwner     JobOwnerResolver
 }
 
-func NewUseCase(repo Repository, customerRepo customer.Repository) *UseCase {
+func NewUseCase(repo Repository, customerRepo customer.Repository, jobOwner JobOwnerResolver) *UseCase {
 	return &UseCase{
 		repo:         repo,
 		customerRepo: customerRepo,
+		jobOwner:     jobOwner,
 	}
 }
@@ -13,7 +13,7 @@ import (
 func TestCreate_Success(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	sup := &Supervisor{
@@ -39,7 +39,7 @@ func TestCreate_Success(t *testing.T) {
 func TestCreate_CustomerNotFound(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	sup := &Supervisor{
@@ -60,7 +60,7 @@ func TestCreate_CustomerNotFound(t *testing.T) {
 func TestCreate_RepoError(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	sup := &Supervisor{
@@ -83,7 +83,7 @@ func TestCreate_RepoError(t *testing.T) {
 func TestGetByID_Success(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	expected := &Supervisor{
@@ -104,7 +104,7 @@ func TestGetByID_Success(t *testing.T) {
 func TestGetByID_NotFound(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 
@@ -120,7 +120,7 @@ func TestGetByID_NotFound(t *testing.T) {
 func TestList_Success(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	filters := map[string]interface{}{"search": "john"}
@@ -139,10 +139,29 @@ func TestList_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestList_ScopedToCurrentUser(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockCustomerRepo := new(customer.MockRepository)
+	jobOwner := func(ctx context.Context) (int64, bool) { return 5, true }
+	uc := NewUseCase(mockRepo, mockCustomerRepo, jobOwner)
+
+	ctx := context.Background()
+	filters := map[string]interface{}{"search": "john"}
+	scoped := map[string]interface{}{"search": "john", "job_user_id": int64(5)}
+
+	mockRepo.On("List", ctx, scoped, 1, 10).Return([]*Supervisor{}, 0, nil)
+
+	_, _, err := uc.List(ctx, filters, 1, 10)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, filters, "job_user_id")
+	mockRepo.AssertExpectations(t)
+}
+
 func TestList_InvalidPagination(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	filters := map[string]interface{}{}
@@ -160,7 +179,7 @@ func TestList_InvalidPagination(t *testing.T) {
 func TestList_WithValidCustomerFilter(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	filters := map[string]interface{}{"customer_id": int64(1)}
@@ -184,7 +203,7 @@ func TestList_WithValidCustomerFilter(t *testing.T) {
 func TestList_WithInvalidCustomerFilter(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	filters := map[string]interface{}{"customer_id": int64(999)}
@@ -204,7 +223,7 @@ func TestList_WithInvalidCustomerFilter(t *testing.T) {
 func TestList_RepoError(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	filters := map[string]interface{}{}
@@ -222,7 +241,7 @@ func TestList_RepoError(t *testing.T) {
 func TestUpdate_Success(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	existing := &Supervisor{
@@ -254,7 +273,7 @@ func TestUpdate_Success(t *testing.T) {
 func TestUpdate_SupervisorDeleted(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	now := time.Now()
@@ -285,7 +304,7 @@ func TestUpdate_SupervisorDeleted(t *testing.T) {
 func TestUpdate_NotFound(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	updated := &Supervisor{
@@ -307,7 +326,7 @@ func TestUpdate_NotFound(t *testing.T) {
 func TestUpdate_InvalidCustomer(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	existing := &Supervisor{
@@ -338,7 +357,7 @@ func TestUpdate_InvalidCustomer(t *testing.T) {
 func TestDelete_Success(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	existing := &Supervisor{
@@ -360,7 +379,7 @@ func TestDelete_Success(t *testing.T) {
 func TestDelete_AlreadyDeleted(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	now := time.Now()
@@ -384,7 +403,7 @@ func TestDelete_AlreadyDeleted(t *testing.T) {
 func TestDelete_NotFound(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 
@@ -400,7 +419,7 @@ func TestDelete_NotFound(t *testing.T) {
 func TestDelete_RepoError(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockCustomerRepo := new(customer.MockRepository)
-	uc := NewUseCase(mockRepo, mockCustomerRepo)
+	uc := NewUseCase(mockRepo, mockCustomerRepo, nil)
 
 	ctx := context.Background()
 	existing := &Supervisor{
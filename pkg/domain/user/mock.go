@@ -30,6 +30,11 @@ func (m *MockRepository) GetByEmail(ctx context.Context, email string) (*User, e
 	return args.Get(0).(*User), args.Error(1)
 }
 
+func (m *MockRepository) UpdatePassword(ctx context.Context, id int64, hashedPassword string) error {
+	args := m.Called(ctx, id, hashedPassword)
+	return args.Error(0)
+}
+
 func (m *MockRepository) Create(ctx context.Context, user *User) error {
 	args := m.Called(ctx, user)
 	return args.Error(0)
@@ -164,3 +169,28 @@ func (m *MockRoleRepository) List(ctx context.Context, filters map[string]interf
 	}
 	return args.Get(0).([]*role.Role), args.Int(1), args.Error(2)
 }
+
+// MockPasswordPolicy es un mock de la política de contraseñas
+type MockPasswordPolicy struct {
+	mock.Mock
+}
+
+func (m *MockPasswordPolicy) Validate(ctx context.Context, userID int64, password string) error {
+	args := m.Called(ctx, userID, password)
+	return args.Error(0)
+}
+
+func (m *MockPasswordPolicy) Record(ctx context.Context, userID int64, hashedPassword string) error {
+	args := m.Called(ctx, userID, hashedPassword)
+	return args.Error(0)
+}
+
+// MockTokenRevoker es un mock de la interfaz TokenRevoker
+type MockTokenRevoker struct {
+	mock.Mock
+}
+
+func (m *MockTokenRevoker) RevokeUserTokens(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
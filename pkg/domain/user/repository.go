@@ -21,6 +21,9 @@ type Repository interface {
 	// Actualizar un usuario existente
 	Update(ctx context.Context, user *User) error
 
+	// Actualizar la contraseña (ya hasheada con bcrypt) de un usuario
+	UpdatePassword(ctx context.Context, id int64, hashedPassword string) error
+
 	// Eliminar un usuario (soft delete)
 	Delete(ctx context.Context, id string) error
 
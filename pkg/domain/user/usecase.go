@@ -12,6 +12,7 @@ import (
 
 	"github.com/your-org/jvairv2/pkg/domain/ability"
 	"github.com/your-org/jvairv2/pkg/domain/assigned_role"
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 	"github.com/your-org/jvairv2/pkg/domain/role"
 )
 
@@ -23,19 +24,40 @@ var (
 	ErrAssignedRoleNotFound = errors.New("asignación de rol no encontrada")
 )
 
+// PasswordPolicy valida las contraseñas nuevas contra la política configurada en settings
+// y las registra en el historial
+type PasswordPolicy interface {
+	Validate(ctx context.Context, userID int64, password string) error
+	Record(ctx context.Context, userID int64, hashedPassword string) error
+}
+
+// TokenRevoker cierra las sesiones abiertas de un usuario
+type TokenRevoker interface {
+	RevokeUserTokens(ctx context.Context, userID int64) error
+}
+
 // UseCase define los casos de uso para la gestión de usuarios
 type UseCase struct {
 	repo             Repository
 	assignedRoleRepo assigned_role.Repository
 	roleRepo         role.Repository
+	passwordPolicy   PasswordPolicy
+	abilityCache     authorization.Invalidator
+	tokens           TokenRevoker
 }
 
-// NewUseCase crea una nueva instancia del caso de uso de usuarios
-func NewUseCase(repo Repository, assignedRoleRepo assigned_role.Repository, roleRepo role.Repository) *UseCase {
+// NewUseCase crea una nueva instancia del caso de uso de usuarios.
+// passwordPolicy puede ser nil; en ese caso no se valida ni se registra el historial.
+// abilityCache puede ser nil si los permisos no se guardan en caché.
+// tokens puede ser nil; en ese caso desactivar o eliminar un usuario no cierra sus sesiones.
+func NewUseCase(repo Repository, assignedRoleRepo assigned_role.Repository, roleRepo role.Repository, passwordPolicy PasswordPolicy, abilityCache authorization.Invalidator, tokens TokenRevoker) *UseCase {
 	return &UseCase{
 		repo:             repo,
 		assignedRoleRepo: assignedRoleRepo,
 		roleRepo:         roleRepo,
+		passwordPolicy:   passwordPolicy,
+		abilityCache:     abilityCache,
+		tokens:           tokens,
 	}
 }
 
@@ -72,6 +94,13 @@ func (uc *UseCase) Create(ctx context.Context, user *User) error {
 		return fmt.Errorf("error al verificar email existente: %w", err)
 	}
 
+	// Validar la contraseña contra la política
+	if uc.passwordPolicy != nil {
+		if err := uc.passwordPolicy.Validate(ctx, 0, user.Password); err != nil {
+			return err
+		}
+	}
+
 	// Hash de la contraseña
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -106,6 +135,8 @@ func (uc *UseCase) Create(ctx context.Context, user *User) error {
 		"email", user.Email,
 	)
 
+	uc.recordPassword(ctx, user.ID, user.Password)
+
 	// Si se especificó un rol, asignar el rol al usuario
 	if user.RoleID != nil {
 		roleID, err := uc.getRoleID(*user.RoleID)
@@ -160,13 +191,20 @@ func (uc *UseCase) Update(ctx context.Context, user *User) error {
 		}
 	}
 
-	// Si se proporciona una nueva contraseña, hashearla
+	// Si se proporciona una nueva contraseña, validarla y hashearla
+	passwordChanged := false
 	if user.Password != "" && user.Password != existingUser.Password {
+		if uc.passwordPolicy != nil {
+			if err := uc.passwordPolicy.Validate(ctx, user.ID, user.Password); err != nil {
+				return err
+			}
+		}
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 		if err != nil {
 			return err
 		}
 		user.Password = string(hashedPassword)
+		passwordChanged = true
 	} else {
 		// Mantener la contraseña existente
 		user.Password = existingUser.Password
@@ -182,6 +220,19 @@ func (uc *UseCase) Update(ctx context.Context, user *User) error {
 		return err
 	}
 
+	// Un usuario desactivado pierde sus sesiones abiertas
+	if existingUser.IsActive && !user.IsActive {
+		uc.revokeSessions(ctx, user.ID)
+	}
+
+	// Update no modifica la contraseña; se guarda aparte
+	if passwordChanged {
+		if err := uc.repo.UpdatePassword(ctx, user.ID, user.Password); err != nil {
+			return err
+		}
+		uc.recordPassword(ctx, user.ID, user.Password)
+	}
+
 	// Si se especificó un nuevo rol, actualizar la asignación de rol
 	if user.RoleID != nil && (existingUser.RoleID == nil || *user.RoleID != *existingUser.RoleID) {
 		// Obtener el ID numérico del rol
@@ -219,6 +270,8 @@ func (uc *UseCase) Update(ctx context.Context, user *User) error {
 		if err != nil {
 			return err
 		}
+
+		authorization.InvalidateEntity(ctx, uc.abilityCache, authorization.UserType, user.ID)
 	}
 
 	return nil
@@ -226,7 +279,15 @@ func (uc *UseCase) Update(ctx context.Context, user *User) error {
 
 // Delete elimina un usuario (soft delete)
 func (uc *UseCase) Delete(ctx context.Context, id string) error {
-	return uc.repo.Delete(ctx, id)
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if userID, err := strconv.ParseInt(id, 10, 64); err == nil {
+		uc.revokeSessions(ctx, userID)
+	}
+
+	return nil
 }
 
 // List obtiene una lista paginada de usuarios con filtros opcionales
@@ -330,3 +391,34 @@ func (uc *UseCase) getRoleID(roleIDStr string) (int64, error) {
 func parseRoleID(roleID string) (int64, error) {
 	return strconv.ParseInt(roleID, 10, 64)
 }
+
+// recordPassword registra la contraseña en el historial. La contraseña ya se guardó,
+// por lo que un fallo solo se registra en el log.
+func (uc *UseCase) recordPassword(ctx context.Context, userID int64, hashedPassword string) {
+	if uc.passwordPolicy == nil {
+		return
+	}
+	if err := uc.passwordPolicy.Record(ctx, userID, hashedPassword); err != nil {
+		slog.Warn("Error al registrar el historial de contraseñas",
+			"user_id", userID,
+			"error", err,
+		)
+	}
+}
+
+// revokeSessions cierra las sesiones del usuario; el cambio ya se guardó, por lo que un fallo solo se registra
+func (uc *UseCase) revokeSessions(ctx context.Context, userID int64) {
+	if uc.tokens == nil {
+		return
+	}
+	if err := uc.tokens.RevokeUserTokens(ctx, userID); err != nil {
+		slog.Error("Error al cerrar las sesiones del usuario",
+			"user_id", userID,
+			"error", err,
+		)
+		return
+	}
+	slog.Info("Sesiones del usuario cerradas",
+		"user_id", userID,
+	)
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -19,7 +20,7 @@ func TestUseCase_GetByID(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -59,7 +60,7 @@ func TestUseCase_GetByEmail(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -99,7 +100,7 @@ func TestUseCase_Create(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -151,7 +152,7 @@ func TestUseCase_Create_DuplicateEmail(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -197,7 +198,7 @@ func TestUseCase_VerifyCredentials_Success(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -241,7 +242,7 @@ func TestUseCase_VerifyCredentials_InvalidCredentials(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -287,7 +288,7 @@ func TestUseCase_VerifyCredentials_InactiveUser(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -332,7 +333,7 @@ func TestUseCase_Update(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -387,7 +388,7 @@ func TestUseCase_Update_WithNewPassword(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -427,6 +428,11 @@ func TestUseCase_Update_WithNewPassword(t *testing.T) {
 		// Verificar que se actualizó el timestamp
 		assert.NotNil(t, u.UpdatedAt)
 	})
+	mockRepo.On("UpdatePassword", ctx, int64(1), mock.AnythingOfType("string")).Return(nil).Run(func(args mock.Arguments) {
+		// La contraseña se guarda hasheada
+		err := bcrypt.CompareHashAndPassword([]byte(args.String(2)), []byte("new_password"))
+		assert.NoError(t, err)
+	})
 
 	// Ejecutar la función que estamos probando
 	err := useCase.Update(ctx, updatedUser)
@@ -438,6 +444,56 @@ func TestUseCase_Update_WithNewPassword(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestUseCase_Update_PasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	existingUser := &User{
+		ID:        1,
+		Name:      "John Doe",
+		Email:     "john@example.com",
+		Password:  "old_hashed_password",
+		IsActive:  true,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	t.Run("rechaza contraseña que incumple la política", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPolicy := new(MockPasswordPolicy)
+		useCase := NewUseCase(mockRepo, new(MockAssignedRoleRepository), new(MockRoleRepository), mockPolicy, nil, nil)
+
+		policyErr := errors.New("password was used recently")
+		mockRepo.On("GetByID", ctx, "1").Return(existingUser, nil)
+		mockPolicy.On("Validate", ctx, int64(1), "reused").Return(policyErr)
+
+		err := useCase.Update(ctx, &User{ID: 1, Name: "John Doe", Email: "john@example.com", Password: "reused"})
+
+		assert.ErrorIs(t, err, policyErr)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("registra la nueva contraseña en el historial", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPolicy := new(MockPasswordPolicy)
+		useCase := NewUseCase(mockRepo, new(MockAssignedRoleRepository), new(MockRoleRepository), mockPolicy, nil, nil)
+
+		var stored string
+		mockRepo.On("GetByID", ctx, "1").Return(existingUser, nil)
+		mockPolicy.On("Validate", ctx, int64(1), "Brand-new-1").Return(nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(nil)
+		mockRepo.On("UpdatePassword", ctx, int64(1), mock.AnythingOfType("string")).Return(nil).Run(func(args mock.Arguments) {
+			stored = args.String(2)
+		})
+		mockPolicy.On("Record", ctx, int64(1), mock.AnythingOfType("string")).Return(nil)
+
+		err := useCase.Update(ctx, &User{ID: 1, Name: "John Doe", Email: "john@example.com", Password: "Brand-new-1"})
+
+		assert.NoError(t, err)
+		mockPolicy.AssertCalled(t, "Record", ctx, int64(1), stored)
+	})
+}
+
 func TestUseCase_Delete(t *testing.T) {
 	// Crear los mocks de los repositorios
 	mockRepo := new(MockRepository)
@@ -445,7 +501,7 @@ func TestUseCase_Delete(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -471,7 +527,7 @@ func TestUseCase_List(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -528,7 +584,7 @@ func TestUseCase_GetUserRoles(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -579,7 +635,7 @@ func TestUseCase_GetUserAbilities(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -641,7 +697,7 @@ func TestUseCase_HasAbility_True(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -704,7 +760,7 @@ func TestUseCase_HasAbility_False(t *testing.T) {
 	mockRoleRepo := new(MockRoleRepository)
 
 	// Crear el caso de uso con los mocks
-	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo)
+	useCase := NewUseCase(mockRepo, mockAssignedRoleRepo, mockRoleRepo, nil, nil, nil)
 
 	// Datos de prueba
 	ctx := context.Background()
@@ -759,3 +815,63 @@ func TestUseCase_HasAbility_False(t *testing.T) {
 	// Verificar que se llamó al método del repositorio con los argumentos correctos
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUseCase_RevokesSessions(t *testing.T) {
+	ctx := context.Background()
+	roleID := "admin"
+
+	newFixture := func() (*MockRepository, *MockTokenRevoker, *UseCase) {
+		mockRepo := new(MockRepository)
+		mockTokens := new(MockTokenRevoker)
+		useCase := NewUseCase(mockRepo, new(MockAssignedRoleRepository), new(MockRoleRepository), nil, nil, mockTokens)
+		return mockRepo, mockTokens, useCase
+	}
+
+	t.Run("deactivation logs the user out", func(t *testing.T) {
+		mockRepo, mockTokens, useCase := newFixture()
+
+		mockRepo.On("GetByID", ctx, "1").Return(&User{ID: 1, RoleID: &roleID, Email: "john@example.com", Password: "hashed", IsActive: true}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(nil)
+		mockTokens.On("RevokeUserTokens", ctx, int64(1)).Return(nil)
+
+		err := useCase.Update(ctx, &User{ID: 1, RoleID: &roleID, Email: "john@example.com", IsActive: false})
+
+		assert.NoError(t, err)
+		mockTokens.AssertExpectations(t)
+	})
+
+	t.Run("active user keeps sessions", func(t *testing.T) {
+		mockRepo, mockTokens, useCase := newFixture()
+
+		mockRepo.On("GetByID", ctx, "1").Return(&User{ID: 1, RoleID: &roleID, Email: "john@example.com", Password: "hashed", IsActive: true}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(nil)
+
+		err := useCase.Update(ctx, &User{ID: 1, RoleID: &roleID, Email: "john@example.com", IsActive: true})
+
+		assert.NoError(t, err)
+		mockTokens.AssertNotCalled(t, "RevokeUserTokens", ctx, int64(1))
+	})
+
+	t.Run("revoke failure does not fail the update", func(t *testing.T) {
+		mockRepo, mockTokens, useCase := newFixture()
+
+		mockRepo.On("GetByID", ctx, "1").Return(&User{ID: 1, RoleID: &roleID, Email: "john@example.com", Password: "hashed", IsActive: true}, nil)
+		mockRepo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(nil)
+		mockTokens.On("RevokeUserTokens", ctx, int64(1)).Return(errors.New("db down"))
+
+		err := useCase.Update(ctx, &User{ID: 1, RoleID: &roleID, Email: "john@example.com", IsActive: false})
+
+		assert.NoError(t, err)
+		mockTokens.AssertExpectations(t)
+	})
+
+	t.Run("delete logs the user out", func(t *testing.T) {
+		mockRepo, mockTokens, useCase := newFixture()
+
+		mockRepo.On("Delete", ctx, "1").Return(nil)
+		mockTokens.On("RevokeUserTokens", ctx, int64(1)).Return(nil)
+
+		assert.NoError(t, useCase.Delete(ctx, "1"))
+		mockTokens.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,36 @@
+package warranty
+
+import "context"
+
+// Checker es el contrato con el que los recursos hijos verifican la existencia de una garantía
+type Checker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// VisibilityChecker decora el Checker de los equipos para que las garantías de jobs fuera
+// del scope del usuario autenticado se reporten como inexistentes
+type VisibilityChecker struct {
+	inner Checker
+	uc    *UseCase
+}
+
+// NewVisibilityChecker crea el verificador de garantías visibles
+func NewVisibilityChecker(inner Checker, uc *UseCase) *VisibilityChecker {
+	return &VisibilityChecker{inner: inner, uc: uc}
+}
+
+// GetByID verifica que la garantía existe y que su job es visible para el usuario autenticado
+func (c *VisibilityChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	if c.uc.jobOwner == nil {
+		return c.inner.GetByID(ctx, id)
+	}
+	if _, restricted := c.uc.jobOwner(ctx); !restricted {
+		return c.inner.GetByID(ctx, id)
+	}
+
+	w, err := c.uc.getVisible(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
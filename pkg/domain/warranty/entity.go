@@ -0,0 +1,39 @@
+package warranty
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Warranty representa la entidad de dominio para una garantía registrada de un trabajo
+type Warranty struct {
+	ID               int64      `json:"id"`
+	WarrantyNumber   string     `json:"warrantyNumber"`
+	JobID            int64      `json:"jobId"`
+	WarrantyTypeID   int64      `json:"warrantyTypeId"`
+	WarrantyStatusID int64      `json:"warrantyStatusId"`
+	DateSubmitted    *time.Time `json:"dateSubmitted,omitempty"`
+	AgreementNumber  *string    `json:"agreementNumber,omitempty"`
+	AuditDone        bool       `json:"auditDone"`
+	Notes            *string    `json:"notes,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ValidateCreate valida los campos requeridos para crear una garantía
+func (w *Warranty) ValidateCreate() error {
+	if strings.TrimSpace(w.WarrantyNumber) == "" {
+		return fmt.Errorf("warranty_number is required")
+	}
+	if w.JobID <= 0 {
+		return fmt.Errorf("job_id is required")
+	}
+	if w.WarrantyTypeID <= 0 {
+		return fmt.Errorf("warranty_type_id is required")
+	}
+	if w.WarrantyStatusID <= 0 {
+		return fmt.Errorf("warranty_status_id is required")
+	}
+	return nil
+}
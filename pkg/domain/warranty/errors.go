@@ -0,0 +1,17 @@
+package warranty
+
+import "errors"
+
+var (
+	// ErrWarrantyNotFound indica que la garantía no fue encontrada
+	ErrWarrantyNotFound = errors.New("warranty not found")
+
+	// ErrInvalidJob indica que el trabajo no es válido
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrInvalidWarrantyType indica que el tipo de garantía no es válido
+	ErrInvalidWarrantyType = errors.New("invalid warranty type")
+
+	// ErrInvalidWarrantyStatus indica que el estado de garantía no es válido
+	ErrInvalidWarrantyStatus = errors.New("invalid warranty status")
+)
@@ -0,0 +1,11 @@
+package warranty
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, w *Warranty) error
+	GetByID(ctx context.Context, id int64) (*Warranty, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Warranty, int64, error)
+	Update(ctx context.Context, w *Warranty) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,92 @@
+package warranty
+
+import "context"
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
+// WarrantyTypeChecker verifica existencia de tipos de garantía
+type WarrantyTypeChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// WarrantyStatusChecker verifica existencia de estados de garantía
+type WarrantyStatusChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// Service define la interfaz del caso de uso de garantías
+type Service interface {
+	Create(ctx context.Context, w *Warranty) error
+	GetByID(ctx context.Context, id int64) (*Warranty, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*Warranty, int64, error)
+	Update(ctx context.Context, w *Warranty) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// UseCase implementa la lógica de negocio de garantías
+type UseCase struct {
+	repo               Repository
+	jobRepo            JobChecker
+	warrantyTypeRepo   WarrantyTypeChecker
+	warrantyStatusRepo WarrantyStatusChecker
+	jobOwner           JobOwnerResolver
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de garantías
+func NewUseCase(
+	repo Repository,
+	jobRepo JobChecker,
+	warrantyTypeRepo WarrantyTypeChecker,
+	warrantyStatusRepo WarrantyStatusChecker,
+	jobOwner JobOwnerResolver,
+) *UseCase {
+	return &UseCase{
+		repo:               repo,
+		jobRepo:            jobRepo,
+		warrantyTypeRepo:   warrantyTypeRepo,
+		warrantyStatusRepo: warrantyStatusRepo,
+		jobOwner:           jobOwner,
+	}
+}
+
+// getVisible obtiene la garantía verificando que su job sea visible para el usuario autenticado
+func (uc *UseCase) getVisible(ctx context.Context, id int64) (*Warranty, error) {
+	w, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.jobOwner != nil {
+		if _, restricted := uc.jobOwner(ctx); restricted {
+			if _, err := uc.jobRepo.GetByID(ctx, w.JobID); err != nil {
+				return nil, ErrWarrantyNotFound
+			}
+		}
+	}
+
+	return w, nil
+}
+
+// scopeFilters limita el listado a los jobs visibles para el usuario autenticado
+func (uc *UseCase) scopeFilters(ctx context.Context, filters map[string]interface{}) map[string]interface{} {
+	if uc.jobOwner == nil {
+		return filters
+	}
+	ownerID, restricted := uc.jobOwner(ctx)
+	if !restricted {
+		return filters
+	}
+
+	scoped := make(map[string]interface{}, len(filters)+1)
+	for k, val := range filters {
+		scoped[k] = val
+	}
+	scoped["job_user_id"] = ownerID
+	return scoped
+}
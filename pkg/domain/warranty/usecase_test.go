@@ -0,0 +1,287 @@
+package warranty
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.Background()
+
+func newTestUseCase() (*UseCase, *MockRepository, *MockChecker, *MockChecker, *MockChecker) {
+	repo := new(MockRepository)
+	jobChecker := new(MockChecker)
+	typeChecker := new(MockChecker)
+	statusChecker := new(MockChecker)
+	uc := NewUseCase(repo, jobChecker, typeChecker, statusChecker, nil)
+	return uc, repo, jobChecker, typeChecker, statusChecker
+}
+
+func validWarranty() *Warranty {
+	return &Warranty{
+		WarrantyNumber:   "W-001",
+		JobID:            1,
+		WarrantyTypeID:   2,
+		WarrantyStatusID: 3,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, statusChecker := newTestUseCase()
+		w := validWarranty()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		typeChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		statusChecker.On("GetByID", ctx, int64(3)).Return(true, nil)
+		repo.On("Create", ctx, w).Return(nil)
+
+		err := uc.Create(ctx, w)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		jobChecker.AssertExpectations(t)
+		typeChecker.AssertExpectations(t)
+		statusChecker.AssertExpectations(t)
+	})
+
+	t.Run("validation error - missing warranty_number", func(t *testing.T) {
+		uc, _, _, _, _ := newTestUseCase()
+		w := validWarranty()
+		w.WarrantyNumber = "  "
+
+		err := uc.Create(ctx, w)
+
+		assert.Error(t, err)
+		assert.Equal(t, "warranty_number is required", err.Error())
+	})
+
+	t.Run("validation error - missing warranty_status_id", func(t *testing.T) {
+		uc, _, _, _, _ := newTestUseCase()
+		w := validWarranty()
+		w.WarrantyStatusID = 0
+
+		err := uc.Create(ctx, w)
+
+		assert.Error(t, err)
+		assert.Equal(t, "warranty_status_id is required", err.Error())
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, repo, jobChecker, _, _ := newTestUseCase()
+		w := validWarranty()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, w)
+
+		assert.ErrorIs(t, err, ErrInvalidJob)
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("invalid warranty type", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, _ := newTestUseCase()
+		w := validWarranty()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		typeChecker.On("GetByID", ctx, int64(2)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, w)
+
+		assert.ErrorIs(t, err, ErrInvalidWarrantyType)
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("invalid warranty status", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, statusChecker := newTestUseCase()
+		w := validWarranty()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		typeChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		statusChecker.On("GetByID", ctx, int64(3)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, w)
+
+		assert.ErrorIs(t, err, ErrInvalidWarrantyStatus)
+		repo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestGetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+		expected := validWarranty()
+		expected.ID = 10
+
+		repo.On("GetByID", ctx, int64(10)).Return(expected, nil)
+
+		result, err := uc.GetByID(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(99)).Return(nil, ErrWarrantyNotFound)
+
+		result, err := uc.GetByID(ctx, 99)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrWarrantyNotFound)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("filters are passed through", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+		filters := map[string]interface{}{"job_id": int64(1), "audit_done": false}
+		expected := []*Warranty{validWarranty()}
+
+		repo.On("List", ctx, filters, 2, 20).Return(expected, int64(21), nil)
+
+		result, total, err := uc.List(ctx, filters, 2, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		assert.Equal(t, int64(21), total)
+	})
+
+	t.Run("default pagination", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+		filters := map[string]interface{}{}
+
+		repo.On("List", ctx, filters, 1, 15).Return([]*Warranty{}, int64(0), nil)
+
+		_, _, err := uc.List(ctx, filters, 0, 0)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("success without reference changes", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, statusChecker := newTestUseCase()
+		existing := validWarranty()
+		existing.ID = 5
+		w := validWarranty()
+		w.ID = 5
+		w.AuditDone = true
+
+		repo.On("GetByID", ctx, int64(5)).Return(existing, nil)
+		repo.On("Update", ctx, w).Return(nil)
+
+		err := uc.Update(ctx, w)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		jobChecker.AssertNotCalled(t, "GetByID")
+		typeChecker.AssertNotCalled(t, "GetByID")
+		statusChecker.AssertNotCalled(t, "GetByID")
+	})
+
+	t.Run("invalid status on change", func(t *testing.T) {
+		uc, repo, _, _, statusChecker := newTestUseCase()
+		existing := validWarranty()
+		existing.ID = 5
+		w := validWarranty()
+		w.ID = 5
+		w.WarrantyStatusID = 9
+
+		repo.On("GetByID", ctx, int64(5)).Return(existing, nil)
+		statusChecker.On("GetByID", ctx, int64(9)).Return(nil, errors.New("not found"))
+
+		err := uc.Update(ctx, w)
+
+		assert.ErrorIs(t, err, ErrInvalidWarrantyStatus)
+		repo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+		w := validWarranty()
+		w.ID = 5
+
+		repo.On("GetByID", ctx, int64(5)).Return(nil, ErrWarrantyNotFound)
+
+		err := uc.Update(ctx, w)
+
+		assert.ErrorIs(t, err, ErrWarrantyNotFound)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(5)).Return(validWarranty(), nil)
+		repo.On("Delete", ctx, int64(5)).Return(nil)
+
+		err := uc.Delete(ctx, 5)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(5)).Return(nil, ErrWarrantyNotFound)
+
+		err := uc.Delete(ctx, 5)
+
+		assert.ErrorIs(t, err, ErrWarrantyNotFound)
+		repo.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestVisibilityChecker(t *testing.T) {
+	owner := int64(5)
+	other := int64(6)
+	restricted := func(ctx context.Context) (int64, bool) { return owner, true }
+
+	t.Run("unrestricted delegates to inner checker", func(t *testing.T) {
+		repo := new(MockRepository)
+		inner := new(MockChecker)
+		checker := NewVisibilityChecker(inner, NewUseCase(repo, new(MockChecker), nil, nil, func(ctx context.Context) (int64, bool) { return 0, false }))
+
+		inner.On("GetByID", ctx, int64(10)).Return(true, nil)
+
+		_, err := checker.GetByID(ctx, 10)
+
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "GetByID", ctx, int64(10))
+	})
+
+	t.Run("restricted rejects warranty of other user's job", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobChecker := new(MockChecker)
+		inner := new(MockChecker)
+		checker := NewVisibilityChecker(inner, NewUseCase(repo, jobChecker, nil, nil, restricted))
+
+		repo.On("GetByID", ctx, int64(10)).Return(&Warranty{ID: 10, JobID: other}, nil)
+		jobChecker.On("GetByID", ctx, other).Return(nil, errors.New("job not found"))
+
+		_, err := checker.GetByID(ctx, 10)
+
+		assert.ErrorIs(t, err, ErrWarrantyNotFound)
+		inner.AssertNotCalled(t, "GetByID", ctx, int64(10))
+	})
+
+	t.Run("restricted accepts warranty of own job", func(t *testing.T) {
+		repo := new(MockRepository)
+		jobChecker := new(MockChecker)
+		checker := NewVisibilityChecker(new(MockChecker), NewUseCase(repo, jobChecker, nil, nil, restricted))
+
+		repo.On("GetByID", ctx, int64(10)).Return(&Warranty{ID: 10, JobID: owner}, nil)
+		jobChecker.On("GetByID", ctx, owner).Return(true, nil)
+
+		_, err := checker.GetByID(ctx, 10)
+
+		assert.NoError(t, err)
+	})
+}
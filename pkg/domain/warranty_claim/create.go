@@ -0,0 +1,48 @@
+package warranty_claim
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create crea una nueva reclamación de garantía
+func (uc *UseCase) Create(ctx context.Context, c *WarrantyClaim) error {
+	if err := c.ValidateCreate(); err != nil {
+		return err
+	}
+
+	// Verificar que el job existe
+	if _, err := uc.jobRepo.GetByID(ctx, c.JobID); err != nil {
+		slog.ErrorContext(ctx, "Invalid job",
+			slog.Int64("jobId", c.JobID),
+			slog.String("error", err.Error()))
+		return ErrInvalidJob
+	}
+
+	// Verificar que el tipo de reclamación existe
+	if _, err := uc.claimTypeRepo.GetByID(ctx, c.WarrantyClaimTypeID); err != nil {
+		slog.ErrorContext(ctx, "Invalid warranty claim type",
+			slog.Int64("warrantyClaimTypeId", c.WarrantyClaimTypeID),
+			slog.String("error", err.Error()))
+		return ErrInvalidWarrantyClaimType
+	}
+
+	// Verificar que el estado de reclamación existe
+	if _, err := uc.claimStatusRepo.GetByID(ctx, c.WarrantyClaimStatusID); err != nil {
+		slog.ErrorContext(ctx, "Invalid warranty claim status",
+			slog.Int64("warrantyClaimStatusId", c.WarrantyClaimStatusID),
+			slog.String("error", err.Error()))
+		return ErrInvalidWarrantyClaimStatus
+	}
+
+	if err := uc.repo.Create(ctx, c); err != nil {
+		slog.ErrorContext(ctx, "Failed to create warranty claim",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty claim created successfully",
+		slog.Int64("id", c.ID))
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package warranty_claim
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WarrantyClaim representa una reclamación de garantía ante el fabricante
+type WarrantyClaim struct {
+	ID                    int64      `json:"id"`
+	InternalClaimNumber   string     `json:"internalClaimNumber"`
+	WarrantyClaimTypeID   int64      `json:"warrantyClaimTypeId"`
+	WarrantyClaimStatusID int64      `json:"warrantyClaimStatusId"`
+	JobID                 int64      `json:"jobId"`
+	InvoiceNumber         *string    `json:"invoiceNumber,omitempty"`
+	WorkDone              bool       `json:"workDone"`
+	WarrantyPart          *string    `json:"warrantyPart,omitempty"`
+	Manufacturer          *string    `json:"manufacturer,omitempty"`
+	ModelNumber           *string    `json:"modelNumber,omitempty"`
+	PartNumber            *string    `json:"partNumber,omitempty"`
+	ReplacementPartNumber *string    `json:"replacementPartNumber,omitempty"`
+	PartDistributor       *string    `json:"partDistributor,omitempty"`
+	PartInvoiceNumber     *string    `json:"partInvoiceNumber,omitempty"`
+	OldPartSerialNumber   *string    `json:"oldPartSerialNumber,omitempty"`
+	NewPartSerialNumber   *string    `json:"newPartSerialNumber,omitempty"`
+	EsaNumber             *string    `json:"esaNumber,omitempty"`
+	Serial                *string    `json:"serial,omitempty"`
+	ClaimNumber           *string    `json:"claimNumber,omitempty"`
+	Approved              bool       `json:"approved"`
+	PartsCreditReceived   bool       `json:"partsCreditReceived"`
+	LaborPaymentReceived  bool       `json:"laborPaymentReceived"`
+	Notes                 *string    `json:"notes,omitempty"`
+	CreatedAt             *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt             *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ValidateCreate valida los campos requeridos para crear una reclamación
+func (c *WarrantyClaim) ValidateCreate() error {
+	if strings.TrimSpace(c.InternalClaimNumber) == "" {
+		return fmt.Errorf("internal_claim_number is required")
+	}
+	if c.JobID <= 0 {
+		return fmt.Errorf("job_id is required")
+	}
+	if c.WarrantyClaimTypeID <= 0 {
+		return fmt.Errorf("warranty_claim_type_id is required")
+	}
+	if c.WarrantyClaimStatusID <= 0 {
+		return fmt.Errorf("warranty_claim_status_id is required")
+	}
+	return nil
+}
+
+// IsCreditPending indica si la reclamación está aprobada pero el crédito de partes no se ha recibido
+func (c *WarrantyClaim) IsCreditPending() bool {
+	return c.Approved && !c.PartsCreditReceived
+}
+
+// IsLaborPending indica si la reclamación está aprobada pero el pago de mano de obra no se ha recibido
+func (c *WarrantyClaim) IsLaborPending() bool {
+	return c.Approved && !c.LaborPaymentReceived
+}
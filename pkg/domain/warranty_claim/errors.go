@@ -0,0 +1,17 @@
+package warranty_claim
+
+import "errors"
+
+var (
+	// ErrWarrantyClaimNotFound indica que la reclamación de garantía no fue encontrada
+	ErrWarrantyClaimNotFound = errors.New("warranty claim not found")
+
+	// ErrInvalidJob indica que el trabajo no es válido
+	ErrInvalidJob = errors.New("invalid job")
+
+	// ErrInvalidWarrantyClaimType indica que el tipo de reclamación no es válido
+	ErrInvalidWarrantyClaimType = errors.New("invalid warranty claim type")
+
+	// ErrInvalidWarrantyClaimStatus indica que el estado de reclamación no es válido
+	ErrInvalidWarrantyClaimStatus = errors.New("invalid warranty claim status")
+)
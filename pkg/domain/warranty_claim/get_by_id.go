@@ -0,0 +1,21 @@
+package warranty_claim
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (uc *UseCase) GetByID(ctx context.Context, id int64) (*WarrantyClaim, error) {
+	c, err := uc.getVisible(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get warranty claim by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_claim_id", id))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Warranty claim retrieved successfully",
+		slog.Int64("warranty_claim_id", id))
+
+	return c, nil
+}
@@ -0,0 +1,11 @@
+package warranty_claim
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, c *WarrantyClaim) error
+	GetByID(ctx context.Context, id int64) (*WarrantyClaim, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*WarrantyClaim, int64, error)
+	Update(ctx context.Context, c *WarrantyClaim) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,64 @@
+package warranty_claim
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza una reclamación de garantía existente
+func (uc *UseCase) Update(ctx context.Context, c *WarrantyClaim) error {
+	if err := c.ValidateCreate(); err != nil {
+		return err
+	}
+
+	// Verificar que la reclamación existe
+	existing, err := uc.getVisible(ctx, c.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get warranty claim for update",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_claim_id", c.ID))
+		return err
+	}
+
+	// Verificar que el job existe si cambió
+	if c.JobID != existing.JobID {
+		if _, err := uc.jobRepo.GetByID(ctx, c.JobID); err != nil {
+			slog.ErrorContext(ctx, "Invalid job",
+				slog.Int64("jobId", c.JobID),
+				slog.String("error", err.Error()))
+			return ErrInvalidJob
+		}
+	}
+
+	// Verificar que el tipo de reclamación existe si cambió
+	if c.WarrantyClaimTypeID != existing.WarrantyClaimTypeID {
+		if _, err := uc.claimTypeRepo.GetByID(ctx, c.WarrantyClaimTypeID); err != nil {
+			slog.ErrorContext(ctx, "Invalid warranty claim type",
+				slog.Int64("warrantyClaimTypeId", c.WarrantyClaimTypeID),
+				slog.String("error", err.Error()))
+			return ErrInvalidWarrantyClaimType
+		}
+	}
+
+	// Verificar que el estado de reclamación existe si cambió
+	if c.WarrantyClaimStatusID != existing.WarrantyClaimStatusID {
+		if _, err := uc.claimStatusRepo.GetByID(ctx, c.WarrantyClaimStatusID); err != nil {
+			slog.ErrorContext(ctx, "Invalid warranty claim status",
+				slog.Int64("warrantyClaimStatusId", c.WarrantyClaimStatusID),
+				slog.String("error", err.Error()))
+			return ErrInvalidWarrantyClaimStatus
+		}
+	}
+
+	if err := uc.repo.Update(ctx, c); err != nil {
+		slog.ErrorContext(ctx, "Failed to update warranty claim",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_claim_id", c.ID))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty claim updated successfully",
+		slog.Int64("warranty_claim_id", c.ID))
+
+	return nil
+}
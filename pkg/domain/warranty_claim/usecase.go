@@ -0,0 +1,92 @@
+package warranty_claim
+
+import "context"
+
+// JobChecker verifica existencia de jobs
+type JobChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// JobOwnerResolver obtiene el usuario al que se limitan los jobs visibles; false si puede verlos todos
+type JobOwnerResolver func(ctx context.Context) (int64, bool)
+
+// WarrantyClaimTypeChecker verifica existencia de tipos de reclamación
+type WarrantyClaimTypeChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// WarrantyClaimStatusChecker verifica existencia de estados de reclamación
+type WarrantyClaimStatusChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// Service define la interfaz del caso de uso de reclamaciones de garantía
+type Service interface {
+	Create(ctx context.Context, c *WarrantyClaim) error
+	GetByID(ctx context.Context, id int64) (*WarrantyClaim, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*WarrantyClaim, int64, error)
+	Update(ctx context.Context, c *WarrantyClaim) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// UseCase implementa la lógica de negocio de reclamaciones de garantía
+type UseCase struct {
+	repo            Repository
+	jobRepo         JobChecker
+	claimTypeRepo   WarrantyClaimTypeChecker
+	claimStatusRepo WarrantyClaimStatusChecker
+	jobOwner        JobOwnerResolver
+}
+
+// NewUseCase crea una nueva instancia del caso de uso de reclamaciones de garantía
+func NewUseCase(
+	repo Repository,
+	jobRepo JobChecker,
+	claimTypeRepo WarrantyClaimTypeChecker,
+	claimStatusRepo WarrantyClaimStatusChecker,
+	jobOwner JobOwnerResolver,
+) *UseCase {
+	return &UseCase{
+		repo:            repo,
+		jobRepo:         jobRepo,
+		claimTypeRepo:   claimTypeRepo,
+		claimStatusRepo: claimStatusRepo,
+		jobOwner:        jobOwner,
+	}
+}
+
+// getVisible obtiene la reclamación verificando que su job sea visible para el usuario autenticado
+func (uc *UseCase) getVisible(ctx context.Context, id int64) (*WarrantyClaim, error) {
+	c, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.jobOwner != nil {
+		if _, restricted := uc.jobOwner(ctx); restricted {
+			if _, err := uc.jobRepo.GetByID(ctx, c.JobID); err != nil {
+				return nil, ErrWarrantyClaimNotFound
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// scopeFilters limita el listado a los jobs visibles para el usuario autenticado
+func (uc *UseCase) scopeFilters(ctx context.Context, filters map[string]interface{}) map[string]interface{} {
+	if uc.jobOwner == nil {
+		return filters
+	}
+	ownerID, restricted := uc.jobOwner(ctx)
+	if !restricted {
+		return filters
+	}
+
+	scoped := make(map[string]interface{}, len(filters)+1)
+	for k, val := range filters {
+		scoped[k] = val
+	}
+	scoped["job_user_id"] = ownerID
+	return scoped
+}
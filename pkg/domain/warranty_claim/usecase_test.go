@@ -0,0 +1,171 @@
+package warranty_claim
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.Background()
+
+func newTestUseCase() (*UseCase, *MockRepository, *MockChecker, *MockChecker, *MockChecker) {
+	repo := new(MockRepository)
+	jobChecker := new(MockChecker)
+	typeChecker := new(MockChecker)
+	statusChecker := new(MockChecker)
+	uc := NewUseCase(repo, jobChecker, typeChecker, statusChecker, nil)
+	return uc, repo, jobChecker, typeChecker, statusChecker
+}
+
+func validClaim() *WarrantyClaim {
+	return &WarrantyClaim{
+		InternalClaimNumber:   "WC-001",
+		JobID:                 1,
+		WarrantyClaimTypeID:   2,
+		WarrantyClaimStatusID: 3,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, statusChecker := newTestUseCase()
+		c := validClaim()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		typeChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		statusChecker.On("GetByID", ctx, int64(3)).Return(true, nil)
+		repo.On("Create", ctx, c).Return(nil)
+
+		err := uc.Create(ctx, c)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - missing internal_claim_number", func(t *testing.T) {
+		uc, _, _, _, _ := newTestUseCase()
+		c := validClaim()
+		c.InternalClaimNumber = ""
+
+		err := uc.Create(ctx, c)
+
+		assert.EqualError(t, err, "internal_claim_number is required")
+	})
+
+	t.Run("validation error - missing warranty_claim_type_id", func(t *testing.T) {
+		uc, _, _, _, _ := newTestUseCase()
+		c := validClaim()
+		c.WarrantyClaimTypeID = 0
+
+		err := uc.Create(ctx, c)
+
+		assert.EqualError(t, err, "warranty_claim_type_id is required")
+	})
+
+	t.Run("invalid job", func(t *testing.T) {
+		uc, repo, jobChecker, _, _ := newTestUseCase()
+		c := validClaim()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, c)
+
+		assert.ErrorIs(t, err, ErrInvalidJob)
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("invalid claim status", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, statusChecker := newTestUseCase()
+		c := validClaim()
+
+		jobChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		typeChecker.On("GetByID", ctx, int64(2)).Return(true, nil)
+		statusChecker.On("GetByID", ctx, int64(3)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, c)
+
+		assert.ErrorIs(t, err, ErrInvalidWarrantyClaimStatus)
+		repo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("outstanding filters are passed through", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+		filters := map[string]interface{}{"credit_pending": true, "labor_pending": true}
+
+		repo.On("List", ctx, filters, 1, 15).Return([]*WarrantyClaim{validClaim()}, int64(1), nil)
+
+		result, total, err := uc.List(ctx, filters, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, int64(1), total)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("success marking credit received", func(t *testing.T) {
+		uc, repo, jobChecker, typeChecker, statusChecker := newTestUseCase()
+		existing := validClaim()
+		existing.ID = 7
+		existing.Approved = true
+		c := validClaim()
+		c.ID = 7
+		c.Approved = true
+		c.PartsCreditReceived = true
+
+		repo.On("GetByID", ctx, int64(7)).Return(existing, nil)
+		repo.On("Update", ctx, c).Return(nil)
+
+		err := uc.Update(ctx, c)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		jobChecker.AssertNotCalled(t, "GetByID")
+		typeChecker.AssertNotCalled(t, "GetByID")
+		statusChecker.AssertNotCalled(t, "GetByID")
+	})
+
+	t.Run("invalid type on change", func(t *testing.T) {
+		uc, repo, _, typeChecker, _ := newTestUseCase()
+		existing := validClaim()
+		existing.ID = 7
+		c := validClaim()
+		c.ID = 7
+		c.WarrantyClaimTypeID = 9
+
+		repo.On("GetByID", ctx, int64(7)).Return(existing, nil)
+		typeChecker.On("GetByID", ctx, int64(9)).Return(nil, errors.New("not found"))
+
+		err := uc.Update(ctx, c)
+
+		assert.ErrorIs(t, err, ErrInvalidWarrantyClaimType)
+		repo.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, _, _, _ := newTestUseCase()
+
+		repo.On("GetByID", ctx, int64(7)).Return(nil, ErrWarrantyClaimNotFound)
+
+		err := uc.Delete(ctx, 7)
+
+		assert.ErrorIs(t, err, ErrWarrantyClaimNotFound)
+		repo.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestOutstandingHelpers(t *testing.T) {
+	c := &WarrantyClaim{Approved: true, PartsCreditReceived: true}
+
+	assert.False(t, c.IsCreditPending())
+	assert.True(t, c.IsLaborPending())
+
+	c.Approved = false
+	assert.False(t, c.IsLaborPending())
+}
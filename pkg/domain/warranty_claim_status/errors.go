@@ -0,0 +1,11 @@
+package warranty_claim_status
+
+import "errors"
+
+var (
+	// ErrWarrantyClaimStatusNotFound indica que el estado de reclamación de garantía no fue encontrado
+	ErrWarrantyClaimStatusNotFound = errors.New("warranty claim status not found")
+
+	// ErrWarrantyClaimStatusInUse indica que el estado de reclamación de garantía tiene reclamaciones asociadas
+	ErrWarrantyClaimStatusInUse = errors.New("warranty claim status is in use by warranty claims")
+)
@@ -0,0 +1,33 @@
+package warranty_claim_status
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (uc *UseCase) Update(ctx context.Context, ws *WarrantyClaimStatus) error {
+	if err := ws.Validate(); err != nil {
+		return err
+	}
+
+	// Validar que el estado existe
+	if _, err := uc.repo.GetByID(ctx, ws.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to get warranty claim status for update",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_claim_status_id", ws.ID))
+		return err
+	}
+
+	if err := uc.repo.Update(ctx, ws); err != nil {
+		slog.ErrorContext(ctx, "Failed to update warranty claim status",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_claim_status_id", ws.ID))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty claim status updated successfully",
+		slog.Int64("warranty_claim_status_id", ws.ID),
+		slog.String("label", ws.Label))
+
+	return nil
+}
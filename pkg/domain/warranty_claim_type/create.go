@@ -0,0 +1,25 @@
+package warranty_claim_type
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (uc *UseCase) Create(ctx context.Context, wt *WarrantyClaimType) error {
+	if err := wt.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(ctx, wt); err != nil {
+		slog.ErrorContext(ctx, "Failed to create warranty claim type",
+			slog.String("error", err.Error()),
+			slog.String("label", wt.Label))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty claim type created successfully",
+		slog.Int64("warranty_claim_type_id", wt.ID),
+		slog.String("label", wt.Label))
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package warranty_claim_type
+
+import "errors"
+
+var (
+	// ErrWarrantyClaimTypeNotFound indica que el tipo de reclamación de garantía no fue encontrado
+	ErrWarrantyClaimTypeNotFound = errors.New("warranty claim type not found")
+
+	// ErrWarrantyClaimTypeInUse indica que el tipo de reclamación de garantía tiene reclamaciones asociadas
+	ErrWarrantyClaimTypeInUse = errors.New("warranty claim type is in use by warranty claims")
+)
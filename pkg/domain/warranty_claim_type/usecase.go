@@ -0,0 +1,21 @@
+package warranty_claim_type
+
+import "context"
+
+type Service interface {
+	Create(ctx context.Context, wt *WarrantyClaimType) error
+	GetByID(ctx context.Context, id int64) (*WarrantyClaimType, error)
+	List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*WarrantyClaimType, int, error)
+	Update(ctx context.Context, wt *WarrantyClaimType) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type UseCase struct {
+	repo Repository
+}
+
+func NewUseCase(repo Repository) *UseCase {
+	return &UseCase{
+		repo: repo,
+	}
+}
@@ -0,0 +1,34 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Create crea un nuevo equipo asociado a una garantía
+func (uc *UseCase) Create(ctx context.Context, equipment *WarrantyEquipment) error {
+	if err := equipment.Validate(); err != nil {
+		return err
+	}
+
+	// Verificar que la garantía existe
+	if _, err := uc.warrantyChecker.GetByID(ctx, equipment.WarrantyID); err != nil {
+		slog.WarnContext(ctx, "Invalid warranty",
+			slog.Int64("warranty_id", equipment.WarrantyID),
+			slog.String("error", err.Error()))
+		return ErrInvalidWarranty
+	}
+
+	if err := uc.repo.Create(ctx, equipment); err != nil {
+		slog.ErrorContext(ctx, "Failed to create warranty equipment",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_id", equipment.WarrantyID))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty equipment created successfully",
+		slog.Int64("equipment_id", equipment.ID),
+		slog.Int64("warranty_id", equipment.WarrantyID))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina un equipo de la garantía indicada
+func (uc *UseCase) Delete(ctx context.Context, warrantyID, id int64) error {
+	// Validar que el equipo existe y pertenece a la garantía
+	if _, err := uc.getOwned(ctx, warrantyID, id); err != nil {
+		slog.WarnContext(ctx, "Failed to get warranty equipment for deletion",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_id", warrantyID),
+			slog.Int64("equipment_id", id))
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete warranty equipment",
+			slog.String("error", err.Error()),
+			slog.Int64("equipment_id", id))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty equipment deleted successfully",
+		slog.Int64("equipment_id", id))
+
+	return nil
+}
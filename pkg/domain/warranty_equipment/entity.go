@@ -0,0 +1,57 @@
+package warranty_equipment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WarrantyEquipment representa un equipo cubierto por una garantía
+type WarrantyEquipment struct {
+	ID                  int64      `json:"id"`
+	WarrantyID          int64      `json:"warrantyId"`
+	Area                *string    `json:"area,omitempty"`
+	OutdoorBrand        *string    `json:"outdoorBrand,omitempty"`
+	OutdoorModel        *string    `json:"outdoorModel,omitempty"`
+	OutdoorSerial       *string    `json:"outdoorSerial,omitempty"`
+	OutdoorInstalled    *time.Time `json:"outdoorInstalled,omitempty"`
+	FurnaceBrand        *string    `json:"furnaceBrand,omitempty"`
+	FurnaceModel        *string    `json:"furnaceModel,omitempty"`
+	FurnaceSerial       *string    `json:"furnaceSerial,omitempty"`
+	FurnaceInstalled    *time.Time `json:"furnaceInstalled,omitempty"`
+	EvaporatorBrand     *string    `json:"evaporatorBrand,omitempty"`
+	EvaporatorModel     *string    `json:"evaporatorModel,omitempty"`
+	EvaporatorSerial    *string    `json:"evaporatorSerial,omitempty"`
+	EvaporatorInstalled *time.Time `json:"evaporatorInstalled,omitempty"`
+	AirHandlerBrand     *string    `json:"airHandlerBrand,omitempty"`
+	AirHandlerModel     *string    `json:"airHandlerModel,omitempty"`
+	AirHandlerSerial    *string    `json:"airHandlerSerial,omitempty"`
+	AirHandlerInstalled *time.Time `json:"airHandlerInstalled,omitempty"`
+	CreatedAt           *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt           *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate valida los campos requeridos del equipo de garantía
+func (e *WarrantyEquipment) Validate() error {
+	if e.WarrantyID <= 0 {
+		return fmt.Errorf("warranty_id is required")
+	}
+	return nil
+}
+
+// GetOutdoorUnit retorna la unidad exterior formateada (brand model | S/N serial)
+func (e *WarrantyEquipment) GetOutdoorUnit() string {
+	parts := []string{}
+
+	if e.OutdoorBrand != nil && *e.OutdoorBrand != "" {
+		parts = append(parts, *e.OutdoorBrand)
+	}
+	if e.OutdoorModel != nil && *e.OutdoorModel != "" {
+		parts = append(parts, *e.OutdoorModel)
+	}
+	if e.OutdoorSerial != nil && *e.OutdoorSerial != "" {
+		parts = append(parts, "| S/N "+*e.OutdoorSerial)
+	}
+
+	return strings.Join(parts, " ")
+}
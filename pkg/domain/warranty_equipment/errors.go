@@ -0,0 +1,14 @@
+package warranty_equipment
+
+import "errors"
+
+var (
+	// ErrEquipmentNotFound indica que el equipo de garantía no fue encontrado
+	ErrEquipmentNotFound = errors.New("warranty equipment not found")
+
+	// ErrInvalidWarranty indica que la garantía no es válida
+	ErrInvalidWarranty = errors.New("invalid warranty")
+
+	// ErrEquipmentMismatch indica que el equipo no pertenece a la garantía indicada
+	ErrEquipmentMismatch = errors.New("equipment does not belong to this warranty")
+)
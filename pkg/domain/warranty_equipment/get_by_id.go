@@ -0,0 +1,20 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+)
+
+// GetByID obtiene un equipo de la garantía indicada
+func (uc *UseCase) GetByID(ctx context.Context, warrantyID, id int64) (*WarrantyEquipment, error) {
+	equipment, err := uc.getOwned(ctx, warrantyID, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get warranty equipment by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_id", warrantyID),
+			slog.Int64("equipment_id", id))
+		return nil, err
+	}
+
+	return equipment, nil
+}
@@ -0,0 +1,31 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListByWarrantyID obtiene los equipos de una garantía
+func (uc *UseCase) ListByWarrantyID(ctx context.Context, warrantyID int64) ([]*WarrantyEquipment, error) {
+	// Verificar que la garantía existe
+	if _, err := uc.warrantyChecker.GetByID(ctx, warrantyID); err != nil {
+		slog.WarnContext(ctx, "Invalid warranty for listing equipment",
+			slog.Int64("warranty_id", warrantyID),
+			slog.String("error", err.Error()))
+		return nil, ErrInvalidWarranty
+	}
+
+	equipment, err := uc.repo.ListByWarrantyID(ctx, warrantyID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list warranty equipment",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_id", warrantyID))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Warranty equipment listed successfully",
+		slog.Int("total", len(equipment)),
+		slog.Int64("warranty_id", warrantyID))
+
+	return equipment, nil
+}
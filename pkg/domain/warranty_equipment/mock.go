@@ -0,0 +1,53 @@
+package warranty_equipment
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRepository es un mock del repositorio de equipos de garantía
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, equipment *WarrantyEquipment) error {
+	args := m.Called(ctx, equipment)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*WarrantyEquipment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*WarrantyEquipment), args.Error(1)
+}
+
+func (m *MockRepository) ListByWarrantyID(ctx context.Context, warrantyID int64) ([]*WarrantyEquipment, error) {
+	args := m.Called(ctx, warrantyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*WarrantyEquipment), args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, equipment *WarrantyEquipment) error {
+	args := m.Called(ctx, equipment)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockWarrantyChecker es un mock del checker de garantías
+type MockWarrantyChecker struct {
+	mock.Mock
+}
+
+func (m *MockWarrantyChecker) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0), args.Error(1)
+}
@@ -0,0 +1,34 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Update actualiza un equipo de garantía existente
+func (uc *UseCase) Update(ctx context.Context, equipment *WarrantyEquipment) error {
+	if err := equipment.Validate(); err != nil {
+		return err
+	}
+
+	// Validar que el equipo existe y pertenece a la garantía
+	if _, err := uc.getOwned(ctx, equipment.WarrantyID, equipment.ID); err != nil {
+		slog.WarnContext(ctx, "Failed to get warranty equipment for update",
+			slog.String("error", err.Error()),
+			slog.Int64("warranty_id", equipment.WarrantyID),
+			slog.Int64("equipment_id", equipment.ID))
+		return err
+	}
+
+	if err := uc.repo.Update(ctx, equipment); err != nil {
+		slog.ErrorContext(ctx, "Failed to update warranty equipment",
+			slog.String("error", err.Error()),
+			slog.Int64("equipment_id", equipment.ID))
+		return err
+	}
+
+	slog.InfoContext(ctx, "Warranty equipment updated successfully",
+		slog.Int64("equipment_id", equipment.ID))
+
+	return nil
+}
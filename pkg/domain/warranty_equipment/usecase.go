@@ -0,0 +1,50 @@
+package warranty_equipment
+
+import "context"
+
+// WarrantyChecker verifica existencia de garantías
+type WarrantyChecker interface {
+	GetByID(ctx context.Context, id int64) (interface{}, error)
+}
+
+// Service define la interfaz del caso de uso de equipos de garantía
+type Service interface {
+	Create(ctx context.Context, equipment *WarrantyEquipment) error
+	GetByID(ctx context.Context, warrantyID, id int64) (*WarrantyEquipment, error)
+	ListByWarrantyID(ctx context.Context, warrantyID int64) ([]*WarrantyEquipment, error)
+	Update(ctx context.Context, equipment *WarrantyEquipment) error
+	Delete(ctx context.Context, warrantyID, id int64) error
+}
+
+// UseCase orquesta las operaciones de negocio para equipos de garantía
+type UseCase struct {
+	repo            Repository
+	warrantyChecker WarrantyChecker
+}
+
+// NewUseCase crea una nueva instancia de UseCase
+func NewUseCase(repo Repository, warrantyChecker WarrantyChecker) *UseCase {
+	return &UseCase{
+		repo:            repo,
+		warrantyChecker: warrantyChecker,
+	}
+}
+
+// getOwned obtiene un equipo verificando que pertenezca a la garantía indicada y que la
+// garantía sea visible; si no lo es, el equipo se reporta como inexistente
+func (uc *UseCase) getOwned(ctx context.Context, warrantyID, id int64) (*WarrantyEquipment, error) {
+	if _, err := uc.warrantyChecker.GetByID(ctx, warrantyID); err != nil {
+		return nil, ErrEquipmentNotFound
+	}
+
+	equipment, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if equipment.WarrantyID != warrantyID {
+		return nil, ErrEquipmentMismatch
+	}
+
+	return equipment, nil
+}
@@ -0,0 +1,211 @@
+package warranty_equipment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.Background()
+
+func newTestUseCase() (*UseCase, *MockRepository, *MockWarrantyChecker) {
+	repo := new(MockRepository)
+	warrantyChecker := new(MockWarrantyChecker)
+	return NewUseCase(repo, warrantyChecker), repo, warrantyChecker
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		equipment := &WarrantyEquipment{WarrantyID: 1, Area: strPtr("Attic")}
+
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		repo.On("Create", ctx, equipment).Return(nil)
+
+		err := uc.Create(ctx, equipment)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		warrantyChecker.AssertExpectations(t)
+	})
+
+	t.Run("missing warranty_id", func(t *testing.T) {
+		uc, repo, _ := newTestUseCase()
+
+		err := uc.Create(ctx, &WarrantyEquipment{})
+
+		assert.EqualError(t, err, "warranty_id is required")
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("invalid warranty", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		equipment := &WarrantyEquipment{WarrantyID: 1}
+
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("not found"))
+
+		err := uc.Create(ctx, equipment)
+
+		assert.ErrorIs(t, err, ErrInvalidWarranty)
+		repo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestGetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		expected := &WarrantyEquipment{ID: 3, WarrantyID: 1}
+
+		repo.On("GetByID", ctx, int64(3)).Return(expected, nil)
+
+		result, err := uc.GetByID(ctx, 1, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("belongs to another warranty", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(&WarrantyEquipment{ID: 3, WarrantyID: 2}, nil)
+
+		result, err := uc.GetByID(ctx, 1, 3)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrEquipmentMismatch)
+	})
+
+	t.Run("warranty outside of scope", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("warranty not found"))
+
+		result, err := uc.GetByID(ctx, 1, 3)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrEquipmentNotFound)
+		repo.AssertNotCalled(t, "GetByID", ctx, int64(3))
+	})
+}
+
+func TestListByWarrantyID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		expected := []*WarrantyEquipment{{ID: 1, WarrantyID: 1}, {ID: 2, WarrantyID: 1}}
+
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		repo.On("ListByWarrantyID", ctx, int64(1)).Return(expected, nil)
+
+		result, err := uc.ListByWarrantyID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("invalid warranty", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("not found"))
+
+		_, err := uc.ListByWarrantyID(ctx, 1)
+
+		assert.ErrorIs(t, err, ErrInvalidWarranty)
+		repo.AssertNotCalled(t, "ListByWarrantyID")
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		equipment := &WarrantyEquipment{ID: 3, WarrantyID: 1, OutdoorBrand: strPtr("Carrier")}
+
+		repo.On("GetByID", ctx, int64(3)).Return(&WarrantyEquipment{ID: 3, WarrantyID: 1}, nil)
+		repo.On("Update", ctx, equipment).Return(nil)
+
+		err := uc.Update(ctx, equipment)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		equipment := &WarrantyEquipment{ID: 3, WarrantyID: 1}
+
+		repo.On("GetByID", ctx, int64(3)).Return(nil, ErrEquipmentNotFound)
+
+		err := uc.Update(ctx, equipment)
+
+		assert.ErrorIs(t, err, ErrEquipmentNotFound)
+		repo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("belongs to another warranty", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+		equipment := &WarrantyEquipment{ID: 3, WarrantyID: 1}
+
+		repo.On("GetByID", ctx, int64(3)).Return(&WarrantyEquipment{ID: 3, WarrantyID: 2}, nil)
+
+		err := uc.Update(ctx, equipment)
+
+		assert.ErrorIs(t, err, ErrEquipmentMismatch)
+		repo.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(&WarrantyEquipment{ID: 3, WarrantyID: 1}, nil)
+		repo.On("Delete", ctx, int64(3)).Return(nil)
+
+		err := uc.Delete(ctx, 1, 3)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("belongs to another warranty", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(true, nil)
+
+		repo.On("GetByID", ctx, int64(3)).Return(&WarrantyEquipment{ID: 3, WarrantyID: 2}, nil)
+
+		err := uc.Delete(ctx, 1, 3)
+
+		assert.ErrorIs(t, err, ErrEquipmentMismatch)
+		repo.AssertNotCalled(t, "Delete")
+	})
+
+	t.Run("warranty outside of scope", func(t *testing.T) {
+		uc, repo, warrantyChecker := newTestUseCase()
+		warrantyChecker.On("GetByID", ctx, int64(1)).Return(nil, errors.New("warranty not found"))
+
+		err := uc.Delete(ctx, 1, 3)
+
+		assert.ErrorIs(t, err, ErrEquipmentNotFound)
+		repo.AssertNotCalled(t, "Delete", ctx, int64(3))
+	})
+}
+
+func TestGetOutdoorUnit(t *testing.T) {
+	e := &WarrantyEquipment{
+		OutdoorBrand:  strPtr("Carrier"),
+		OutdoorModel:  strPtr("24ACC6"),
+		OutdoorSerial: strPtr("1234"),
+	}
+
+	assert.Equal(t, "Carrier 24ACC6 | S/N 1234", e.GetOutdoorUnit())
+}
@@ -0,0 +1,35 @@
+package alert
+
+import (
+	"context"
+	"log/slog"
+
+	domainAlert "github.com/your-org/jvairv2/pkg/domain/alert"
+)
+
+// Create inserta una nueva alerta sin leer
+func (r *Repository) Create(ctx context.Context, a *domainAlert.Alert) error {
+	query := `
+		INSERT INTO alerts (user_id, alert_type, entity_id, entity_type, message_level, message, is_read, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, 0, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, a.UserID, a.AlertType, a.EntityID, a.EntityType, a.MessageLevel, a.Message)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create alert",
+			slog.String("type", a.AlertType),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	a.ID = id
+	a.IsRead = false
+	return nil
+}
@@ -0,0 +1,27 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainAlert "github.com/your-org/jvairv2/pkg/domain/alert"
+)
+
+// GetByID obtiene una alerta por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainAlert.Alert, error) {
+	query := "SELECT " + alertColumns + " FROM alerts WHERE id = ?"
+
+	a, err := scanAlert(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainAlert.ErrAlertNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get alert by ID",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return a, nil
+}
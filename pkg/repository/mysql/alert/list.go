@@ -0,0 +1,51 @@
+package alert
+
+import (
+	"context"
+	"log/slog"
+
+	domainAlert "github.com/your-org/jvairv2/pkg/domain/alert"
+)
+
+// ListByUser obtiene la bandeja de alertas de un usuario: no leídas primero y luego las más recientes
+func (r *Repository) ListByUser(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*domainAlert.Alert, int, error) {
+	where := " WHERE user_id = ?"
+	if unreadOnly {
+		where += " AND is_read = 0"
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM alerts"+where, userID).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count alerts",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	query := "SELECT " + alertColumns + " FROM alerts" + where +
+		" ORDER BY is_read ASC, created_at DESC, id DESC LIMIT ? OFFSET ?"
+
+	rows, err := r.db.QueryContext(ctx, query, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list alerts",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*domainAlert.Alert
+	for rows.Next() {
+		a, err := scanAlert(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan alert row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		items = append(items, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
@@ -0,0 +1,49 @@
+package alert
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// MarkRead marca como leídas las alertas indicadas de un usuario; sin IDs marca todas
+func (r *Repository) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	query := "UPDATE alerts SET is_read = 1, updated_at = NOW() WHERE user_id = ? AND is_read = 0"
+	args := []interface{}{userID}
+
+	if len(ids) > 0 {
+		query += " AND id IN (?" + strings.Repeat(",?", len(ids)-1) + ")"
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark alerts as read",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// MarkReadByEntity marca como leídas las alertas de un tipo asociadas a una entidad
+func (r *Repository) MarkReadByEntity(ctx context.Context, userID int64, alertType, entityType string, entityID int64) (int64, error) {
+	query := `
+		UPDATE alerts SET is_read = 1, updated_at = NOW()
+		WHERE user_id = ? AND alert_type = ? AND entity_type = ? AND entity_id = ? AND is_read = 0
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, alertType, entityType, entityID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark entity alerts as read",
+			slog.Int64("userId", userID),
+			slog.Int64("entityId", entityID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
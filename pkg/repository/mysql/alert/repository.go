@@ -0,0 +1,33 @@
+package alert
+
+import (
+	"database/sql"
+
+	domainAlert "github.com/your-org/jvairv2/pkg/domain/alert"
+)
+
+// Repository implementa el repositorio MySQL para alertas
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de alertas
+func NewRepository(db *sql.DB) domainAlert.Repository {
+	return &Repository{db: db}
+}
+
+const alertColumns = `id, user_id, alert_type, entity_id, entity_type, message_level, message, is_read, created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAlert(row rowScanner) (*domainAlert.Alert, error) {
+	a := &domainAlert.Alert{}
+	err := row.Scan(&a.ID, &a.UserID, &a.AlertType, &a.EntityID, &a.EntityType,
+		&a.MessageLevel, &a.Message, &a.IsRead, &a.CreatedAt, &a.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
@@ -0,0 +1,126 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	domainAlert "github.com/your-org/jvairv2/pkg/domain/alert"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+var columns = []string{"id", "user_id", "alert_type", "entity_id", "entity_type", "message_level", "message", "is_read", "created_at", "updated_at"}
+
+func TestListByUser_UnreadFirst(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM alerts WHERE user_id = \\?$").
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery("SELECT .* FROM alerts WHERE user_id = \\? ORDER BY is_read ASC, created_at DESC, id DESC LIMIT \\? OFFSET \\?").
+		WithArgs(int64(5), 10, 10).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(8, 5, "call_log", 10, domainAlert.EntityTypeJob, "warning", "Call log updated", false, now, now).
+			AddRow(3, 5, "job_assigned", 10, domainAlert.EntityTypeJob, "info", "Assigned", true, now, now))
+
+	alerts, total, err := repo.ListByUser(ctx, 5, false, 2, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, alerts, 2)
+	assert.False(t, alerts[0].IsRead)
+	assert.True(t, alerts[1].IsRead)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListByUser_UnreadOnly(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM alerts WHERE user_id = \\? AND is_read = 0").
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery("SELECT .* FROM alerts WHERE user_id = \\? AND is_read = 0 ORDER BY").
+		WithArgs(int64(5), 10, 0).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	alerts, total, err := repo.ListByUser(ctx, 5, true, 1, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, total)
+	assert.Empty(t, alerts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkRead(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE alerts SET is_read = 1, updated_at = NOW\\(\\) WHERE user_id = \\? AND is_read = 0 AND id IN \\(\\?,\\?\\)").
+		WithArgs(int64(5), int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	count, err := repo.MarkRead(ctx, 5, []int64{1, 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkRead_All(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE alerts SET is_read = 1, updated_at = NOW\\(\\) WHERE user_id = \\? AND is_read = 0$").
+		WithArgs(int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 7))
+
+	count, err := repo.MarkRead(ctx, 5, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkReadByEntity(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE alerts SET is_read = 1").
+		WithArgs(int64(5), domainAlert.TypeCallLog, domainAlert.EntityTypeJob, int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	count, err := repo.MarkReadByEntity(ctx, 5, domainAlert.TypeCallLog, domainAlert.EntityTypeJob, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
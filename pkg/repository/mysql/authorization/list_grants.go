@@ -0,0 +1,87 @@
+package authorization
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainAuthorization "github.com/your-org/jvairv2/pkg/domain/authorization"
+)
+
+// ListGrants obtiene los permisos del usuario: los directos, los concedidos a todos
+// (entity_id y entity_type nulos) y los de sus roles asignados, incluidos los prohibidos
+func (r *Repository) ListGrants(ctx context.Context, userID int64) ([]domainAuthorization.Grant, error) {
+	query := `
+		SELECT a.id, a.name, a.entity_type, a.entity_id, a.only_owned, a.scope,
+			p.forbidden, p.scope, NULL, NULL, NULL, NULL
+		FROM permissions p
+		INNER JOIN abilities a ON a.id = p.ability_id
+		WHERE (p.entity_type = ? AND p.entity_id = ?)
+			OR (p.entity_type IS NULL AND p.entity_id IS NULL)
+		UNION ALL
+		SELECT a.id, a.name, a.entity_type, a.entity_id, a.only_owned, a.scope,
+			p.forbidden, p.scope, r.scope, ar.scope, ar.restricted_to_type, ar.restricted_to_id
+		FROM assigned_roles ar
+		INNER JOIN roles r ON r.id = ar.role_id
+		INNER JOIN permissions p ON p.entity_type = ? AND p.entity_id = r.id
+		INNER JOIN abilities a ON a.id = p.ability_id
+		WHERE ar.entity_type = ? AND ar.entity_id = ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		domainAuthorization.UserType, userID,
+		domainAuthorization.RoleType,
+		domainAuthorization.UserType, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list grants",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	grants := []domainAuthorization.Grant{}
+	for rows.Next() {
+		var g domainAuthorization.Grant
+		var entityType, restrictedToType sql.NullString
+		var entityID, restrictedToID sql.NullInt64
+		var abilityScope, permissionScope, roleScope, assignmentScope sql.NullInt32
+
+		if err := rows.Scan(
+			&g.AbilityID, &g.Name, &entityType, &entityID, &g.OnlyOwned, &abilityScope,
+			&g.Forbidden, &permissionScope, &roleScope, &assignmentScope, &restrictedToType, &restrictedToID,
+		); err != nil {
+			return nil, err
+		}
+
+		g.EntityType = entityType.String
+		g.RestrictedToType = restrictedToType.String
+		g.EntityID = nullInt64(entityID)
+		g.RestrictedToID = nullInt64(restrictedToID)
+		g.AbilityScope = nullInt(abilityScope)
+		g.PermissionScope = nullInt(permissionScope)
+		g.RoleScope = nullInt(roleScope)
+		g.AssignmentScope = nullInt(assignmentScope)
+
+		grants = append(grants, g)
+	}
+
+	return grants, rows.Err()
+}
+
+func nullInt64(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+func nullInt(v sql.NullInt32) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int32)
+	return &i
+}
@@ -0,0 +1,17 @@
+package authorization
+
+import (
+	"database/sql"
+
+	domainAuthorization "github.com/your-org/jvairv2/pkg/domain/authorization"
+)
+
+// Repository implementa la lectura de permisos de Bouncer en MySQL
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de autorización
+func NewRepository(db *sql.DB) domainAuthorization.Repository {
+	return &Repository{db: db}
+}
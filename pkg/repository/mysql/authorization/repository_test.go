@@ -0,0 +1,74 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	domainAuthorization "github.com/your-org/jvairv2/pkg/domain/authorization"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+var grantColumns = []string{
+	"id", "name", "entity_type", "entity_id", "only_owned", "scope",
+	"forbidden", "p_scope", "r_scope", "ar_scope", "restricted_to_type", "restricted_to_id",
+}
+
+func TestListGrants(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT (.+) FROM permissions p (.+) UNION ALL SELECT (.+) FROM assigned_roles ar").
+		WithArgs(domainAuthorization.UserType, int64(7), domainAuthorization.RoleType, domainAuthorization.UserType, int64(7)).
+		WillReturnRows(sqlmock.NewRows(grantColumns).
+			AddRow(1, "job_view", nil, nil, false, nil, false, nil, nil, nil, nil, nil).
+			AddRow(2, "edit", "App\\Models\\Job", 42, true, 1, true, 1, 1, 1, "App\\Models\\Job", 42))
+
+	grants, err := repo.ListGrants(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.Len(t, grants, 2)
+	assert.Equal(t, domainAuthorization.Grant{AbilityID: 1, Name: "job_view"}, grants[0])
+
+	edit := grants[1]
+	assert.Equal(t, "App\\Models\\Job", edit.EntityType)
+	assert.Equal(t, int64(42), *edit.EntityID)
+	assert.True(t, edit.OnlyOwned)
+	assert.True(t, edit.Forbidden)
+	assert.Equal(t, 1, *edit.AbilityScope)
+	assert.Equal(t, 1, *edit.PermissionScope)
+	assert.Equal(t, 1, *edit.RoleScope)
+	assert.Equal(t, 1, *edit.AssignmentScope)
+	assert.Equal(t, "App\\Models\\Job", edit.RestrictedToType)
+	assert.Equal(t, int64(42), *edit.RestrictedToID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListGrants_Error(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT (.+) FROM permissions p").
+		WillReturnError(errors.New("db down"))
+
+	_, err := repo.ListGrants(context.Background(), 7)
+
+	assert.EqualError(t, err, "db down")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,29 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/email_template"
+)
+
+func (r *Repository) Create(ctx context.Context, t *email_template.EmailTemplate) error {
+	query := "INSERT INTO email_templates (label, subject, body, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, NOW(), NOW())"
+
+	result, err := r.db.ExecContext(ctx, query, t.Label, t.Subject, t.Body, t.IsActive)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert email template query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	t.ID = id
+	return nil
+}
@@ -0,0 +1,20 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	query := "DELETE FROM email_templates WHERE id = ?"
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete email template",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package email_template
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/email_template"
+)
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*email_template.EmailTemplate, error) {
+	query := "SELECT id, label, subject, body, is_active, created_at, updated_at FROM email_templates WHERE id = ?"
+
+	t := &email_template.EmailTemplate{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&t.ID,
+		&t.Label,
+		&t.Subject,
+		&t.Body,
+		&t.IsActive,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, email_template.ErrEmailTemplateNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get email template by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,17 @@
+package email_template
+
+import (
+	"database/sql"
+
+	"github.com/your-org/jvairv2/pkg/domain/email_template"
+)
+
+// Repository implementa el repositorio MySQL para plantillas de email
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de plantillas de email
+func NewRepository(db *sql.DB) email_template.Repository {
+	return &Repository{db: db}
+}
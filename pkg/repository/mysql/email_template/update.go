@@ -0,0 +1,22 @@
+package email_template
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/email_template"
+)
+
+func (r *Repository) Update(ctx context.Context, t *email_template.EmailTemplate) error {
+	query := "UPDATE email_templates SET label = ?, subject = ?, body = ?, is_active = ?, updated_at = NOW() WHERE id = ?"
+
+	_, err := r.db.ExecContext(ctx, query, t.Label, t.Subject, t.Body, t.IsActive, t.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update email template",
+			slog.String("error", err.Error()),
+			slog.Int64("id", t.ID))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package file
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// fileableTables relaciona cada fileable_type con su tabla
+var fileableTables = map[string]string{
+	`App\Models\Job`:           "jobs",
+	`App\Models\JobVisit`:      "job_visits",
+	`App\Models\Warranty`:      "warranties",
+	`App\Models\WarrantyClaim`: "warranty_claims",
+}
+
+// FileableCheckerAdapter verifica que exista la entidad a la que se adjunta un archivo
+type FileableCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewFileableCheckerAdapter(db *sql.DB) domainFile.FileableChecker {
+	return &FileableCheckerAdapter{db: db}
+}
+
+func (a *FileableCheckerAdapter) Exists(ctx context.Context, fileableType string, id int64) (bool, error) {
+	table, ok := fileableTables[fileableType]
+	if !ok {
+		return false, domainFile.ErrInvalidFileableType
+	}
+
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM "+table+" WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// JobID retorna el job al que pertenece la entidad; un job es su propio job
+func (a *FileableCheckerAdapter) JobID(ctx context.Context, fileableType string, id int64) (int64, error) {
+	table, ok := fileableTables[fileableType]
+	if !ok {
+		return 0, domainFile.ErrInvalidFileableType
+	}
+	if table == "jobs" {
+		return id, nil
+	}
+
+	var jobID int64
+	err := a.db.QueryRowContext(ctx, "SELECT job_id FROM "+table+" WHERE id = ? AND deleted_at IS NULL", id).Scan(&jobID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, domainFile.ErrFileableNotFound
+		}
+		return 0, err
+	}
+	return jobID, nil
+}
+
+// JobCheckerAdapter adapta la verificación de existencia de jobs
+type JobCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobCheckerAdapter(db *sql.DB) domainFile.JobChecker {
+	return &JobCheckerAdapter{db: db}
+}
+
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)",
+		id,
+	).Scan(&exists)
+	if err != nil || !exists {
+		slog.ErrorContext(ctx, "Job not found",
+			slog.Int64("jobId", id))
+		return nil, domainFile.ErrFileableNotFound
+	}
+	return true, nil
+}
@@ -0,0 +1,35 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// Create registra un archivo
+func (r *Repository) Create(ctx context.Context, f *domainFile.File) error {
+	query := `
+		INSERT INTO files (type, path, url, fileable_id, fileable_type, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, f.Type, f.Path, f.URL, f.FileableID, f.FileableType)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create file",
+			slog.String("fileableType", f.FileableType),
+			slog.Int64("fileableId", f.FileableID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	f.ID = id
+	return nil
+}
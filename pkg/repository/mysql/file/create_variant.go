@@ -0,0 +1,35 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// CreateVariant registra una variante de imagen
+func (r *Repository) CreateVariant(ctx context.Context, v *domainFile.Variant) error {
+	query := `
+		INSERT INTO file_variants (file_id, name, path, url, content_type, width, height, size, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, v.FileID, v.Name, v.Path, v.URL, v.ContentType, v.Width, v.Height, v.Size)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create file variant",
+			slog.Int64("fileId", v.FileID),
+			slog.String("name", v.Name),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	v.ID = id
+	return nil
+}
@@ -0,0 +1,44 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// Delete elimina el registro de un archivo y sus variantes (la tabla files no tiene borrado lógico)
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM file_variants WHERE file_id = ?", id); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete file variants",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM files WHERE id = ?", id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete file",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domainFile.ErrFileNotFound
+	}
+
+	return tx.Commit()
+}
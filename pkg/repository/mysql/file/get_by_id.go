@@ -0,0 +1,27 @@
+package file
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// GetByID obtiene un archivo por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainFile.File, error) {
+	query := "SELECT " + fileColumns + " FROM files WHERE id = ?"
+
+	f, err := scanFile(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainFile.ErrFileNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get file by ID",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return f, nil
+}
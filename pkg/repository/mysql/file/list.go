@@ -0,0 +1,38 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// ListByFileable obtiene los archivos de una entidad, del más reciente al más antiguo
+func (r *Repository) ListByFileable(ctx context.Context, fileableType string, fileableID int64) ([]*domainFile.File, error) {
+	query := "SELECT " + fileColumns + " FROM files WHERE fileable_type = ? AND fileable_id = ? ORDER BY id DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, fileableType, fileableID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list files",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	files := []*domainFile.File{}
+	for rows.Next() {
+		f, err := scanFile(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan file row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
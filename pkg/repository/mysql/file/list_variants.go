@@ -0,0 +1,54 @@
+package file
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// ListVariants obtiene las variantes de varios archivos, de la más pequeña a la más grande
+func (r *Repository) ListVariants(ctx context.Context, fileIDs []int64) ([]*domainFile.Variant, error) {
+	variants := []*domainFile.Variant{}
+	if len(fileIDs) == 0 {
+		return variants, nil
+	}
+
+	query := `
+		SELECT id, file_id, name, path, url, content_type, width, height, size, created_at, updated_at
+		FROM file_variants
+		WHERE file_id IN (?` + strings.Repeat(",?", len(fileIDs)-1) + `)
+		ORDER BY file_id, width, id
+	`
+
+	args := make([]interface{}, len(fileIDs))
+	for i, id := range fileIDs {
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list file variants",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		v := &domainFile.Variant{}
+		if err := rows.Scan(&v.ID, &v.FileID, &v.Name, &v.Path, &v.URL, &v.ContentType,
+			&v.Width, &v.Height, &v.Size, &v.CreatedAt, &v.UpdatedAt); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan file variant row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		variants = append(variants, v)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
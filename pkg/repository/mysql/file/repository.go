@@ -0,0 +1,32 @@
+package file
+
+import (
+	"database/sql"
+
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+// Repository implementa el repositorio MySQL para archivos
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de archivos
+func NewRepository(db *sql.DB) domainFile.Repository {
+	return &Repository{db: db}
+}
+
+const fileColumns = `id, type, path, url, fileable_id, fileable_type, created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanFile(row rowScanner) (*domainFile.File, error) {
+	f := &domainFile.File{}
+	err := row.Scan(&f.ID, &f.Type, &f.Path, &f.URL, &f.FileableID, &f.FileableType, &f.CreatedAt, &f.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
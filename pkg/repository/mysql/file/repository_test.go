@@ -0,0 +1,122 @@
+package file
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	domainFile "github.com/your-org/jvairv2/pkg/domain/file"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+func TestListByFileable(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT .* FROM files WHERE fileable_type = \\? AND fileable_id = \\? ORDER BY id DESC").
+		WithArgs(`App\Models\Job`, int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "path", "url", "fileable_id", "fileable_type", "created_at", "updated_at"}).
+			AddRow(2, "image", "job/5/a.jpg", "http://files/job/5/a.jpg", 5, `App\Models\Job`, now, now).
+			AddRow(1, nil, nil, "https://legacy/old.jpg", 5, `App\Models\Job`, nil, nil))
+
+	files, err := repo.ListByFileable(ctx, `App\Models\Job`, 5)
+
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "job/5/a.jpg", *files[0].Path)
+	assert.Nil(t, files[1].Path)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDelete_NotFound(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM file_variants WHERE file_id = \\?").
+		WithArgs(int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM files WHERE id = \\?").
+		WithArgs(int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.Delete(context.Background(), 9)
+
+	assert.Equal(t, domainFile.ErrFileNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListVariants(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT .* FROM file_variants WHERE file_id IN \\(\\?,\\?\\) ORDER BY file_id, width, id").
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "file_id", "name", "path", "url", "content_type", "width", "height", "size", "created_at", "updated_at"}).
+			AddRow(3, 1, "thumbnail", "job/5/a_thumbnail.jpg", "http://files/job/5/a_thumbnail.jpg", "image/jpeg", 320, 240, 18000, nil, nil).
+			AddRow(4, 1, "web", "job/5/a_web.jpg", "http://files/job/5/a_web.jpg", "image/jpeg", 1600, 1200, 310000, nil, nil))
+
+	variants, err := repo.ListVariants(context.Background(), []int64{1, 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, variants, 2)
+	assert.Equal(t, "thumbnail", variants[0].Name)
+	assert.Equal(t, 1600, variants[1].Width)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	variants, err = repo.ListVariants(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, variants)
+}
+
+func TestFileableChecker(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	checker := NewFileableCheckerAdapter(db)
+
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM warranty_claims WHERE id = \\? AND deleted_at IS NULL\\)").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := checker.Exists(context.Background(), `App\Models\WarrantyClaim`, 3)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	_, err = checker.Exists(context.Background(), `App\Models\Customer`, 3)
+	assert.Equal(t, domainFile.ErrInvalidFileableType, err)
+
+	mock.ExpectQuery("SELECT job_id FROM warranty_claims WHERE id = \\? AND deleted_at IS NULL").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id"}).AddRow(int64(5)))
+
+	jobID, err := checker.JobID(context.Background(), `App\Models\WarrantyClaim`, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), jobID)
+
+	jobID, err = checker.JobID(context.Background(), `App\Models\Job`, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), jobID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
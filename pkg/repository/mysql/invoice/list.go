@@ -24,6 +24,12 @@ func (r *Repository) List(ctx context.Context, filters map[string]interface{}, p
 		args = append(args, jobID)
 	}
 
+	// Scope de jobs visibles: solo facturas de jobs asignados al usuario
+	if ownerID, ok := filters["job_user_id"].(int64); ok {
+		conditions = append(conditions, "i.job_id IN (SELECT id FROM jobs WHERE user_id = ?)")
+		args = append(args, ownerID)
+	}
+
 	// Búsqueda en múltiples campos (fiel al original Laravel: invoice_number, work_order, property, customer)
 	if search, ok := filters["search"].(string); ok && search != "" {
 		searchCondition := `(
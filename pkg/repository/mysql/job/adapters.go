@@ -206,3 +206,22 @@ func (a *TechnicianJobStatusCheckerAdapter) GetLinkedJobStatusID(ctx context.Con
 	val := jobStatusID.Int64
 	return &val, nil
 }
+
+// WarrantyClaimCheckerAdapter consulta warranty_claims para el checker del job use case
+type WarrantyClaimCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewWarrantyClaimCheckerAdapter(db *sql.DB) domainJob.WarrantyClaimChecker {
+	return &WarrantyClaimCheckerAdapter{db: db}
+}
+
+// HasClaims verifica si el job tiene al menos una reclamación de garantía activa
+func (a *WarrantyClaimCheckerAdapter) HasClaims(ctx context.Context, jobID int64) (bool, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM warranty_claims WHERE job_id = ? AND deleted_at IS NULL)", jobID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
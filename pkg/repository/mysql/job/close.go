@@ -2,14 +2,35 @@ package job
 
 import (
 	"context"
+	"database/sql"
 	"log/slog"
+
+	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
 )
 
-// Close cierra un job actualizando closed=true y el job_status_id
-func (r *Repository) Close(ctx context.Context, id int64, jobStatusID int64) error {
-	query := `UPDATE jobs SET closed = 1, job_status_id = ?, updated_at = NOW() WHERE id = ? AND deleted_at IS NULL`
+// equipmentColumns son las columnas de equipo compartidas por job_equipment,
+// property_equipment y warranty_equipment
+const equipmentColumns = `area,
+	outdoor_brand, outdoor_model, outdoor_serial, outdoor_installed,
+	furnace_brand, furnace_model, furnace_serial, furnace_installed,
+	evaporator_brand, evaporator_model, evaporator_serial, evaporator_installed,
+	air_handler_brand, air_handler_model, air_handler_serial, air_handler_installed`
+
+// Close cierra un job actualizando closed=true y el job_status_id.
+// Las opciones de cierre (equipos de propiedad y garantía) se aplican en la misma
+// transacción, de modo que un fallo en cualquier paso no deja datos a medias.
+func (r *Repository) Close(ctx context.Context, id int64, jobStatusID int64, opts domainJob.CloseOptions) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `UPDATE jobs SET closed = 1, job_status_id = ?, updated_at = NOW() WHERE id = ? AND closed = 0 AND deleted_at IS NULL`
 
-	_, err := r.db.ExecContext(ctx, query, jobStatusID, id)
+	result, err := tx.ExecContext(ctx, query, jobStatusID, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to close job",
 			slog.Int64("id", id),
@@ -17,5 +38,102 @@ func (r *Repository) Close(ctx context.Context, id int64, jobStatusID int64) err
 		return err
 	}
 
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainJob.ErrJobAlreadyClosed
+	}
+
+	if opts.UpdatePropertyEquipment {
+		if err := copyEquipmentToProperty(ctx, tx, id); err != nil {
+			slog.ErrorContext(ctx, "Failed to copy job equipment to property",
+				slog.Int64("id", id),
+				slog.String("error", err.Error()))
+			return err
+		}
+	}
+
+	if opts.Warranty != nil {
+		if err := createWarranty(ctx, tx, id, opts.Warranty); err != nil {
+			slog.ErrorContext(ctx, "Failed to create warranty on close",
+				slog.Int64("id", id),
+				slog.String("error", err.Error()))
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// copyEquipmentToProperty copia los equipos "new" del job a property_equipment
+func copyEquipmentToProperty(ctx context.Context, tx *sql.Tx, jobID int64) error {
+	var propertyID int64
+	if err := tx.QueryRowContext(ctx, "SELECT property_id FROM jobs WHERE id = ?", jobID).Scan(&propertyID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO property_equipment (property_id, ` + equipmentColumns + `, created_at, updated_at)
+		SELECT ?, ` + equipmentColumns + `, NOW(), NOW()
+		FROM job_equipment
+		WHERE job_id = ? AND type = 'new'
+	`
+
+	_, err := tx.ExecContext(ctx, query, propertyID, jobID)
+	return err
+}
+
+// createWarranty crea la garantía del job y clona sus equipos "new" en warranty_equipment
+func createWarranty(ctx context.Context, tx *sql.Tx, jobID int64, w *domainJob.CloseWarranty) error {
+	var exists bool
+	if err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM warranty_types WHERE id = ?)", w.WarrantyTypeID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return domainJob.ErrInvalidWarrantyType
+	}
+
+	if err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM warranty_statuses WHERE id = ?)", w.WarrantyStatusID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return domainJob.ErrInvalidWarrantyStatus
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO warranties (warranty_number, job_id, warranty_type_id, warranty_status_id,
+			date_submitted, agreement_number, audit_done, notes, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, 0, ?, NOW(), NOW())
+	`,
+		w.WarrantyNumber,
+		jobID,
+		w.WarrantyTypeID,
+		w.WarrantyStatusID,
+		w.DateSubmitted,
+		w.AgreementNumber,
+		w.Notes,
+	)
+	if err != nil {
+		return err
+	}
+
+	warrantyID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO warranty_equipment (warranty_id, ` + equipmentColumns + `, created_at, updated_at)
+		SELECT ?, ` + equipmentColumns + `, NOW(), NOW()
+		FROM job_equipment
+		WHERE job_id = ? AND type = 'new'
+	`
+	if _, err := tx.ExecContext(ctx, query, warrantyID, jobID); err != nil {
+		return err
+	}
+
+	w.ID = warrantyID
 	return nil
 }
@@ -90,6 +90,7 @@ func (r *Repository) List(ctx context.Context, filters map[string]interface{}, p
 	}
 
 	// Búsqueda en múltiples campos (fiel al original: work_order, property fields, customer name)
+	// y en los residentes del job (nombre, teléfonos y email)
 	if search, ok := filters["search"].(string); ok && search != "" {
 		searchCondition := `(
 			j.work_order LIKE ? OR
@@ -98,11 +99,20 @@ func (r *Repository) List(ctx context.Context, filters map[string]interface{}, p
 			p.city LIKE ? OR
 			p.state LIKE ? OR
 			p.zip LIKE ? OR
-			c.name LIKE ?
+			c.name LIKE ? OR
+			EXISTS (
+				SELECT 1 FROM job_residents jr
+				WHERE jr.job_id = j.id AND jr.deleted_at IS NULL AND (
+					jr.name LIKE ? OR
+					jr.mobile_phone LIKE ? OR
+					jr.home_phone LIKE ? OR
+					jr.email LIKE ?
+				)
+			)
 		)`
 		conditions = append(conditions, searchCondition)
 		searchPattern := "%" + search + "%"
-		for i := 0; i < 7; i++ {
+		for i := 0; i < 11; i++ {
 			args = append(args, searchPattern)
 		}
 	}
@@ -0,0 +1,41 @@
+package job_activity_log
+
+import (
+	"context"
+	"log/slog"
+
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+)
+
+// Create crea una nueva entrada de actividad en la base de datos
+func (r *Repository) Create(ctx context.Context, activity *domainActivity.JobActivityLog) error {
+	query := `
+		INSERT INTO job_activity_logs (
+			job_id, type, log, user_id,
+			created_at, updated_at
+		) VALUES (
+			?, ?, ?, ?,
+			NOW(), NOW()
+		)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		activity.JobID, activity.Type, activity.Log, activity.UserID,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create job activity",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	activity.ID = id
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package job_activity_log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una entrada de actividad (hard delete, la tabla no tiene deleted_at)
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM job_activity_logs WHERE id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job activity",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package job_activity_log
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+)
+
+// GetByID obtiene una entrada de actividad por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainActivity.JobActivityLog, error) {
+	query := `
+		SELECT
+			jal.id, jal.job_id, jal.type, jal.log, jal.user_id, u.name,
+			jal.created_at, jal.updated_at
+		FROM job_activity_logs jal
+		LEFT JOIN users u ON u.id = jal.user_id
+		WHERE jal.id = ?
+	`
+
+	activity := &domainActivity.JobActivityLog{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&activity.ID, &activity.JobID, &activity.Type, &activity.Log, &activity.UserID, &activity.UserName,
+		&activity.CreatedAt, &activity.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainActivity.ErrActivityNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get job activity by ID",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return activity, nil
+}
@@ -0,0 +1,98 @@
+package job_activity_log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+)
+
+// ListByJobID obtiene las actividades de un job con paginación
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*domainActivity.JobActivityLog, int, error) {
+	var conditions []string
+	var args []interface{}
+
+	// Siempre filtrar por job_id
+	conditions = append(conditions, "jal.job_id = ?")
+	args = append(args, jobID)
+
+	if logType, ok := filters["type"].(string); ok && logType != "" {
+		conditions = append(conditions, "jal.type = ?")
+		args = append(args, logType)
+	}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		conditions = append(conditions, "jal.log LIKE ?")
+		args = append(args, "%"+search+"%")
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM job_activity_logs jal
+		WHERE %s
+	`, whereClause)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job activities",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	// Sorting: la línea de tiempo se muestra de la más reciente a la más antigua
+	direction := "DESC"
+	if dir, ok := filters["direction"].(string); ok && strings.ToUpper(dir) == "ASC" {
+		direction = "ASC"
+	}
+	orderClause := fmt.Sprintf("jal.created_at %s, jal.id %s", direction, direction)
+
+	// Data query
+	offset := (page - 1) * pageSize
+	dataQuery := fmt.Sprintf(`
+		SELECT
+			jal.id, jal.job_id, jal.type, jal.log, jal.user_id, u.name,
+			jal.created_at, jal.updated_at
+		FROM job_activity_logs jal
+		LEFT JOIN users u ON u.id = jal.user_id
+		WHERE %s
+		ORDER BY %s
+		LIMIT ? OFFSET ?
+	`, whereClause, orderClause)
+
+	queryArgs := append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, queryArgs...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job activities",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var activities []*domainActivity.JobActivityLog
+	for rows.Next() {
+		activity := &domainActivity.JobActivityLog{}
+		if err := rows.Scan(
+			&activity.ID, &activity.JobID, &activity.Type, &activity.Log, &activity.UserID, &activity.UserName,
+			&activity.CreatedAt, &activity.UpdatedAt,
+		); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job activity row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		activities = append(activities, activity)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating job activity rows",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return activities, total, nil
+}
@@ -0,0 +1,15 @@
+package job_activity_log
+
+import (
+	"database/sql"
+)
+
+// Repository implementa el repositorio MySQL para actividades de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de actividades de jobs
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,39 @@
+package job_email
+
+import (
+	"context"
+	"log/slog"
+
+	domainJobEmail "github.com/your-org/jvairv2/pkg/domain/job_email"
+)
+
+// Create registra un correo enviado desde un job
+func (r *Repository) Create(ctx context.Context, e *domainJobEmail.JobEmail) error {
+	recipients, err := encodeRecipients(e.Recipients)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO job_emails (job_id, recipients, type, created_at, updated_at)
+		VALUES (?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, e.JobID, recipients, e.Type)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create job email",
+			slog.Int64("jobId", e.JobID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	e.ID = id
+	return nil
+}
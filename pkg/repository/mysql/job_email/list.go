@@ -0,0 +1,53 @@
+package job_email
+
+import (
+	"context"
+	"log/slog"
+
+	domainJobEmail "github.com/your-org/jvairv2/pkg/domain/job_email"
+)
+
+// ListByJobID obtiene los correos enviados de un job con paginación
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*domainJobEmail.JobEmail, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM job_emails WHERE job_id = ?", jobID).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job emails",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT id, job_id, recipients, type, created_at, updated_at
+		FROM job_emails
+		WHERE job_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, jobID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job emails",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*domainJobEmail.JobEmail
+	for rows.Next() {
+		s := &domainJobEmail.JobEmail{}
+		var recipients []byte
+		if err := rows.Scan(&s.ID, &s.JobID, &recipients, &s.Type, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job email row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		s.Recipients = decodeRecipients(recipients)
+		items = append(items, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
@@ -1,8 +1,12 @@
 package job_equipment
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
+
+	domainJobEquip "github.com/your-org/jvairv2/pkg/domain/job_equipment"
 )
 
 // JobCheckerAdapter implementa job_equipment.JobChecker usando MySQL
@@ -11,22 +15,23 @@ type JobCheckerAdapter struct {
 }
 
 // NewJobCheckerAdapter crea una nueva instancia del adapter
-func NewJobCheckerAdapter(db *sql.DB) *JobCheckerAdapter {
+func NewJobCheckerAdapter(db *sql.DB) domainJobEquip.JobChecker {
 	return &JobCheckerAdapter{db: db}
 }
 
-// JobExists verifica si un job existe y no está eliminado
-func (a *JobCheckerAdapter) JobExists(id int64) (bool, error) {
-	query := `SELECT COUNT(*) FROM jobs WHERE id = ? AND deleted_at IS NULL`
-
-	var count int
-	err := a.db.QueryRow(query, id).Scan(&count)
+// GetByID verifica si un job existe y no está eliminado
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
 	if err != nil {
-		slog.Error("Failed to check job existence",
+		slog.ErrorContext(ctx, "Failed to check job existence",
 			slog.String("error", err.Error()),
 			slog.Int64("job_id", id))
-		return false, err
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("job not found")
 	}
 
-	return count > 0, nil
+	return true, nil
 }
@@ -0,0 +1,37 @@
+package job_history
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+)
+
+// CreateBatch inserta varias entradas de historial en una sola sentencia
+func (r *Repository) CreateBatch(ctx context.Context, entries []*domainHistory.JobHistory) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(entries))
+	args := make([]interface{}, 0, len(entries)*6)
+	for i, e := range entries {
+		placeholders[i] = "(?, ?, ?, ?, ?, ?)"
+		args = append(args, e.JobID, e.UserID, e.Field, e.OldValue, e.NewValue, e.CreatedAt)
+	}
+
+	query := `
+		INSERT INTO job_histories (
+			job_id, user_id, field, old_value, new_value, created_at
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		slog.ErrorContext(ctx, "Failed to create job history entries",
+			slog.Int("count", len(entries)),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package job_history
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+)
+
+// ListByJobID obtiene el historial de un job con paginación
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64, filters map[string]interface{}, page, pageSize int) ([]*domainHistory.JobHistory, int, error) {
+	var conditions []string
+	var args []interface{}
+
+	conditions = append(conditions, "jh.job_id = ?")
+	args = append(args, jobID)
+
+	if field, ok := filters["field"].(string); ok && field != "" {
+		conditions = append(conditions, "jh.field = ?")
+		args = append(args, field)
+	}
+
+	if userID, ok := filters["user_id"].(int64); ok && userID > 0 {
+		conditions = append(conditions, "jh.user_id = ?")
+		args = append(args, userID)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	// Count query
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM job_histories jh
+		WHERE %s
+	`, whereClause)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job history",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	// Data query
+	offset := (page - 1) * pageSize
+	dataQuery := fmt.Sprintf(`
+		SELECT
+			jh.id, jh.job_id, jh.user_id, u.name, jh.field, jh.old_value, jh.new_value, jh.created_at
+		FROM job_histories jh
+		LEFT JOIN users u ON u.id = jh.user_id
+		WHERE %s
+		ORDER BY jh.created_at DESC, jh.id DESC
+		LIMIT ? OFFSET ?
+	`, whereClause)
+
+	queryArgs := append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, queryArgs...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job history",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []*domainHistory.JobHistory
+	for rows.Next() {
+		e := &domainHistory.JobHistory{}
+		if err := rows.Scan(
+			&e.ID, &e.JobID, &e.UserID, &e.UserName, &e.Field, &e.OldValue, &e.NewValue, &e.CreatedAt,
+		); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job history row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating job history rows",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,15 @@
+package job_history
+
+import (
+	"database/sql"
+)
+
+// Repository implementa el repositorio MySQL para el historial de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de historial de jobs
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
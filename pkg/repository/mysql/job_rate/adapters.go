@@ -0,0 +1,62 @@
+package job_rate
+
+import (
+	"context"
+	"database/sql"
+
+	domainRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+)
+
+// JobCheckerAdapter adapta la verificación de jobs para el use case de tarifas
+type JobCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobCheckerAdapter(db *sql.DB) domainRate.JobChecker {
+	return &JobCheckerAdapter{db: db}
+}
+
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainRate.ErrInvalidJob
+	}
+	return true, nil
+}
+
+// UserCheckerAdapter adapta la verificación de usuarios para el use case de tarifas
+type UserCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewUserCheckerAdapter(db *sql.DB) domainRate.UserChecker {
+	return &UserCheckerAdapter{db: db}
+}
+
+func (a *UserCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE id = ? AND is_active = 1 AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainRate.ErrInvalidUser
+	}
+	return true, nil
+}
+
+// JobRateStatusCheckerAdapter adapta la verificación de estados de tarifa
+type JobRateStatusCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobRateStatusCheckerAdapter(db *sql.DB) domainRate.JobRateStatusChecker {
+	return &JobRateStatusCheckerAdapter{db: db}
+}
+
+func (a *JobRateStatusCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM job_rate_statuses WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainRate.ErrInvalidJobRateStatus
+	}
+	return true, nil
+}
@@ -0,0 +1,39 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+
+	domainRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+)
+
+// Create crea una nueva tarifa
+func (r *Repository) Create(ctx context.Context, rate *domainRate.JobRate) error {
+	query := `
+		INSERT INTO job_rates (
+			job_id, user_id, job_rate_status_id, sale_price, rate_percent, rate_flat,
+			tech_parts, company_parts, parts_replaced, deduction, payment, paid, held, notes,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		rate.JobID, rate.UserID, rate.JobRateStatusID, rate.SalePrice, rate.RatePercent, rate.RateFlat,
+		rate.TechParts, rate.CompanyParts, rate.PartsReplaced, rate.Deduction, rate.Payment,
+		rate.Paid, rate.Held, rate.Notes)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert job rate query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	rate.ID = id
+	return nil
+}
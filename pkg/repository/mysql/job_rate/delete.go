@@ -0,0 +1,21 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una tarifa (soft delete)
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	query := `UPDATE job_rates SET deleted_at = NOW() WHERE id = ? AND deleted_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job rate",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package job_rate
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+)
+
+// selectColumns son las columnas comunes de las consultas de tarifas
+const selectColumns = `
+	jr.id, jr.job_id, jr.user_id, u.name, jr.job_rate_status_id, jrs.label, jrs.class,
+	jr.sale_price, jr.rate_percent, jr.rate_flat, jr.tech_parts, jr.company_parts,
+	jr.parts_replaced, jr.deduction, jr.payment, jr.paid, jr.held, jr.paid_at, jr.notes,
+	jr.created_at, jr.updated_at, jr.deleted_at
+`
+
+// selectJoins son los joins comunes de las consultas de tarifas
+const selectJoins = `
+	FROM job_rates jr
+	LEFT JOIN users u ON u.id = jr.user_id
+	LEFT JOIN job_rate_statuses jrs ON jrs.id = jr.job_rate_status_id
+`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRate(s scanner) (*domainRate.JobRate, error) {
+	r := &domainRate.JobRate{}
+	err := s.Scan(
+		&r.ID, &r.JobID, &r.UserID, &r.UserName, &r.JobRateStatusID, &r.JobRateStatusLabel, &r.JobRateStatusClass,
+		&r.SalePrice, &r.RatePercent, &r.RateFlat, &r.TechParts, &r.CompanyParts,
+		&r.PartsReplaced, &r.Deduction, &r.Payment, &r.Paid, &r.Held, &r.PaidAt, &r.Notes,
+		&r.CreatedAt, &r.UpdatedAt, &r.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetByID obtiene una tarifa por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainRate.JobRate, error) {
+	query := `SELECT ` + selectColumns + selectJoins + ` WHERE jr.id = ? AND jr.deleted_at IS NULL`
+
+	rate, err := scanRate(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainRate.ErrRateNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get job rate",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,41 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+
+	domainRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+)
+
+// ListByJobID obtiene las tarifas de un job en orden de registro
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64) ([]*domainRate.JobRate, error) {
+	query := `SELECT ` + selectColumns + selectJoins + ` WHERE jr.job_id = ? AND jr.deleted_at IS NULL ORDER BY jr.id`
+
+	rows, err := r.db.QueryContext(ctx, query, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job rates",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	rates := []*domainRate.JobRate{}
+	for rows.Next() {
+		rate, err := scanRate(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job rate row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating job rate rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,17 @@
+package job_rate
+
+import (
+	"database/sql"
+
+	domainRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+)
+
+// Repository implementa el repositorio MySQL para tarifas de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de tarifas de jobs
+func NewRepository(db *sql.DB) domainRate.Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,32 @@
+package job_rate
+
+import (
+	"context"
+	"log/slog"
+
+	domainRate "github.com/your-org/jvairv2/pkg/domain/job_rate"
+)
+
+// Update actualiza una tarifa existente; paid y held se gestionan desde nómina
+func (r *Repository) Update(ctx context.Context, rate *domainRate.JobRate) error {
+	query := `
+		UPDATE job_rates SET
+			user_id = ?, job_rate_status_id = ?, sale_price = ?, rate_percent = ?, rate_flat = ?,
+			tech_parts = ?, company_parts = ?, parts_replaced = ?, deduction = ?, payment = ?,
+			notes = ?, updated_at = NOW()
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		rate.UserID, rate.JobRateStatusID, rate.SalePrice, rate.RatePercent, rate.RateFlat,
+		rate.TechParts, rate.CompanyParts, rate.PartsReplaced, rate.Deduction, rate.Payment,
+		rate.Notes, rate.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update job rate",
+			slog.Int64("id", rate.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package job_rate_status
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+)
+
+func (r *Repository) Create(ctx context.Context, s *job_rate_status.JobRateStatus) error {
+	query := "INSERT INTO job_rate_statuses (label, class, `order`, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW())"
+
+	result, err := r.db.ExecContext(ctx, query,
+		s.Label,
+		s.Class,
+		s.Order,
+	)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert job rate status query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	s.ID = id
+	return nil
+}
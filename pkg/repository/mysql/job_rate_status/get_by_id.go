@@ -0,0 +1,35 @@
+package job_rate_status
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+)
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*job_rate_status.JobRateStatus, error) {
+	query := "SELECT id, label, class, `order`, created_at, updated_at FROM job_rate_statuses WHERE id = ? AND deleted_at IS NULL"
+
+	s := &job_rate_status.JobRateStatus{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&s.ID,
+		&s.Label,
+		&s.Class,
+		&s.Order,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, job_rate_status.ErrJobRateStatusNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get job rate status by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,20 @@
+package job_rate_status
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (r *Repository) HasJobRates(ctx context.Context, id int64) (bool, error) {
+	query := `SELECT COUNT(*) FROM job_rates WHERE job_rate_status_id = ? AND deleted_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		slog.ErrorContext(ctx, "Failed to check job rate status job rates",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,69 @@
+package job_rate_status
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+)
+
+func (r *Repository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*job_rate_status.JobRateStatus, int, error) {
+	where := []string{"deleted_at IS NULL"}
+	args := []interface{}{}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		where = append(where, "(label LIKE ? OR class LIKE ?)")
+		args = append(args, "%"+search+"%", "%"+search+"%")
+	}
+
+	whereClause := strings.Join(where, " AND ")
+
+	// Count total
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM job_rate_statuses WHERE %s", whereClause)
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job rate statuses",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	// Query with pagination
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf("SELECT id, label, class, `order`, created_at, updated_at FROM job_rate_statuses WHERE %s ORDER BY `order` ASC LIMIT ? OFFSET ?", whereClause)
+
+	args = append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job rate statuses",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var statuses []*job_rate_status.JobRateStatus
+	for rows.Next() {
+		s := &job_rate_status.JobRateStatus{}
+		if err := rows.Scan(
+			&s.ID,
+			&s.Label,
+			&s.Class,
+			&s.Order,
+			&s.CreatedAt,
+			&s.UpdatedAt,
+		); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job rate status",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		statuses = append(statuses, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return statuses, total, nil
+}
@@ -0,0 +1,15 @@
+package job_rate_status
+
+import (
+	"database/sql"
+
+	"github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) job_rate_status.Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,28 @@
+package job_rate_status
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+)
+
+func (r *Repository) Update(ctx context.Context, s *job_rate_status.JobRateStatus) error {
+	query := "UPDATE job_rate_statuses SET label = ?, class = ?, `order` = ?, updated_at = NOW() WHERE id = ? AND deleted_at IS NULL"
+
+	_, err := r.db.ExecContext(ctx, query,
+		s.Label,
+		s.Class,
+		s.Order,
+		s.ID,
+	)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update job rate status",
+			slog.String("error", err.Error()),
+			slog.Int64("id", s.ID))
+		return err
+	}
+
+	return nil
+}
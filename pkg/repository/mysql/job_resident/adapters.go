@@ -0,0 +1,26 @@
+package job_resident
+
+import (
+	"context"
+	"database/sql"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
+
+// JobCheckerAdapter adapta la verificación de jobs para el use case de residentes
+type JobCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobCheckerAdapter(db *sql.DB) domainResident.JobChecker {
+	return &JobCheckerAdapter{db: db}
+}
+
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainResident.ErrInvalidJob
+	}
+	return true, nil
+}
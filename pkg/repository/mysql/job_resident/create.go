@@ -0,0 +1,34 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
+
+// Create crea un nuevo residente
+func (r *Repository) Create(ctx context.Context, resident *domainResident.JobResident) error {
+	query := `
+		INSERT INTO job_residents (job_id, name, mobile_phone, home_phone, email, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		resident.JobID, resident.Name, resident.MobilePhone, resident.HomePhone, resident.Email)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert job resident query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	resident.ID = id
+	return nil
+}
@@ -0,0 +1,21 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina un residente (soft delete)
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	query := `UPDATE job_residents SET deleted_at = NOW() WHERE id = ? AND deleted_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job resident",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package job_resident
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
+
+// selectColumns son las columnas comunes de las consultas de residentes
+const selectColumns = `
+	id, job_id, name, mobile_phone, home_phone, email, created_at, updated_at, deleted_at
+`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanResident(s scanner) (*domainResident.JobResident, error) {
+	r := &domainResident.JobResident{}
+	err := s.Scan(
+		&r.ID, &r.JobID, &r.Name, &r.MobilePhone, &r.HomePhone, &r.Email,
+		&r.CreatedAt, &r.UpdatedAt, &r.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetByID obtiene un residente por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainResident.JobResident, error) {
+	query := `SELECT ` + selectColumns + ` FROM job_residents WHERE id = ? AND deleted_at IS NULL`
+
+	resident, err := scanResident(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainResident.ErrResidentNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get job resident",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resident, nil
+}
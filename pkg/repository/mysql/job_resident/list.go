@@ -0,0 +1,41 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
+
+// ListByJobID obtiene los residentes de un job en orden de registro
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64) ([]*domainResident.JobResident, error) {
+	query := `SELECT ` + selectColumns + ` FROM job_residents WHERE job_id = ? AND deleted_at IS NULL ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job residents",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	residents := []*domainResident.JobResident{}
+	for rows.Next() {
+		resident, err := scanResident(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job resident row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		residents = append(residents, resident)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating job resident rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return residents, nil
+}
@@ -0,0 +1,17 @@
+package job_resident
+
+import (
+	"database/sql"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
+
+// Repository implementa el repositorio MySQL para residentes de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de residentes de jobs
+func NewRepository(db *sql.DB) domainResident.Repository {
+	return &Repository{db: db}
+}
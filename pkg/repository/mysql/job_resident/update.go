@@ -0,0 +1,28 @@
+package job_resident
+
+import (
+	"context"
+	"log/slog"
+
+	domainResident "github.com/your-org/jvairv2/pkg/domain/job_resident"
+)
+
+// Update actualiza un residente existente
+func (r *Repository) Update(ctx context.Context, resident *domainResident.JobResident) error {
+	query := `
+		UPDATE job_residents SET
+			name = ?, mobile_phone = ?, home_phone = ?, email = ?, updated_at = NOW()
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		resident.Name, resident.MobilePhone, resident.HomePhone, resident.Email, resident.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update job resident",
+			slog.Int64("id", resident.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package job_sms
+
+import (
+	"context"
+	"log/slog"
+
+	domainJobSMS "github.com/your-org/jvairv2/pkg/domain/job_sms"
+)
+
+// Create registra un SMS enviado desde un job
+func (r *Repository) Create(ctx context.Context, s *domainJobSMS.JobSMS) error {
+	recipients, err := encodeRecipients(s.Recipients)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO job_sms (job_id, recipients, type, message, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, s.JobID, recipients, s.Type, s.Message)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create job sms",
+			slog.Int64("jobId", s.JobID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	s.ID = id
+	return nil
+}
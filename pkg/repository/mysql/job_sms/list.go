@@ -0,0 +1,53 @@
+package job_sms
+
+import (
+	"context"
+	"log/slog"
+
+	domainJobSMS "github.com/your-org/jvairv2/pkg/domain/job_sms"
+)
+
+// ListByJobID obtiene los SMS enviados de un job con paginación
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64, page, pageSize int) ([]*domainJobSMS.JobSMS, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM job_sms WHERE job_id = ?", jobID).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job sms",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT id, job_id, recipients, type, message, created_at, updated_at
+		FROM job_sms
+		WHERE job_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, jobID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job sms",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var items []*domainJobSMS.JobSMS
+	for rows.Next() {
+		s := &domainJobSMS.JobSMS{}
+		var recipients []byte
+		if err := rows.Scan(&s.ID, &s.JobID, &recipients, &s.Type, &s.Message, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job sms row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		s.Recipients = decodeRecipients(recipients)
+		items = append(items, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
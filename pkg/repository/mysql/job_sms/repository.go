@@ -0,0 +1,49 @@
+package job_sms
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	domainJobSMS "github.com/your-org/jvairv2/pkg/domain/job_sms"
+)
+
+// Repository implementa el repositorio MySQL para el historial de SMS de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de SMS de jobs
+func NewRepository(db *sql.DB) domainJobSMS.Repository {
+	return &Repository{db: db}
+}
+
+// encodeRecipients serializa los destinatarios como arreglo JSON
+func encodeRecipients(recipients []string) ([]byte, error) {
+	if recipients == nil {
+		recipients = []string{}
+	}
+	return json.Marshal(recipients)
+}
+
+// decodeRecipients interpreta recipients; acepta arreglos JSON y, por compatibilidad
+// con datos antiguos, listas separadas por comas
+func decodeRecipients(raw []byte) []string {
+	value := strings.TrimSpace(string(raw))
+	recipients := []string{}
+	if value == "" {
+		return recipients
+	}
+
+	if err := json.Unmarshal([]byte(value), &recipients); err == nil {
+		return recipients
+	}
+
+	recipients = []string{}
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			recipients = append(recipients, part)
+		}
+	}
+	return recipients
+}
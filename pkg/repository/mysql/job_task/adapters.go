@@ -0,0 +1,62 @@
+package job_task
+
+import (
+	"context"
+	"database/sql"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// JobCheckerAdapter adapta la verificación de jobs para el use case de tareas
+type JobCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobCheckerAdapter(db *sql.DB) domainTask.JobChecker {
+	return &JobCheckerAdapter{db: db}
+}
+
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainTask.ErrInvalidJob
+	}
+	return true, nil
+}
+
+// UserCheckerAdapter adapta la verificación de usuarios para el use case de tareas
+type UserCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewUserCheckerAdapter(db *sql.DB) domainTask.UserChecker {
+	return &UserCheckerAdapter{db: db}
+}
+
+func (a *UserCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE id = ? AND is_active = 1 AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainTask.ErrInvalidUser
+	}
+	return true, nil
+}
+
+// TaskStatusCheckerAdapter adapta la verificación de estados de tarea para el use case de tareas
+type TaskStatusCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewTaskStatusCheckerAdapter(db *sql.DB) domainTask.TaskStatusChecker {
+	return &TaskStatusCheckerAdapter{db: db}
+}
+
+func (a *TaskStatusCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM task_statuses WHERE id = ? AND is_active = 1)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainTask.ErrInvalidTaskStatus
+	}
+	return true, nil
+}
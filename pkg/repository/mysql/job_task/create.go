@@ -0,0 +1,33 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// Create crea una nueva tarea
+func (r *Repository) Create(ctx context.Context, t *domainTask.JobTask) error {
+	query := `
+		INSERT INTO job_tasks (job_id, user_id, due_date, task, task_status_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, t.JobID, t.UserID, t.DueDate, t.Task, t.TaskStatusID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert job task query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	t.ID = id
+	return nil
+}
@@ -0,0 +1,21 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Delete elimina una tarea (soft delete)
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	query := `UPDATE job_tasks SET deleted_at = NOW() WHERE id = ? AND deleted_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete job task",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package job_task
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// selectColumns son las columnas comunes de las consultas de tareas (alias jt)
+const selectColumns = `
+	jt.id, jt.job_id, jt.user_id, u.name, jt.task, jt.task_status_id, ts.label, ts.class,
+	jt.due_date, j.work_order,
+	(SELECT MAX(jtn.created_at) FROM job_task_notifications jtn WHERE jtn.job_task_id = jt.id),
+	jt.created_at, jt.updated_at, jt.deleted_at
+`
+
+// selectJoins son los joins comunes de las consultas de tareas
+const selectJoins = `
+	FROM job_tasks jt
+	LEFT JOIN users u ON u.id = jt.user_id
+	LEFT JOIN task_statuses ts ON ts.id = jt.task_status_id
+	LEFT JOIN jobs j ON j.id = jt.job_id
+`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTask(s scanner) (*domainTask.JobTask, error) {
+	t := &domainTask.JobTask{}
+	err := s.Scan(
+		&t.ID, &t.JobID, &t.UserID, &t.UserName, &t.Task, &t.TaskStatusID, &t.TaskStatusLabel, &t.TaskStatusClass,
+		&t.DueDate, &t.JobWorkOrder,
+		&t.LastNotifiedAt,
+		&t.CreatedAt, &t.UpdatedAt, &t.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// GetByID obtiene una tarea por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainTask.JobTask, error) {
+	query := `SELECT ` + selectColumns + selectJoins + ` WHERE jt.id = ? AND jt.deleted_at IS NULL`
+
+	t, err := scanTask(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainTask.ErrTaskNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get job task",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return t, nil
+}
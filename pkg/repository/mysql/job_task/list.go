@@ -0,0 +1,112 @@
+package job_task
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// List obtiene las tareas con filtros y paginación
+func (r *Repository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*domainTask.JobTask, int, error) {
+	conditions := []string{"jt.deleted_at IS NULL"}
+	var args []interface{}
+
+	if jobID, ok := filters["job_id"].(int64); ok && jobID > 0 {
+		conditions = append(conditions, "jt.job_id = ?")
+		args = append(args, jobID)
+	}
+
+	// Scope de jobs visibles: solo tareas de jobs asignados al usuario
+	if ownerID, ok := filters["job_user_id"].(int64); ok {
+		conditions = append(conditions, "jt.job_id IN (SELECT id FROM jobs WHERE user_id = ?)")
+		args = append(args, ownerID)
+	}
+
+	if userID, ok := filters["user_id"].(int64); ok && userID > 0 {
+		conditions = append(conditions, "jt.user_id = ?")
+		args = append(args, userID)
+	}
+
+	if statusID, ok := filters["task_status_id"].(int64); ok && statusID > 0 {
+		conditions = append(conditions, "jt.task_status_id = ?")
+		args = append(args, statusID)
+	}
+
+	if overdue, ok := filters["overdue"].(bool); ok {
+		if overdue {
+			conditions = append(conditions, "jt.due_date IS NOT NULL AND jt.due_date < NOW()")
+		} else {
+			conditions = append(conditions, "(jt.due_date IS NULL OR jt.due_date >= NOW())")
+		}
+	}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		conditions = append(conditions, "(jt.task LIKE ? OR j.work_order LIKE ?)")
+		like := "%" + search + "%"
+		args = append(args, like, like)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	countQuery := `SELECT COUNT(*) ` + selectJoins + ` WHERE ` + whereClause
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job tasks",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	sortColumn := "jt.created_at"
+	if sort, ok := filters["sort"].(string); ok {
+		switch sort {
+		case "due_date":
+			sortColumn = "jt.due_date"
+		case "task":
+			sortColumn = "jt.task"
+		case "created_at":
+			sortColumn = "jt.created_at"
+		}
+	}
+
+	direction := "DESC"
+	if dir, ok := filters["direction"].(string); ok && strings.ToUpper(dir) == "ASC" {
+		direction = "ASC"
+	}
+
+	offset := (page - 1) * pageSize
+	dataQuery := fmt.Sprintf(`SELECT %s %s WHERE %s ORDER BY %s %s, jt.id %s LIMIT ? OFFSET ?`,
+		selectColumns, selectJoins, whereClause, sortColumn, direction, direction)
+
+	queryArgs := append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, queryArgs...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job tasks",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var tasks []*domainTask.JobTask
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job task row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		tasks = append(tasks, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating job task rows",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
@@ -0,0 +1,75 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// CreateNotification registra una notificación enviada para una tarea
+func (r *Repository) CreateNotification(ctx context.Context, n *domainTask.TaskNotification) error {
+	query := `
+		INSERT INTO job_task_notifications (job_task_id, user_id, sent_by, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	result, err := r.db.ExecContext(ctx, query, n.JobTaskID, n.UserID, n.SentByID, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to insert job task notification",
+			slog.Int64("taskId", n.JobTaskID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	n.ID = id
+	n.CreatedAt = &now
+	return nil
+}
+
+// ListNotifications obtiene las notificaciones de una tarea, de la más reciente a la más antigua
+func (r *Repository) ListNotifications(ctx context.Context, taskID int64) ([]*domainTask.TaskNotification, error) {
+	query := `
+		SELECT jtn.id, jtn.job_task_id, jtn.user_id, u.name, jtn.sent_by, s.name, jtn.created_at
+		FROM job_task_notifications jtn
+		LEFT JOIN users u ON u.id = jtn.user_id
+		LEFT JOIN users s ON s.id = jtn.sent_by
+		WHERE jtn.job_task_id = ?
+		ORDER BY jtn.created_at DESC, jtn.id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, taskID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job task notifications",
+			slog.Int64("taskId", taskID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	notifications := []*domainTask.TaskNotification{}
+	for rows.Next() {
+		n := &domainTask.TaskNotification{}
+		if err := rows.Scan(&n.ID, &n.JobTaskID, &n.UserID, &n.UserName, &n.SentByID, &n.SentByName, &n.CreatedAt); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job task notification row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,17 @@
+package job_task
+
+import (
+	"database/sql"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// Repository implementa el repositorio MySQL para tareas de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de tareas de jobs
+func NewRepository(db *sql.DB) domainTask.Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,27 @@
+package job_task
+
+import (
+	"context"
+	"log/slog"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// Update actualiza una tarea existente
+func (r *Repository) Update(ctx context.Context, t *domainTask.JobTask) error {
+	query := `
+		UPDATE job_tasks SET
+			user_id = ?, due_date = ?, task = ?, task_status_id = ?, updated_at = NOW()
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query, t.UserID, t.DueDate, t.Task, t.TaskStatusID, t.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update job task",
+			slog.Int64("id", t.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package job_visit
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// JobCheckerAdapter adapta la verificación de jobs para el use case de visitas
+type JobCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobCheckerAdapter(db *sql.DB) domainVisit.JobChecker {
+	return &JobCheckerAdapter{db: db}
+}
+
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainVisit.ErrInvalidJob
+	}
+	return true, nil
+}
+
+// UserCheckerAdapter adapta la verificación de usuarios para el use case de visitas
+type UserCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewUserCheckerAdapter(db *sql.DB) domainVisit.UserChecker {
+	return &UserCheckerAdapter{db: db}
+}
+
+func (a *UserCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE id = ? AND is_active = 1 AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil || !exists {
+		return nil, domainVisit.ErrInvalidUser
+	}
+	return true, nil
+}
+
+// RoleProviderAdapter obtiene los roles asignados a un usuario para evaluar viewable_by
+type RoleProviderAdapter struct {
+	db *sql.DB
+}
+
+func NewRoleProviderAdapter(db *sql.DB) domainVisit.RoleProvider {
+	return &RoleProviderAdapter{db: db}
+}
+
+func (a *RoleProviderAdapter) GetUserRoleKeys(ctx context.Context, userID int64) ([]string, error) {
+	query := `
+		SELECT r.id, r.name
+		FROM roles r
+		INNER JOIN assigned_roles ar ON r.id = ar.role_id
+		WHERE ar.entity_id = ? AND ar.entity_type = 'App\\Models\\User'
+	`
+
+	rows, err := a.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var keys []string
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		keys = append(keys, strconv.FormatInt(id, 10), name)
+	}
+
+	return keys, rows.Err()
+}
@@ -0,0 +1,38 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// Create crea una nueva visita
+func (r *Repository) Create(ctx context.Context, v *domainVisit.JobVisit) error {
+	viewableBy, err := encodeViewableBy(v.ViewableBy)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO job_visits (job_id, user_id, viewable_by, date, report, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, v.JobID, v.UserID, viewableBy, v.Date, v.Report)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert job visit query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	v.ID = id
+	return nil
+}
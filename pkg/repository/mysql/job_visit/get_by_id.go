@@ -0,0 +1,57 @@
+package job_visit
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// selectColumns son las columnas comunes de las consultas de visitas (alias jv)
+const selectColumns = `
+	jv.id, jv.job_id, jv.user_id, u.name, jv.viewable_by, jv.date, jv.report,
+	jv.created_at, jv.updated_at, jv.deleted_at
+`
+
+// selectJoins son los joins comunes de las consultas de visitas
+const selectJoins = `
+	FROM job_visits jv
+	LEFT JOIN users u ON u.id = jv.user_id
+`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanVisit(s scanner) (*domainVisit.JobVisit, error) {
+	v := &domainVisit.JobVisit{}
+	var viewableBy sql.NullString
+	err := s.Scan(
+		&v.ID, &v.JobID, &v.UserID, &v.UserName, &viewableBy, &v.Date, &v.Report,
+		&v.CreatedAt, &v.UpdatedAt, &v.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	v.ViewableBy = decodeViewableBy(viewableBy)
+	return v, nil
+}
+
+// GetByID obtiene una visita por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainVisit.JobVisit, error) {
+	query := `SELECT ` + selectColumns + selectJoins + ` WHERE jv.id = ? AND jv.deleted_at IS NULL`
+
+	v, err := scanVisit(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainVisit.ErrVisitNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get job visit",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return v, nil
+}
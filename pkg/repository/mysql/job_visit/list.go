@@ -0,0 +1,123 @@
+package job_visit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// viewerCondition construye la condición SQL que replica JobVisit.IsViewableBy,
+// de modo que la paginación solo cuente las visitas visibles
+func viewerCondition(viewer *domainVisit.Viewer) (string, []interface{}) {
+	if viewer == nil || viewer.ViewAll {
+		return "", nil
+	}
+
+	parts := []string{
+		"jv.viewable_by IS NULL",
+		"TRIM(jv.viewable_by) IN ('', '[]')",
+		"jv.user_id = ?",
+	}
+	args := []interface{}{viewer.UserID}
+
+	for _, role := range viewer.Roles {
+		// Valores JSON: los IDs pueden estar guardados como número o como cadena
+		if _, err := strconv.ParseInt(role, 10, 64); err == nil {
+			parts = append(parts, "(JSON_VALID(jv.viewable_by) AND JSON_CONTAINS(jv.viewable_by, ?))")
+			args = append(args, role)
+		}
+		parts = append(parts, "(JSON_VALID(jv.viewable_by) AND JSON_CONTAINS(jv.viewable_by, JSON_QUOTE(?)))")
+		args = append(args, role)
+
+		// Valores antiguos separados por comas
+		parts = append(parts, "(NOT JSON_VALID(jv.viewable_by) AND FIND_IN_SET(?, REPLACE(jv.viewable_by, ' ', '')) > 0)")
+		args = append(args, role)
+	}
+
+	return "(" + strings.Join(parts, " OR ") + ")", args
+}
+
+// ListByJobID obtiene las visitas de un job visibles para el viewer, con paginación
+func (r *Repository) ListByJobID(ctx context.Context, jobID int64, viewer *domainVisit.Viewer, filters map[string]interface{}, page, pageSize int) ([]*domainVisit.JobVisit, int, error) {
+	conditions := []string{"jv.deleted_at IS NULL", "jv.job_id = ?"}
+	args := []interface{}{jobID}
+
+	if condition, conditionArgs := viewerCondition(viewer); condition != "" {
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+	}
+
+	if userID, ok := filters["user_id"].(int64); ok && userID > 0 {
+		conditions = append(conditions, "jv.user_id = ?")
+		args = append(args, userID)
+	}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		conditions = append(conditions, "(jv.report LIKE ? OR u.name LIKE ?)")
+		like := "%" + search + "%"
+		args = append(args, like, like)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	countQuery := `SELECT COUNT(*) ` + selectJoins + ` WHERE ` + whereClause
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count job visits",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	sortColumn := "jv.date"
+	if sort, ok := filters["sort"].(string); ok {
+		switch sort {
+		case "date":
+			sortColumn = "jv.date"
+		case "created_at":
+			sortColumn = "jv.created_at"
+		}
+	}
+
+	direction := "DESC"
+	if dir, ok := filters["direction"].(string); ok && strings.ToUpper(dir) == "ASC" {
+		direction = "ASC"
+	}
+
+	offset := (page - 1) * pageSize
+	dataQuery := fmt.Sprintf(`SELECT %s %s WHERE %s ORDER BY %s %s, jv.id %s LIMIT ? OFFSET ?`,
+		selectColumns, selectJoins, whereClause, sortColumn, direction, direction)
+
+	queryArgs := append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, queryArgs...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list job visits",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var visits []*domainVisit.JobVisit
+	for rows.Next() {
+		v, err := scanVisit(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job visit row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		visits = append(visits, v)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating job visit rows",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return visits, total, nil
+}
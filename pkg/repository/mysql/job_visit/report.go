@@ -0,0 +1,97 @@
+package job_visit
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainJobEquip "github.com/your-org/jvairv2/pkg/domain/job_equipment"
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// GetReportData obtiene los datos del job, la propiedad y los equipos para el reporte de una visita
+func (r *Repository) GetReportData(ctx context.Context, jobID int64) (*domainVisit.ReportData, error) {
+	query := `
+		SELECT j.work_order, j.date_received, jc.label, js.label, c.name,
+			p.property_code, p.street, p.city, p.state, p.zip
+		FROM jobs j
+		LEFT JOIN job_categories jc ON jc.id = j.job_category_id
+		LEFT JOIN job_statuses js ON js.id = j.job_status_id
+		LEFT JOIN properties p ON p.id = j.property_id
+		LEFT JOIN customers c ON c.id = p.customer_id
+		WHERE j.id = ? AND j.deleted_at IS NULL
+	`
+
+	data := &domainVisit.ReportData{}
+	var street, city, state, zip sql.NullString
+	err := r.db.QueryRowContext(ctx, query, jobID).Scan(
+		&data.WorkOrder, &data.DateReceived, &data.JobCategory, &data.JobStatus, &data.CustomerName,
+		&data.PropertyCode, &street, &city, &state, &zip,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainVisit.ErrInvalidJob
+		}
+		slog.ErrorContext(ctx, "Failed to get job visit report data",
+			slog.Int64("jobId", jobID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	data.Street, data.City, data.State, data.Zip = street.String, city.String, state.String, zip.String
+
+	equipment, err := r.listJobEquipment(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	data.Equipment = equipment
+
+	return data, nil
+}
+
+// listJobEquipment obtiene los equipos del job (actuales primero)
+func (r *Repository) listJobEquipment(ctx context.Context, jobID int64) ([]*domainJobEquip.JobEquipment, error) {
+	query := `
+		SELECT
+			id, job_id, type, area,
+			outdoor_brand, outdoor_model, outdoor_serial, outdoor_installed,
+			furnace_brand, furnace_model, furnace_serial, furnace_installed,
+			evaporator_brand, evaporator_model, evaporator_serial, evaporator_installed,
+			air_handler_brand, air_handler_model, air_handler_serial, air_handler_installed,
+			created_at, updated_at
+		FROM job_equipment
+		WHERE job_id = ?
+		ORDER BY type, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, jobID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to query job equipment for visit report",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var equipment []*domainJobEquip.JobEquipment
+	for rows.Next() {
+		e := &domainJobEquip.JobEquipment{}
+		if err := rows.Scan(
+			&e.ID, &e.JobID, &e.Type, &e.Area,
+			&e.OutdoorBrand, &e.OutdoorModel, &e.OutdoorSerial, &e.OutdoorInstalled,
+			&e.FurnaceBrand, &e.FurnaceModel, &e.FurnaceSerial, &e.FurnaceInstalled,
+			&e.EvaporatorBrand, &e.EvaporatorModel, &e.EvaporatorSerial, &e.EvaporatorInstalled,
+			&e.AirHandlerBrand, &e.AirHandlerModel, &e.AirHandlerSerial, &e.AirHandlerInstalled,
+			&e.CreatedAt, &e.UpdatedAt,
+		); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan job equipment row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		equipment = append(equipment, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return equipment, nil
+}
@@ -0,0 +1,65 @@
+package job_visit
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// Repository implementa el repositorio MySQL para visitas de jobs
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de visitas de jobs
+func NewRepository(db *sql.DB) domainVisit.Repository {
+	return &Repository{db: db}
+}
+
+// encodeViewableBy serializa viewable_by como arreglo JSON (NULL si no hay restricción)
+func encodeViewableBy(values []string) (*string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	s := string(b)
+	return &s, nil
+}
+
+// decodeViewableBy interpreta viewable_by; acepta arreglos JSON de números o cadenas
+// y, por compatibilidad con datos antiguos, listas separadas por comas
+func decodeViewableBy(raw sql.NullString) []string {
+	value := strings.TrimSpace(raw.String)
+	if !raw.Valid || value == "" {
+		return nil
+	}
+
+	var items []interface{}
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&items); err == nil {
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			switch v := item.(type) {
+			case string:
+				result = append(result, v)
+			case json.Number:
+				result = append(result, v.String())
+			}
+		}
+		return result
+	}
+
+	var result []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package job_visit
+
+import (
+	"context"
+	"log/slog"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+)
+
+// Update actualiza una visita existente
+func (r *Repository) Update(ctx context.Context, v *domainVisit.JobVisit) error {
+	viewableBy, err := encodeViewableBy(v.ViewableBy)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE job_visits SET
+			user_id = ?, viewable_by = ?, date = ?, report = ?, updated_at = NOW()
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	_, err = r.db.ExecContext(ctx, query, v.UserID, viewableBy, v.Date, v.Report, v.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update job visit",
+			slog.Int64("id", v.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// Claim toma mensajes listos para enviarse y los bloquea hasta lockUntil.
+// SKIP LOCKED permite que varias instancias de la API procesen la cola sin tomar
+// el mismo mensaje dos veces.
+func (r *Repository) Claim(ctx context.Context, now, lockUntil time.Time, limit int) ([]*domainOutbox.Message, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	selectQuery := `
+		SELECT id FROM outbox_messages
+		WHERE (status = ? AND next_attempt_at <= ?)
+			OR (status = ? AND locked_until < ?)
+		ORDER BY next_attempt_at, id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.QueryContext(ctx, selectQuery,
+		domainOutbox.StatusPending, now, domainOutbox.StatusProcessing, now, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to select outbox messages to claim",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var ids []interface{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+
+	updateQuery := fmt.Sprintf(`
+		UPDATE outbox_messages
+		SET status = ?, attempts = attempts + 1, locked_until = ?, updated_at = NOW()
+		WHERE id IN (%s)
+	`, placeholders)
+	updateArgs := append([]interface{}{domainOutbox.StatusProcessing, lockUntil}, ids...)
+	if _, err := tx.ExecContext(ctx, updateQuery, updateArgs...); err != nil {
+		slog.ErrorContext(ctx, "Failed to claim outbox messages",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	dataQuery := fmt.Sprintf("SELECT %s FROM outbox_messages WHERE id IN (%s) ORDER BY next_attempt_at, id", selectColumns, placeholders)
+	dataRows, err := tx.QueryContext(ctx, dataQuery, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*domainOutbox.Message
+	for dataRows.Next() {
+		m, err := scanMessage(dataRows)
+		if err != nil {
+			_ = dataRows.Close()
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	_ = dataRows.Close()
+	if err := dataRows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// Create encola un mensaje
+func (r *Repository) Create(ctx context.Context, m *domainOutbox.Message) error {
+	query := `
+		INSERT INTO outbox_messages (channel, kind, reference_id, payload, status, attempts, max_attempts, next_attempt_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, 0, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		m.Channel, m.Kind, m.ReferenceID, string(m.Payload), m.Status, m.MaxAttempts, m.NextAttemptAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create outbox message",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	m.ID = id
+	return nil
+}
@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// GetByID obtiene un mensaje por su ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainOutbox.Message, error) {
+	query := "SELECT " + selectColumns + " FROM outbox_messages WHERE id = ?"
+
+	m, err := scanMessage(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainOutbox.ErrMessageNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get outbox message",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return m, nil
+}
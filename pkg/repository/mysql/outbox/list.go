@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// List obtiene una lista paginada de mensajes con filtros opcionales
+func (r *Repository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*domainOutbox.Message, int, error) {
+	conditions := []string{"1 = 1"}
+	var args []interface{}
+
+	if status, ok := filters["status"].(string); ok && status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, status)
+	}
+
+	if channel, ok := filters["channel"].(string); ok && channel != "" {
+		conditions = append(conditions, "channel = ?")
+		args = append(args, channel)
+	}
+
+	if kind, ok := filters["kind"].(string); ok && kind != "" {
+		conditions = append(conditions, "kind = ?")
+		args = append(args, kind)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM outbox_messages WHERE %s", whereClause)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count outbox messages",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	dataQuery := fmt.Sprintf(`
+		SELECT %s
+		FROM outbox_messages
+		WHERE %s
+		ORDER BY id DESC
+		LIMIT ? OFFSET ?
+	`, selectColumns, whereClause)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, append(args, pageSize, (page-1)*pageSize)...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list outbox messages",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var messages []*domainOutbox.Message
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan outbox message row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		messages = append(messages, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return messages, total, nil
+}
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// MarkSent marca el mensaje como entregado
+func (r *Repository) MarkSent(ctx context.Context, id int64, providerID string, sentAt time.Time) error {
+	var provider *string
+	if providerID != "" {
+		provider = &providerID
+	}
+
+	query := `
+		UPDATE outbox_messages
+		SET status = ?, provider_id = ?, sent_at = ?, locked_until = NULL, last_error = NULL, updated_at = NOW()
+		WHERE id = ?
+	`
+	return r.exec(ctx, "Failed to mark outbox message as sent", id, query,
+		domainOutbox.StatusSent, provider, sentAt, id)
+}
+
+// MarkRetry devuelve el mensaje a pending para reintentarse en nextAttemptAt
+func (r *Repository) MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE outbox_messages
+		SET status = ?, next_attempt_at = ?, last_error = ?, locked_until = NULL, updated_at = NOW()
+		WHERE id = ?
+	`
+	return r.exec(ctx, "Failed to reschedule outbox message", id, query,
+		domainOutbox.StatusPending, nextAttemptAt, lastError, id)
+}
+
+// MarkDead marca el mensaje como dead
+func (r *Repository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	query := `
+		UPDATE outbox_messages
+		SET status = ?, last_error = ?, locked_until = NULL, updated_at = NOW()
+		WHERE id = ?
+	`
+	return r.exec(ctx, "Failed to mark outbox message as dead", id, query,
+		domainOutbox.StatusDead, lastError, id)
+}
+
+// Requeue devuelve un mensaje dead a pending con sus intentos reiniciados
+func (r *Repository) Requeue(ctx context.Context, id int64, nextAttemptAt time.Time) error {
+	query := `
+		UPDATE outbox_messages
+		SET status = ?, attempts = 0, next_attempt_at = ?, updated_at = NOW()
+		WHERE id = ? AND status = ?
+	`
+	return r.exec(ctx, "Failed to requeue outbox message", id, query,
+		domainOutbox.StatusPending, nextAttemptAt, id, domainOutbox.StatusDead)
+}
+
+func (r *Repository) exec(ctx context.Context, logMsg string, id int64, query string, args ...interface{}) error {
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		slog.ErrorContext(ctx, logMsg,
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"database/sql"
+
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+// selectColumns son las columnas de las consultas de mensajes
+const selectColumns = `
+	id, channel, kind, reference_id, payload, status, attempts, max_attempts,
+	next_attempt_at, locked_until, last_error, provider_id, sent_at, created_at, updated_at
+`
+
+// Repository implementa el repositorio MySQL para la cola de mensajes salientes
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de la cola
+func NewRepository(db *sql.DB) domainOutbox.Repository {
+	return &Repository{db: db}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMessage(s scanner) (*domainOutbox.Message, error) {
+	m := &domainOutbox.Message{}
+	var payload []byte
+	err := s.Scan(
+		&m.ID, &m.Channel, &m.Kind, &m.ReferenceID, &payload, &m.Status, &m.Attempts, &m.MaxAttempts,
+		&m.NextAttemptAt, &m.LockedUntil, &m.LastError, &m.ProviderID, &m.SentAt, &m.CreatedAt, &m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	m.Payload = payload
+	return m, nil
+}
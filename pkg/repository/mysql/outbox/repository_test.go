@@ -0,0 +1,99 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	domainOutbox "github.com/your-org/jvairv2/pkg/domain/outbox"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+var messageColumns = []string{
+	"id", "channel", "kind", "reference_id", "payload", "status", "attempts", "max_attempts",
+	"next_attempt_at", "locked_until", "last_error", "provider_id", "sent_at", "created_at", "updated_at",
+}
+
+func TestClaim_Success(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	lockUntil := now.Add(30 * time.Second)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM outbox_messages .* FOR UPDATE SKIP LOCKED").
+		WithArgs(domainOutbox.StatusPending, now, domainOutbox.StatusProcessing, now, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(7))
+	mock.ExpectExec("UPDATE outbox_messages\\s+SET status = \\?, attempts = attempts \\+ 1").
+		WithArgs(domainOutbox.StatusProcessing, lockUntil, int64(4), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery("SELECT .* FROM outbox_messages WHERE id IN \\(\\?,\\?\\)").
+		WithArgs(int64(4), int64(7)).
+		WillReturnRows(sqlmock.NewRows(messageColumns).
+			AddRow(4, "email", "job_dispatch", nil, `{"To":["a@example.com"]}`, "processing", 1, 5, now, lockUntil, nil, nil, nil, now, now).
+			AddRow(7, "sms", "job_dispatch", 9, `{"To":"+1555"}`, "processing", 3, 5, now, lockUntil, "timeout", nil, nil, now, now))
+	mock.ExpectCommit()
+
+	messages, err := repo.Claim(ctx, now, lockUntil, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, int64(4), messages[0].ID)
+	assert.Equal(t, "processing", messages[0].Status)
+	assert.JSONEq(t, `{"To":["a@example.com"]}`, string(messages[0].Payload))
+	assert.Equal(t, 3, messages[1].Attempts)
+	assert.Equal(t, int64(9), *messages[1].ReferenceID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaim_Empty(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM outbox_messages").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	messages, err := repo.Claim(context.Background(), now, now.Add(time.Minute), 4)
+
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkRetry(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	next := time.Date(2026, 3, 2, 10, 4, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE outbox_messages").
+		WithArgs(domainOutbox.StatusPending, next, "timeout", int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.MarkRetry(context.Background(), 4, next, "timeout")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
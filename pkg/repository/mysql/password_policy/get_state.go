@@ -0,0 +1,34 @@
+package password_policy
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainPasswordPolicy "github.com/your-org/jvairv2/pkg/domain/password_policy"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+// GetState obtiene si el usuario debe cambiar la contraseña y la fecha del último cambio.
+// Si no hay historial se toma la fecha de alta del usuario.
+func (r *Repository) GetState(ctx context.Context, userID int64) (*domainPasswordPolicy.State, error) {
+	query := `
+		SELECT u.is_change_password,
+			COALESCE((SELECT MAX(h.created_at) FROM password_history h WHERE h.user_id = u.id), u.created_at)
+		FROM users u
+		WHERE u.id = ? AND u.deleted_at IS NULL
+	`
+
+	state := &domainPasswordPolicy.State{}
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&state.MustChange, &state.ChangedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, user.ErrUserNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get password state",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return state, nil
+}
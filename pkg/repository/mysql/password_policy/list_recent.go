@@ -0,0 +1,38 @@
+package password_policy
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ListRecent obtiene los hashes de las últimas contraseñas del usuario
+func (r *Repository) ListRecent(ctx context.Context, userID int64, limit int) ([]string, error) {
+	query := `
+		SELECT password
+		FROM password_history
+		WHERE user_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list password history",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	hashes := make([]string, 0, limit)
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, rows.Err()
+}
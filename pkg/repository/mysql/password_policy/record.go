@@ -0,0 +1,35 @@
+package password_policy
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Record guarda la contraseña en el historial
+func (r *Repository) Record(ctx context.Context, userID int64, hashedPassword string) error {
+	now := r.now()
+
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO password_history (user_id, password, created_at, updated_at) VALUES (?, ?, ?, ?)",
+		userID, hashedPassword, now, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to insert password history",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// ClearMustChange marca que el usuario ya no debe cambiar la contraseña
+func (r *Repository) ClearMustChange(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE users SET is_change_password = 0 WHERE id = ?", userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to clear is_change_password",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package password_policy
+
+import (
+	"database/sql"
+	"time"
+
+	domainPasswordPolicy "github.com/your-org/jvairv2/pkg/domain/password_policy"
+)
+
+// Repository implementa el repositorio MySQL para password_history
+type Repository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// NewRepository crea una nueva instancia del repositorio de password_history
+func NewRepository(db *sql.DB) domainPasswordPolicy.Repository {
+	return &Repository{db: db, now: time.Now}
+}
@@ -0,0 +1,119 @@
+package password_policy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db, now: time.Now}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+func TestListRecent(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT password FROM password_history WHERE user_id = \\? ORDER BY created_at DESC, id DESC LIMIT \\?").
+		WithArgs(int64(7), 3).
+		WillReturnRows(sqlmock.NewRows([]string{"password"}).AddRow("$2a$10$new").AddRow("$2a$10$old"))
+
+	hashes, err := repo.ListRecent(context.Background(), 7, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"$2a$10$new", "$2a$10$old"}, hashes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecord(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	now := time.Now()
+	repo.now = func() time.Time { return now }
+
+	mock.ExpectExec("INSERT INTO password_history \\(user_id, password, created_at, updated_at\\) VALUES \\(\\?, \\?, \\?, \\?\\)").
+		WithArgs(int64(7), "$2a$10$hash", now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := repo.Record(context.Background(), 7, "$2a$10$hash")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecord_Error(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectExec("INSERT INTO password_history").
+		WillReturnError(errors.New("db down"))
+
+	err := repo.Record(context.Background(), 7, "$2a$10$hash")
+
+	assert.EqualError(t, err, "db down")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClearMustChange(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectExec("UPDATE users SET is_change_password = 0 WHERE id = \\?").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.ClearMustChange(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetState(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	changed := time.Now()
+
+	mock.ExpectQuery("SELECT u.is_change_password, COALESCE\\(.+password_history.+\\) FROM users u WHERE u.id = \\? AND u.deleted_at IS NULL").
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"is_change_password", "changed_at"}).AddRow(true, changed))
+
+	state, err := repo.GetState(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.True(t, state.MustChange)
+	assert.Equal(t, changed, *state.ChangedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetState_NotFound(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT u.is_change_password").
+		WithArgs(int64(7)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetState(context.Background(), 7)
+
+	assert.ErrorIs(t, err, user.ErrUserNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,36 @@
+package password_reset
+
+import (
+	"context"
+	"log/slog"
+
+	domainPasswordReset "github.com/your-org/jvairv2/pkg/domain/password_reset"
+)
+
+// Create reemplaza las solicitudes previas del email por la nueva, como Laravel
+func (r *Repository) Create(ctx context.Context, reset *domainPasswordReset.PasswordReset) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM password_resets WHERE email = ?", reset.Email); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete previous password resets",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO password_resets (email, token, created_at) VALUES (?, ?, ?)",
+		reset.Email, reset.Token, reset.CreatedAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create password reset",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return tx.Commit()
+}
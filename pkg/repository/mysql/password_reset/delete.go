@@ -0,0 +1,16 @@
+package password_reset
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DeleteByEmail elimina las solicitudes del email
+func (r *Repository) DeleteByEmail(ctx context.Context, email string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM password_resets WHERE email = ?", email); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete password resets",
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
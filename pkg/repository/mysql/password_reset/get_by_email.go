@@ -0,0 +1,37 @@
+package password_reset
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	domainPasswordReset "github.com/your-org/jvairv2/pkg/domain/password_reset"
+)
+
+// ErrNotFound indica que el email no tiene una solicitud pendiente
+var ErrNotFound = errors.New("password reset not found")
+
+// GetByEmail obtiene la solicitud más reciente del email
+func (r *Repository) GetByEmail(ctx context.Context, email string) (*domainPasswordReset.PasswordReset, error) {
+	query := `
+		SELECT email, token, created_at
+		FROM password_resets
+		WHERE email = ?
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	reset := &domainPasswordReset.PasswordReset{}
+	err := r.db.QueryRowContext(ctx, query, email).Scan(&reset.Email, &reset.Token, &reset.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get password reset",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return reset, nil
+}
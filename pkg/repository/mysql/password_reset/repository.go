@@ -0,0 +1,17 @@
+package password_reset
+
+import (
+	"database/sql"
+
+	domainPasswordReset "github.com/your-org/jvairv2/pkg/domain/password_reset"
+)
+
+// Repository implementa el repositorio MySQL para password_resets
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de password_resets
+func NewRepository(db *sql.DB) domainPasswordReset.Repository {
+	return &Repository{db: db}
+}
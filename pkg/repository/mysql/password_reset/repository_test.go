@@ -0,0 +1,77 @@
+package password_reset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	domainPasswordReset "github.com/your-org/jvairv2/pkg/domain/password_reset"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+func TestCreate_ReplacesPrevious(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM password_resets WHERE email = \\?").
+		WithArgs("tech@example.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO password_resets \\(email, token, created_at\\) VALUES \\(\\?, \\?, \\?\\)").
+		WithArgs("tech@example.com", "$2a$10$hash", &now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.Create(context.Background(), &domainPasswordReset.PasswordReset{
+		Email:     "tech@example.com",
+		Token:     "$2a$10$hash",
+		CreatedAt: &now,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByEmail(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT email, token, created_at FROM password_resets WHERE email = \\? ORDER BY created_at DESC LIMIT 1").
+		WithArgs("tech@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"email", "token", "created_at"}).
+			AddRow("tech@example.com", "$2a$10$hash", now))
+
+	reset, err := repo.GetByEmail(context.Background(), "tech@example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "$2a$10$hash", reset.Token)
+	assert.Equal(t, now, *reset.CreatedAt)
+
+	mock.ExpectQuery("SELECT email, token, created_at FROM password_resets").
+		WithArgs("nobody@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"email", "token", "created_at"}))
+
+	_, err = repo.GetByEmail(context.Background(), "nobody@example.com")
+	assert.Equal(t, ErrNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,108 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
+)
+
+// deliveryColumns son las columnas de las consultas de envíos de recibos
+const deliveryColumns = `
+	id, user_id, period_from, period_to, email, status, attempts, last_error,
+	requested_by, sent_at, created_at, updated_at
+`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDelivery(s scanner) (*domainPayroll.PaystubDelivery, error) {
+	d := &domainPayroll.PaystubDelivery{}
+	err := s.Scan(
+		&d.ID, &d.UserID, &d.PeriodFrom, &d.PeriodTo, &d.Email, &d.Status, &d.Attempts, &d.LastError,
+		&d.RequestedBy, &d.SentAt, &d.CreatedAt, &d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// CreateDelivery registra una solicitud de envío de recibo de pago
+func (r *Repository) CreateDelivery(ctx context.Context, d *domainPayroll.PaystubDelivery) error {
+	query := `
+		INSERT INTO paystub_deliveries (user_id, period_from, period_to, email, status, requested_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		d.UserID, d.PeriodFrom.Format("2006-01-02"), d.PeriodTo.Format("2006-01-02"), d.Email, d.Status, d.RequestedBy)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert paystub delivery query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	d.ID = id
+	return nil
+}
+
+// ListDeliveries obtiene las solicitudes de envío de recibos del técnico, más recientes primero
+func (r *Repository) ListDeliveries(ctx context.Context, userID int64) ([]*domainPayroll.PaystubDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM paystub_deliveries WHERE user_id = ? ORDER BY id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list paystub deliveries",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	deliveries := []*domainPayroll.PaystubDelivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan paystub delivery row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating paystub delivery rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery actualiza el estado, los intentos y el último error de un envío
+func (r *Repository) UpdateDelivery(ctx context.Context, id int64, status string, attempts int, lastError *string) error {
+	query := `
+		UPDATE paystub_deliveries
+		SET status = ?, attempts = ?, last_error = ?,
+			sent_at = IF(? = 'sent', NOW(), sent_at), updated_at = NOW()
+		WHERE id = ?
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, status, attempts, lastError, status, id); err != nil {
+		slog.ErrorContext(ctx, "Failed to update paystub delivery",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package payroll
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
+)
+
+// ListItems obtiene las tarifas de un técnico en el periodo, ordenadas por fecha
+func (r *Repository) ListItems(ctx context.Context, userID int64, period domainPayroll.Period) ([]*domainPayroll.PayItem, error) {
+	query := `
+		SELECT jr.id, jr.job_id, j.work_order, p.street, p.city, p.state, p.zip, ` + rateDate + `,
+			jr.sale_price, jr.tech_parts, jr.company_parts, jr.deduction, jr.payment,
+			jr.paid, jr.held, jr.paid_at
+		FROM job_rates jr
+		INNER JOIN jobs j ON j.id = jr.job_id AND j.deleted_at IS NULL
+		LEFT JOIN properties p ON p.id = j.property_id
+		WHERE jr.deleted_at IS NULL AND jr.user_id = ? AND ` + periodCondition + `
+		ORDER BY ` + rateDate + `, jr.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, period.From, period.End())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list pay items",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := []*domainPayroll.PayItem{}
+	for rows.Next() {
+		i := &domainPayroll.PayItem{}
+		var street, city, state, zip sql.NullString
+		if err := rows.Scan(
+			&i.RateID, &i.JobID, &i.WorkOrder, &street, &city, &state, &zip, &i.JobDate,
+			&i.SalePrice, &i.TechParts, &i.CompanyParts, &i.Deduction, &i.Payment,
+			&i.Paid, &i.Held, &i.PaidAt,
+		); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan pay item row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		i.Street, i.City, i.State, i.Zip = street.String, city.String, state.String, zip.String
+		items = append(items, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating pay item rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,36 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
+)
+
+// MarkPaid marca como pagadas, en una sola sentencia, las tarifas no pagadas ni retenidas
+// del técnico en el periodo (opcionalmente limitadas a rateIDs)
+func (r *Repository) MarkPaid(ctx context.Context, userID int64, period domainPayroll.Period, rateIDs []int64) (int64, error) {
+	query := `
+		UPDATE job_rates jr
+		INNER JOIN jobs j ON j.id = jr.job_id AND j.deleted_at IS NULL
+		SET jr.paid = 1, jr.paid_at = NOW(), jr.updated_at = NOW()
+		WHERE jr.deleted_at IS NULL AND jr.paid = 0 AND jr.held = 0
+			AND jr.user_id = ? AND ` + periodCondition
+	args := []interface{}{userID, period.From, period.End()}
+
+	if len(rateIDs) > 0 {
+		in, inArgs := inClause(rateIDs)
+		query += ` AND jr.id IN ` + in
+		args = append(args, inArgs...)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark job rates as paid",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
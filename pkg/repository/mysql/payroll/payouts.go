@@ -0,0 +1,47 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
+)
+
+// ListPayouts agrupa por día de pago las tarifas pagadas del técnico, más recientes primero
+func (r *Repository) ListPayouts(ctx context.Context, userID int64) ([]*domainPayroll.Payout, error) {
+	query := `
+		SELECT DATE(jr.paid_at), COUNT(*), COALESCE(SUM(jr.payment), 0)
+		FROM job_rates jr
+		WHERE jr.deleted_at IS NULL AND jr.user_id = ? AND jr.paid = 1 AND jr.paid_at IS NOT NULL
+		GROUP BY DATE(jr.paid_at)
+		ORDER BY DATE(jr.paid_at) DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list payouts",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	payouts := []*domainPayroll.Payout{}
+	for rows.Next() {
+		p := &domainPayroll.Payout{}
+		if err := rows.Scan(&p.PaidOn, &p.RateCount, &p.Payment); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan payout row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		payouts = append(payouts, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating payout rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return payouts, nil
+}
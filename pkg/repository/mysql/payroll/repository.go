@@ -0,0 +1,47 @@
+package payroll
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
+)
+
+// rateDate es la fecha con la que una tarifa entra en un periodo de pago:
+// la fecha de completado del job o, si no tiene, la de creación de la tarifa
+const rateDate = `COALESCE(j.completion_date, DATE(jr.created_at))`
+
+// periodCondition filtra las tarifas cuya fecha cae en el periodo [from, to+1)
+const periodCondition = rateDate + ` >= ? AND ` + rateDate + ` < ?`
+
+// Repository implementa el repositorio MySQL de nómina sobre job_rates
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de nómina
+func NewRepository(db *sql.DB) domainPayroll.Repository {
+	return &Repository{db: db}
+}
+
+// GetTechnician obtiene el nombre y el email de un técnico
+func (r *Repository) GetTechnician(ctx context.Context, userID int64) (*domainPayroll.Technician, error) {
+	t := &domainPayroll.Technician{ID: userID}
+	err := r.db.QueryRowContext(ctx, "SELECT name, email FROM users WHERE id = ? AND deleted_at IS NULL", userID).Scan(&t.Name, &t.Email)
+	if err != nil {
+		return nil, domainPayroll.ErrInvalidUser
+	}
+	return t, nil
+}
+
+// inClause construye la lista de placeholders y argumentos para un IN (...)
+func inClause(ids []int64) (string, []interface{}) {
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	return "(" + strings.Join(placeholders, ", ") + ")", args
+}
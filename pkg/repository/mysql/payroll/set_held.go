@@ -0,0 +1,25 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SetHeld retiene o libera tarifas no pagadas del técnico
+func (r *Repository) SetHeld(ctx context.Context, userID int64, rateIDs []int64, held bool) (int64, error) {
+	in, inArgs := inClause(rateIDs)
+	query := `
+		UPDATE job_rates SET held = ?, updated_at = NOW()
+		WHERE deleted_at IS NULL AND paid = 0 AND held <> ? AND user_id = ? AND id IN ` + in
+	args := append([]interface{}{held, held, userID}, inArgs...)
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update job rate hold",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
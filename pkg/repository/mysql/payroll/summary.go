@@ -0,0 +1,51 @@
+package payroll
+
+import (
+	"context"
+	"log/slog"
+
+	domainPayroll "github.com/your-org/jvairv2/pkg/domain/payroll"
+)
+
+// Summary agrupa por técnico las tarifas no pagadas del periodo
+func (r *Repository) Summary(ctx context.Context, period domainPayroll.Period) ([]*domainPayroll.UserSummary, error) {
+	query := `
+		SELECT jr.user_id, u.name,
+			SUM(jr.held = 0), SUM(jr.held = 1),
+			COALESCE(SUM(CASE WHEN jr.held = 0 THEN jr.payment END), 0),
+			COALESCE(SUM(CASE WHEN jr.held = 1 THEN jr.payment END), 0)
+		FROM job_rates jr
+		INNER JOIN jobs j ON j.id = jr.job_id AND j.deleted_at IS NULL
+		LEFT JOIN users u ON u.id = jr.user_id
+		WHERE jr.deleted_at IS NULL AND jr.paid = 0 AND ` + periodCondition + `
+		GROUP BY jr.user_id, u.name
+		ORDER BY u.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, period.From, period.End())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to query payroll summary",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	summaries := []*domainPayroll.UserSummary{}
+	for rows.Next() {
+		s := &domainPayroll.UserSummary{}
+		if err := rows.Scan(&s.UserID, &s.UserName, &s.RateCount, &s.HeldCount, &s.PayablePayment, &s.HeldPayment); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan payroll summary row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		summaries = append(summaries, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating payroll summary rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return summaries, nil
+}
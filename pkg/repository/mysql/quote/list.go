@@ -24,6 +24,11 @@ func (r *Repository) List(ctx context.Context, filters map[string]interface{}, p
 		args = append(args, jobID)
 	}
 
+	if ownerID, ok := filters["job_user_id"].(int64); ok {
+		where = append(where, "q.job_id IN (SELECT id FROM jobs WHERE user_id = ?)")
+		args = append(args, ownerID)
+	}
+
 	if quoteStatusID, ok := filters["quote_status_id"].(int64); ok {
 		where = append(where, "q.quote_status_id = ?")
 		args = append(args, quoteStatusID)
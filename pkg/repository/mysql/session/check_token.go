@@ -0,0 +1,48 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+)
+
+// CheckToken verifica que el token de acceso o de refresco pertenezca a una sesión vigente.
+// Con un token de acceso también registra la última actividad de la sesión.
+func (r *Repository) CheckToken(ctx context.Context, tokenID string) (bool, error) {
+	query := `
+		SELECT id, access_uuid = ?, last_seen_at
+		FROM auth_sessions
+		WHERE (access_uuid = ? AND access_expires_at > ?)
+			OR (refresh_uuid = ? AND refresh_expires_at > ?)
+		LIMIT 1
+	`
+
+	now := time.Now()
+	var (
+		id         string
+		isAccess   bool
+		lastSeenAt sql.NullTime
+	)
+	err := r.db.QueryRowContext(ctx, query, tokenID, tokenID, now, tokenID, now).
+		Scan(&id, &isAccess, &lastSeenAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		slog.ErrorContext(ctx, "Failed to check session token",
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	if isAccess && (!lastSeenAt.Valid || now.Sub(lastSeenAt.Time) >= lastSeenInterval) {
+		// La última actividad es informativa; un fallo no invalida el token
+		if _, err := r.db.ExecContext(ctx, "UPDATE auth_sessions SET last_seen_at = ? WHERE id = ?", now, id); err != nil {
+			slog.WarnContext(ctx, "Failed to update session last seen",
+				slog.String("sessionId", id),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	return true, nil
+}
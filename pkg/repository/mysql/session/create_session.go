@@ -0,0 +1,31 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/auth"
+)
+
+// CreateSession registra una sesión nueva
+func (r *Repository) CreateSession(ctx context.Context, s *auth.Session) error {
+	query := `
+		INSERT INTO auth_sessions (
+			id, user_id, access_uuid, refresh_uuid, access_expires_at, refresh_expires_at,
+			user_agent, ip_address, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		s.ID, s.UserID, s.AccessUUID, s.RefreshUUID, s.AccessExpiresAt, s.RefreshExpiresAt,
+		s.UserAgent, s.IPAddress, s.CreatedAt, s.CreatedAt,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create session",
+			slog.Int64("userId", s.UserID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
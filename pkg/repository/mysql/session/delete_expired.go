@@ -0,0 +1,21 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// DeleteExpired elimina las sesiones cuyo token de refresco expiró
+func (r *Repository) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM auth_sessions WHERE refresh_expires_at <= ?",
+		time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete expired sessions",
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
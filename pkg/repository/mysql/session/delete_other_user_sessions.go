@@ -0,0 +1,21 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DeleteOtherUserSessions cierra todas las sesiones del usuario excepto sessionID
+func (r *Repository) DeleteOtherUserSessions(ctx context.Context, userID int64, sessionID string) error {
+	_, err := r.db.ExecContext(ctx,
+		"DELETE FROM auth_sessions WHERE user_id = ? AND id <> ?",
+		userID, sessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete other user sessions",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DeleteToken cierra la sesión a la que pertenece el token
+func (r *Repository) DeleteToken(ctx context.Context, tokenID string) error {
+	_, err := r.db.ExecContext(ctx,
+		"DELETE FROM auth_sessions WHERE access_uuid = ? OR refresh_uuid = ?",
+		tokenID, tokenID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete session",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
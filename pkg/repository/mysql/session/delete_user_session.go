@@ -0,0 +1,27 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DeleteUserSession cierra una sesión del usuario; retorna false si no existe o es de otro usuario
+func (r *Repository) DeleteUserSession(ctx context.Context, userID int64, sessionID string) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM auth_sessions WHERE id = ? AND user_id = ?",
+		sessionID, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete user session",
+			slog.Int64("userId", userID),
+			slog.String("sessionId", sessionID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
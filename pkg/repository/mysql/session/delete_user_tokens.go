@@ -0,0 +1,19 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DeleteUserTokens cierra todas las sesiones de un usuario
+func (r *Repository) DeleteUserTokens(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM auth_sessions WHERE user_id = ?", userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete user sessions",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
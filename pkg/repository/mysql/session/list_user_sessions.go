@@ -0,0 +1,45 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/domain/auth"
+)
+
+// ListUserSessions retorna las sesiones vigentes de un usuario, la más reciente primero
+func (r *Repository) ListUserSessions(ctx context.Context, userID int64) ([]*auth.Session, error) {
+	query := `
+		SELECT id, user_id, access_uuid, refresh_uuid, access_expires_at, refresh_expires_at,
+			user_agent, ip_address, last_seen_at, created_at
+		FROM auth_sessions
+		WHERE user_id = ? AND refresh_expires_at > ?
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list user sessions",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	sessions := []*auth.Session{}
+	for rows.Next() {
+		s := &auth.Session{}
+		if err := rows.Scan(
+			&s.ID, &s.UserID, &s.AccessUUID, &s.RefreshUUID, &s.AccessExpiresAt, &s.RefreshExpiresAt,
+			&s.UserAgent, &s.IPAddress, &s.LastSeenAt, &s.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+
+	return sessions, rows.Err()
+}
@@ -0,0 +1,22 @@
+package session
+
+import (
+	"database/sql"
+	"time"
+
+	commonAuth "github.com/your-org/jvairv2/pkg/common/auth"
+)
+
+// lastSeenInterval evita escribir last_seen_at en cada solicitud de la misma sesión
+const lastSeenInterval = time.Minute
+
+// Repository implementa el TokenStore MySQL sobre la tabla auth_sessions, compartido
+// entre instancias de la API y persistente entre despliegues
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de sesiones
+func NewRepository(db *sql.DB) commonAuth.TokenStore {
+	return &Repository{db: db}
+}
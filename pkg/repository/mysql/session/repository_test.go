@@ -0,0 +1,233 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/jvairv2/pkg/domain/auth"
+)
+
+func setupTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := &Repository{db: db}
+
+	cleanup := func() {
+		_ = db.Close()
+	}
+
+	return repo, mock, cleanup
+}
+
+func TestCreateSession(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	now := time.Now()
+	s := &auth.Session{
+		ID:               "s1",
+		UserID:           7,
+		AccessUUID:       "a1",
+		RefreshUUID:      "r1",
+		AccessExpiresAt:  now.Add(15 * time.Minute),
+		RefreshExpiresAt: now.Add(24 * time.Hour),
+		UserAgent:        "JVAir/2.0 (Android)",
+		IPAddress:        "10.0.0.1",
+		CreatedAt:        now,
+	}
+
+	mock.ExpectExec("INSERT INTO auth_sessions").
+		WithArgs("s1", int64(7), "a1", "r1", s.AccessExpiresAt, s.RefreshExpiresAt, "JVAir/2.0 (Android)", "10.0.0.1", now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.CreateSession(context.Background(), s)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRotateSession(t *testing.T) {
+	s := &auth.Session{ID: "s1", AccessUUID: "a2", RefreshUUID: "r2"}
+
+	t.Run("rotated", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectExec("UPDATE auth_sessions SET access_uuid = \\?, refresh_uuid = \\?").
+			WithArgs("a2", "r2", sqlmock.AnyArg(), sqlmock.AnyArg(), "", "", "", "", sqlmock.AnyArg(), "s1", "r1", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		rotated, err := repo.RotateSession(context.Background(), "r1", s)
+
+		assert.NoError(t, err)
+		assert.True(t, rotated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("refresh token already used", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectExec("UPDATE auth_sessions").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		rotated, err := repo.RotateSession(context.Background(), "r0", s)
+
+		assert.NoError(t, err)
+		assert.False(t, rotated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCheckToken(t *testing.T) {
+	t.Run("access token updates last seen", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectQuery("SELECT id, access_uuid = \\?, last_seen_at FROM auth_sessions").
+			WithArgs("a1", "a1", sqlmock.AnyArg(), "a1", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "is_access", "last_seen_at"}).AddRow("s1", true, nil))
+		mock.ExpectExec("UPDATE auth_sessions SET last_seen_at = \\? WHERE id = \\?").
+			WithArgs(sqlmock.AnyArg(), "s1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ok, err := repo.CheckToken(context.Background(), "a1")
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("recent activity is not rewritten", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectQuery("SELECT id, access_uuid = \\?, last_seen_at FROM auth_sessions").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "is_access", "last_seen_at"}).AddRow("s1", true, time.Now()))
+
+		ok, err := repo.CheckToken(context.Background(), "a1")
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectQuery("SELECT id, access_uuid = \\?, last_seen_at FROM auth_sessions").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "is_access", "last_seen_at"}))
+
+		ok, err := repo.CheckToken(context.Background(), "nope")
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestListUserSessions(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	now := time.Now()
+	columns := []string{"id", "user_id", "access_uuid", "refresh_uuid", "access_expires_at", "refresh_expires_at", "user_agent", "ip_address", "last_seen_at", "created_at"}
+
+	mock.ExpectQuery("SELECT (.+) FROM auth_sessions WHERE user_id = \\? AND refresh_expires_at > \\? ORDER BY created_at DESC").
+		WithArgs(int64(7), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("s2", int64(7), "a2", "r2", now, now.Add(time.Hour), "Chrome", "10.0.0.2", now, now).
+			AddRow("s1", int64(7), "a1", "r1", now, now.Add(time.Hour), "Android", "10.0.0.1", nil, now.Add(-time.Hour)))
+
+	sessions, err := repo.ListUserSessions(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "s2", sessions[0].ID)
+	assert.Equal(t, "Chrome", sessions[0].UserAgent)
+	assert.NotNil(t, sessions[0].LastSeenAt)
+	assert.Nil(t, sessions[1].LastSeenAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteUserSession(t *testing.T) {
+	t.Run("deleted", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectExec("DELETE FROM auth_sessions WHERE id = \\? AND user_id = \\?").
+			WithArgs("s1", int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		deleted, err := repo.DeleteUserSession(context.Background(), 7, "s1")
+
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("session of another user", func(t *testing.T) {
+		repo, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectExec("DELETE FROM auth_sessions WHERE id = \\? AND user_id = \\?").
+			WithArgs("s9", int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		deleted, err := repo.DeleteUserSession(context.Background(), 7, "s9")
+
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeleteOtherUserSessions(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectExec("DELETE FROM auth_sessions WHERE user_id = \\? AND id <> \\?").
+		WithArgs(int64(7), "s1").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	err := repo.DeleteOtherUserSessions(context.Background(), 7, "s1")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteUserTokens(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectExec("DELETE FROM auth_sessions WHERE user_id = \\?").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.DeleteUserTokens(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteExpired(t *testing.T) {
+	repo, mock, cleanup := setupTest(t)
+	defer cleanup()
+
+	mock.ExpectExec("DELETE FROM auth_sessions WHERE refresh_expires_at <= \\?").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	deleted, err := repo.DeleteExpired(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
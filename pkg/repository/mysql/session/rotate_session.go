@@ -0,0 +1,42 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/domain/auth"
+)
+
+// RotateSession reemplaza los tokens de la sesión solo si refreshUUID sigue vigente en ella.
+// La condición sobre refresh_uuid impide reutilizar un token de refresco ya rotado.
+func (r *Repository) RotateSession(ctx context.Context, refreshUUID string, s *auth.Session) (bool, error) {
+	query := `
+		UPDATE auth_sessions
+		SET access_uuid = ?, refresh_uuid = ?, access_expires_at = ?, refresh_expires_at = ?,
+			user_agent = IF(? = '', user_agent, ?), ip_address = IF(? = '', ip_address, ?),
+			updated_at = ?
+		WHERE id = ? AND refresh_uuid = ? AND refresh_expires_at > ?
+	`
+
+	now := time.Now()
+	result, err := r.db.ExecContext(ctx, query,
+		s.AccessUUID, s.RefreshUUID, s.AccessExpiresAt, s.RefreshExpiresAt,
+		s.UserAgent, s.UserAgent, s.IPAddress, s.IPAddress,
+		now,
+		s.ID, refreshUUID, now,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to rotate session",
+			slog.String("sessionId", s.ID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,29 @@
+package sms_template
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+func (r *Repository) Create(ctx context.Context, t *sms_template.SMSTemplate) error {
+	query := "INSERT INTO sms_templates (label, message, is_active, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW())"
+
+	result, err := r.db.ExecContext(ctx, query, t.Label, t.Message, t.IsActive)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute insert sms template query",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get last insert ID",
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	t.ID = id
+	return nil
+}
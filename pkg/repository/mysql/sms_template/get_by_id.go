@@ -0,0 +1,35 @@
+package sms_template
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*sms_template.SMSTemplate, error) {
+	query := "SELECT id, label, message, is_active, created_at, updated_at FROM sms_templates WHERE id = ?"
+
+	t := &sms_template.SMSTemplate{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&t.ID,
+		&t.Label,
+		&t.Message,
+		&t.IsActive,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, sms_template.ErrSMSTemplateNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get sms template by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,74 @@
+package sms_template
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+func (r *Repository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*sms_template.SMSTemplate, int, error) {
+	where := []string{"1=1"}
+	args := []interface{}{}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		where = append(where, "(label LIKE ? OR message LIKE ?)")
+		args = append(args, "%"+search+"%", "%"+search+"%")
+	}
+
+	if isActive, ok := filters["is_active"].(bool); ok {
+		where = append(where, "is_active = ?")
+		args = append(args, isActive)
+	}
+
+	whereClause := strings.Join(where, " AND ")
+
+	// Count total
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM sms_templates WHERE %s", whereClause)
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count sms templates",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	// Query with pagination
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf("SELECT id, label, message, is_active, created_at, updated_at FROM sms_templates WHERE %s ORDER BY label ASC LIMIT ? OFFSET ?", whereClause)
+
+	args = append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list sms templates",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var templates []*sms_template.SMSTemplate
+	for rows.Next() {
+		t := &sms_template.SMSTemplate{}
+		if err := rows.Scan(
+			&t.ID,
+			&t.Label,
+			&t.Message,
+			&t.IsActive,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+		); err != nil {
+			slog.ErrorContext(ctx, "Failed to scan sms template",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		templates = append(templates, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
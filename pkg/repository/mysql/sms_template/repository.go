@@ -0,0 +1,17 @@
+package sms_template
+
+import (
+	"database/sql"
+
+	"github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+// Repository implementa el repositorio MySQL para plantillas de SMS
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio de plantillas de SMS
+func NewRepository(db *sql.DB) sms_template.Repository {
+	return &Repository{db: db}
+}
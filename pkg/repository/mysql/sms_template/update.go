@@ -0,0 +1,22 @@
+package sms_template
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/sms_template"
+)
+
+func (r *Repository) Update(ctx context.Context, t *sms_template.SMSTemplate) error {
+	query := "UPDATE sms_templates SET label = ?, message = ?, is_active = ?, updated_at = NOW() WHERE id = ?"
+
+	_, err := r.db.ExecContext(ctx, query, t.Label, t.Message, t.IsActive, t.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update sms template",
+			slog.String("error", err.Error()),
+			slog.Int64("id", t.ID))
+		return err
+	}
+
+	return nil
+}
@@ -26,6 +26,16 @@ func (r *Repository) List(ctx context.Context, filters map[string]interface{}, p
 		args = append(args, customerID)
 	}
 
+	// Scope de jobs visibles: supervisores de clientes con jobs asignados al usuario
+	if ownerID, ok := filters["job_user_id"].(int64); ok {
+		conditions = append(conditions, `customer_id IN (
+			SELECT p.customer_id FROM jobs j
+			INNER JOIN properties p ON p.id = j.property_id
+			WHERE j.user_id = ? AND j.deleted_at IS NULL
+		)`)
+		args = append(args, ownerID)
+	}
+
 	if search, ok := filters["search"].(string); ok && search != "" {
 		searchCondition := `(
 			name LIKE ? OR
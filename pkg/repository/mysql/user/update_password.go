@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// UpdatePassword actualiza la contraseña de un usuario. También se limpia
+// remember_token para invalidar las sesiones "recordarme" de Laravel.
+func (r *Repository) UpdatePassword(ctx context.Context, id int64, hashedPassword string) error {
+	query := `
+		UPDATE users
+		SET password = ?, remember_token = NULL, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, hashedPassword, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
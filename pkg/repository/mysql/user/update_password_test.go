@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePassword_Success(t *testing.T) {
+	// Configurar el mock de la base de datos
+	db, mock, repo := setupMockDB(t)
+	defer func() { _ = db.Close() }()
+
+	// Configurar la expectativa para la consulta UPDATE
+	mock.ExpectExec(regexp.QuoteMeta(`
+		UPDATE users
+		SET password = ?, remember_token = NULL, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`)).WithArgs(
+		"$2a$10$hash", sqlmock.AnyArg(), int64(123),
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Ejecutar la función que estamos probando
+	err := repo.UpdatePassword(context.Background(), 123, "$2a$10$hash")
+
+	// Verificar que no haya errores
+	assert.NoError(t, err)
+
+	// Verificar que todas las expectativas se cumplieron
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePassword_NotFound(t *testing.T) {
+	// Configurar el mock de la base de datos
+	db, mock, repo := setupMockDB(t)
+	defer func() { _ = db.Close() }()
+
+	// Configurar la expectativa para la consulta UPDATE sin filas afectadas
+	mock.ExpectExec(regexp.QuoteMeta(`
+		UPDATE users
+		SET password = ?, remember_token = NULL, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`)).WithArgs(
+		"$2a$10$hash", sqlmock.AnyArg(), int64(999),
+	).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Ejecutar la función que estamos probando
+	err := repo.UpdatePassword(context.Background(), 999, "$2a$10$hash")
+
+	// Verificar que se retorne el error esperado
+	assert.Equal(t, ErrUserNotFound, err)
+
+	// Verificar que todas las expectativas se cumplieron
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,71 @@
+package warranty
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainWarranty "github.com/your-org/jvairv2/pkg/domain/warranty"
+)
+
+const selectColumns = `w.id, w.warranty_number, w.job_id, w.warranty_type_id, w.warranty_status_id,
+		       w.date_submitted, w.agreement_number, w.audit_done, w.notes, w.created_at, w.updated_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWarranty(s scanner) (*domainWarranty.Warranty, error) {
+	w := &domainWarranty.Warranty{}
+	var agreementNumber, notes sql.NullString
+	var dateSubmitted sql.NullTime
+
+	if err := s.Scan(
+		&w.ID,
+		&w.WarrantyNumber,
+		&w.JobID,
+		&w.WarrantyTypeID,
+		&w.WarrantyStatusID,
+		&dateSubmitted,
+		&agreementNumber,
+		&w.AuditDone,
+		&notes,
+		&w.CreatedAt,
+		&w.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if dateSubmitted.Valid {
+		w.DateSubmitted = &dateSubmitted.Time
+	}
+	if agreementNumber.Valid {
+		w.AgreementNumber = &agreementNumber.String
+	}
+	if notes.Valid {
+		w.Notes = &notes.String
+	}
+
+	return w, nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainWarranty.Warranty, error) {
+	query := `
+		SELECT ` + selectColumns + `
+		FROM warranties w
+		WHERE w.id = ? AND w.deleted_at IS NULL
+	`
+
+	w, err := scanWarranty(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainWarranty.ErrWarrantyNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get warranty by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return nil, err
+	}
+
+	return w, nil
+}
@@ -0,0 +1,111 @@
+package warranty
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	domainWarranty "github.com/your-org/jvairv2/pkg/domain/warranty"
+)
+
+func (r *Repository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*domainWarranty.Warranty, int64, error) {
+	where := []string{"w.deleted_at IS NULL"}
+	args := []interface{}{}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		where = append(where, "(w.warranty_number LIKE ? OR w.agreement_number LIKE ?)")
+		args = append(args, "%"+search+"%", "%"+search+"%")
+	}
+
+	if jobID, ok := filters["job_id"].(int64); ok {
+		where = append(where, "w.job_id = ?")
+		args = append(args, jobID)
+	}
+
+	if ownerID, ok := filters["job_user_id"].(int64); ok {
+		where = append(where, "w.job_id IN (SELECT id FROM jobs WHERE user_id = ?)")
+		args = append(args, ownerID)
+	}
+
+	if warrantyTypeID, ok := filters["warranty_type_id"].(int64); ok {
+		where = append(where, "w.warranty_type_id = ?")
+		args = append(args, warrantyTypeID)
+	}
+
+	if warrantyStatusID, ok := filters["warranty_status_id"].(int64); ok {
+		where = append(where, "w.warranty_status_id = ?")
+		args = append(args, warrantyStatusID)
+	}
+
+	if auditDone, ok := filters["audit_done"].(bool); ok {
+		where = append(where, "w.audit_done = ?")
+		args = append(args, auditDone)
+	}
+
+	whereClause := strings.Join(where, " AND ")
+
+	// Count
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM warranties w WHERE %s", whereClause)
+	var total int64
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count warranties",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	// Sorting
+	sortColumn := "w.created_at"
+	sortDirection := "DESC"
+	if sort, ok := filters["sort"].(string); ok {
+		switch sort {
+		case "warranty_number":
+			sortColumn = "w.warranty_number"
+		case "date_submitted":
+			sortColumn = "w.date_submitted"
+		case "created_at":
+			sortColumn = "w.created_at"
+		}
+	}
+	if direction, ok := filters["direction"].(string); ok {
+		switch strings.ToUpper(direction) {
+		case "ASC":
+			sortDirection = "ASC"
+		case "DESC":
+			sortDirection = "DESC"
+		}
+	}
+
+	// Query
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM warranties w
+		WHERE %s
+		ORDER BY %s %s
+		LIMIT ? OFFSET ?
+	`, selectColumns, whereClause, sortColumn, sortDirection)
+
+	args = append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list warranties",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var warranties []*domainWarranty.Warranty
+	for rows.Next() {
+		w, err := scanWarranty(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan warranty row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		warranties = append(warranties, w)
+	}
+
+	return warranties, total, nil
+}
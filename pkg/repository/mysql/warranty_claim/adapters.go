@@ -0,0 +1,78 @@
+package warranty_claim
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+)
+
+// JobCheckerAdapter adapta la verificación de existencia de jobs
+type JobCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewJobCheckerAdapter(db *sql.DB) domainClaim.JobChecker {
+	return &JobCheckerAdapter{db: db}
+}
+
+func (a *JobCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM jobs WHERE id = ? AND deleted_at IS NULL)",
+		id,
+	).Scan(&exists)
+	if err != nil || !exists {
+		slog.ErrorContext(ctx, "Job not found",
+			slog.Int64("jobId", id))
+		return nil, domainClaim.ErrInvalidJob
+	}
+	return true, nil
+}
+
+// WarrantyClaimTypeCheckerAdapter adapta la verificación de existencia de tipos de reclamación
+type WarrantyClaimTypeCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewWarrantyClaimTypeCheckerAdapter(db *sql.DB) domainClaim.WarrantyClaimTypeChecker {
+	return &WarrantyClaimTypeCheckerAdapter{db: db}
+}
+
+func (a *WarrantyClaimTypeCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM warranty_claim_types WHERE id = ?)",
+		id,
+	).Scan(&exists)
+	if err != nil || !exists {
+		slog.ErrorContext(ctx, "Warranty claim type not found",
+			slog.Int64("warrantyClaimTypeId", id))
+		return nil, domainClaim.ErrInvalidWarrantyClaimType
+	}
+	return true, nil
+}
+
+// WarrantyClaimStatusCheckerAdapter adapta la verificación de existencia de estados de reclamación
+type WarrantyClaimStatusCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewWarrantyClaimStatusCheckerAdapter(db *sql.DB) domainClaim.WarrantyClaimStatusChecker {
+	return &WarrantyClaimStatusCheckerAdapter{db: db}
+}
+
+func (a *WarrantyClaimStatusCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM warranty_claim_statuses WHERE id = ?)",
+		id,
+	).Scan(&exists)
+	if err != nil || !exists {
+		slog.ErrorContext(ctx, "Warranty claim status not found",
+			slog.Int64("warrantyClaimStatusId", id))
+		return nil, domainClaim.ErrInvalidWarrantyClaimStatus
+	}
+	return true, nil
+}
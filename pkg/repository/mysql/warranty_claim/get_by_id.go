@@ -0,0 +1,56 @@
+package warranty_claim
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	domainClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+)
+
+const selectColumns = `wc.id, wc.internal_claim_number, wc.warranty_claim_type_id, wc.warranty_claim_status_id, wc.job_id,
+		       wc.invoice_number, wc.work_done, wc.warranty_part, wc.manufacturer, wc.model_number, wc.part_number,
+		       wc.replacement_part_number, wc.part_distributor, wc.part_invoice_number,
+		       wc.old_part_serial_number, wc.new_part_serial_number, wc.esa_number, wc.serial, wc.claim_number,
+		       wc.approved, wc.parts_credit_received, wc.labor_payment_received, wc.notes,
+		       wc.created_at, wc.updated_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanClaim(s scanner) (*domainClaim.WarrantyClaim, error) {
+	c := &domainClaim.WarrantyClaim{}
+	if err := s.Scan(
+		&c.ID, &c.InternalClaimNumber, &c.WarrantyClaimTypeID, &c.WarrantyClaimStatusID, &c.JobID,
+		&c.InvoiceNumber, &c.WorkDone, &c.WarrantyPart, &c.Manufacturer, &c.ModelNumber, &c.PartNumber,
+		&c.ReplacementPartNumber, &c.PartDistributor, &c.PartInvoiceNumber,
+		&c.OldPartSerialNumber, &c.NewPartSerialNumber, &c.EsaNumber, &c.Serial, &c.ClaimNumber,
+		&c.Approved, &c.PartsCreditReceived, &c.LaborPaymentReceived, &c.Notes,
+		&c.CreatedAt, &c.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainClaim.WarrantyClaim, error) {
+	query := `
+		SELECT ` + selectColumns + `
+		FROM warranty_claims wc
+		WHERE wc.id = ? AND wc.deleted_at IS NULL
+	`
+
+	c, err := scanClaim(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainClaim.ErrWarrantyClaimNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to get warranty claim by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("id", id))
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,127 @@
+package warranty_claim
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	domainClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+)
+
+func (r *Repository) List(ctx context.Context, filters map[string]interface{}, page, pageSize int) ([]*domainClaim.WarrantyClaim, int64, error) {
+	where := []string{"wc.deleted_at IS NULL"}
+	args := []interface{}{}
+
+	if search, ok := filters["search"].(string); ok && search != "" {
+		where = append(where, "(wc.internal_claim_number LIKE ? OR wc.claim_number LIKE ? OR wc.esa_number LIKE ? OR wc.part_number LIKE ?)")
+		like := "%" + search + "%"
+		args = append(args, like, like, like, like)
+	}
+
+	if jobID, ok := filters["job_id"].(int64); ok {
+		where = append(where, "wc.job_id = ?")
+		args = append(args, jobID)
+	}
+
+	if ownerID, ok := filters["job_user_id"].(int64); ok {
+		where = append(where, "wc.job_id IN (SELECT id FROM jobs WHERE user_id = ?)")
+		args = append(args, ownerID)
+	}
+
+	if typeID, ok := filters["warranty_claim_type_id"].(int64); ok {
+		where = append(where, "wc.warranty_claim_type_id = ?")
+		args = append(args, typeID)
+	}
+
+	if statusID, ok := filters["warranty_claim_status_id"].(int64); ok {
+		where = append(where, "wc.warranty_claim_status_id = ?")
+		args = append(args, statusID)
+	}
+
+	if approved, ok := filters["approved"].(bool); ok {
+		where = append(where, "wc.approved = ?")
+		args = append(args, approved)
+	}
+
+	if workDone, ok := filters["work_done"].(bool); ok {
+		where = append(where, "wc.work_done = ?")
+		args = append(args, workDone)
+	}
+
+	// Aprobadas cuyo crédito de partes aún no se recibe
+	if pending, ok := filters["credit_pending"].(bool); ok && pending {
+		where = append(where, "wc.approved = 1 AND wc.parts_credit_received = 0")
+	}
+
+	// Aprobadas cuyo pago de mano de obra aún no se recibe
+	if pending, ok := filters["labor_pending"].(bool); ok && pending {
+		where = append(where, "wc.approved = 1 AND wc.labor_payment_received = 0")
+	}
+
+	whereClause := strings.Join(where, " AND ")
+
+	// Count
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM warranty_claims wc WHERE %s", whereClause)
+	var total int64
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		slog.ErrorContext(ctx, "Failed to count warranty claims",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	// Sorting
+	sortColumn := "wc.created_at"
+	sortDirection := "DESC"
+	if sort, ok := filters["sort"].(string); ok {
+		switch sort {
+		case "internal_claim_number":
+			sortColumn = "wc.internal_claim_number"
+		case "claim_number":
+			sortColumn = "wc.claim_number"
+		case "created_at":
+			sortColumn = "wc.created_at"
+		}
+	}
+	if direction, ok := filters["direction"].(string); ok {
+		switch strings.ToUpper(direction) {
+		case "ASC":
+			sortDirection = "ASC"
+		case "DESC":
+			sortDirection = "DESC"
+		}
+	}
+
+	// Query
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM warranty_claims wc
+		WHERE %s
+		ORDER BY %s %s
+		LIMIT ? OFFSET ?
+	`, selectColumns, whereClause, sortColumn, sortDirection)
+
+	args = append(args, pageSize, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list warranty claims",
+			slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var claims []*domainClaim.WarrantyClaim
+	for rows.Next() {
+		c, err := scanClaim(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan warranty claim row",
+				slog.String("error", err.Error()))
+			return nil, 0, err
+		}
+		claims = append(claims, c)
+	}
+
+	return claims, total, nil
+}
@@ -0,0 +1,15 @@
+package warranty_claim
+
+import (
+	"database/sql"
+
+	domainClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) domainClaim.Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,39 @@
+package warranty_claim
+
+import (
+	"context"
+	"log/slog"
+
+	domainClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+)
+
+func (r *Repository) Update(ctx context.Context, c *domainClaim.WarrantyClaim) error {
+	query := `
+		UPDATE warranty_claims SET
+			internal_claim_number = ?, warranty_claim_type_id = ?, warranty_claim_status_id = ?, job_id = ?,
+			invoice_number = ?, work_done = ?, warranty_part = ?, manufacturer = ?, model_number = ?, part_number = ?,
+			replacement_part_number = ?, part_distributor = ?, part_invoice_number = ?,
+			old_part_serial_number = ?, new_part_serial_number = ?, esa_number = ?, serial = ?, claim_number = ?,
+			approved = ?, parts_credit_received = ?, labor_payment_received = ?, notes = ?,
+			updated_at = NOW()
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		c.InternalClaimNumber, c.WarrantyClaimTypeID, c.WarrantyClaimStatusID, c.JobID,
+		c.InvoiceNumber, c.WorkDone, c.WarrantyPart, c.Manufacturer, c.ModelNumber, c.PartNumber,
+		c.ReplacementPartNumber, c.PartDistributor, c.PartInvoiceNumber,
+		c.OldPartSerialNumber, c.NewPartSerialNumber, c.EsaNumber, c.Serial, c.ClaimNumber,
+		c.Approved, c.PartsCreditReceived, c.LaborPaymentReceived, c.Notes,
+		c.ID,
+	)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update warranty claim",
+			slog.String("error", err.Error()),
+			slog.Int64("id", c.ID))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package warranty_claim_status
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/warranty_claim_status"
+)
+
+func (r *Repository) Update(ctx context.Context, ws *warranty_claim_status.WarrantyClaimStatus) error {
+	query := `
+		UPDATE warranty_claim_statuses
+		SET label = ?, class = ?, ` + "`order`" + ` = ?, is_active = ?, updated_at = NOW()
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		ws.Label,
+		ws.Class,
+		ws.Order,
+		ws.IsActive,
+		ws.ID,
+	)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update warranty claim status",
+			slog.String("error", err.Error()),
+			slog.Int64("id", ws.ID))
+		return err
+	}
+
+	return nil
+}
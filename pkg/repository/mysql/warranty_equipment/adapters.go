@@ -0,0 +1,32 @@
+package warranty_equipment
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+)
+
+// WarrantyCheckerAdapter adapta la verificación de existencia de garantías
+type WarrantyCheckerAdapter struct {
+	db *sql.DB
+}
+
+func NewWarrantyCheckerAdapter(db *sql.DB) warranty_equipment.WarrantyChecker {
+	return &WarrantyCheckerAdapter{db: db}
+}
+
+func (a *WarrantyCheckerAdapter) GetByID(ctx context.Context, id int64) (interface{}, error) {
+	var exists bool
+	err := a.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM warranties WHERE id = ? AND deleted_at IS NULL)",
+		id,
+	).Scan(&exists)
+	if err != nil || !exists {
+		slog.ErrorContext(ctx, "Warranty not found",
+			slog.Int64("warrantyId", id))
+		return nil, warranty_equipment.ErrInvalidWarranty
+	}
+	return true, nil
+}
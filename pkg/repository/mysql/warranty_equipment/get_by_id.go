@@ -0,0 +1,46 @@
+package warranty_equipment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+)
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*warranty_equipment.WarrantyEquipment, error) {
+	query := `
+		SELECT
+			id, warranty_id, area,
+			outdoor_brand, outdoor_model, outdoor_serial, outdoor_installed,
+			furnace_brand, furnace_model, furnace_serial, furnace_installed,
+			evaporator_brand, evaporator_model, evaporator_serial, evaporator_installed,
+			air_handler_brand, air_handler_model, air_handler_serial, air_handler_installed,
+			created_at, updated_at
+		FROM warranty_equipment
+		WHERE id = ?
+	`
+
+	e := &warranty_equipment.WarrantyEquipment{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&e.ID, &e.WarrantyID, &e.Area,
+		&e.OutdoorBrand, &e.OutdoorModel, &e.OutdoorSerial, &e.OutdoorInstalled,
+		&e.FurnaceBrand, &e.FurnaceModel, &e.FurnaceSerial, &e.FurnaceInstalled,
+		&e.EvaporatorBrand, &e.EvaporatorModel, &e.EvaporatorSerial, &e.EvaporatorInstalled,
+		&e.AirHandlerBrand, &e.AirHandlerModel, &e.AirHandlerSerial, &e.AirHandlerInstalled,
+		&e.CreatedAt, &e.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, warranty_equipment.ErrEquipmentNotFound
+		}
+		slog.ErrorContext(ctx, "Failed to query warranty equipment by ID",
+			slog.String("error", err.Error()),
+			slog.Int64("equipment_id", id))
+		return nil, err
+	}
+
+	return e, nil
+}
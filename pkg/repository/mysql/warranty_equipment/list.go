@@ -0,0 +1,58 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+)
+
+func (r *Repository) ListByWarrantyID(ctx context.Context, warrantyID int64) ([]*warranty_equipment.WarrantyEquipment, error) {
+	query := `
+		SELECT
+			id, warranty_id, area,
+			outdoor_brand, outdoor_model, outdoor_serial, outdoor_installed,
+			furnace_brand, furnace_model, furnace_serial, furnace_installed,
+			evaporator_brand, evaporator_model, evaporator_serial, evaporator_installed,
+			air_handler_brand, air_handler_model, air_handler_serial, air_handler_installed,
+			created_at, updated_at
+		FROM warranty_equipment
+		WHERE warranty_id = ?
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, warrantyID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to query warranty equipment",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var equipment []*warranty_equipment.WarrantyEquipment
+	for rows.Next() {
+		e := &warranty_equipment.WarrantyEquipment{}
+		err := rows.Scan(
+			&e.ID, &e.WarrantyID, &e.Area,
+			&e.OutdoorBrand, &e.OutdoorModel, &e.OutdoorSerial, &e.OutdoorInstalled,
+			&e.FurnaceBrand, &e.FurnaceModel, &e.FurnaceSerial, &e.FurnaceInstalled,
+			&e.EvaporatorBrand, &e.EvaporatorModel, &e.EvaporatorSerial, &e.EvaporatorInstalled,
+			&e.AirHandlerBrand, &e.AirHandlerModel, &e.AirHandlerSerial, &e.AirHandlerInstalled,
+			&e.CreatedAt, &e.UpdatedAt,
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to scan warranty equipment row",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		equipment = append(equipment, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "Error iterating warranty equipment rows",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return equipment, nil
+}
@@ -0,0 +1,17 @@
+package warranty_equipment
+
+import (
+	"database/sql"
+
+	"github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+)
+
+// Repository implementa warranty_equipment.Repository usando MySQL
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository crea una nueva instancia del repositorio
+func NewRepository(db *sql.DB) warranty_equipment.Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,41 @@
+package warranty_equipment
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+)
+
+func (r *Repository) Update(ctx context.Context, e *warranty_equipment.WarrantyEquipment) error {
+	query := `
+		UPDATE warranty_equipment
+		SET area = ?,
+		    outdoor_brand = ?, outdoor_model = ?, outdoor_serial = ?, outdoor_installed = ?,
+		    furnace_brand = ?, furnace_model = ?, furnace_serial = ?, furnace_installed = ?,
+		    evaporator_brand = ?, evaporator_model = ?, evaporator_serial = ?, evaporator_installed = ?,
+		    air_handler_brand = ?, air_handler_model = ?, air_handler_serial = ?, air_handler_installed = ?,
+		    updated_at = NOW()
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		e.Area,
+		e.OutdoorBrand, e.OutdoorModel, e.OutdoorSerial, e.OutdoorInstalled,
+		e.FurnaceBrand, e.FurnaceModel, e.FurnaceSerial, e.FurnaceInstalled,
+		e.EvaporatorBrand, e.EvaporatorModel, e.EvaporatorSerial, e.EvaporatorInstalled,
+		e.AirHandlerBrand, e.AirHandlerModel, e.AirHandlerSerial, e.AirHandlerInstalled,
+		e.ID,
+	)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to execute update warranty_equipment query",
+			slog.String("error", err.Error()),
+			slog.Int64("equipment_id", e.ID))
+		return err
+	}
+
+	return nil
+}
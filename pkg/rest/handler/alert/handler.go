@@ -0,0 +1,192 @@
+package alert
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/alert"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para la bandeja de alertas
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de alertas
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/alerts", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/mark-read", h.MarkRead)
+		r.Post("/mark-call-log/{jobId}", h.MarkCallLog)
+		r.Post("/{id}/open", h.Open)
+	})
+}
+
+// MarkReadRequest representa la solicitud de marcado masivo de alertas como leídas
+type MarkReadRequest struct {
+	IDs []int64 `json:"ids,omitempty" example:"1,2,3"`
+}
+
+// CountResponse representa la cantidad de alertas actualizadas
+type CountResponse struct {
+	Count int64 `json:"count" example:"3"`
+}
+
+// writeAlertError traduce los errores del dominio a respuestas HTTP
+func writeAlertError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case domain.ErrUnauthenticated:
+		response.Error(w, http.StatusUnauthorized, "Usuario no autenticado")
+	case domain.ErrAlertNotFound:
+		response.Error(w, http.StatusNotFound, "Alerta no encontrada")
+	case domain.ErrInvalidJob:
+		response.Error(w, http.StatusNotFound, "Job no encontrado")
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// List maneja la solicitud de la bandeja de alertas del usuario autenticado
+// @Summary Listar alertas
+// @Description Obtiene las alertas del usuario autenticado: primero las no leídas y luego las más recientes
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param unread query bool false "Solo alertas no leídas"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/alerts [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	unreadOnly, _ := strconv.ParseBool(r.URL.Query().Get("unread"))
+
+	alerts, total, err := h.useCase.List(r.Context(), unreadOnly, page, pageSize)
+	if err != nil {
+		writeAlertError(w, err, "Error al listar alertas")
+		return
+	}
+
+	if alerts == nil {
+		alerts = []*domain.Alert{}
+	}
+
+	response.Paginated(w, alerts, page, pageSize, total)
+}
+
+// Open maneja la solicitud de apertura de una alerta
+// @Summary Abrir alerta
+// @Description Obtiene una alerta del usuario autenticado y la marca como leída. entityType y entityId indican la entidad a la que navegar
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la alerta"
+// @Success 200 {object} alert.Alert
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/alerts/{id}/open [post]
+// @Security BearerAuth
+func (h *Handler) Open(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	alert, err := h.useCase.Open(r.Context(), id)
+	if err != nil {
+		writeAlertError(w, err, "Error al abrir alerta")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, alert)
+}
+
+// MarkRead maneja la solicitud de marcado masivo de alertas como leídas
+// @Summary Marcar alertas como leídas
+// @Description Marca como leídas las alertas indicadas del usuario autenticado. Sin ids se marca toda la bandeja
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param request body MarkReadRequest false "IDs de las alertas"
+// @Success 200 {object} CountResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/alerts/mark-read [post]
+// @Security BearerAuth
+func (h *Handler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	// El cuerpo es opcional
+	var req MarkReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	count, err := h.useCase.MarkRead(r.Context(), req.IDs)
+	if err != nil {
+		writeAlertError(w, err, "Error al marcar alertas como leídas")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CountResponse{Count: count})
+}
+
+// MarkCallLog maneja la solicitud de marcado de las alertas de registro de llamadas de un job
+// @Summary Marcar registro de llamadas como leído
+// @Description Marca como leídas las alertas de registro de llamadas (call_log) de un job para el usuario autenticado
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Success 200 {object} CountResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/alerts/mark-call-log/{jobId} [post]
+// @Security BearerAuth
+func (h *Handler) MarkCallLog(w http.ResponseWriter, r *http.Request) {
+	jobID, err := strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	count, err := h.useCase.MarkCallLogRead(r.Context(), jobID)
+	if err != nil {
+		writeAlertError(w, err, "Error al marcar registro de llamadas")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CountResponse{Count: count})
+}
@@ -2,10 +2,12 @@ package auth
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
 
+	commonAuth "github.com/your-org/jvairv2/pkg/common/auth"
 	"github.com/your-org/jvairv2/pkg/domain/auth"
 )
 
@@ -36,6 +38,8 @@ func NewHandler(authUseCase *auth.UseCase) *Handler {
 // @Router /auth/login [post]
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	var req auth.LoginRequest
+	// La sesión registra el dispositivo y la IP desde donde se inicia
+	r = withClientInfo(r)
 
 	// Decodificar el cuerpo de la solicitud
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -155,6 +159,7 @@ func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		RefreshToken string `json:"refreshToken"`
 	}
+	r = withClientInfo(r)
 
 	// Decodificar el cuerpo de la solicitud
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -171,7 +176,8 @@ func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	// Refrescar token
 	td, err := h.authUseCase.RefreshToken(r.Context(), req.RefreshToken)
 	if err != nil {
-		if err == auth.ErrInvalidToken {
+		// El token de refresco se invalida al rotarlo, así que reutilizarlo también es 401
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, commonAuth.ErrInvalidToken) || errors.Is(err, commonAuth.ErrExpiredToken) {
 			http.Error(w, "Token inválido", http.StatusUnauthorized)
 			return
 		}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	commonAuth "github.com/your-org/jvairv2/pkg/common/auth"
+	"github.com/your-org/jvairv2/pkg/domain/auth"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// maxUserAgentLength es el largo de la columna user_agent de auth_sessions
+const maxUserAgentLength = 500
+
+// SessionResponse representa una sesión activa del usuario
+type SessionResponse struct {
+	ID         string     `json:"id" example:"3f0c9a1e5b7d4c2a8e6f1b3d5a7c9e0f"`
+	Device     string     `json:"device" example:"JVAir/2.0 (Android 14)"`
+	IPAddress  string     `json:"ipAddress" example:"203.0.113.10"`
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty" example:"2024-01-01T10:15:00Z"`
+	CreatedAt  time.Time  `json:"createdAt" example:"2024-01-01T08:00:00Z"`
+	ExpiresAt  time.Time  `json:"expiresAt" example:"2024-01-02T08:00:00Z"`
+	Current    bool       `json:"current" example:"true"`
+}
+
+// RegisterSessionRoutes registra las rutas de sesiones del usuario autenticado (bajo /api/v1)
+func (h *Handler) RegisterSessionRoutes(r chi.Router) {
+	r.Route("/auth/sessions", func(r chi.Router) {
+		r.Get("/", h.ListSessions)
+		r.Post("/revoke-others", h.RevokeOtherSessions)
+		r.Delete("/{id}", h.RevokeSession)
+	})
+}
+
+// withClientInfo agrega al contexto el dispositivo y la IP de la solicitud para registrarlos en la sesión
+func withClientInfo(r *http.Request) *http.Request {
+	userAgent := r.UserAgent()
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	// RealIP ya reemplazó RemoteAddr por la IP del cliente; puede venir sin puerto
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	ctx := auth.WithClientInfo(r.Context(), auth.ClientInfo{
+		UserAgent: userAgent,
+		IPAddress: ip,
+	})
+	return r.WithContext(ctx)
+}
+
+// bearerToken extrae el token de acceso del encabezado Authorization
+func bearerToken(r *http.Request) string {
+	splitToken := strings.Split(r.Header.Get("Authorization"), "Bearer ")
+	if len(splitToken) != 2 {
+		return ""
+	}
+	return splitToken[1]
+}
+
+// ListSessions maneja la consulta de las sesiones activas
+// @Summary Listar sesiones
+// @Description Lista las sesiones activas del usuario autenticado con su dispositivo, IP y última actividad. La sesión del token usado se marca como actual
+// @Tags Auth
+// @Produce json
+// @Success 200 {array} SessionResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/auth/sessions [get]
+// @Security BearerAuth
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	sessions, err := h.authUseCase.Sessions(r.Context(), bearerToken(r))
+	if err != nil {
+		writeSessionError(w, err, "Error al obtener las sesiones")
+		return
+	}
+
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, SessionResponse{
+			ID:         s.ID,
+			Device:     s.UserAgent,
+			IPAddress:  s.IPAddress,
+			LastSeenAt: s.LastSeenAt,
+			CreatedAt:  s.CreatedAt,
+			ExpiresAt:  s.RefreshExpiresAt,
+			Current:    s.Current,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// RevokeSession maneja el cierre de una sesión
+// @Summary Cerrar una sesión
+// @Description Cierra una sesión del usuario autenticado; sus tokens de acceso y de refresco dejan de ser válidos
+// @Tags Auth
+// @Param id path string true "ID de la sesión"
+// @Success 204 "No Content"
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/auth/sessions/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	if err := h.authUseCase.RevokeSession(r.Context(), bearerToken(r), chi.URLParam(r, "id")); err != nil {
+		writeSessionError(w, err, "Error al cerrar la sesión")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeOtherSessions maneja el cierre de las demás sesiones
+// @Summary Cerrar las demás sesiones
+// @Description Cierra todas las sesiones del usuario autenticado excepto la del token usado
+// @Tags Auth
+// @Success 204 "No Content"
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/auth/sessions/revoke-others [post]
+// @Security BearerAuth
+func (h *Handler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	if err := h.authUseCase.RevokeOtherSessions(r.Context(), bearerToken(r)); err != nil {
+		writeSessionError(w, err, "Error al cerrar las sesiones")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeSessionError traduce los errores de sesiones a respuestas HTTP
+func writeSessionError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, auth.ErrSessionNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, auth.ErrInvalidToken), errors.Is(err, commonAuth.ErrInvalidToken), errors.Is(err, commonAuth.ErrExpiredToken):
+		response.Error(w, http.StatusUnauthorized, "No autorizado")
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
@@ -0,0 +1,381 @@
+package email_template
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/domain/email_template"
+	domainPlaceholder "github.com/your-org/jvairv2/pkg/domain/job_placeholder"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para plantillas de email
+type Handler struct {
+	useCase email_template.Service
+}
+
+// NewHandler crea una nueva instancia del handler de plantillas de email
+func NewHandler(useCase email_template.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/email-templates", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/placeholders", h.Placeholders)
+		r.Post("/preview", h.PreviewDraft)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+		r.Get("/{id}/preview", h.Preview)
+	})
+}
+
+// EmailTemplateRequest representa la solicitud para crear o actualizar una plantilla de email
+type EmailTemplateRequest struct {
+	Label    string `json:"label" example:"Dispatch"`
+	Subject  string `json:"subject" example:"Work order {{job.work_order}}"`
+	Body     string `json:"body" example:"Please visit {{property.address}} on {{job.dispatch_date}}"`
+	IsActive *bool  `json:"isActive,omitempty" example:"true"`
+}
+
+// PreviewDraftRequest representa la solicitud para previsualizar una plantilla no guardada
+type PreviewDraftRequest struct {
+	Subject string `json:"subject" example:"Work order {{job.work_order}}"`
+	Body    string `json:"body" example:"Please visit {{property.address}}"`
+	JobID   int64  `json:"jobId" example:"100"`
+}
+
+// EmailTemplateResponse representa la respuesta de una plantilla de email
+type EmailTemplateResponse struct {
+	ID        int64  `json:"id" example:"1"`
+	Label     string `json:"label" example:"Dispatch"`
+	Subject   string `json:"subject" example:"Work order {{job.work_order}}"`
+	Body      string `json:"body" example:"Please visit {{property.address}} on {{job.dispatch_date}}"`
+	IsActive  bool   `json:"isActive" example:"true"`
+	CreatedAt string `json:"createdAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt string `json:"updatedAt,omitempty" example:"2024-01-18T14:20:00Z"`
+}
+
+func toResponse(t *email_template.EmailTemplate) EmailTemplateResponse {
+	resp := EmailTemplateResponse{
+		ID:       t.ID,
+		Label:    t.Label,
+		Subject:  t.Subject,
+		Body:     t.Body,
+		IsActive: t.IsActive,
+	}
+
+	if t.CreatedAt != nil {
+		resp.CreatedAt = t.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if t.UpdatedAt != nil {
+		resp.UpdatedAt = t.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return resp
+}
+
+func (req *EmailTemplateRequest) toEntity() *email_template.EmailTemplate {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	return &email_template.EmailTemplate{
+		Label:    req.Label,
+		Subject:  req.Subject,
+		Body:     req.Body,
+		IsActive: isActive,
+	}
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	if isActiveStr := r.URL.Query().Get("isActive"); isActiveStr != "" {
+		if isActive, err := strconv.ParseBool(isActiveStr); err == nil {
+			filters["is_active"] = isActive
+		}
+	}
+
+	return filters
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "label is required",
+		"subject is required",
+		"body is required":
+		return true
+	}
+	return strings.HasPrefix(err.Error(), "unknown placeholders")
+}
+
+// writeTemplateError traduce los errores del dominio a respuestas HTTP
+func writeTemplateError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err == email_template.ErrEmailTemplateNotFound:
+		response.Error(w, http.StatusNotFound, "Plantilla de email no encontrada")
+	case err == email_template.ErrInvalidJob:
+		response.Error(w, http.StatusNotFound, "Job no encontrado")
+	case isValidationError(err):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// List maneja la solicitud de listado de plantillas de email
+// @Summary Listar plantillas de email
+// @Description Obtiene una lista paginada de plantillas de email con filtros opcionales
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param search query string false "Búsqueda por label o asunto"
+// @Param isActive query bool false "Filtrar por estado activo"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	templates, total, err := h.useCase.List(r.Context(), parseFilters(r), page, pageSize)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al listar plantillas de email")
+		return
+	}
+
+	items := make([]EmailTemplateResponse, len(templates))
+	for i, t := range templates {
+		items[i] = toResponse(t)
+	}
+
+	response.Paginated(w, items, page, pageSize, total)
+}
+
+// Create maneja la solicitud de creación de una plantilla de email
+// @Summary Crear plantilla de email
+// @Description Crea una plantilla de email. El asunto y el cuerpo solo pueden usar los marcadores de /email-templates/placeholders
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param template body EmailTemplateRequest true "Datos de la plantilla"
+// @Success 201 {object} EmailTemplateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req EmailTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	t := req.toEntity()
+
+	if err := h.useCase.Create(r.Context(), t); err != nil {
+		writeTemplateError(w, err, "Error al crear plantilla de email")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(t))
+}
+
+// Get maneja la solicitud de obtención de una plantilla de email por ID
+// @Summary Obtener plantilla de email
+// @Description Obtiene una plantilla de email por su ID
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Success 200 {object} EmailTemplateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	t, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		writeTemplateError(w, err, "Error al obtener plantilla de email")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(t))
+}
+
+// Update maneja la solicitud de actualización de una plantilla de email
+// @Summary Actualizar plantilla de email
+// @Description Actualiza una plantilla de email existente
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Param template body EmailTemplateRequest true "Datos de la plantilla"
+// @Success 200 {object} EmailTemplateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req EmailTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	t := req.toEntity()
+	t.ID = id
+
+	if err := h.useCase.Update(r.Context(), t); err != nil {
+		writeTemplateError(w, err, "Error al actualizar plantilla de email")
+		return
+	}
+
+	updated, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		response.JSON(w, http.StatusOK, toResponse(t))
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(updated))
+}
+
+// Delete maneja la solicitud de eliminación de una plantilla de email
+// @Summary Eliminar plantilla de email
+// @Description Elimina una plantilla de email
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		writeTemplateError(w, err, "Error al eliminar plantilla de email")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Placeholders maneja la solicitud de los marcadores disponibles
+// @Summary Listar marcadores de plantillas
+// @Description Obtiene los marcadores que pueden usarse en las plantillas, p. ej. {{job.work_order}}
+// @Tags EmailTemplates
+// @Produce json
+// @Success 200 {array} string
+// @Router /api/v1/email-templates/placeholders [get]
+// @Security BearerAuth
+func (h *Handler) Placeholders(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, domainPlaceholder.Keys)
+}
+
+// Preview maneja la solicitud de previsualización de una plantilla con un job
+// @Summary Previsualizar plantilla de email
+// @Description Renderiza el asunto y el cuerpo de la plantilla con los datos del job indicado
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Param jobId query int true "ID del job"
+// @Success 200 {object} email_template.Rendered
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates/{id}/preview [get]
+// @Security BearerAuth
+func (h *Handler) Preview(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	jobID, err := strconv.ParseInt(r.URL.Query().Get("jobId"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	rendered, err := h.useCase.Preview(r.Context(), id, jobID)
+	if err != nil {
+		writeTemplateError(w, err, "Error al previsualizar plantilla de email")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, rendered)
+}
+
+// PreviewDraft maneja la solicitud de previsualización de una plantilla no guardada
+// @Summary Previsualizar borrador de plantilla de email
+// @Description Renderiza un asunto y un cuerpo aún no guardados con los datos del job indicado
+// @Tags EmailTemplates
+// @Accept json
+// @Produce json
+// @Param request body PreviewDraftRequest true "Borrador y job"
+// @Success 200 {object} email_template.Rendered
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/email-templates/preview [post]
+// @Security BearerAuth
+func (h *Handler) PreviewDraft(w http.ResponseWriter, r *http.Request) {
+	var req PreviewDraftRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	if req.JobID == 0 {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	rendered, err := h.useCase.PreviewDraft(r.Context(), req.Subject, req.Body, req.JobID)
+	if err != nil {
+		writeTemplateError(w, err, "Error al previsualizar plantilla de email")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, rendered)
+}
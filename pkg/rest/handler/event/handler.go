@@ -0,0 +1,179 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/event"
+	"github.com/your-org/jvairv2/pkg/rest/middleware"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+const (
+	// DefaultHeartbeat es el intervalo por defecto entre comentarios de keep-alive
+	DefaultHeartbeat = 25 * time.Second
+
+	// retryMillis es el tiempo que el navegador espera antes de reconectar
+	retryMillis = 3000
+)
+
+// Handler maneja el stream de eventos en tiempo real (Server-Sent Events)
+type Handler struct {
+	bus       *domain.Bus
+	heartbeat time.Duration
+}
+
+// NewHandler crea una nueva instancia del handler de eventos.
+// Con heartbeat 0 se usa DefaultHeartbeat.
+func NewHandler(bus *domain.Bus, heartbeat time.Duration) *Handler {
+	if heartbeat <= 0 {
+		heartbeat = DefaultHeartbeat
+	}
+
+	return &Handler{
+		bus:       bus,
+		heartbeat: heartbeat,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Get("/events", h.Stream)
+}
+
+// lastEventID obtiene el ID desde el que reanudar: la cabecera Last-Event-ID que envía
+// EventSource al reconectar o, en la primera conexión, el parámetro lastEventId
+func lastEventID(r *http.Request) int64 {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("lastEventId")
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0
+	}
+	return id
+}
+
+// subscriber arma el suscriptor con las habilidades del usuario autenticado
+func subscriber(r *http.Request, userID int64) domain.Subscriber {
+	ctx := r.Context()
+
+	abilities := make(map[string]bool, len(domain.Abilities))
+	for _, ability := range domain.Abilities {
+		abilities[ability] = middleware.HasAbility(ctx, ability)
+	}
+
+	// El comodín "*" concede todo, pero no debe restringir al usuario a sus propios jobs
+	assignedOnly := middleware.HasAbility(ctx, domain.AbilityJobViewUserOnly) && !middleware.HasAbility(ctx, "*")
+
+	return domain.Subscriber{
+		UserID:       userID,
+		Abilities:    abilities,
+		AssignedOnly: assignedOnly,
+	}
+}
+
+// writeEvent escribe un evento en formato SSE
+func writeEvent(w http.ResponseWriter, e *domain.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
+
+// Stream maneja la conexión al stream de eventos
+// @Summary Stream de eventos en tiempo real
+// @Description Abre un stream Server-Sent Events con los cambios de jobs (job.created, job.updated, job.closed), alertas nuevas del usuario (alert.raised) y pagos registrados (payment.recorded), filtrados según las habilidades y asignaciones del usuario. Al reasignar un job, el técnico anterior también recibe el job.updated (con previousUserId) para que lo quite de su vista. Envía heartbeats periódicos y reanuda desde la cabecera Last-Event-ID (o el parámetro lastEventId); si esos eventos ya no están disponibles envía un evento reset y el cliente debe recargar todo
+// @Tags Events
+// @Produce text/event-stream
+// @Param lastEventId query int false "ID del último evento recibido"
+// @Success 200 {string} string "Stream de eventos"
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 503 {object} response.ErrorResponse
+// @Router /api/v1/events [get]
+// @Security BearerAuth
+func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "Usuario no autenticado")
+		return
+	}
+
+	sub, replay, err := h.bus.Subscribe(subscriber(r, userID), lastEventID(r))
+	if err != nil {
+		response.Error(w, http.StatusServiceUnavailable, "Servidor apagándose")
+		return
+	}
+	defer sub.Close()
+
+	// El stream es de larga duración: se anula el WriteTimeout del servidor para esta conexión
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		slog.DebugContext(ctx, "Could not clear write deadline for event stream",
+			slog.String("error", err.Error()))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", retryMillis); err != nil {
+		return
+	}
+	for _, e := range replay {
+		if err := writeEvent(w, e); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		slog.ErrorContext(ctx, "Event stream not supported by response writer",
+			slog.String("error", err.Error()))
+		return
+	}
+
+	slog.InfoContext(ctx, "Event stream opened",
+		slog.Int64("userId", userID),
+		slog.Int("replayed", len(replay)))
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, open := <-sub.Events():
+			if !open {
+				// Apagado del servidor o cliente demasiado lento: se cierra y el cliente reconecta
+				slog.InfoContext(ctx, "Event stream closed by server",
+					slog.Int64("userId", userID))
+				return
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package file
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/common/storage"
+	domain "github.com/your-org/jvairv2/pkg/domain/file"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para archivos adjuntos
+type Handler struct {
+	useCase domain.Service
+	local   *storage.LocalStorage
+}
+
+// NewHandler crea una nueva instancia del handler de archivos.
+// local solo se indica con el driver local, para servir sus descargas firmadas.
+func NewHandler(useCase domain.Service, local *storage.LocalStorage) *Handler {
+	return &Handler{
+		useCase: useCase,
+		local:   local,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/files", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Upload)
+		r.Get("/{id}", h.Get)
+		r.Get("/{id}/download", h.Download)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// RegisterPublicRoutes registra la descarga de archivos del disco local. Es pública porque
+// la autorización va en la firma de la URL (como las URLs prefirmadas de S3).
+func (h *Handler) RegisterPublicRoutes(r chi.Router) {
+	if h.local == nil {
+		return
+	}
+	r.Get(storage.LocalRoute+"*", h.ServeLocal)
+}
+
+// writeFileError traduce los errores del dominio a respuestas HTTP
+func writeFileError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case domain.ErrFileNotFound:
+		response.Error(w, http.StatusNotFound, "Archivo no encontrado")
+	case domain.ErrVariantNotFound:
+		response.Error(w, http.StatusNotFound, "Variante no encontrada")
+	case domain.ErrFileableNotFound:
+		response.Error(w, http.StatusNotFound, "Entidad no encontrada")
+	case domain.ErrInvalidFileableType, domain.ErrFileRequired:
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case domain.ErrFileTooLarge:
+		response.Error(w, http.StatusRequestEntityTooLarge, err.Error())
+	case domain.ErrUnsupportedType:
+		response.Error(w, http.StatusUnsupportedMediaType, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+func parseID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+}
+
+// Upload maneja la subida de un archivo
+// @Summary Subir archivo
+// @Description Sube un archivo (multipart/form-data) y lo adjunta a un job, visita, garantía o reclamación de garantía. Se aceptan imágenes (incluido HEIC), videos MP4/MOV, PDF y texto. De las imágenes JPEG, PNG y GIF se eliminan los datos GPS y se generan las variantes thumbnail y web
+// @Tags Files
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Archivo"
+// @Param fileableType formData string true "Tipo de entidad (job, job_visit, warranty, warranty_claim)"
+// @Param fileableId formData int true "ID de la entidad"
+// @Param type formData string false "Etiqueta del archivo (p. ej. nameplate); por defecto image, video o document"
+// @Success 201 {object} file.File
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 413 {object} response.ErrorResponse
+// @Failure 415 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/files [post]
+// @Security BearerAuth
+func (h *Handler) Upload(w http.ResponseWriter, r *http.Request) {
+	// Margen de 1 MB para los demás campos del formulario
+	r.Body = http.MaxBytesReader(w, r.Body, h.useCase.MaxSize()+1<<20)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			writeFileError(w, domain.ErrFileTooLarge, "")
+			return
+		}
+		response.Error(w, http.StatusBadRequest, "Error al leer el formulario")
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	fileableID, err := strconv.ParseInt(r.FormValue("fileableId"), 10, 64)
+	if err != nil || fileableID <= 0 {
+		response.Error(w, http.StatusBadRequest, "fileableId inválido")
+		return
+	}
+
+	upload, header, err := r.FormFile("file")
+	if err != nil {
+		writeFileError(w, domain.ErrFileRequired, "")
+		return
+	}
+	defer func() { _ = upload.Close() }()
+
+	req := &domain.UploadRequest{
+		FileableType: r.FormValue("fileableType"),
+		FileableID:   fileableID,
+		Filename:     header.Filename,
+		Size:         header.Size,
+		Body:         upload,
+	}
+	if fileType := r.FormValue("type"); fileType != "" {
+		req.Type = &fileType
+	}
+
+	f, err := h.useCase.Upload(r.Context(), req)
+	if err != nil {
+		writeFileError(w, err, "Error al subir archivo")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, f)
+}
+
+// List maneja el listado de archivos de una entidad
+// @Summary Listar archivos de una entidad
+// @Description Obtiene los archivos adjuntos a una entidad, más recientes primero, con URLs de descarga firmadas
+// @Tags Files
+// @Accept json
+// @Produce json
+// @Param fileableType query string true "Tipo de entidad (job, job_visit, warranty, warranty_claim)"
+// @Param fileableId query int true "ID de la entidad"
+// @Success 200 {array} file.File
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/files [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	fileableID, err := strconv.ParseInt(r.URL.Query().Get("fileableId"), 10, 64)
+	if err != nil || fileableID <= 0 {
+		response.Error(w, http.StatusBadRequest, "fileableId inválido")
+		return
+	}
+
+	files, err := h.useCase.ListByFileable(r.Context(), r.URL.Query().Get("fileableType"), fileableID)
+	if err != nil {
+		writeFileError(w, err, "Error al listar archivos")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, files)
+}
+
+// Get maneja la obtención de un archivo
+// @Summary Obtener archivo
+// @Description Obtiene un archivo con su URL de descarga firmada
+// @Tags Files
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del archivo"
+// @Success 200 {object} file.File
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/v1/files/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	f, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		writeFileError(w, err, "Error al obtener archivo")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, f)
+}
+
+// Download redirige a la URL de descarga firmada del archivo o de una de sus variantes
+// @Summary Descargar archivo
+// @Description Redirige (302) a una URL de descarga firmada y temporal del archivo. Con variant se descarga la miniatura o la versión web de una imagen
+// @Tags Files
+// @Param id path int true "ID del archivo"
+// @Param variant query string false "Variante (thumbnail, web)"
+// @Success 302
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/v1/files/{id}/download [get]
+// @Security BearerAuth
+func (h *Handler) Download(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	url, err := h.useCase.GetDownloadURL(r.Context(), id, r.URL.Query().Get("variant"))
+	if err != nil {
+		writeFileError(w, err, "Error al obtener archivo")
+		return
+	}
+
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+// Delete maneja la eliminación de un archivo
+// @Summary Eliminar archivo
+// @Description Elimina un archivo adjunto y su contenido almacenado
+// @Tags Files
+// @Param id path int true "ID del archivo"
+// @Success 204
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/files/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		writeFileError(w, err, "Error al eliminar archivo")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeLocal sirve un archivo del disco local validando la firma de la URL
+func (h *Handler) ServeLocal(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "*")
+	query := r.URL.Query()
+
+	if err := h.local.Verify(key, query.Get("expires"), query.Get("signature")); err != nil {
+		response.Error(w, http.StatusForbidden, "URL de descarga inválida o expirada")
+		return
+	}
+
+	content, err := h.local.Get(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			response.Error(w, http.StatusNotFound, "Archivo no encontrado")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to open stored file",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al descargar archivo")
+		return
+	}
+	defer func() { _ = content.Close() }()
+
+	if contentType := mime.TypeByExtension(strings.ToLower(path.Ext(key))); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	if _, err := io.Copy(w, content); err != nil {
+		slog.WarnContext(r.Context(), "Failed to stream stored file",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+	}
+}
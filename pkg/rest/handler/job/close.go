@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	domainJob "github.com/your-org/jvairv2/pkg/domain/job"
@@ -13,7 +14,8 @@ import (
 
 // Close maneja la solicitud de cierre de un job
 // @Summary Cerrar trabajo
-// @Description Cierra un trabajo estableciendo closed=true y actualizando el job_status_id
+// @Description Cierra un trabajo estableciendo closed=true y actualizando el job_status_id.
+// @Description Opcionalmente copia los equipos nuevos a la propiedad (updatePropertyEquipment) y crea una garantía con esos equipos (warranty), todo en una misma transacción
 // @Tags Jobs
 // @Accept json
 // @Produce json
@@ -21,6 +23,7 @@ import (
 // @Param close body CloseJobRequest true "Datos para cerrar el trabajo"
 // @Success 200 {object} JobResponse
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 409 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
@@ -41,15 +44,49 @@ func (h *Handler) Close(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.useCase.Close(r.Context(), id, req.JobStatusID); err != nil {
+	opts := domainJob.CloseOptions{
+		UpdatePropertyEquipment: req.UpdatePropertyEquipment,
+	}
+	if req.Warranty != nil {
+		opts.Warranty = &domainJob.CloseWarranty{
+			WarrantyNumber:   req.Warranty.WarrantyNumber,
+			WarrantyTypeID:   req.Warranty.WarrantyTypeID,
+			WarrantyStatusID: req.Warranty.WarrantyStatusID,
+			AgreementNumber:  req.Warranty.AgreementNumber,
+			Notes:            req.Warranty.Notes,
+		}
+		if req.Warranty.DateSubmitted != nil && *req.Warranty.DateSubmitted != "" {
+			t, err := time.Parse("01-02-2006", *req.Warranty.DateSubmitted)
+			if err != nil {
+				t, err = time.Parse("2006-01-02", *req.Warranty.DateSubmitted)
+				if err != nil {
+					response.Error(w, http.StatusBadRequest, "Formato de fecha de envío de garantía inválido")
+					return
+				}
+			}
+			opts.Warranty.DateSubmitted = &t
+		}
+	}
+
+	if err := h.useCase.Close(r.Context(), id, req.JobStatusID, opts); err != nil {
 		switch err {
 		case domainJob.ErrJobNotFound:
 			response.Error(w, http.StatusNotFound, "Trabajo no encontrado")
+		case domainJob.ErrJobForbidden:
+			response.Error(w, http.StatusForbidden, "No tiene permisos sobre este trabajo")
 		case domainJob.ErrJobAlreadyClosed:
 			response.Error(w, http.StatusConflict, "El trabajo ya está cerrado")
 		case domainJob.ErrInvalidJobStatus:
 			response.Error(w, http.StatusBadRequest, "Estado de trabajo inválido")
+		case domainJob.ErrInvalidWarrantyType,
+			domainJob.ErrInvalidWarrantyStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
 		default:
+			if err.Error() == "warranty_type_id is required" ||
+				err.Error() == "warranty_status_id is required" {
+				response.Error(w, http.StatusBadRequest, err.Error())
+				return
+			}
 			slog.ErrorContext(r.Context(), "Failed to close job",
 				slog.Int64("id", id),
 				slog.String("error", err.Error()))
@@ -107,7 +107,8 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 			domainJob.ErrInvalidUser,
 			domainJob.ErrInvalidWorkflow,
 			domainJob.ErrInvalidJobStatus,
-			domainJob.ErrWorkflowHasNoStatuses:
+			domainJob.ErrWorkflowHasNoStatuses,
+			domainJob.ErrWarrantyClaimRequired:
 			response.Error(w, http.StatusBadRequest, err.Error())
 		default:
 			if err.Error() == "job_category_id is required" ||
@@ -19,6 +19,7 @@ import (
 // @Param id path int true "ID del trabajo"
 // @Success 200 {object} JobResponse
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/jobs/{id} [get]
@@ -36,6 +37,10 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 			response.Error(w, http.StatusNotFound, "Trabajo no encontrado")
 			return
 		}
+		if err == domainJob.ErrJobForbidden {
+			response.Error(w, http.StatusForbidden, "No tiene permisos sobre este trabajo")
+			return
+		}
 		slog.ErrorContext(r.Context(), "Failed to get job",
 			slog.Int64("id", id),
 			slog.String("error", err.Error()))
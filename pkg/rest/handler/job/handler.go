@@ -91,7 +91,19 @@ type UpdateJobRequest struct {
 
 // CloseJobRequest representa la solicitud para cerrar un job
 type CloseJobRequest struct {
-	JobStatusID int64 `json:"jobStatusId"`
+	JobStatusID             int64                 `json:"jobStatusId"`
+	UpdatePropertyEquipment bool                  `json:"updatePropertyEquipment"`
+	Warranty                *CloseWarrantyRequest `json:"warranty,omitempty"`
+}
+
+// CloseWarrantyRequest representa la garantía a crear al cerrar un job
+type CloseWarrantyRequest struct {
+	WarrantyNumber   string  `json:"warrantyNumber,omitempty"`
+	WarrantyTypeID   int64   `json:"warrantyTypeId"`
+	WarrantyStatusID int64   `json:"warrantyStatusId"`
+	DateSubmitted    *string `json:"dateSubmitted,omitempty"`
+	AgreementNumber  *string `json:"agreementNumber,omitempty"`
+	Notes            *string `json:"notes,omitempty"`
 }
 
 // JobResponse representa la respuesta de un job
@@ -129,6 +141,17 @@ type JobResponse struct {
 	CallAttempted         bool     `json:"callAttempted"`
 	CreatedAt             string   `json:"createdAt,omitempty"`
 	UpdatedAt             string   `json:"updatedAt,omitempty"`
+
+	Residents []JobResidentResponse `json:"residents,omitempty"`
+}
+
+// JobResidentResponse representa un contacto en sitio incluido en el detalle del job
+type JobResidentResponse struct {
+	ID          int64   `json:"id" example:"1"`
+	Name        string  `json:"name" example:"Jane Doe"`
+	MobilePhone *string `json:"mobilePhone,omitempty" example:"(555) 123-4567"`
+	HomePhone   *string `json:"homePhone,omitempty" example:"(555) 765-4321"`
+	Email       *string `json:"email,omitempty" example:"jane@example.com"`
 }
 
 const timeFormat = "2006-01-02T15:04:05Z07:00"
@@ -186,6 +209,18 @@ func toJobResponse(j *domainJob.Job) JobResponse {
 	if j.UpdatedAt != nil {
 		resp.UpdatedAt = j.UpdatedAt.Format(timeFormat)
 	}
+	if j.Residents != nil {
+		resp.Residents = make([]JobResidentResponse, len(j.Residents))
+		for i, res := range j.Residents {
+			resp.Residents[i] = JobResidentResponse{
+				ID:          res.ID,
+				Name:        res.Name,
+				MobilePhone: res.MobilePhone,
+				HomePhone:   res.HomePhone,
+				Email:       res.Email,
+			}
+		}
+	}
 
 	return resp
 }
@@ -16,7 +16,7 @@ import (
 // @Produce json
 // @Param page query int false "Número de página" default(1)
 // @Param pageSize query int false "Tamaño de página" default(10)
-// @Param search query string false "Búsqueda en work_order, property, customer"
+// @Param search query string false "Búsqueda en work_order, property, customer y residentes (nombre, teléfonos, email)"
 // @Param closed query string false "Filtrar por cerrado: 0, 1, all" default(0)
 // @Param jobCategoryId query int false "Filtrar por categoría de trabajo"
 // @Param jobStatusId query int false "Filtrar por estado de trabajo"
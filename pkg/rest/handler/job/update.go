@@ -22,6 +22,7 @@ import (
 // @Param job body UpdateJobRequest true "Datos del trabajo"
 // @Success 200 {object} JobResponse
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/jobs/{id} [put]
@@ -48,6 +49,10 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 			response.Error(w, http.StatusNotFound, "Trabajo no encontrado")
 			return
 		}
+		if err == domainJob.ErrJobForbidden {
+			response.Error(w, http.StatusForbidden, "No tiene permisos sobre este trabajo")
+			return
+		}
 		response.Error(w, http.StatusInternalServerError, "Error al obtener trabajo")
 		return
 	}
@@ -188,12 +193,15 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case domainJob.ErrJobNotFound:
 			response.Error(w, http.StatusNotFound, "Trabajo no encontrado")
+		case domainJob.ErrJobForbidden:
+			response.Error(w, http.StatusForbidden, "No tiene permisos sobre este trabajo")
 		case domainJob.ErrInvalidJobCategory,
 			domainJob.ErrInvalidJobPriority,
 			domainJob.ErrInvalidJobStatus,
 			domainJob.ErrInvalidWorkflow,
 			domainJob.ErrInvalidUser,
-			domainJob.ErrInvalidTechnicianJobStatus:
+			domainJob.ErrInvalidTechnicianJobStatus,
+			domainJob.ErrWarrantyClaimRequired:
 			response.Error(w, http.StatusBadRequest, err.Error())
 		default:
 			response.Error(w, http.StatusInternalServerError, "Error al actualizar trabajo")
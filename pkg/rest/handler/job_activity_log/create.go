@@ -0,0 +1,65 @@
+package job_activity_log
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Create maneja la solicitud de creación de una nota en la bitácora de un job
+// @Summary Crear nota de job
+// @Description Agrega una nota a la bitácora del job a nombre del usuario autenticado
+// @Tags Job Activities
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param activity body CreateActivityRequest true "Texto de la nota"
+// @Success 201 {object} ActivityResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/activities [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	var req CreateActivityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.WarnContext(r.Context(), "Invalid request body",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	activity := &domainActivity.JobActivityLog{
+		JobID: jobID,
+		Type:  domainActivity.TypeNote,
+		Log:   req.Log,
+	}
+
+	if err := h.useCase.Create(r.Context(), activity); err != nil {
+		switch err {
+		case domainActivity.ErrInvalidJob:
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+		case domainActivity.ErrUserRequired:
+			response.Error(w, http.StatusUnauthorized, "No autorizado")
+		default:
+			if err.Error() == "log is required" {
+				response.Error(w, http.StatusBadRequest, err.Error())
+			} else {
+				response.Error(w, http.StatusInternalServerError, "Error al crear actividad")
+			}
+		}
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toActivityResponse(activity))
+}
@@ -0,0 +1,53 @@
+package job_activity_log
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Delete maneja la solicitud de eliminación de una entrada de la bitácora
+// @Summary Eliminar actividad de job
+// @Description Elimina una entrada de la bitácora del job
+// @Tags Job Activities
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la actividad"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/activities/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de actividad inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), jobID, id); err != nil {
+		if err == domainActivity.ErrActivityNotFound {
+			response.Error(w, http.StatusNotFound, "Actividad no encontrada")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to delete job activity",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar actividad")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
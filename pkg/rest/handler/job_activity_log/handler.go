@@ -0,0 +1,91 @@
+package job_activity_log
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+)
+
+// Handler maneja las peticiones HTTP para la bitácora de actividad de jobs
+type Handler struct {
+	useCase domainActivity.Service
+}
+
+// NewHandler crea una nueva instancia del handler de actividades de jobs
+func NewHandler(useCase domainActivity.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/jobs/{jobId}/activities", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// CreateActivityRequest representa la solicitud para crear una nota en la bitácora
+type CreateActivityRequest struct {
+	Log string `json:"log" example:"Se llamó al residente para confirmar la visita"`
+}
+
+// ActivityResponse representa la respuesta de una entrada de la bitácora
+type ActivityResponse struct {
+	ID        int64   `json:"id"`
+	JobID     int64   `json:"jobId"`
+	Type      string  `json:"type"`
+	Log       string  `json:"log"`
+	UserID    int64   `json:"userId"`
+	UserName  *string `json:"userName,omitempty"`
+	CreatedAt string  `json:"createdAt,omitempty"`
+	UpdatedAt string  `json:"updatedAt,omitempty"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toActivityResponse(a *domainActivity.JobActivityLog) ActivityResponse {
+	resp := ActivityResponse{
+		ID:       a.ID,
+		JobID:    a.JobID,
+		Type:     a.Type,
+		Log:      a.Log,
+		UserID:   a.UserID,
+		UserName: a.UserName,
+	}
+
+	if a.CreatedAt != nil {
+		resp.CreatedAt = a.CreatedAt.Format(timeFormat)
+	}
+	if a.UpdatedAt != nil {
+		resp.UpdatedAt = a.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	if logType := r.URL.Query().Get("type"); logType != "" {
+		filters["type"] = logType
+	}
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	if direction := r.URL.Query().Get("direction"); direction != "" {
+		filters["direction"] = direction
+	}
+
+	return filters
+}
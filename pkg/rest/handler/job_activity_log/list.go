@@ -0,0 +1,75 @@
+package job_activity_log
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	domainActivity "github.com/your-org/jvairv2/pkg/domain/job_activity_log"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// List maneja la solicitud de listado de la bitácora de un job
+// @Summary Listar actividades de job
+// @Description Obtiene la bitácora de actividad (notas y eventos automáticos) de un job, de la más reciente a la más antigua
+// @Tags Job Activities
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param type query string false "Filtrar por tipo: note, job_created, job_updated, job_closed"
+// @Param search query string false "Búsqueda en el texto de la actividad"
+// @Param direction query string false "Dirección de ordenamiento por fecha: asc, desc" default(desc)
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/activities [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	filters := parseFilters(r)
+
+	activities, total, err := h.useCase.ListByJobID(r.Context(), jobID, filters, page, pageSize)
+	if err != nil {
+		if err == domainActivity.ErrInvalidJob {
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to list job activities",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al listar actividades")
+		return
+	}
+
+	items := make([]ActivityResponse, len(activities))
+	for i, a := range activities {
+		items[i] = toActivityResponse(a)
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+
+	response.JSON(w, http.StatusOK, response.PaginatedResponse{
+		Items:      items,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	})
+}
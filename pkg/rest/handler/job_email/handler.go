@@ -0,0 +1,171 @@
+package job_email
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/job_email"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para correos de jobs
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de correos de jobs
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Put("/jobs/{jobId}/dispatch", h.Dispatch)
+	r.Put("/jobs/{jobId}/dispatch-supervisor", h.DispatchSupervisor)
+	r.Get("/jobs/{jobId}/emails", h.List)
+}
+
+// DispatchRequest representa la solicitud de envío de un correo de despacho
+type DispatchRequest struct {
+	TemplateID *int64 `json:"templateId,omitempty" example:"1"`
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+// writeEmailError traduce los errores del dominio a respuestas HTTP
+func writeEmailError(w http.ResponseWriter, err error) {
+	switch err {
+	case domain.ErrInvalidJob:
+		response.Error(w, http.StatusNotFound, "Job no encontrado")
+	case domain.ErrForbidden:
+		response.Error(w, http.StatusForbidden, "No tiene permiso para despachar este job")
+	case domain.ErrTemplateNotFound:
+		response.Error(w, http.StatusNotFound, "Plantilla de email no encontrada")
+	case domain.ErrInvalidType, domain.ErrNoTechnician, domain.ErrNoSupervisors:
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case domain.ErrSendFailed:
+		response.Error(w, http.StatusBadGateway, "No se pudo enviar el correo")
+	default:
+		response.Error(w, http.StatusInternalServerError, "Error al procesar correo del job")
+	}
+}
+
+// Dispatch maneja la solicitud de envío del correo de despacho al técnico
+// @Summary Enviar correo de despacho al técnico
+// @Description Envía el correo de despacho al técnico asignado al job, con el texto por defecto o una plantilla de email, y lo registra en el historial
+// @Tags Job Emails
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param request body DispatchRequest false "Plantilla opcional"
+// @Success 200 {object} job_email.JobEmail
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 502 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/dispatch [put]
+// @Security BearerAuth
+func (h *Handler) Dispatch(w http.ResponseWriter, r *http.Request) {
+	h.dispatch(w, r, domain.TypeDispatch)
+}
+
+// DispatchSupervisor maneja la solicitud de envío del correo de despacho a los supervisores
+// @Summary Enviar correo de despacho a supervisores
+// @Description Envía el correo de despacho a los supervisores del cliente asignados al job (supervisorIds), con el texto por defecto o una plantilla de email, y lo registra en el historial
+// @Tags Job Emails
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param request body DispatchRequest false "Plantilla opcional"
+// @Success 200 {object} job_email.JobEmail
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 502 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/dispatch-supervisor [put]
+// @Security BearerAuth
+func (h *Handler) DispatchSupervisor(w http.ResponseWriter, r *http.Request) {
+	h.dispatch(w, r, domain.TypeDispatchSupervisor)
+}
+
+func (h *Handler) dispatch(w http.ResponseWriter, r *http.Request, emailType string) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	// El cuerpo es opcional
+	var req DispatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	email, err := h.useCase.Dispatch(r.Context(), &domain.DispatchRequest{
+		JobID:      jobID,
+		Type:       emailType,
+		TemplateID: req.TemplateID,
+	})
+	if err != nil {
+		writeEmailError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, email)
+}
+
+// List maneja la solicitud del historial de correos de un job
+// @Summary Historial de correos de job
+// @Description Obtiene los correos enviados desde un job, del más reciente al más antiguo
+// @Tags Job Emails
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/emails [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	emails, total, err := h.useCase.ListByJobID(r.Context(), jobID, page, pageSize)
+	if err != nil {
+		writeEmailError(w, err)
+		return
+	}
+
+	if emails == nil {
+		emails = []*domain.JobEmail{}
+	}
+
+	response.Paginated(w, emails, page, pageSize, total)
+}
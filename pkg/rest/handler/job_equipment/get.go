@@ -23,6 +23,15 @@ import (
 // @Router /jobs/{jobId}/equipment/{id} [get]
 // @Security BearerAuth
 func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	jobIDStr := chi.URLParam(r, "jobId")
+	jobID, err := strconv.ParseInt(jobIDStr, 10, 64)
+	if err != nil {
+		slog.WarnContext(r.Context(), "Invalid job ID",
+			slog.String("jobId", jobIDStr))
+		response.Error(w, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
@@ -32,7 +41,7 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	equipment, err := h.useCase.GetByID(r.Context(), id)
+	equipment, err := h.useCase.GetByID(r.Context(), id, jobID)
 	if err != nil {
 		if err.Error() == "job equipment not found" {
 			response.Error(w, http.StatusNotFound, "Job equipment not found")
@@ -101,6 +101,6 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedEq, _ := h.useCase.GetByID(r.Context(), id)
+	updatedEq, _ := h.useCase.GetByID(r.Context(), id, jobID)
 	response.JSON(w, http.StatusOK, toResponse(updatedEq))
 }
@@ -0,0 +1,80 @@
+package job_history
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+)
+
+// Handler maneja las peticiones HTTP para el historial de cambios de jobs
+type Handler struct {
+	useCase domainHistory.Service
+}
+
+// NewHandler crea una nueva instancia del handler de historial de jobs
+func NewHandler(useCase domainHistory.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/jobs/{jobId}/history", func(r chi.Router) {
+		r.Get("/", h.List)
+	})
+}
+
+// HistoryResponse representa la respuesta de una entrada del historial
+type HistoryResponse struct {
+	ID        int64   `json:"id"`
+	JobID     int64   `json:"jobId"`
+	UserID    *int64  `json:"userId,omitempty"`
+	UserName  *string `json:"userName,omitempty"`
+	Field     string  `json:"field"`
+	OldValue  *string `json:"oldValue"`
+	NewValue  *string `json:"newValue"`
+	CreatedAt string  `json:"createdAt,omitempty"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toHistoryResponse(e *domainHistory.JobHistory) HistoryResponse {
+	resp := HistoryResponse{
+		ID:       e.ID,
+		JobID:    e.JobID,
+		UserID:   e.UserID,
+		UserName: e.UserName,
+		Field:    e.Field,
+		OldValue: e.OldValue,
+		NewValue: e.NewValue,
+	}
+
+	if e.CreatedAt != nil {
+		resp.CreatedAt = e.CreatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	if field := r.URL.Query().Get("field"); field != "" {
+		filters["field"] = field
+	}
+
+	if userID := r.URL.Query().Get("userId"); userID != "" {
+		if id, err := strconv.ParseInt(userID, 10, 64); err == nil {
+			filters["user_id"] = id
+		}
+	}
+
+	return filters
+}
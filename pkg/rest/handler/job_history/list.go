@@ -0,0 +1,74 @@
+package job_history
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	domainHistory "github.com/your-org/jvairv2/pkg/domain/job_history"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// List maneja la solicitud de listado del historial de cambios de un job
+// @Summary Historial de cambios de job
+// @Description Obtiene el historial de cambios a nivel de campo de un job (status, técnico asignado, fechas, notas y precios), del más reciente al más antiguo
+// @Tags Job History
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param field query string false "Filtrar por campo (ej. user_id, job_status_id, job_sales_price)"
+// @Param userId query int false "Filtrar por usuario que realizó el cambio"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/history [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	filters := parseFilters(r)
+
+	entries, total, err := h.useCase.ListByJobID(r.Context(), jobID, filters, page, pageSize)
+	if err != nil {
+		if err == domainHistory.ErrInvalidJob {
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to list job history",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al obtener historial")
+		return
+	}
+
+	items := make([]HistoryResponse, len(entries))
+	for i, e := range entries {
+		items[i] = toHistoryResponse(e)
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+
+	response.JSON(w, http.StatusOK, response.PaginatedResponse{
+		Items:      items,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	})
+}
@@ -0,0 +1,415 @@
+package job_rate
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/common/money"
+	domain "github.com/your-org/jvairv2/pkg/domain/job_rate"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para tarifas de jobs
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de tarifas de jobs
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Post("/calculate-rate-payment", h.Calculate)
+	r.Route("/jobs/{jobId}/rates", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// CalculateRequest representa los montos con los que se calcula un pago
+type CalculateRequest struct {
+	SalePrice    money.Amount `json:"salePrice" swaggertype:"number" example:"1250.00"`
+	RatePercent  money.Amount `json:"ratePercent" swaggertype:"number" example:"12.5"`
+	RateFlat     money.Amount `json:"rateFlat" swaggertype:"number" example:"25.00"`
+	TechParts    money.Amount `json:"techParts" swaggertype:"number" example:"100.10"`
+	CompanyParts money.Amount `json:"companyParts" swaggertype:"number" example:"49.90"`
+	Deduction    money.Amount `json:"deduction" swaggertype:"number" example:"10.00"`
+}
+
+// CalculateResponse representa el desglose de un pago calculado
+type CalculateResponse struct {
+	NetSale    money.Amount `json:"netSale" swaggertype:"number" example:"1100.00"`
+	Commission money.Amount `json:"commission" swaggertype:"number" example:"137.50"`
+	RateFlat   money.Amount `json:"rateFlat" swaggertype:"number" example:"25.00"`
+	Deduction  money.Amount `json:"deduction" swaggertype:"number" example:"10.00"`
+	Payment    money.Amount `json:"payment" swaggertype:"number" example:"152.50"`
+}
+
+// RateRequest representa la solicitud para crear o actualizar una tarifa
+type RateRequest struct {
+	CalculateRequest
+	UserID          int64   `json:"userId" example:"5"`
+	JobRateStatusID int64   `json:"jobRateStatusId" example:"1"`
+	PartsReplaced   *string `json:"partsReplaced,omitempty" example:"Capacitor 45/5"`
+	Notes           *string `json:"notes,omitempty" example:"Pago por instalación"`
+}
+
+// RateResponse representa la respuesta de una tarifa
+type RateResponse struct {
+	ID                 int64        `json:"id" example:"1"`
+	JobID              int64        `json:"jobId" example:"100"`
+	UserID             int64        `json:"userId" example:"5"`
+	UserName           *string      `json:"userName,omitempty" example:"John Tech"`
+	JobRateStatusID    int64        `json:"jobRateStatusId" example:"1"`
+	JobRateStatusLabel *string      `json:"jobRateStatusLabel,omitempty" example:"Approved"`
+	JobRateStatusClass *string      `json:"jobRateStatusClass,omitempty" example:"green"`
+	SalePrice          money.Amount `json:"salePrice" swaggertype:"number" example:"1250.00"`
+	RatePercent        money.Amount `json:"ratePercent" swaggertype:"number" example:"12.5"`
+	RateFlat           money.Amount `json:"rateFlat" swaggertype:"number" example:"25.00"`
+	TechParts          money.Amount `json:"techParts" swaggertype:"number" example:"100.10"`
+	CompanyParts       money.Amount `json:"companyParts" swaggertype:"number" example:"49.90"`
+	PartsReplaced      *string      `json:"partsReplaced,omitempty" example:"Capacitor 45/5"`
+	Deduction          money.Amount `json:"deduction" swaggertype:"number" example:"10.00"`
+	Payment            money.Amount `json:"payment" swaggertype:"number" example:"152.50"`
+	Paid               bool         `json:"paid" example:"false"`
+	Held               bool         `json:"held" example:"false"`
+	PaidAt             string       `json:"paidAt,omitempty" example:"2024-01-31T18:00:00Z"`
+	Notes              *string      `json:"notes,omitempty" example:"Pago por instalación"`
+	CreatedAt          string       `json:"createdAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt          string       `json:"updatedAt,omitempty" example:"2024-01-18T14:20:00Z"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toResponse(e *domain.JobRate) RateResponse {
+	resp := RateResponse{
+		ID:                 e.ID,
+		JobID:              e.JobID,
+		UserID:             e.UserID,
+		UserName:           e.UserName,
+		JobRateStatusID:    e.JobRateStatusID,
+		JobRateStatusLabel: e.JobRateStatusLabel,
+		JobRateStatusClass: e.JobRateStatusClass,
+		SalePrice:          e.SalePrice,
+		RatePercent:        e.RatePercent,
+		RateFlat:           e.RateFlat,
+		TechParts:          e.TechParts,
+		CompanyParts:       e.CompanyParts,
+		PartsReplaced:      e.PartsReplaced,
+		Deduction:          e.Deduction,
+		Payment:            e.Payment,
+		Paid:               e.Paid,
+		Held:               e.Held,
+		Notes:              e.Notes,
+	}
+
+	if e.PaidAt != nil {
+		resp.PaidAt = e.PaidAt.Format(timeFormat)
+	}
+	if e.CreatedAt != nil {
+		resp.CreatedAt = e.CreatedAt.Format(timeFormat)
+	}
+	if e.UpdatedAt != nil {
+		resp.UpdatedAt = e.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func (req *CalculateRequest) toInput() domain.PaymentInput {
+	return domain.PaymentInput{
+		SalePrice:    req.SalePrice,
+		RatePercent:  req.RatePercent,
+		RateFlat:     req.RateFlat,
+		TechParts:    req.TechParts,
+		CompanyParts: req.CompanyParts,
+		Deduction:    req.Deduction,
+	}
+}
+
+func (req *RateRequest) toEntity(jobID int64) *domain.JobRate {
+	return &domain.JobRate{
+		JobID:           jobID,
+		UserID:          req.UserID,
+		JobRateStatusID: req.JobRateStatusID,
+		SalePrice:       req.SalePrice,
+		RatePercent:     req.RatePercent,
+		RateFlat:        req.RateFlat,
+		TechParts:       req.TechParts,
+		CompanyParts:    req.CompanyParts,
+		PartsReplaced:   req.PartsReplaced,
+		Deduction:       req.Deduction,
+		Notes:           req.Notes,
+	}
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+func parseIDs(r *http.Request) (int64, int64, error) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return jobID, id, nil
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "job_id is required",
+		"user_id is required",
+		"job_rate_status_id is required",
+		"rate_percent cannot be greater than 100":
+		return true
+	}
+	return strings.HasSuffix(err.Error(), "cannot be negative")
+}
+
+// writeRateError traduce los errores del dominio a respuestas HTTP
+func writeRateError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	switch {
+	case err == domain.ErrRateNotFound:
+		response.Error(w, http.StatusNotFound, "Tarifa no encontrada")
+	case err == domain.ErrInvalidJob:
+		response.Error(w, http.StatusNotFound, "Job no encontrado")
+	case err == domain.ErrRateAlreadyPaid:
+		response.Error(w, http.StatusConflict, "La tarifa ya fue pagada y no puede modificarse")
+	case err == domain.ErrInvalidUser, err == domain.ErrInvalidJobRateStatus, isValidationError(err):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		slog.ErrorContext(r.Context(), fallback,
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// Calculate maneja la solicitud de cálculo del pago de una tarifa
+// @Summary Calcular pago de tarifa
+// @Description Calcula el pago de un técnico sin guardarlo: ((precio de venta - partes del técnico - partes de la compañía) * porcentaje / 100) + monto fijo - deducción
+// @Tags Job Rates
+// @Accept json
+// @Produce json
+// @Param rate body CalculateRequest true "Montos de la tarifa"
+// @Success 200 {object} CalculateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/v1/calculate-rate-payment [post]
+// @Security BearerAuth
+func (h *Handler) Calculate(w http.ResponseWriter, r *http.Request) {
+	var req CalculateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	b, err := h.useCase.Calculate(r.Context(), req.toInput())
+	if err != nil {
+		writeRateError(w, r, err, "Error al calcular el pago")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CalculateResponse{
+		NetSale:    b.NetSale,
+		Commission: b.Commission,
+		RateFlat:   b.RateFlat,
+		Deduction:  b.Deduction,
+		Payment:    b.Payment,
+	})
+}
+
+// List maneja la solicitud de listado de tarifas de un job
+// @Summary Listar tarifas de job
+// @Description Obtiene las tarifas (pagos a técnicos) registradas en un job
+// @Tags Job Rates
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Success 200 {array} RateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/rates [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	rates, err := h.useCase.ListByJobID(r.Context(), jobID)
+	if err != nil {
+		writeRateError(w, r, err, "Error al listar tarifas")
+		return
+	}
+
+	items := make([]RateResponse, len(rates))
+	for i, e := range rates {
+		items[i] = toResponse(e)
+	}
+
+	response.JSON(w, http.StatusOK, items)
+}
+
+// Create maneja la solicitud de creación de una tarifa
+// @Summary Crear tarifa de job
+// @Description Registra lo que gana un técnico por un job. El pago se calcula a partir de los montos
+// @Tags Job Rates
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param rate body RateRequest true "Datos de la tarifa"
+// @Success 201 {object} RateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/rates [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	var req RateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	e := req.toEntity(jobID)
+
+	if err := h.useCase.Create(r.Context(), e); err != nil {
+		writeRateError(w, r, err, "Error al crear tarifa")
+		return
+	}
+
+	created, err := h.useCase.GetByID(r.Context(), jobID, e.ID)
+	if err != nil {
+		created = e
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(created))
+}
+
+// Get maneja la solicitud de obtención de una tarifa
+// @Summary Obtener tarifa de job
+// @Description Obtiene una tarifa de un job por su ID
+// @Tags Job Rates
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarifa"
+// @Success 200 {object} RateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/rates/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	e, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		writeRateError(w, r, err, "Error al obtener tarifa")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(e))
+}
+
+// Update maneja la solicitud de actualización de una tarifa
+// @Summary Actualizar tarifa de job
+// @Description Actualiza una tarifa y recalcula su pago. Las tarifas pagadas no pueden modificarse
+// @Tags Job Rates
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarifa"
+// @Param rate body RateRequest true "Datos de la tarifa"
+// @Success 200 {object} RateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/rates/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req RateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	e := req.toEntity(jobID)
+	e.ID = id
+
+	if err := h.useCase.Update(r.Context(), e); err != nil {
+		writeRateError(w, r, err, "Error al actualizar tarifa")
+		return
+	}
+
+	updated, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		response.JSON(w, http.StatusOK, toResponse(e))
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(updated))
+}
+
+// Delete maneja la solicitud de eliminación de una tarifa
+// @Summary Eliminar tarifa de job
+// @Description Elimina una tarifa de un job (soft delete). Las tarifas pagadas no pueden eliminarse
+// @Tags Job Rates
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarifa"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/rates/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), jobID, id); err != nil {
+		writeRateError(w, r, err, "Error al eliminar tarifa")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
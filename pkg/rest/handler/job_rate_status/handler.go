@@ -0,0 +1,265 @@
+package job_rate_status
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/domain/job_rate_status"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+type Handler struct {
+	useCase job_rate_status.Service
+}
+
+func NewHandler(useCase job_rate_status.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/job-rate-statuses", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// CreateJobRateStatusRequest representa la solicitud para crear un estado de tarifa
+type CreateJobRateStatusRequest struct {
+	Label string  `json:"label" validate:"required"`
+	Class *string `json:"class,omitempty"`
+	Order int     `json:"order"`
+}
+
+// UpdateJobRateStatusRequest representa la solicitud para actualizar un estado de tarifa
+type UpdateJobRateStatusRequest struct {
+	Label string  `json:"label" validate:"required"`
+	Class *string `json:"class,omitempty"`
+	Order int     `json:"order"`
+}
+
+// JobRateStatusResponse representa la respuesta de un estado de tarifa
+type JobRateStatusResponse struct {
+	ID        int64   `json:"id"`
+	Label     string  `json:"label"`
+	Class     *string `json:"class,omitempty"`
+	Order     int     `json:"order"`
+	CreatedAt string  `json:"createdAt,omitempty"`
+	UpdatedAt string  `json:"updatedAt,omitempty"`
+}
+
+func toResponse(s *job_rate_status.JobRateStatus) JobRateStatusResponse {
+	resp := JobRateStatusResponse{
+		ID:    s.ID,
+		Label: s.Label,
+		Class: s.Class,
+		Order: s.Order,
+	}
+
+	if s.CreatedAt != nil {
+		resp.CreatedAt = s.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if s.UpdatedAt != nil {
+		resp.UpdatedAt = s.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return resp
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	return filters
+}
+
+// List maneja la solicitud de listado de estados de tarifa
+// @Summary Listar estados de tarifa
+// @Description Obtiene una lista paginada de estados de tarifa con filtros opcionales
+// @Tags JobRateStatuses
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param search query string false "Búsqueda por label o class"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/job-rate-statuses [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	filters := parseFilters(r)
+
+	statuses, total, err := h.useCase.List(r.Context(), filters, page, pageSize)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al listar estados de tarifa")
+		return
+	}
+
+	items := make([]JobRateStatusResponse, len(statuses))
+	for i, s := range statuses {
+		items[i] = toResponse(s)
+	}
+
+	response.Paginated(w, items, page, pageSize, total)
+}
+
+// Create maneja la solicitud de creación de un estado de tarifa
+// @Summary Crear estado de tarifa
+// @Description Crea un nuevo estado de tarifa. El campo class acepta colores Bootstrap: blue, indigo, purple, pink, red, orange, yellow, green, teal, cyan, dark, light
+// @Tags JobRateStatuses
+// @Accept json
+// @Produce json
+// @Param status body CreateJobRateStatusRequest true "Datos del estado de tarifa"
+// @Success 201 {object} JobRateStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/job-rate-statuses [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateJobRateStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	status := &job_rate_status.JobRateStatus{
+		Label: req.Label,
+		Class: req.Class,
+		Order: req.Order,
+	}
+
+	if err := h.useCase.Create(r.Context(), status); err != nil {
+		response.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(status))
+}
+
+// Get maneja la solicitud de obtención de un estado de tarifa por ID
+// @Summary Obtener estado de tarifa
+// @Description Obtiene un estado de tarifa por su ID
+// @Tags JobRateStatuses
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del estado de tarifa"
+// @Success 200 {object} JobRateStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/job-rate-statuses/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	status, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		if err == job_rate_status.ErrJobRateStatusNotFound {
+			response.Error(w, http.StatusNotFound, "Estado de tarifa no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al obtener estado de tarifa")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(status))
+}
+
+// Update maneja la solicitud de actualización de un estado de tarifa
+// @Summary Actualizar estado de tarifa
+// @Description Actualiza un estado de tarifa existente
+// @Tags JobRateStatuses
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del estado de tarifa"
+// @Param status body UpdateJobRateStatusRequest true "Datos del estado de tarifa"
+// @Success 200 {object} JobRateStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/job-rate-statuses/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req UpdateJobRateStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	status := &job_rate_status.JobRateStatus{
+		ID:    id,
+		Label: req.Label,
+		Class: req.Class,
+		Order: req.Order,
+	}
+
+	if err := h.useCase.Update(r.Context(), status); err != nil {
+		if err == job_rate_status.ErrJobRateStatusNotFound {
+			response.Error(w, http.StatusNotFound, "Estado de tarifa no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(status))
+}
+
+// Delete maneja la solicitud de eliminación de un estado de tarifa
+// @Summary Eliminar estado de tarifa
+// @Description Elimina un estado de tarifa. No se puede eliminar si tiene tarifas de trabajo asociadas
+// @Tags JobRateStatuses
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del estado de tarifa"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/job-rate-statuses/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		if err == job_rate_status.ErrJobRateStatusNotFound {
+			response.Error(w, http.StatusNotFound, "Estado de tarifa no encontrado")
+			return
+		}
+		if err == job_rate_status.ErrJobRateStatusInUse {
+			response.Error(w, http.StatusConflict, err.Error())
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar estado de tarifa")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,328 @@
+package job_resident
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/job_resident"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para residentes de jobs
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de residentes de jobs
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/jobs/{jobId}/residents", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// ResidentRequest representa la solicitud para crear o actualizar un residente
+type ResidentRequest struct {
+	Name        string  `json:"name" example:"Jane Doe"`
+	MobilePhone *string `json:"mobilePhone,omitempty" example:"(555) 123-4567"`
+	HomePhone   *string `json:"homePhone,omitempty" example:"(555) 765-4321"`
+	Email       *string `json:"email,omitempty" example:"jane@example.com"`
+}
+
+// ResidentResponse representa la respuesta de un residente
+type ResidentResponse struct {
+	ID          int64   `json:"id" example:"1"`
+	JobID       int64   `json:"jobId" example:"100"`
+	Name        string  `json:"name" example:"Jane Doe"`
+	MobilePhone *string `json:"mobilePhone,omitempty" example:"(555) 123-4567"`
+	HomePhone   *string `json:"homePhone,omitempty" example:"(555) 765-4321"`
+	Email       *string `json:"email,omitempty" example:"jane@example.com"`
+	CreatedAt   string  `json:"createdAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt   string  `json:"updatedAt,omitempty" example:"2024-01-18T14:20:00Z"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toResponse(e *domain.JobResident) ResidentResponse {
+	resp := ResidentResponse{
+		ID:          e.ID,
+		JobID:       e.JobID,
+		Name:        e.Name,
+		MobilePhone: e.MobilePhone,
+		HomePhone:   e.HomePhone,
+		Email:       e.Email,
+	}
+
+	if e.CreatedAt != nil {
+		resp.CreatedAt = e.CreatedAt.Format(timeFormat)
+	}
+	if e.UpdatedAt != nil {
+		resp.UpdatedAt = e.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func (req *ResidentRequest) toEntity(jobID int64) *domain.JobResident {
+	return &domain.JobResident{
+		JobID:       jobID,
+		Name:        req.Name,
+		MobilePhone: req.MobilePhone,
+		HomePhone:   req.HomePhone,
+		Email:       req.Email,
+	}
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+func parseIDs(r *http.Request) (int64, int64, error) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return jobID, id, nil
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "job_id is required",
+		"name is required",
+		"email is invalid":
+		return true
+	}
+	return false
+}
+
+// List maneja la solicitud de listado de residentes de un job
+// @Summary Listar residentes de job
+// @Description Obtiene los contactos en sitio (residentes) registrados en un job
+// @Tags Job Residents
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Success 200 {array} ResidentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/residents [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	residents, err := h.useCase.ListByJobID(r.Context(), jobID)
+	if err != nil {
+		if err == domain.ErrInvalidJob {
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to list job residents",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al listar residentes")
+		return
+	}
+
+	items := make([]ResidentResponse, len(residents))
+	for i, e := range residents {
+		items[i] = toResponse(e)
+	}
+
+	response.JSON(w, http.StatusOK, items)
+}
+
+// Create maneja la solicitud de creación de un residente
+// @Summary Crear residente de job
+// @Description Registra un contacto en sitio (residente) en un job
+// @Tags Job Residents
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param resident body ResidentRequest true "Datos del residente"
+// @Success 201 {object} ResidentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/residents [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	var req ResidentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	e := req.toEntity(jobID)
+
+	if err := h.useCase.Create(r.Context(), e); err != nil {
+		if err == domain.ErrInvalidJob {
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+			return
+		}
+		if isValidationError(err) {
+			response.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to create job resident",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al crear residente")
+		return
+	}
+
+	created, err := h.useCase.GetByID(r.Context(), jobID, e.ID)
+	if err != nil {
+		created = e
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(created))
+}
+
+// Get maneja la solicitud de obtención de un residente
+// @Summary Obtener residente de job
+// @Description Obtiene un residente de un job por su ID
+// @Tags Job Residents
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID del residente"
+// @Success 200 {object} ResidentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/residents/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	e, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		if err == domain.ErrResidentNotFound {
+			response.Error(w, http.StatusNotFound, "Residente no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al obtener residente")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(e))
+}
+
+// Update maneja la solicitud de actualización de un residente
+// @Summary Actualizar residente de job
+// @Description Actualiza un residente existente de un job
+// @Tags Job Residents
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID del residente"
+// @Param resident body ResidentRequest true "Datos del residente"
+// @Success 200 {object} ResidentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/residents/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req ResidentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	e := req.toEntity(jobID)
+	e.ID = id
+
+	if err := h.useCase.Update(r.Context(), e); err != nil {
+		if err == domain.ErrResidentNotFound {
+			response.Error(w, http.StatusNotFound, "Residente no encontrado")
+			return
+		}
+		if isValidationError(err) {
+			response.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to update job resident",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al actualizar residente")
+		return
+	}
+
+	updated, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		response.JSON(w, http.StatusOK, toResponse(e))
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(updated))
+}
+
+// Delete maneja la solicitud de eliminación de un residente
+// @Summary Eliminar residente de job
+// @Description Elimina un residente de un job (soft delete)
+// @Tags Job Residents
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID del residente"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/residents/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), jobID, id); err != nil {
+		if err == domain.ErrResidentNotFound {
+			response.Error(w, http.StatusNotFound, "Residente no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar residente")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
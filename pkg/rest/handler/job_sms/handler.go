@@ -0,0 +1,158 @@
+package job_sms
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/job_sms"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para SMS de jobs
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de SMS de jobs
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Put("/jobs/{jobId}/dispatch-sms", h.Dispatch)
+	r.Get("/jobs/{jobId}/sms", h.List)
+}
+
+// DispatchRequest representa la solicitud de envío del SMS de despacho de un job
+type DispatchRequest struct {
+	Type         string   `json:"type" example:"residents" enums:"technician,residents"`
+	TemplateID   *int64   `json:"templateId,omitempty" example:"1"`
+	Message      *string  `json:"message,omitempty" example:"Your technician will arrive {{scheduled_time}}"`
+	ResidentIDs  []int64  `json:"residentIds,omitempty"`
+	PhoneNumbers []string `json:"phoneNumbers,omitempty" example:"(555) 123-4567"`
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+// writeDispatchError traduce los errores del dominio a respuestas HTTP
+func writeDispatchError(w http.ResponseWriter, err error) {
+	switch err {
+	case domain.ErrInvalidJob:
+		response.Error(w, http.StatusNotFound, "Job no encontrado")
+	case domain.ErrForbidden:
+		response.Error(w, http.StatusForbidden, "No tiene permiso para despachar este job")
+	case domain.ErrTemplateNotFound:
+		response.Error(w, http.StatusNotFound, "Plantilla de SMS no encontrada")
+	case domain.ErrInvalidType, domain.ErrMessageRequired, domain.ErrPhoneNumbersRequired, domain.ErrNoRecipients:
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case domain.ErrNoTechnician:
+		response.Error(w, http.StatusConflict, "El job no tiene técnico asignado")
+	case domain.ErrSMSDisabled:
+		response.Error(w, http.StatusConflict, "El envío de SMS no está habilitado")
+	default:
+		response.Error(w, http.StatusInternalServerError, "Error al procesar SMS del job")
+	}
+}
+
+// Dispatch maneja la solicitud de envío del SMS de despacho de un job
+// @Summary Enviar SMS de despacho
+// @Description Envía un SMS al técnico asignado o a los residentes del job usando una plantilla o un mensaje libre con marcadores. Cada envío queda registrado en el historial del job.
+// @Description Los usuarios no tienen teléfono registrado: para type=technician el job debe tener técnico asignado y phoneNumbers es obligatorio con el número que indica quien envía (409 si no hay técnico)
+// @Tags Job SMS
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param request body DispatchRequest true "Destinatarios y mensaje"
+// @Success 200 {object} job_sms.DispatchResult
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 502 {object} job_sms.DispatchResult
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/dispatch-sms [put]
+// @Security BearerAuth
+func (h *Handler) Dispatch(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	var req DispatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	result, err := h.useCase.Dispatch(r.Context(), &domain.DispatchRequest{
+		JobID:        jobID,
+		Type:         req.Type,
+		TemplateID:   req.TemplateID,
+		Message:      req.Message,
+		ResidentIDs:  req.ResidentIDs,
+		PhoneNumbers: req.PhoneNumbers,
+	})
+	if err == domain.ErrSendFailed {
+		response.JSON(w, http.StatusBadGateway, result)
+		return
+	}
+	if err != nil {
+		writeDispatchError(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, result)
+}
+
+// List maneja la solicitud del historial de SMS de un job
+// @Summary Historial de SMS de job
+// @Description Obtiene los SMS enviados desde un job, del más reciente al más antiguo
+// @Tags Job SMS
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/sms [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	items, total, err := h.useCase.ListByJobID(r.Context(), jobID, page, pageSize)
+	if err != nil {
+		writeDispatchError(w, err)
+		return
+	}
+
+	if items == nil {
+		items = []*domain.JobSMS{}
+	}
+
+	response.Paginated(w, items, page, pageSize, total)
+}
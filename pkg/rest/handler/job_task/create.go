@@ -0,0 +1,72 @@
+package job_task
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Create maneja la solicitud de creación de una tarea
+// @Summary Crear tarea de job
+// @Description Crea una tarea asignada a un usuario dentro de un job
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param task body TaskRequest true "Datos de la tarea"
+// @Success 201 {object} TaskResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	var req TaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	task, err := req.toEntity(jobID)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha límite inválido")
+		return
+	}
+
+	if err := h.useCase.Create(r.Context(), task); err != nil {
+		switch err {
+		case domainTask.ErrInvalidJob:
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+		case domainTask.ErrInvalidUser,
+			domainTask.ErrInvalidTaskStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			slog.ErrorContext(r.Context(), "Failed to create job task",
+				slog.String("error", err.Error()))
+			response.Error(w, http.StatusInternalServerError, "Error al crear tarea")
+		}
+		return
+	}
+
+	// Re-fetch para incluir usuario y estado
+	created, err := h.useCase.GetByID(r.Context(), jobID, task.ID)
+	if err != nil {
+		created = task
+	}
+
+	response.JSON(w, http.StatusCreated, toTaskResponse(created, time.Now()))
+}
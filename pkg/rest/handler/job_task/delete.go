@@ -0,0 +1,45 @@
+package job_task
+
+import (
+	"log/slog"
+	"net/http"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Delete maneja la solicitud de eliminación de una tarea
+// @Summary Eliminar tarea de job
+// @Description Elimina una tarea de un job (soft delete)
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarea"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), jobID, id); err != nil {
+		if err == domainTask.ErrTaskNotFound {
+			response.Error(w, http.StatusNotFound, "Tarea no encontrada")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to delete job task",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar tarea")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
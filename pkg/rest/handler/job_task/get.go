@@ -0,0 +1,47 @@
+package job_task
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Get maneja la solicitud de obtención de una tarea por ID
+// @Summary Obtener tarea de job
+// @Description Obtiene una tarea de un job por su ID
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} TaskResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	task, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		if err == domainTask.ErrTaskNotFound {
+			response.Error(w, http.StatusNotFound, "Tarea no encontrada")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to get job task",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al obtener tarea")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toTaskResponse(task, time.Now()))
+}
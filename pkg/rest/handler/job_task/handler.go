@@ -0,0 +1,236 @@
+package job_task
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+)
+
+// Handler maneja las peticiones HTTP para tareas de jobs
+type Handler struct {
+	useCase domainTask.Service
+}
+
+// NewHandler crea una nueva instancia del handler de tareas de jobs
+func NewHandler(useCase domainTask.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler: el sub-recurso de jobs y la vista global de tareas
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/jobs/{jobId}/tasks", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+		r.Put("/{id}/send-notification", h.SendNotification)
+		r.Get("/{id}/notifications", h.ListNotifications)
+	})
+
+	r.Get("/tasks", h.ListAll)
+}
+
+// TaskRequest representa la solicitud para crear o actualizar una tarea
+type TaskRequest struct {
+	UserID       int64   `json:"userId" example:"5"`
+	Task         string  `json:"task" example:"Llamar al residente para confirmar la visita"`
+	TaskStatusID int64   `json:"taskStatusId" example:"1"`
+	DueDate      *string `json:"dueDate,omitempty" example:"2026-03-15"`
+}
+
+// TaskResponse representa la respuesta de una tarea
+type TaskResponse struct {
+	ID              int64   `json:"id"`
+	JobID           int64   `json:"jobId"`
+	JobWorkOrder    *string `json:"jobWorkOrder,omitempty"`
+	UserID          int64   `json:"userId"`
+	UserName        *string `json:"userName,omitempty"`
+	Task            string  `json:"task"`
+	TaskStatusID    int64   `json:"taskStatusId"`
+	TaskStatusLabel *string `json:"taskStatusLabel,omitempty"`
+	TaskStatusClass *string `json:"taskStatusClass,omitempty"`
+	DueDate         *string `json:"dueDate,omitempty"`
+	Overdue         bool    `json:"overdue"`
+	LastNotifiedAt  *string `json:"lastNotifiedAt,omitempty"`
+	CreatedAt       string  `json:"createdAt,omitempty"`
+	UpdatedAt       string  `json:"updatedAt,omitempty"`
+}
+
+// NotificationResponse representa una notificación enviada para una tarea
+type NotificationResponse struct {
+	ID         int64   `json:"id"`
+	JobTaskID  int64   `json:"jobTaskId"`
+	UserID     int64   `json:"userId"`
+	UserName   *string `json:"userName,omitempty"`
+	SentByID   *int64  `json:"sentById,omitempty"`
+	SentByName *string `json:"sentByName,omitempty"`
+	CreatedAt  string  `json:"createdAt,omitempty"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func formatTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(timeFormat)
+	return &s
+}
+
+func toTaskResponse(t *domainTask.JobTask, now time.Time) TaskResponse {
+	resp := TaskResponse{
+		ID:              t.ID,
+		JobID:           t.JobID,
+		JobWorkOrder:    t.JobWorkOrder,
+		UserID:          t.UserID,
+		UserName:        t.UserName,
+		Task:            t.Task,
+		TaskStatusID:    t.TaskStatusID,
+		TaskStatusLabel: t.TaskStatusLabel,
+		TaskStatusClass: t.TaskStatusClass,
+		DueDate:         formatTimePtr(t.DueDate),
+		Overdue:         t.IsOverdue(now),
+		LastNotifiedAt:  formatTimePtr(t.LastNotifiedAt),
+	}
+
+	if t.CreatedAt != nil {
+		resp.CreatedAt = t.CreatedAt.Format(timeFormat)
+	}
+	if t.UpdatedAt != nil {
+		resp.UpdatedAt = t.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func toNotificationResponse(n *domainTask.TaskNotification) NotificationResponse {
+	resp := NotificationResponse{
+		ID:         n.ID,
+		JobTaskID:  n.JobTaskID,
+		UserID:     n.UserID,
+		UserName:   n.UserName,
+		SentByID:   n.SentByID,
+		SentByName: n.SentByName,
+	}
+
+	if n.CreatedAt != nil {
+		resp.CreatedAt = n.CreatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+// toEntity convierte la solicitud en una tarea del job indicado
+func (req *TaskRequest) toEntity(jobID int64) (*domainTask.JobTask, error) {
+	t := &domainTask.JobTask{
+		JobID:        jobID,
+		UserID:       req.UserID,
+		Task:         req.Task,
+		TaskStatusID: req.TaskStatusID,
+	}
+
+	if req.DueDate != nil && *req.DueDate != "" {
+		due, err := parseDueDate(*req.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		t.DueDate = &due
+	}
+
+	return t, nil
+}
+
+// parseDueDate acepta RFC3339, YYYY-MM-DD o MM-DD-YYYY
+func parseDueDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02", "01-02-2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid due date: %s", value)
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+func parseIDs(r *http.Request) (int64, int64, error) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return jobID, id, nil
+}
+
+func parsePagination(r *http.Request) (int, int) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 15
+	}
+
+	return page, pageSize
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+	q := r.URL.Query()
+
+	if search := q.Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	if userIDStr := q.Get("userId"); userIDStr != "" {
+		if id, err := strconv.ParseInt(userIDStr, 10, 64); err == nil {
+			filters["user_id"] = id
+		}
+	}
+
+	if statusIDStr := q.Get("taskStatusId"); statusIDStr != "" {
+		if id, err := strconv.ParseInt(statusIDStr, 10, 64); err == nil {
+			filters["task_status_id"] = id
+		}
+	}
+
+	if overdueStr := q.Get("overdue"); overdueStr != "" {
+		if overdue, err := strconv.ParseBool(overdueStr); err == nil {
+			filters["overdue"] = overdue
+		}
+	}
+
+	if sort := q.Get("sort"); sort != "" {
+		filters["sort"] = sort
+	}
+
+	if direction := q.Get("direction"); direction != "" {
+		filters["direction"] = direction
+	}
+
+	return filters
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "job_id is required",
+		"user_id is required",
+		"task is required",
+		"task_status_id is required":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package job_task
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// List maneja la solicitud de listado de las tareas de un job
+// @Summary Listar tareas de job
+// @Description Obtiene una lista paginada de las tareas de un job
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(15)
+// @Param userId query int false "Filtrar por usuario asignado"
+// @Param taskStatusId query int false "Filtrar por estado de tarea"
+// @Param overdue query bool false "Filtrar por tareas vencidas (true) o no vencidas (false)"
+// @Param search query string false "Búsqueda en el texto de la tarea"
+// @Param sort query string false "Campo de ordenamiento (due_date, task, created_at)"
+// @Param direction query string false "Dirección de ordenamiento (asc, desc)" default(desc)
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	page, pageSize := parsePagination(r)
+	filters := parseFilters(r)
+
+	tasks, total, err := h.useCase.ListByJobID(r.Context(), jobID, filters, page, pageSize)
+	if err != nil {
+		if err == domainTask.ErrInvalidJob {
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to list job tasks",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al listar tareas")
+		return
+	}
+
+	response.Paginated(w, toTaskResponses(tasks), page, pageSize, total)
+}
+
+// ListAll maneja la solicitud de listado global de tareas
+// @Summary Listar todas las tareas
+// @Description Obtiene una lista paginada de las tareas de todos los jobs. Use mine=true para ver solo las tareas asignadas al usuario autenticado
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(15)
+// @Param mine query bool false "Solo las tareas asignadas al usuario autenticado"
+// @Param userId query int false "Filtrar por usuario asignado"
+// @Param taskStatusId query int false "Filtrar por estado de tarea"
+// @Param overdue query bool false "Filtrar por tareas vencidas (true) o no vencidas (false)"
+// @Param jobId query int false "Filtrar por job"
+// @Param search query string false "Búsqueda en el texto de la tarea o la orden de trabajo"
+// @Param sort query string false "Campo de ordenamiento (due_date, task, created_at)"
+// @Param direction query string false "Dirección de ordenamiento (asc, desc)" default(desc)
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/tasks [get]
+// @Security BearerAuth
+func (h *Handler) ListAll(w http.ResponseWriter, r *http.Request) {
+	page, pageSize := parsePagination(r)
+	filters := parseFilters(r)
+
+	if jobIDStr := r.URL.Query().Get("jobId"); jobIDStr != "" {
+		if id, err := strconv.ParseInt(jobIDStr, 10, 64); err == nil {
+			filters["job_id"] = id
+		}
+	}
+
+	if mineStr := r.URL.Query().Get("mine"); mineStr != "" {
+		if mine, err := strconv.ParseBool(mineStr); err == nil {
+			filters["mine"] = mine
+		}
+	}
+
+	tasks, total, err := h.useCase.List(r.Context(), filters, page, pageSize)
+	if err != nil {
+		if err == domainTask.ErrUserRequired {
+			response.Error(w, http.StatusUnauthorized, "Usuario no autenticado")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to list tasks",
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al listar tareas")
+		return
+	}
+
+	response.Paginated(w, toTaskResponses(tasks), page, pageSize, total)
+}
+
+func toTaskResponses(tasks []*domainTask.JobTask) []TaskResponse {
+	now := time.Now()
+	items := make([]TaskResponse, len(tasks))
+	for i, t := range tasks {
+		items[i] = toTaskResponse(t, now)
+	}
+	return items
+}
@@ -0,0 +1,88 @@
+package job_task
+
+import (
+	"log/slog"
+	"net/http"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// SendNotification maneja la solicitud de envío de notificación al usuario asignado
+// @Summary Enviar notificación de tarea
+// @Description Notifica al usuario asignado con una alerta en su bandeja y, si se entregó, registra quién fue notificado y quién la envió
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} NotificationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks/{id}/send-notification [put]
+// @Security BearerAuth
+func (h *Handler) SendNotification(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	notification, err := h.useCase.SendNotification(r.Context(), jobID, id)
+	if err != nil {
+		if err == domainTask.ErrTaskNotFound {
+			response.Error(w, http.StatusNotFound, "Tarea no encontrada")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to send job task notification",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al enviar notificación")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toNotificationResponse(notification))
+}
+
+// ListNotifications maneja la solicitud del historial de notificaciones de una tarea
+// @Summary Listar notificaciones de tarea
+// @Description Obtiene las notificaciones enviadas para una tarea, de la más reciente a la más antigua
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarea"
+// @Success 200 {array} NotificationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks/{id}/notifications [get]
+// @Security BearerAuth
+func (h *Handler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	notifications, err := h.useCase.ListNotifications(r.Context(), jobID, id)
+	if err != nil {
+		if err == domainTask.ErrTaskNotFound {
+			response.Error(w, http.StatusNotFound, "Tarea no encontrada")
+			return
+		}
+		slog.ErrorContext(r.Context(), "Failed to list job task notifications",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al listar notificaciones")
+		return
+	}
+
+	items := make([]NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		items[i] = toNotificationResponse(n)
+	}
+
+	response.JSON(w, http.StatusOK, items)
+}
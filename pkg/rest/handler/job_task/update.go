@@ -0,0 +1,76 @@
+package job_task
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	domainTask "github.com/your-org/jvairv2/pkg/domain/job_task"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Update maneja la solicitud de actualización de una tarea
+// @Summary Actualizar tarea de job
+// @Description Actualiza una tarea existente de un job
+// @Tags Job Tasks
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la tarea"
+// @Param task body TaskRequest true "Datos de la tarea"
+// @Success 200 {object} TaskResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/tasks/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req TaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	task, err := req.toEntity(jobID)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha límite inválido")
+		return
+	}
+	task.ID = id
+
+	if err := h.useCase.Update(r.Context(), task); err != nil {
+		switch err {
+		case domainTask.ErrTaskNotFound:
+			response.Error(w, http.StatusNotFound, "Tarea no encontrada")
+		case domainTask.ErrInvalidJob,
+			domainTask.ErrInvalidUser,
+			domainTask.ErrInvalidTaskStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			slog.ErrorContext(r.Context(), "Failed to update job task",
+				slog.Int64("id", id),
+				slog.String("error", err.Error()))
+			response.Error(w, http.StatusInternalServerError, "Error al actualizar tarea")
+		}
+		return
+	}
+
+	// Re-fetch para incluir usuario y estado actualizados
+	updated, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		updated = task
+	}
+
+	response.JSON(w, http.StatusOK, toTaskResponse(updated, time.Now()))
+}
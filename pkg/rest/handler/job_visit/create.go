@@ -0,0 +1,70 @@
+package job_visit
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Create maneja la solicitud de creación de una visita
+// @Summary Crear visita de job
+// @Description Registra una visita de un técnico a un job. Se registra a nombre del usuario autenticado; solo un usuario con job_visit_view_all puede indicar otro userId; si no se indica fecha se usa la actual. viewableBy restringe la visita a los roles indicados (ID o nombre)
+// @Tags Job Visits
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param visit body VisitRequest true "Datos de la visita"
+// @Success 201 {object} VisitResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/visits [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	var req VisitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	visit := &domainVisit.JobVisit{JobID: jobID}
+	if err := req.applyTo(visit); err != nil {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	if err := h.useCase.Create(r.Context(), visit); err != nil {
+		switch err {
+		case domainVisit.ErrInvalidJob:
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+		case domainVisit.ErrInvalidUser:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			slog.ErrorContext(r.Context(), "Failed to create job visit",
+				slog.String("error", err.Error()))
+			response.Error(w, http.StatusInternalServerError, "Error al crear visita")
+		}
+		return
+	}
+
+	// Re-fetch para incluir el nombre del técnico
+	created, err := h.useCase.GetByID(r.Context(), jobID, visit.ID)
+	if err != nil {
+		created = visit
+	}
+
+	response.JSON(w, http.StatusCreated, toVisitResponse(created))
+}
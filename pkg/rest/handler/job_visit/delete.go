@@ -0,0 +1,37 @@
+package job_visit
+
+import (
+	"net/http"
+
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Delete maneja la solicitud de eliminación de una visita
+// @Summary Eliminar visita de job
+// @Description Elimina una visita de un job (soft delete). Solo su autor o un usuario con job_visit_view_all pueden eliminarla
+// @Tags Job Visits
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la visita"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/visits/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), jobID, id); err != nil {
+		writeVisitError(w, r, err, "Failed to delete job visit")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,184 @@
+package job_visit
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/your-org/jvairv2/pkg/common/pdf"
+	domainJobEquip "github.com/your-org/jvairv2/pkg/domain/job_equipment"
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Download maneja la solicitud de descarga del reporte de una visita en PDF
+// @Summary Descargar reporte de visita
+// @Description Genera un PDF con el reporte de la visita, los datos del job, la propiedad y los equipos del job
+// @Tags Job Visits
+// @Produce application/pdf
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la visita"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/visits/{id}/download [get]
+// @Security BearerAuth
+func (h *Handler) Download(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	data, err := h.useCase.GetReport(r.Context(), jobID, id)
+	if err != nil {
+		if err == domainVisit.ErrInvalidJob {
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+			return
+		}
+		writeVisitError(w, r, err, "Failed to get job visit report")
+		return
+	}
+
+	content, err := renderReport(data).Bytes()
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to render job visit report",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al generar el reporte")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="job-%d-visit-%d.pdf"`, jobID, id))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(content)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
+const reportDateFormat = "01/02/2006"
+
+// renderReport construye el documento PDF del reporte de visita
+func renderReport(data *domainVisit.ReportData) *pdf.Document {
+	visit := data.Visit
+	doc := pdf.New(fmt.Sprintf("Visit Report #%d", visit.ID))
+	doc.Footer = fmt.Sprintf("Generated %s", time.Now().Format("01/02/2006 03:04 PM"))
+
+	doc.Heading("Visit Report", 18)
+
+	doc.SetFont(false, 10)
+	doc.KeyValue("Work Order", valueOr(data.WorkOrder, fmt.Sprintf("Job #%d", visit.JobID)))
+	doc.KeyValue("Visit Date", visit.Date.Format("01/02/2006 03:04 PM"))
+	doc.KeyValue("Technician", valueOr(visit.UserName, "-"))
+	doc.Space(8)
+
+	doc.Heading("Job", 13)
+	doc.SetFont(false, 10)
+	if data.DateReceived != nil {
+		doc.KeyValue("Date Received", data.DateReceived.Format(reportDateFormat))
+	}
+	doc.KeyValue("Category", valueOr(data.JobCategory, "-"))
+	doc.KeyValue("Status", valueOr(data.JobStatus, "-"))
+	doc.Space(8)
+
+	doc.Heading("Property", 13)
+	doc.SetFont(false, 10)
+	doc.KeyValue("Customer", valueOr(data.CustomerName, "-"))
+	if data.PropertyCode != nil && *data.PropertyCode != "" {
+		doc.KeyValue("Property Code", *data.PropertyCode)
+	}
+	doc.KeyValue("Address", data.PropertyAddress())
+	doc.Space(8)
+
+	doc.Heading("Report", 13)
+	doc.SetFont(false, 10)
+	doc.Paragraph(valueOr(visit.Report, "No report was entered for this visit."))
+	doc.Space(8)
+
+	doc.Heading("Equipment", 13)
+	doc.SetFont(false, 9)
+	if len(data.Equipment) == 0 {
+		doc.Paragraph("No equipment recorded for this job.")
+		return doc
+	}
+
+	width := doc.ContentWidth()
+	columns := []float64{width * 0.16, width * 0.2, width * 0.24, width * 0.24, width * 0.16}
+	for _, e := range data.Equipment {
+		doc.Space(4)
+		doc.SetFont(true, 10)
+		doc.Paragraph(fmt.Sprintf("%s equipment - %s", titleCase(e.Type), valueOr(e.Area, "No area")))
+		doc.SetFont(false, 9)
+
+		doc.Row(
+			pdf.Cell{Text: "Unit", Width: columns[0], Bold: true},
+			pdf.Cell{Text: "Brand", Width: columns[1], Bold: true},
+			pdf.Cell{Text: "Model", Width: columns[2], Bold: true},
+			pdf.Cell{Text: "Serial", Width: columns[3], Bold: true},
+			pdf.Cell{Text: "Installed", Width: columns[4], Bold: true, Align: pdf.AlignRight},
+		)
+		doc.Rule()
+		for _, u := range equipmentUnits(e) {
+			doc.Row(
+				pdf.Cell{Text: u.name, Width: columns[0]},
+				pdf.Cell{Text: valueOr(u.brand, ""), Width: columns[1]},
+				pdf.Cell{Text: valueOr(u.model, ""), Width: columns[2]},
+				pdf.Cell{Text: valueOr(u.serial, ""), Width: columns[3]},
+				pdf.Cell{Text: formatDate(u.installed), Width: columns[4], Align: pdf.AlignRight},
+			)
+		}
+	}
+
+	return doc
+}
+
+type equipmentUnit struct {
+	name      string
+	brand     *string
+	model     *string
+	serial    *string
+	installed *time.Time
+}
+
+// equipmentUnits retorna las unidades del equipo que tienen algún dato
+func equipmentUnits(e *domainJobEquip.JobEquipment) []equipmentUnit {
+	units := []equipmentUnit{
+		{"Outdoor", e.OutdoorBrand, e.OutdoorModel, e.OutdoorSerial, e.OutdoorInstalled},
+		{"Furnace", e.FurnaceBrand, e.FurnaceModel, e.FurnaceSerial, e.FurnaceInstalled},
+		{"Evaporator", e.EvaporatorBrand, e.EvaporatorModel, e.EvaporatorSerial, e.EvaporatorInstalled},
+		{"Air Handler", e.AirHandlerBrand, e.AirHandlerModel, e.AirHandlerSerial, e.AirHandlerInstalled},
+	}
+
+	result := make([]equipmentUnit, 0, len(units))
+	for _, u := range units {
+		if valueOr(u.brand, "") != "" || valueOr(u.model, "") != "" || valueOr(u.serial, "") != "" || u.installed != nil {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+func valueOr(s *string, fallback string) string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return fallback
+	}
+	return *s
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(reportDateFormat)
+}
+
+func titleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
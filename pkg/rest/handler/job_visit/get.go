@@ -0,0 +1,38 @@
+package job_visit
+
+import (
+	"net/http"
+
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Get maneja la solicitud de obtención de una visita por ID
+// @Summary Obtener visita de job
+// @Description Obtiene una visita de un job por su ID si el usuario autenticado puede verla
+// @Tags Job Visits
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la visita"
+// @Success 200 {object} VisitResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/visits/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	visit, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		writeVisitError(w, r, err, "Failed to get job visit")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toVisitResponse(visit))
+}
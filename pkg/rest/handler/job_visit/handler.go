@@ -0,0 +1,196 @@
+package job_visit
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para visitas de jobs
+type Handler struct {
+	useCase domainVisit.Service
+}
+
+// NewHandler crea una nueva instancia del handler de visitas de jobs
+func NewHandler(useCase domainVisit.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/jobs/{jobId}/visits", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+		r.Get("/{id}/download", h.Download)
+	})
+}
+
+// RoleList es una lista de roles que acepta tanto IDs numéricos como nombres
+type RoleList []string
+
+// UnmarshalJSON acepta elementos numéricos o de texto
+func (l *RoleList) UnmarshalJSON(data []byte) error {
+	var mixed []interface{}
+	if err := json.Unmarshal(data, &mixed); err != nil {
+		return err
+	}
+	*l = make(RoleList, 0, len(mixed))
+	for _, item := range mixed {
+		switch v := item.(type) {
+		case string:
+			*l = append(*l, v)
+		case float64:
+			*l = append(*l, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			return fmt.Errorf("invalid role: %v", item)
+		}
+	}
+	return nil
+}
+
+// VisitRequest representa la solicitud para crear o actualizar una visita
+type VisitRequest struct {
+	UserID     *int64    `json:"userId,omitempty" example:"5"`
+	ViewableBy *RoleList `json:"viewableBy,omitempty" swaggertype:"array,string" example:"2,office"`
+	Date       *string   `json:"date,omitempty" example:"2026-03-15T10:30:00Z"`
+	Report     *string   `json:"report,omitempty" example:"Se revisó el equipo y se reemplazó el capacitor"`
+}
+
+// VisitResponse representa la respuesta de una visita
+type VisitResponse struct {
+	ID         int64    `json:"id"`
+	JobID      int64    `json:"jobId"`
+	UserID     int64    `json:"userId"`
+	UserName   *string  `json:"userName,omitempty"`
+	ViewableBy []string `json:"viewableBy"`
+	Date       string   `json:"date"`
+	Report     *string  `json:"report,omitempty"`
+	CreatedAt  string   `json:"createdAt,omitempty"`
+	UpdatedAt  string   `json:"updatedAt,omitempty"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toVisitResponse(v *domainVisit.JobVisit) VisitResponse {
+	resp := VisitResponse{
+		ID:         v.ID,
+		JobID:      v.JobID,
+		UserID:     v.UserID,
+		UserName:   v.UserName,
+		ViewableBy: v.ViewableBy,
+		Date:       v.Date.Format(timeFormat),
+		Report:     v.Report,
+	}
+
+	if resp.ViewableBy == nil {
+		resp.ViewableBy = []string{}
+	}
+	if v.CreatedAt != nil {
+		resp.CreatedAt = v.CreatedAt.Format(timeFormat)
+	}
+	if v.UpdatedAt != nil {
+		resp.UpdatedAt = v.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+// applyTo copia los campos proporcionados en la solicitud sobre la visita
+func (req *VisitRequest) applyTo(v *domainVisit.JobVisit) error {
+	if req.UserID != nil {
+		v.UserID = *req.UserID
+	}
+	if req.ViewableBy != nil {
+		v.ViewableBy = []string(*req.ViewableBy)
+	}
+	if req.Date != nil && *req.Date != "" {
+		date, err := parseDate(*req.Date)
+		if err != nil {
+			return err
+		}
+		v.Date = date
+	}
+	if req.Report != nil {
+		v.Report = req.Report
+	}
+	return nil
+}
+
+// parseDate acepta RFC3339, YYYY-MM-DD o MM-DD-YYYY
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02", "01-02-2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date: %s", value)
+}
+
+func parseJobID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+}
+
+func parseIDs(r *http.Request) (int64, int64, error) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return jobID, id, nil
+}
+
+func parsePagination(r *http.Request) (int, int) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 15
+	}
+
+	return page, pageSize
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "job_id is required",
+		"user_id is required":
+		return true
+	}
+	return false
+}
+
+// writeVisitError responde los errores comunes de lectura de una visita
+func writeVisitError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch err {
+	case domainVisit.ErrVisitNotFound:
+		response.Error(w, http.StatusNotFound, "Visita no encontrada")
+	case domainVisit.ErrVisitForbidden:
+		response.Error(w, http.StatusForbidden, "No tiene permiso para ver esta visita")
+	case domainVisit.ErrVisitNotEditable:
+		response.Error(w, http.StatusForbidden, "Solo el autor de la visita puede modificarla")
+	case domainVisit.ErrUserRequired:
+		response.Error(w, http.StatusUnauthorized, "Usuario no autenticado")
+	default:
+		slog.ErrorContext(r.Context(), message,
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al procesar la visita")
+	}
+}
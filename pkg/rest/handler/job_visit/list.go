@@ -0,0 +1,79 @@
+package job_visit
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// List maneja la solicitud de listado de las visitas de un job
+// @Summary Listar visitas de job
+// @Description Obtiene una lista paginada de las visitas de un job. Solo se incluyen las visitas cuyo viewable_by permite verlas al usuario autenticado
+// @Tags Job Visits
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(15)
+// @Param userId query int false "Filtrar por técnico"
+// @Param search query string false "Búsqueda en el reporte o el nombre del técnico"
+// @Param sort query string false "Campo de ordenamiento (date, created_at)"
+// @Param direction query string false "Dirección de ordenamiento (asc, desc)" default(desc)
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/visits [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	jobID, err := parseJobID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de job inválido")
+		return
+	}
+
+	page, pageSize := parsePagination(r)
+	filters := make(map[string]interface{})
+	q := r.URL.Query()
+
+	if userIDStr := q.Get("userId"); userIDStr != "" {
+		if id, err := strconv.ParseInt(userIDStr, 10, 64); err == nil {
+			filters["user_id"] = id
+		}
+	}
+	if search := q.Get("search"); search != "" {
+		filters["search"] = search
+	}
+	if sort := q.Get("sort"); sort != "" {
+		filters["sort"] = sort
+	}
+	if direction := q.Get("direction"); direction != "" {
+		filters["direction"] = direction
+	}
+
+	visits, total, err := h.useCase.ListByJobID(r.Context(), jobID, filters, page, pageSize)
+	if err != nil {
+		switch err {
+		case domainVisit.ErrInvalidJob:
+			response.Error(w, http.StatusNotFound, "Job no encontrado")
+		case domainVisit.ErrUserRequired:
+			response.Error(w, http.StatusUnauthorized, "Usuario no autenticado")
+		default:
+			slog.ErrorContext(r.Context(), "Failed to list job visits",
+				slog.String("error", err.Error()))
+			response.Error(w, http.StatusInternalServerError, "Error al listar visitas")
+		}
+		return
+	}
+
+	items := make([]VisitResponse, len(visits))
+	for i, v := range visits {
+		items[i] = toVisitResponse(v)
+	}
+
+	response.Paginated(w, items, page, pageSize, total)
+}
@@ -0,0 +1,67 @@
+package job_visit
+
+import (
+	"encoding/json"
+	"net/http"
+
+	domainVisit "github.com/your-org/jvairv2/pkg/domain/job_visit"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Update maneja la solicitud de actualización de una visita
+// @Summary Actualizar visita de job
+// @Description Actualiza los campos proporcionados de una visita de un job. Solo su autor o un usuario con job_visit_view_all pueden modificarla; solo job_visit_view_all puede cambiar su userId
+// @Tags Job Visits
+// @Accept json
+// @Produce json
+// @Param jobId path int true "ID del job"
+// @Param id path int true "ID de la visita"
+// @Param visit body VisitRequest true "Datos de la visita"
+// @Success 200 {object} VisitResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{jobId}/visits/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	jobID, id, err := parseIDs(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req VisitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	existing, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		writeVisitError(w, r, err, "Failed to get job visit")
+		return
+	}
+
+	visit := *existing
+	if err := req.applyTo(&visit); err != nil {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	if err := h.useCase.Update(r.Context(), &visit); err != nil {
+		if err == domainVisit.ErrInvalidUser || isValidationError(err) {
+			response.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeVisitError(w, r, err, "Failed to update job visit")
+		return
+	}
+
+	updated, err := h.useCase.GetByID(r.Context(), jobID, id)
+	if err != nil {
+		updated = &visit
+	}
+
+	response.JSON(w, http.StatusOK, toVisitResponse(updated))
+}
@@ -0,0 +1,159 @@
+package outbox
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/outbox"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para la cola de mensajes salientes
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de la cola de mensajes
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/outbox", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Get("/{id}", h.Get)
+		r.Post("/{id}/retry", h.Retry)
+	})
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	for _, key := range []string{"status", "channel", "kind"} {
+		if value := r.URL.Query().Get(key); value != "" {
+			filters[key] = value
+		}
+	}
+
+	return filters
+}
+
+// writeOutboxError traduce los errores del dominio a respuestas HTTP
+func writeOutboxError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case domain.ErrMessageNotFound:
+		response.Error(w, http.StatusNotFound, "Mensaje no encontrado")
+	case domain.ErrInvalidStatus:
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case domain.ErrNotDead:
+		response.Error(w, http.StatusConflict, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// List maneja la solicitud de listado de mensajes de la cola
+// @Summary Listar mensajes salientes
+// @Description Obtiene una lista paginada de los correos y SMS de la cola, más recientes primero. Con status=dead se listan los que agotaron sus intentos
+// @Tags Outbox
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param status query string false "Filtrar por estado (pending, processing, sent, dead)"
+// @Param channel query string false "Filtrar por canal (email, sms)"
+// @Param kind query string false "Filtrar por origen (p. ej. job_dispatch, paystub)"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/outbox [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	messages, total, err := h.useCase.List(r.Context(), parseFilters(r), page, pageSize)
+	if err != nil {
+		writeOutboxError(w, err, "Error al listar mensajes")
+		return
+	}
+
+	if messages == nil {
+		messages = []*domain.Message{}
+	}
+
+	response.Paginated(w, messages, page, pageSize, total)
+}
+
+// Get maneja la solicitud de obtención de un mensaje de la cola
+// @Summary Obtener mensaje saliente
+// @Description Obtiene un mensaje de la cola con su contenido, intentos y último error
+// @Tags Outbox
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del mensaje"
+// @Success 200 {object} outbox.Message
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/outbox/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	msg, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		writeOutboxError(w, err, "Error al obtener mensaje")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, msg)
+}
+
+// Retry maneja la solicitud de reintento de un mensaje dead
+// @Summary Reintentar mensaje saliente
+// @Description Devuelve a la cola un mensaje que agotó sus intentos, con los intentos reiniciados
+// @Tags Outbox
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del mensaje"
+// @Success 200 {object} outbox.Message
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/outbox/{id}/retry [post]
+// @Security BearerAuth
+func (h *Handler) Retry(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	msg, err := h.useCase.Retry(r.Context(), id)
+	if err != nil {
+		writeOutboxError(w, err, "Error al reintentar mensaje")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, msg)
+}
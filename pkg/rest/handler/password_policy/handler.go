@@ -0,0 +1,95 @@
+package password_policy
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/password_policy"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP de la contraseña del usuario autenticado
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de política de contraseñas
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{useCase: useCase}
+}
+
+// RegisterRoutes registra las rutas del handler. Deben quedar exentas de
+// middleware.RequirePasswordCurrent para que el usuario pueda cambiar la contraseña.
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/password", func(r chi.Router) {
+		r.Get("/status", h.Status)
+		r.Post("/change", h.ChangePassword)
+	})
+}
+
+// writePasswordError traduce los errores del dominio a respuestas HTTP
+func writePasswordError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, domain.ErrUnauthenticated):
+		response.Error(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, domain.ErrPasswordMismatch):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrInvalidCurrentPassword), domain.IsViolation(err):
+		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// Status maneja la consulta del estado de la contraseña
+// @Summary Estado de la contraseña
+// @Description Indica si la contraseña del usuario autenticado venció o si debe cambiarla, junto con la política configurada
+// @Tags Password
+// @Produce json
+// @Success 200 {object} password_policy.Status
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/password/status [get]
+// @Security BearerAuth
+func (h *Handler) Status(w http.ResponseWriter, r *http.Request) {
+	status, err := h.useCase.CurrentStatus(r.Context())
+	if err != nil {
+		writePasswordError(w, err, "Error al obtener el estado de la contraseña")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, status)
+}
+
+// ChangePassword maneja el cambio de contraseña del usuario autenticado
+// @Summary Cambiar contraseña
+// @Description Cambia la contraseña del usuario autenticado verificando la actual y la política de settings (longitud, números, símbolos, historial y antigüedad mínima). Desbloquea la API si la contraseña había vencido
+// @Tags Password
+// @Accept json
+// @Produce json
+// @Param request body password_policy.ChangePasswordRequest true "Contraseña actual y nueva"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/password/change [post]
+// @Security BearerAuth
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	if err := h.useCase.ChangePassword(r.Context(), &req); err != nil {
+		writePasswordError(w, err, "Error al cambiar la contraseña")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "Contraseña actualizada correctamente",
+	})
+}
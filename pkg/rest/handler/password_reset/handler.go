@@ -0,0 +1,96 @@
+package password_reset
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	passwordPolicy "github.com/your-org/jvairv2/pkg/domain/password_policy"
+	domain "github.com/your-org/jvairv2/pkg/domain/password_reset"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para restablecer contraseñas
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de restablecimiento de contraseña
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{useCase: useCase}
+}
+
+// RegisterRoutes registra las rutas del handler dentro de /auth. Son públicas.
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/password", func(r chi.Router) {
+		r.Post("/email", h.SendResetLink)
+		r.Post("/reset", h.Reset)
+	})
+}
+
+// writePasswordResetError traduce los errores del dominio a respuestas HTTP
+func writePasswordResetError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err == domain.ErrEmailRequired, err == domain.ErrPasswordMismatch:
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case err == domain.ErrInvalidToken, passwordPolicy.IsViolation(err):
+		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// SendResetLink maneja la solicitud de un enlace de restablecimiento
+// @Summary Solicitar restablecimiento de contraseña
+// @Description Envía por correo un enlace con un token para restablecer la contraseña. Responde igual exista o no el email, para no revelar qué cuentas existen
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body password_reset.ForgotPasswordRequest true "Email de la cuenta"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /auth/password/email [post]
+func (h *Handler) SendResetLink(w http.ResponseWriter, r *http.Request) {
+	var req domain.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	if err := h.useCase.SendResetLink(r.Context(), &req); err != nil {
+		writePasswordResetError(w, err, "Error al enviar el enlace de restablecimiento")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "Si el email corresponde a una cuenta activa, recibirá un enlace para restablecer la contraseña",
+	})
+}
+
+// Reset maneja el restablecimiento de la contraseña
+// @Summary Restablecer contraseña
+// @Description Valida el token recibido por correo y la nueva contraseña contra la política de settings, la guarda y cierra todas las sesiones del usuario
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body password_reset.ResetPasswordRequest true "Token y nueva contraseña"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /auth/password/reset [post]
+func (h *Handler) Reset(w http.ResponseWriter, r *http.Request) {
+	var req domain.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	if err := h.useCase.Reset(r.Context(), &req); err != nil {
+		writePasswordResetError(w, err, "Error al restablecer la contraseña")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "Contraseña restablecida exitosamente"})
+}
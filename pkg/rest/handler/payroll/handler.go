@@ -0,0 +1,266 @@
+package payroll
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/payroll"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP de nómina
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de nómina
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/payroll", func(r chi.Router) {
+		r.Get("/", h.Summary)
+		r.Get("/{userId}/pay", h.GetPay)
+		r.Post("/{userId}/pay/mark-paid", h.MarkPaid)
+		r.Post("/{userId}/pay/hold", h.Hold)
+		r.Get("/{userId}/paystub", h.Paystub)
+		r.Post("/{userId}/paystub/email", h.EmailPaystub)
+		r.Get("/{userId}/paystub/emails", h.ListPaystubEmails)
+		r.Get("/{userId}/history", h.History)
+	})
+}
+
+// PeriodRequest representa un periodo de pago en el cuerpo de una solicitud
+type PeriodRequest struct {
+	From string `json:"from" example:"2024-01-01"`
+	To   string `json:"to" example:"2024-01-15"`
+}
+
+// MarkPaidRequest representa la solicitud para marcar tarifas como pagadas
+type MarkPaidRequest struct {
+	PeriodRequest
+	RateIDs []int64 `json:"rateIds,omitempty"`
+}
+
+// HoldRequest representa la solicitud para retener o liberar tarifas
+type HoldRequest struct {
+	RateIDs []int64 `json:"rateIds"`
+	Held    *bool   `json:"held,omitempty" example:"true"`
+}
+
+// CountResponse representa la cantidad de tarifas afectadas por una operación
+type CountResponse struct {
+	Count int64 `json:"count" example:"3"`
+}
+
+const dateFormat = "2006-01-02"
+
+// parseDate acepta fechas en formato YYYY-MM-DD o MM-DD-YYYY
+func parseDate(s string) (time.Time, error) {
+	t, err := time.Parse(dateFormat, s)
+	if err != nil {
+		t, err = time.Parse("01-02-2006", s)
+	}
+	return t, err
+}
+
+func parsePeriod(from, to string) (domain.Period, bool) {
+	var p domain.Period
+	var err error
+	if from != "" {
+		if p.From, err = parseDate(from); err != nil {
+			return p, false
+		}
+	}
+	if to != "" {
+		if p.To, err = parseDate(to); err != nil {
+			return p, false
+		}
+	}
+	return p, true
+}
+
+func parseUserID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "userId"), 10, 64)
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "from and to are required",
+		"from must be before or equal to to":
+		return true
+	}
+	switch err {
+	case domain.ErrRateIDsRequired, domain.ErrEmailRequired, domain.ErrInvalidEmail, domain.ErrEmptyPaystub:
+		return true
+	}
+	return false
+}
+
+// writePayrollError traduce los errores del dominio a respuestas HTTP
+func writePayrollError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err == domain.ErrInvalidUser:
+		response.Error(w, http.StatusNotFound, "Usuario no encontrado")
+	case isValidationError(err):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// Summary maneja la solicitud del resumen de nómina
+// @Summary Resumen de nómina
+// @Description Obtiene, por técnico, el total de tarifas pendientes de pago (y retenidas) en el periodo
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param from query string true "Inicio del periodo (YYYY-MM-DD)"
+// @Param to query string true "Fin del periodo, inclusivo (YYYY-MM-DD)"
+// @Success 200 {array} domain.UserSummary
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll [get]
+// @Security BearerAuth
+func (h *Handler) Summary(w http.ResponseWriter, r *http.Request) {
+	period, ok := parsePeriod(r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+	if !ok {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	summaries, err := h.useCase.Summary(r.Context(), period)
+	if err != nil {
+		writePayrollError(w, err, "Error al obtener resumen de nómina")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, summaries)
+}
+
+// GetPay maneja la solicitud del detalle de pago de un técnico
+// @Summary Detalle de pago de técnico
+// @Description Obtiene las tarifas de un técnico en el periodo con sus totales (pendiente, retenido y pagado)
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param userId path int true "ID del técnico"
+// @Param from query string true "Inicio del periodo (YYYY-MM-DD)"
+// @Param to query string true "Fin del periodo, inclusivo (YYYY-MM-DD)"
+// @Success 200 {object} domain.Pay
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/pay [get]
+// @Security BearerAuth
+func (h *Handler) GetPay(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	period, ok := parsePeriod(r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+	if !ok {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	pay, err := h.useCase.GetPay(r.Context(), userID, period)
+	if err != nil {
+		writePayrollError(w, err, "Error al obtener detalle de pago")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, pay)
+}
+
+// MarkPaid maneja la solicitud para marcar tarifas como pagadas
+// @Summary Marcar pago como realizado
+// @Description Marca como pagadas las tarifas pendientes del técnico en el periodo. Si se indican rateIds, solo esas. Las tarifas retenidas se omiten
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param userId path int true "ID del técnico"
+// @Param request body MarkPaidRequest true "Periodo y tarifas"
+// @Success 200 {object} CountResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/pay/mark-paid [post]
+// @Security BearerAuth
+func (h *Handler) MarkPaid(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	var req MarkPaidRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	period, ok := parsePeriod(req.From, req.To)
+	if !ok {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	count, err := h.useCase.MarkPaid(r.Context(), userID, period, req.RateIDs)
+	if err != nil {
+		writePayrollError(w, err, "Error al marcar pago")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CountResponse{Count: count})
+}
+
+// Hold maneja la solicitud para retener o liberar tarifas
+// @Summary Retener pago
+// @Description Retiene tarifas no pagadas del técnico para excluirlas del pago. Con held=false se liberan
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param userId path int true "ID del técnico"
+// @Param request body HoldRequest true "Tarifas a retener o liberar"
+// @Success 200 {object} CountResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/pay/hold [post]
+// @Security BearerAuth
+func (h *Handler) Hold(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	var req HoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	held := true
+	if req.Held != nil {
+		held = *req.Held
+	}
+
+	count, err := h.useCase.SetHeld(r.Context(), userID, req.RateIDs, held)
+	if err != nil {
+		writePayrollError(w, err, "Error al retener pago")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CountResponse{Count: count})
+}
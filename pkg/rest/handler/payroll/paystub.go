@@ -0,0 +1,238 @@
+package payroll
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/common/money"
+	domain "github.com/your-org/jvairv2/pkg/domain/payroll"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// PaystubItemResponse representa un job dentro de un recibo de pago
+type PaystubItemResponse struct {
+	RateID          int64        `json:"rateId" example:"12"`
+	JobID           int64        `json:"jobId" example:"100"`
+	WorkOrder       *string      `json:"workOrder,omitempty" example:"WO-2024-001"`
+	PropertyAddress string       `json:"propertyAddress" example:"123 Main St, Springfield, IL 62701"`
+	JobDate         string       `json:"jobDate" example:"2024-01-10"`
+	SalePrice       money.Amount `json:"salePrice" swaggertype:"number" example:"1250.00"`
+	TechParts       money.Amount `json:"techParts" swaggertype:"number" example:"100.10"`
+	CompanyParts    money.Amount `json:"companyParts" swaggertype:"number" example:"49.90"`
+	Deduction       money.Amount `json:"deduction" swaggertype:"number" example:"10.00"`
+	Payment         money.Amount `json:"payment" swaggertype:"number" example:"152.50"`
+	Paid            bool         `json:"paid" example:"false"`
+}
+
+// PaystubResponse representa un recibo de pago
+type PaystubResponse struct {
+	UserID   int64                 `json:"userId" example:"5"`
+	UserName string                `json:"userName" example:"John Tech"`
+	From     string                `json:"from" example:"2024-01-01"`
+	To       string                `json:"to" example:"2024-01-15"`
+	Items    []PaystubItemResponse `json:"items"`
+	Totals   domain.Totals         `json:"totals"`
+}
+
+// EmailPaystubRequest representa la solicitud de envío de un recibo de pago
+type EmailPaystubRequest struct {
+	PeriodRequest
+	Email *string `json:"email,omitempty" example:"john@example.com"`
+}
+
+func toPaystubResponse(stub *domain.Pay) PaystubResponse {
+	resp := PaystubResponse{
+		UserID:   stub.UserID,
+		UserName: stub.UserName,
+		From:     stub.Period.From.Format(dateFormat),
+		To:       stub.Period.To.Format(dateFormat),
+		Items:    make([]PaystubItemResponse, len(stub.Items)),
+		Totals:   stub.Totals,
+	}
+
+	for i, item := range stub.Items {
+		resp.Items[i] = PaystubItemResponse{
+			RateID:          item.RateID,
+			JobID:           item.JobID,
+			WorkOrder:       item.WorkOrder,
+			PropertyAddress: item.PropertyAddress(),
+			JobDate:         item.JobDate.Format(dateFormat),
+			SalePrice:       item.SalePrice,
+			TechParts:       item.TechParts,
+			CompanyParts:    item.CompanyParts,
+			Deduction:       item.Deduction,
+			Payment:         item.Payment,
+			Paid:            item.Paid,
+		}
+	}
+
+	return resp
+}
+
+// wantsPDF indica si la solicitud pide el recibo en PDF (?format=pdf o Accept: application/pdf)
+func wantsPDF(r *http.Request) bool {
+	if strings.EqualFold(r.URL.Query().Get("format"), "pdf") {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), "application/pdf")
+}
+
+// Paystub maneja la solicitud del recibo de pago de un técnico
+// @Summary Ver recibo de pago
+// @Description Obtiene el recibo de pago del técnico en el periodo: work order, dirección, precio de venta, partes, deducciones y pago de cada job, con totales. Las tarifas retenidas no se incluyen. Con format=pdf se descarga en PDF
+// @Tags Payroll
+// @Accept json
+// @Produce json,application/pdf
+// @Param userId path int true "ID del técnico"
+// @Param from query string true "Inicio del periodo (YYYY-MM-DD)"
+// @Param to query string true "Fin del periodo, inclusivo (YYYY-MM-DD)"
+// @Param format query string false "Formato de salida (json, pdf)"
+// @Success 200 {object} PaystubResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/paystub [get]
+// @Security BearerAuth
+func (h *Handler) Paystub(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	period, ok := parsePeriod(r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+	if !ok {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	stub, err := h.useCase.GetPaystub(r.Context(), userID, period)
+	if err != nil {
+		writePayrollError(w, err, "Error al obtener recibo de pago")
+		return
+	}
+
+	if !wantsPDF(r) {
+		response.JSON(w, http.StatusOK, toPaystubResponse(stub))
+		return
+	}
+
+	content, err := domain.RenderPaystub(stub).Bytes()
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to render paystub",
+			slog.Int64("userId", userID),
+			slog.String("error", err.Error()))
+		response.Error(w, http.StatusInternalServerError, "Error al generar el recibo de pago")
+		return
+	}
+
+	filename := fmt.Sprintf("paystub-%d-%s-%s.pdf", userID, period.From.Format(dateFormat), period.To.Format(dateFormat))
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(content)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
+// EmailPaystub maneja la solicitud de envío de un recibo de pago por email
+// @Summary Enviar recibo de pago por email
+// @Description Registra y deja en cola el envío del recibo de pago del periodo. Si no se indica email se usa el del técnico
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param userId path int true "ID del técnico"
+// @Param request body EmailPaystubRequest true "Periodo y email opcional"
+// @Success 202 {object} domain.PaystubDelivery
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/paystub/email [post]
+// @Security BearerAuth
+func (h *Handler) EmailPaystub(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	var req EmailPaystubRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	period, ok := parsePeriod(req.From, req.To)
+	if !ok {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha inválido")
+		return
+	}
+
+	delivery, err := h.useCase.EmailPaystub(r.Context(), userID, period, req.Email)
+	if err != nil {
+		writePayrollError(w, err, "Error al enviar recibo de pago")
+		return
+	}
+
+	response.JSON(w, http.StatusAccepted, delivery)
+}
+
+// ListPaystubEmails maneja la solicitud del historial de envíos de recibos
+// @Summary Listar envíos de recibos de pago
+// @Description Obtiene los envíos de recibos de pago solicitados para el técnico y su estado
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param userId path int true "ID del técnico"
+// @Success 200 {array} domain.PaystubDelivery
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/paystub/emails [get]
+// @Security BearerAuth
+func (h *Handler) ListPaystubEmails(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	deliveries, err := h.useCase.ListDeliveries(r.Context(), userID)
+	if err != nil {
+		writePayrollError(w, err, "Error al listar envíos de recibos")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, deliveries)
+}
+
+// History maneja la solicitud del historial de pagos de un técnico
+// @Summary Historial de pagos de técnico
+// @Description Obtiene los pagos realizados al técnico, agrupados por día de pago
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param userId path int true "ID del técnico"
+// @Success 200 {array} domain.Payout
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/payroll/{userId}/history [get]
+// @Security BearerAuth
+func (h *Handler) History(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de usuario inválido")
+		return
+	}
+
+	payouts, err := h.useCase.History(r.Context(), userID)
+	if err != nil {
+		writePayrollError(w, err, "Error al obtener historial de pagos")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, payouts)
+}
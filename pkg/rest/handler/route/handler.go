@@ -0,0 +1,38 @@
+package route
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/rest/middleware"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler expone el mapa de habilidades por ruta
+type Handler struct {
+	guard *middleware.RouteGuard
+}
+
+// NewHandler crea una nueva instancia del handler de rutas
+func NewHandler(guard *middleware.RouteGuard) *Handler {
+	return &Handler{guard: guard}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Get("/routes", h.List)
+}
+
+// List maneja el listado de rutas con su habilidad requerida
+// @Summary Listar rutas y habilidades
+// @Description Lista cada ruta de /api/v1 con la habilidad que exige, para configurar los roles. Ability vacía indica que basta con estar autenticado; denied indica una ruta sin habilidad asignada, que se rechaza siempre
+// @Tags Abilities
+// @Produce json
+// @Success 200 {array} middleware.RouteAbility
+// @Failure 401 {string} string "No autorizado"
+// @Failure 403 {object} response.ErrorResponse
+// @Router /api/v1/routes [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, h.guard.Routes())
+}
@@ -0,0 +1,281 @@
+package sms_template
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/domain/sms_template"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para plantillas de SMS
+type Handler struct {
+	useCase sms_template.Service
+}
+
+// NewHandler crea una nueva instancia del handler de plantillas de SMS
+func NewHandler(useCase sms_template.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/sms-templates", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// SMSTemplateRequest representa la solicitud para crear o actualizar una plantilla de SMS
+type SMSTemplateRequest struct {
+	Label    string `json:"label" example:"Dispatch"`
+	Message  string `json:"message" example:"New job {{job.work_order}} at {{property.address}}"`
+	IsActive *bool  `json:"isActive,omitempty" example:"true"`
+}
+
+// SMSTemplateResponse representa la respuesta de una plantilla de SMS
+type SMSTemplateResponse struct {
+	ID        int64  `json:"id" example:"1"`
+	Label     string `json:"label" example:"Dispatch"`
+	Message   string `json:"message" example:"New job {{job.work_order}} at {{property.address}}"`
+	IsActive  bool   `json:"isActive" example:"true"`
+	CreatedAt string `json:"createdAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt string `json:"updatedAt,omitempty" example:"2024-01-18T14:20:00Z"`
+}
+
+func toResponse(t *sms_template.SMSTemplate) SMSTemplateResponse {
+	resp := SMSTemplateResponse{
+		ID:       t.ID,
+		Label:    t.Label,
+		Message:  t.Message,
+		IsActive: t.IsActive,
+	}
+
+	if t.CreatedAt != nil {
+		resp.CreatedAt = t.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if t.UpdatedAt != nil {
+		resp.UpdatedAt = t.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return resp
+}
+
+func (req *SMSTemplateRequest) toEntity() *sms_template.SMSTemplate {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	return &sms_template.SMSTemplate{
+		Label:    req.Label,
+		Message:  req.Message,
+		IsActive: isActive,
+	}
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	if isActiveStr := r.URL.Query().Get("isActive"); isActiveStr != "" {
+		if isActive, err := strconv.ParseBool(isActiveStr); err == nil {
+			filters["is_active"] = isActive
+		}
+	}
+
+	return filters
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "label is required",
+		"message is required":
+		return true
+	}
+	return strings.HasPrefix(err.Error(), "unknown placeholders")
+}
+
+// writeTemplateError traduce los errores del dominio a respuestas HTTP
+func writeTemplateError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err == sms_template.ErrSMSTemplateNotFound:
+		response.Error(w, http.StatusNotFound, "Plantilla de SMS no encontrada")
+	case isValidationError(err):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// List maneja la solicitud de listado de plantillas de SMS
+// @Summary Listar plantillas de SMS
+// @Description Obtiene una lista paginada de plantillas de SMS con filtros opcionales
+// @Tags SMSTemplates
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param search query string false "Búsqueda por label o mensaje"
+// @Param isActive query bool false "Filtrar por estado activo"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/sms-templates [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	templates, total, err := h.useCase.List(r.Context(), parseFilters(r), page, pageSize)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al listar plantillas de SMS")
+		return
+	}
+
+	items := make([]SMSTemplateResponse, len(templates))
+	for i, t := range templates {
+		items[i] = toResponse(t)
+	}
+
+	response.Paginated(w, items, page, pageSize, total)
+}
+
+// Create maneja la solicitud de creación de una plantilla de SMS
+// @Summary Crear plantilla de SMS
+// @Description Crea una plantilla de SMS. El mensaje solo puede usar los marcadores de /email-templates/placeholders
+// @Tags SMSTemplates
+// @Accept json
+// @Produce json
+// @Param template body SMSTemplateRequest true "Datos de la plantilla"
+// @Success 201 {object} SMSTemplateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/sms-templates [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req SMSTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	t := req.toEntity()
+
+	if err := h.useCase.Create(r.Context(), t); err != nil {
+		writeTemplateError(w, err, "Error al crear plantilla de SMS")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(t))
+}
+
+// Get maneja la solicitud de obtención de una plantilla de SMS por ID
+// @Summary Obtener plantilla de SMS
+// @Description Obtiene una plantilla de SMS por su ID
+// @Tags SMSTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Success 200 {object} SMSTemplateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/sms-templates/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	t, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		writeTemplateError(w, err, "Error al obtener plantilla de SMS")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(t))
+}
+
+// Update maneja la solicitud de actualización de una plantilla de SMS
+// @Summary Actualizar plantilla de SMS
+// @Description Actualiza una plantilla de SMS existente
+// @Tags SMSTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Param template body SMSTemplateRequest true "Datos de la plantilla"
+// @Success 200 {object} SMSTemplateResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/sms-templates/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req SMSTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	t := req.toEntity()
+	t.ID = id
+
+	if err := h.useCase.Update(r.Context(), t); err != nil {
+		writeTemplateError(w, err, "Error al actualizar plantilla de SMS")
+		return
+	}
+
+	updated, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		response.JSON(w, http.StatusOK, toResponse(t))
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(updated))
+}
+
+// Delete maneja la solicitud de eliminación de una plantilla de SMS
+// @Summary Eliminar plantilla de SMS
+// @Description Elimina una plantilla de SMS
+// @Tags SMSTemplates
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/sms-templates/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		writeTemplateError(w, err, "Error al eliminar plantilla de SMS")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
+	passwordPolicy "github.com/your-org/jvairv2/pkg/domain/password_policy"
 	"github.com/your-org/jvairv2/pkg/domain/user"
 	"github.com/your-org/jvairv2/pkg/rest/middleware"
 	"github.com/your-org/jvairv2/pkg/rest/response"
@@ -31,7 +32,7 @@ func NewHandler(userUseCase *user.UseCase) *Handler {
 type CreateUserRequest struct {
 	Name     string  `json:"name" validate:"required"`
 	Email    string  `json:"email" validate:"required,email"`
-	Password string  `json:"password" validate:"required,min=6"`
+	Password string  `json:"password" validate:"required"`
 	RoleID   *string `json:"roleId,omitempty"`
 }
 
@@ -39,7 +40,7 @@ type CreateUserRequest struct {
 type UpdateUserRequest struct {
 	Name     string  `json:"name" validate:"required"`
 	Email    string  `json:"email" validate:"required,email"`
-	Password string  `json:"password,omitempty" validate:"omitempty,min=6"`
+	Password string  `json:"password,omitempty"`
 	RoleID   *string `json:"roleId,omitempty"`
 	IsActive bool    `json:"isActive"`
 }
@@ -72,6 +73,7 @@ type RoleInfo struct {
 // @Success 201 {object} user.UserResponse
 // @Failure 400 {string} string "Error al decodificar la solicitud o datos inválidos"
 // @Failure 409 {string} string "Email ya está en uso"
+// @Failure 422 {string} string "La contraseña no cumple la política de settings"
 // @Failure 500 {string} string "Error interno del servidor"
 // @Router /api/v1/users [post]
 // @Security BearerAuth
@@ -108,6 +110,10 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 			response.Error(w, http.StatusConflict, "Email ya está en uso")
 			return
 		}
+		if passwordPolicy.IsViolation(err) {
+			response.Error(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
 		// Registrar el error detallado para depuración
 		slog.Error("Error al crear usuario",
 			"name", req.Name,
@@ -224,6 +230,7 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 // @Failure 400 {string} string "Error al decodificar la solicitud o datos inválidos"
 // @Failure 404 {string} string "Usuario no encontrado"
 // @Failure 409 {string} string "Email ya está en uso"
+// @Failure 422 {string} string "La contraseña no cumple la política de settings"
 // @Failure 500 {string} string "Error interno del servidor"
 // @Router /api/v1/users/{id} [put]
 // @Security BearerAuth
@@ -280,6 +287,10 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 			response.Error(w, http.StatusConflict, "Email ya está en uso")
 			return
 		}
+		if passwordPolicy.IsViolation(err) {
+			response.Error(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
 		response.Error(w, http.StatusInternalServerError, "Error al actualizar el usuario")
 		return
 	}
@@ -0,0 +1,401 @@
+package warranty
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	domainWarranty "github.com/your-org/jvairv2/pkg/domain/warranty"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para garantías
+type Handler struct {
+	useCase domainWarranty.Service
+}
+
+// NewHandler crea una nueva instancia del handler de garantías
+func NewHandler(useCase domainWarranty.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/warranties", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// CreateWarrantyRequest representa la solicitud para crear una garantía
+type CreateWarrantyRequest struct {
+	WarrantyNumber   string  `json:"warrantyNumber"`
+	JobID            int64   `json:"jobId"`
+	WarrantyTypeID   int64   `json:"warrantyTypeId"`
+	WarrantyStatusID int64   `json:"warrantyStatusId"`
+	DateSubmitted    *string `json:"dateSubmitted,omitempty"`
+	AgreementNumber  *string `json:"agreementNumber,omitempty"`
+	AuditDone        bool    `json:"auditDone"`
+	Notes            *string `json:"notes,omitempty"`
+}
+
+// UpdateWarrantyRequest representa la solicitud para actualizar una garantía
+type UpdateWarrantyRequest struct {
+	WarrantyNumber   string  `json:"warrantyNumber"`
+	JobID            int64   `json:"jobId"`
+	WarrantyTypeID   int64   `json:"warrantyTypeId"`
+	WarrantyStatusID int64   `json:"warrantyStatusId"`
+	DateSubmitted    *string `json:"dateSubmitted,omitempty"`
+	AgreementNumber  *string `json:"agreementNumber,omitempty"`
+	AuditDone        bool    `json:"auditDone"`
+	Notes            *string `json:"notes,omitempty"`
+}
+
+// WarrantyResponse representa la respuesta de una garantía
+type WarrantyResponse struct {
+	ID               int64   `json:"id"`
+	WarrantyNumber   string  `json:"warrantyNumber"`
+	JobID            int64   `json:"jobId"`
+	WarrantyTypeID   int64   `json:"warrantyTypeId"`
+	WarrantyStatusID int64   `json:"warrantyStatusId"`
+	DateSubmitted    *string `json:"dateSubmitted,omitempty"`
+	AgreementNumber  *string `json:"agreementNumber,omitempty"`
+	AuditDone        bool    `json:"auditDone"`
+	Notes            *string `json:"notes,omitempty"`
+	CreatedAt        string  `json:"createdAt,omitempty"`
+	UpdatedAt        string  `json:"updatedAt,omitempty"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toWarrantyResponse(wr *domainWarranty.Warranty) WarrantyResponse {
+	resp := WarrantyResponse{
+		ID:               wr.ID,
+		WarrantyNumber:   wr.WarrantyNumber,
+		JobID:            wr.JobID,
+		WarrantyTypeID:   wr.WarrantyTypeID,
+		WarrantyStatusID: wr.WarrantyStatusID,
+		AgreementNumber:  wr.AgreementNumber,
+		AuditDone:        wr.AuditDone,
+		Notes:            wr.Notes,
+	}
+
+	if wr.DateSubmitted != nil {
+		s := wr.DateSubmitted.Format("2006-01-02")
+		resp.DateSubmitted = &s
+	}
+	if wr.CreatedAt != nil {
+		resp.CreatedAt = wr.CreatedAt.Format(timeFormat)
+	}
+	if wr.UpdatedAt != nil {
+		resp.UpdatedAt = wr.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+// parseDatePtr parsea una fecha opcional en formato RFC3339 o 2006-01-02
+func parseDatePtr(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		t, err = time.Parse("2006-01-02", *s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	if jobIDStr := r.URL.Query().Get("jobId"); jobIDStr != "" {
+		if id, err := strconv.ParseInt(jobIDStr, 10, 64); err == nil {
+			filters["job_id"] = id
+		}
+	}
+
+	if warrantyTypeIDStr := r.URL.Query().Get("warrantyTypeId"); warrantyTypeIDStr != "" {
+		if id, err := strconv.ParseInt(warrantyTypeIDStr, 10, 64); err == nil {
+			filters["warranty_type_id"] = id
+		}
+	}
+
+	if warrantyStatusIDStr := r.URL.Query().Get("warrantyStatusId"); warrantyStatusIDStr != "" {
+		if id, err := strconv.ParseInt(warrantyStatusIDStr, 10, 64); err == nil {
+			filters["warranty_status_id"] = id
+		}
+	}
+
+	if auditDoneStr := r.URL.Query().Get("auditDone"); auditDoneStr != "" {
+		if auditDone, err := strconv.ParseBool(auditDoneStr); err == nil {
+			filters["audit_done"] = auditDone
+		}
+	}
+
+	if sort := r.URL.Query().Get("sort"); sort != "" {
+		filters["sort"] = sort
+	}
+
+	if direction := r.URL.Query().Get("direction"); direction != "" {
+		filters["direction"] = direction
+	}
+
+	return filters
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "warranty_number is required",
+		"job_id is required",
+		"warranty_type_id is required",
+		"warranty_status_id is required":
+		return true
+	}
+	return false
+}
+
+// List maneja la solicitud de listado de garantías
+// @Summary Listar garantías
+// @Description Obtiene una lista paginada de garantías con filtros opcionales
+// @Tags Warranties
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(15)
+// @Param search query string false "Búsqueda por número de garantía o de acuerdo"
+// @Param jobId query int false "Filtrar por trabajo"
+// @Param warrantyTypeId query int false "Filtrar por tipo de garantía"
+// @Param warrantyStatusId query int false "Filtrar por estado de garantía"
+// @Param auditDone query bool false "Filtrar por auditoría realizada"
+// @Param sort query string false "Campo de ordenamiento (warranty_number, date_submitted, created_at)"
+// @Param direction query string false "Dirección de ordenamiento (asc, desc)"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 15
+	}
+
+	filters := parseFilters(r)
+
+	warranties, total, err := h.useCase.List(r.Context(), filters, page, pageSize)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al listar garantías")
+		return
+	}
+
+	items := make([]WarrantyResponse, len(warranties))
+	for i, wr := range warranties {
+		items[i] = toWarrantyResponse(wr)
+	}
+
+	response.Paginated(w, items, page, pageSize, int(total))
+}
+
+// Create maneja la solicitud de creación de una garantía
+// @Summary Crear garantía
+// @Description Registra una nueva garantía para un trabajo
+// @Tags Warranties
+// @Accept json
+// @Produce json
+// @Param warranty body CreateWarrantyRequest true "Datos de la garantía"
+// @Success 201 {object} WarrantyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateWarrantyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	dateSubmitted, err := parseDatePtr(req.DateSubmitted)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha de envío inválido")
+		return
+	}
+
+	wr := &domainWarranty.Warranty{
+		WarrantyNumber:   req.WarrantyNumber,
+		JobID:            req.JobID,
+		WarrantyTypeID:   req.WarrantyTypeID,
+		WarrantyStatusID: req.WarrantyStatusID,
+		DateSubmitted:    dateSubmitted,
+		AgreementNumber:  req.AgreementNumber,
+		AuditDone:        req.AuditDone,
+		Notes:            req.Notes,
+	}
+
+	if err := h.useCase.Create(r.Context(), wr); err != nil {
+		switch err {
+		case domainWarranty.ErrInvalidJob,
+			domainWarranty.ErrInvalidWarrantyType,
+			domainWarranty.ErrInvalidWarrantyStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+			} else {
+				response.Error(w, http.StatusInternalServerError, "Error al crear garantía")
+			}
+		}
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toWarrantyResponse(wr))
+}
+
+// Get maneja la solicitud de obtención de una garantía por ID
+// @Summary Obtener garantía
+// @Description Obtiene una garantía por su ID
+// @Tags Warranties
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la garantía"
+// @Success 200 {object} WarrantyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	wr, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		if err == domainWarranty.ErrWarrantyNotFound {
+			response.Error(w, http.StatusNotFound, "Garantía no encontrada")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al obtener garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toWarrantyResponse(wr))
+}
+
+// Update maneja la solicitud de actualización de una garantía
+// @Summary Actualizar garantía
+// @Description Actualiza una garantía existente
+// @Tags Warranties
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la garantía"
+// @Param warranty body UpdateWarrantyRequest true "Datos de la garantía"
+// @Success 200 {object} WarrantyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req UpdateWarrantyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	dateSubmitted, err := parseDatePtr(req.DateSubmitted)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Formato de fecha de envío inválido")
+		return
+	}
+
+	wr := &domainWarranty.Warranty{
+		ID:               id,
+		WarrantyNumber:   req.WarrantyNumber,
+		JobID:            req.JobID,
+		WarrantyTypeID:   req.WarrantyTypeID,
+		WarrantyStatusID: req.WarrantyStatusID,
+		DateSubmitted:    dateSubmitted,
+		AgreementNumber:  req.AgreementNumber,
+		AuditDone:        req.AuditDone,
+		Notes:            req.Notes,
+	}
+
+	if err := h.useCase.Update(r.Context(), wr); err != nil {
+		switch err {
+		case domainWarranty.ErrWarrantyNotFound:
+			response.Error(w, http.StatusNotFound, "Garantía no encontrada")
+		case domainWarranty.ErrInvalidJob,
+			domainWarranty.ErrInvalidWarrantyType,
+			domainWarranty.ErrInvalidWarrantyStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+			} else {
+				response.Error(w, http.StatusInternalServerError, "Error al actualizar garantía")
+			}
+		}
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toWarrantyResponse(wr))
+}
+
+// Delete maneja la solicitud de eliminación de una garantía
+// @Summary Eliminar garantía
+// @Description Elimina una garantía (soft delete)
+// @Tags Warranties
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la garantía"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		if err == domainWarranty.ErrWarrantyNotFound {
+			response.Error(w, http.StatusNotFound, "Garantía no encontrada")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar garantía")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
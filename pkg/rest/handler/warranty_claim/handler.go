@@ -0,0 +1,420 @@
+package warranty_claim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	domainClaim "github.com/your-org/jvairv2/pkg/domain/warranty_claim"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para reclamaciones de garantía
+type Handler struct {
+	useCase domainClaim.Service
+}
+
+// NewHandler crea una nueva instancia del handler de reclamaciones de garantía
+func NewHandler(useCase domainClaim.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/warranty-claims", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// WarrantyClaimRequest representa la solicitud para crear o actualizar una reclamación de garantía
+type WarrantyClaimRequest struct {
+	InternalClaimNumber   string  `json:"internalClaimNumber"`
+	WarrantyClaimTypeID   int64   `json:"warrantyClaimTypeId"`
+	WarrantyClaimStatusID int64   `json:"warrantyClaimStatusId"`
+	JobID                 int64   `json:"jobId"`
+	InvoiceNumber         *string `json:"invoiceNumber,omitempty"`
+	WorkDone              bool    `json:"workDone"`
+	WarrantyPart          *string `json:"warrantyPart,omitempty"`
+	Manufacturer          *string `json:"manufacturer,omitempty"`
+	ModelNumber           *string `json:"modelNumber,omitempty"`
+	PartNumber            *string `json:"partNumber,omitempty"`
+	ReplacementPartNumber *string `json:"replacementPartNumber,omitempty"`
+	PartDistributor       *string `json:"partDistributor,omitempty"`
+	PartInvoiceNumber     *string `json:"partInvoiceNumber,omitempty"`
+	OldPartSerialNumber   *string `json:"oldPartSerialNumber,omitempty"`
+	NewPartSerialNumber   *string `json:"newPartSerialNumber,omitempty"`
+	EsaNumber             *string `json:"esaNumber,omitempty"`
+	Serial                *string `json:"serial,omitempty"`
+	ClaimNumber           *string `json:"claimNumber,omitempty"`
+	Approved              bool    `json:"approved"`
+	PartsCreditReceived   bool    `json:"partsCreditReceived"`
+	LaborPaymentReceived  bool    `json:"laborPaymentReceived"`
+	Notes                 *string `json:"notes,omitempty"`
+}
+
+// WarrantyClaimResponse representa la respuesta de una reclamación de garantía
+type WarrantyClaimResponse struct {
+	ID                    int64   `json:"id"`
+	InternalClaimNumber   string  `json:"internalClaimNumber"`
+	WarrantyClaimTypeID   int64   `json:"warrantyClaimTypeId"`
+	WarrantyClaimStatusID int64   `json:"warrantyClaimStatusId"`
+	JobID                 int64   `json:"jobId"`
+	InvoiceNumber         *string `json:"invoiceNumber,omitempty"`
+	WorkDone              bool    `json:"workDone"`
+	WarrantyPart          *string `json:"warrantyPart,omitempty"`
+	Manufacturer          *string `json:"manufacturer,omitempty"`
+	ModelNumber           *string `json:"modelNumber,omitempty"`
+	PartNumber            *string `json:"partNumber,omitempty"`
+	ReplacementPartNumber *string `json:"replacementPartNumber,omitempty"`
+	PartDistributor       *string `json:"partDistributor,omitempty"`
+	PartInvoiceNumber     *string `json:"partInvoiceNumber,omitempty"`
+	OldPartSerialNumber   *string `json:"oldPartSerialNumber,omitempty"`
+	NewPartSerialNumber   *string `json:"newPartSerialNumber,omitempty"`
+	EsaNumber             *string `json:"esaNumber,omitempty"`
+	Serial                *string `json:"serial,omitempty"`
+	ClaimNumber           *string `json:"claimNumber,omitempty"`
+	Approved              bool    `json:"approved"`
+	PartsCreditReceived   bool    `json:"partsCreditReceived"`
+	LaborPaymentReceived  bool    `json:"laborPaymentReceived"`
+	CreditPending         bool    `json:"creditPending"`
+	LaborPending          bool    `json:"laborPending"`
+	Notes                 *string `json:"notes,omitempty"`
+	CreatedAt             string  `json:"createdAt,omitempty"`
+	UpdatedAt             string  `json:"updatedAt,omitempty"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func toWarrantyClaimResponse(c *domainClaim.WarrantyClaim) WarrantyClaimResponse {
+	resp := WarrantyClaimResponse{
+		ID:                    c.ID,
+		InternalClaimNumber:   c.InternalClaimNumber,
+		WarrantyClaimTypeID:   c.WarrantyClaimTypeID,
+		WarrantyClaimStatusID: c.WarrantyClaimStatusID,
+		JobID:                 c.JobID,
+		InvoiceNumber:         c.InvoiceNumber,
+		WorkDone:              c.WorkDone,
+		WarrantyPart:          c.WarrantyPart,
+		Manufacturer:          c.Manufacturer,
+		ModelNumber:           c.ModelNumber,
+		PartNumber:            c.PartNumber,
+		ReplacementPartNumber: c.ReplacementPartNumber,
+		PartDistributor:       c.PartDistributor,
+		PartInvoiceNumber:     c.PartInvoiceNumber,
+		OldPartSerialNumber:   c.OldPartSerialNumber,
+		NewPartSerialNumber:   c.NewPartSerialNumber,
+		EsaNumber:             c.EsaNumber,
+		Serial:                c.Serial,
+		ClaimNumber:           c.ClaimNumber,
+		Approved:              c.Approved,
+		PartsCreditReceived:   c.PartsCreditReceived,
+		LaborPaymentReceived:  c.LaborPaymentReceived,
+		CreditPending:         c.IsCreditPending(),
+		LaborPending:          c.IsLaborPending(),
+		Notes:                 c.Notes,
+	}
+
+	if c.CreatedAt != nil {
+		resp.CreatedAt = c.CreatedAt.Format(timeFormat)
+	}
+	if c.UpdatedAt != nil {
+		resp.UpdatedAt = c.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func (req *WarrantyClaimRequest) toEntity() *domainClaim.WarrantyClaim {
+	return &domainClaim.WarrantyClaim{
+		InternalClaimNumber:   req.InternalClaimNumber,
+		WarrantyClaimTypeID:   req.WarrantyClaimTypeID,
+		WarrantyClaimStatusID: req.WarrantyClaimStatusID,
+		JobID:                 req.JobID,
+		InvoiceNumber:         req.InvoiceNumber,
+		WorkDone:              req.WorkDone,
+		WarrantyPart:          req.WarrantyPart,
+		Manufacturer:          req.Manufacturer,
+		ModelNumber:           req.ModelNumber,
+		PartNumber:            req.PartNumber,
+		ReplacementPartNumber: req.ReplacementPartNumber,
+		PartDistributor:       req.PartDistributor,
+		PartInvoiceNumber:     req.PartInvoiceNumber,
+		OldPartSerialNumber:   req.OldPartSerialNumber,
+		NewPartSerialNumber:   req.NewPartSerialNumber,
+		EsaNumber:             req.EsaNumber,
+		Serial:                req.Serial,
+		ClaimNumber:           req.ClaimNumber,
+		Approved:              req.Approved,
+		PartsCreditReceived:   req.PartsCreditReceived,
+		LaborPaymentReceived:  req.LaborPaymentReceived,
+		Notes:                 req.Notes,
+	}
+}
+
+func parseFilters(r *http.Request) map[string]interface{} {
+	filters := make(map[string]interface{})
+	q := r.URL.Query()
+
+	if search := q.Get("search"); search != "" {
+		filters["search"] = search
+	}
+
+	int64Params := map[string]string{
+		"jobId":                 "job_id",
+		"warrantyClaimTypeId":   "warranty_claim_type_id",
+		"warrantyClaimStatusId": "warranty_claim_status_id",
+	}
+	for param, key := range int64Params {
+		if v := q.Get(param); v != "" {
+			if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+				filters[key] = id
+			}
+		}
+	}
+
+	boolParams := map[string]string{
+		"approved":      "approved",
+		"workDone":      "work_done",
+		"creditPending": "credit_pending",
+		"laborPending":  "labor_pending",
+	}
+	for param, key := range boolParams {
+		if v := q.Get(param); v != "" {
+			if b, err := strconv.ParseBool(v); err == nil {
+				filters[key] = b
+			}
+		}
+	}
+
+	if sort := q.Get("sort"); sort != "" {
+		filters["sort"] = sort
+	}
+
+	if direction := q.Get("direction"); direction != "" {
+		filters["direction"] = direction
+	}
+
+	return filters
+}
+
+func isValidationError(err error) bool {
+	switch err.Error() {
+	case "internal_claim_number is required",
+		"job_id is required",
+		"warranty_claim_type_id is required",
+		"warranty_claim_status_id is required":
+		return true
+	}
+	return false
+}
+
+// List maneja la solicitud de listado de reclamaciones de garantía
+// @Summary Listar reclamaciones de garantía
+// @Description Obtiene una lista paginada de reclamaciones de garantía. Use creditPending para las aprobadas sin crédito de partes y laborPending para las aprobadas sin pago de mano de obra
+// @Tags WarrantyClaims
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(15)
+// @Param search query string false "Búsqueda por número interno, número de reclamación, ESA o número de parte"
+// @Param jobId query int false "Filtrar por trabajo"
+// @Param warrantyClaimTypeId query int false "Filtrar por tipo de reclamación"
+// @Param warrantyClaimStatusId query int false "Filtrar por estado de reclamación"
+// @Param approved query bool false "Filtrar por aprobadas"
+// @Param workDone query bool false "Filtrar por trabajo realizado"
+// @Param creditPending query bool false "Solo aprobadas con crédito de partes pendiente"
+// @Param laborPending query bool false "Solo aprobadas con pago de mano de obra pendiente"
+// @Param sort query string false "Campo de ordenamiento (internal_claim_number, claim_number, created_at)"
+// @Param direction query string false "Dirección de ordenamiento (asc, desc)"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claims [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 15
+	}
+
+	filters := parseFilters(r)
+
+	claims, total, err := h.useCase.List(r.Context(), filters, page, pageSize)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al listar reclamaciones de garantía")
+		return
+	}
+
+	items := make([]WarrantyClaimResponse, len(claims))
+	for i, c := range claims {
+		items[i] = toWarrantyClaimResponse(c)
+	}
+
+	response.Paginated(w, items, page, pageSize, int(total))
+}
+
+// Create maneja la solicitud de creación de una reclamación de garantía
+// @Summary Crear reclamación de garantía
+// @Description Crea una nueva reclamación de garantía para un trabajo
+// @Tags WarrantyClaims
+// @Accept json
+// @Produce json
+// @Param warrantyClaim body WarrantyClaimRequest true "Datos de la reclamación"
+// @Success 201 {object} WarrantyClaimResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claims [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req WarrantyClaimRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	c := req.toEntity()
+
+	if err := h.useCase.Create(r.Context(), c); err != nil {
+		switch err {
+		case domainClaim.ErrInvalidJob,
+			domainClaim.ErrInvalidWarrantyClaimType,
+			domainClaim.ErrInvalidWarrantyClaimStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+			} else {
+				response.Error(w, http.StatusInternalServerError, "Error al crear reclamación de garantía")
+			}
+		}
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toWarrantyClaimResponse(c))
+}
+
+// Get maneja la solicitud de obtención de una reclamación de garantía por ID
+// @Summary Obtener reclamación de garantía
+// @Description Obtiene una reclamación de garantía por su ID
+// @Tags WarrantyClaims
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la reclamación"
+// @Success 200 {object} WarrantyClaimResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claims/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	c, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		if err == domainClaim.ErrWarrantyClaimNotFound {
+			response.Error(w, http.StatusNotFound, "Reclamación de garantía no encontrada")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al obtener reclamación de garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toWarrantyClaimResponse(c))
+}
+
+// Update maneja la solicitud de actualización de una reclamación de garantía
+// @Summary Actualizar reclamación de garantía
+// @Description Actualiza una reclamación de garantía existente
+// @Tags WarrantyClaims
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la reclamación"
+// @Param warrantyClaim body WarrantyClaimRequest true "Datos de la reclamación"
+// @Success 200 {object} WarrantyClaimResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claims/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req WarrantyClaimRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	c := req.toEntity()
+	c.ID = id
+
+	if err := h.useCase.Update(r.Context(), c); err != nil {
+		switch err {
+		case domainClaim.ErrWarrantyClaimNotFound:
+			response.Error(w, http.StatusNotFound, "Reclamación de garantía no encontrada")
+		case domainClaim.ErrInvalidJob,
+			domainClaim.ErrInvalidWarrantyClaimType,
+			domainClaim.ErrInvalidWarrantyClaimStatus:
+			response.Error(w, http.StatusBadRequest, err.Error())
+		default:
+			if isValidationError(err) {
+				response.Error(w, http.StatusBadRequest, err.Error())
+			} else {
+				response.Error(w, http.StatusInternalServerError, "Error al actualizar reclamación de garantía")
+			}
+		}
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toWarrantyClaimResponse(c))
+}
+
+// Delete maneja la solicitud de eliminación de una reclamación de garantía
+// @Summary Eliminar reclamación de garantía
+// @Description Elimina una reclamación de garantía (soft delete)
+// @Tags WarrantyClaims
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la reclamación"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claims/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		if err == domainClaim.ErrWarrantyClaimNotFound {
+			response.Error(w, http.StatusNotFound, "Reclamación de garantía no encontrada")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar reclamación de garantía")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,292 @@
+package warranty_claim_status
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/domain/warranty_claim_status"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+type Handler struct {
+	useCase warranty_claim_status.Service
+}
+
+func NewHandler(useCase warranty_claim_status.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/warranty-claim-statuses", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// CreateWarrantyClaimStatusRequest representa la solicitud para crear un estado de reclamación de garantía
+type CreateWarrantyClaimStatusRequest struct {
+	Label    string  `json:"label" validate:"required"`
+	Class    *string `json:"class,omitempty"`
+	Order    int     `json:"order"`
+	IsActive *bool   `json:"isActive,omitempty"`
+}
+
+// UpdateWarrantyClaimStatusRequest representa la solicitud para actualizar un estado de reclamación de garantía
+type UpdateWarrantyClaimStatusRequest struct {
+	Label    string  `json:"label" validate:"required"`
+	Class    *string `json:"class,omitempty"`
+	Order    int     `json:"order"`
+	IsActive *bool   `json:"isActive,omitempty"`
+}
+
+// WarrantyClaimStatusResponse representa la respuesta de un estado de reclamación de garantía
+type WarrantyClaimStatusResponse struct {
+	ID        int64   `json:"id"`
+	Label     string  `json:"label"`
+	Class     *string `json:"class,omitempty"`
+	Order     int     `json:"order"`
+	IsActive  bool    `json:"isActive"`
+	CreatedAt string  `json:"createdAt,omitempty"`
+	UpdatedAt string  `json:"updatedAt,omitempty"`
+}
+
+func toResponse(ws *warranty_claim_status.WarrantyClaimStatus) WarrantyClaimStatusResponse {
+	resp := WarrantyClaimStatusResponse{
+		ID:       ws.ID,
+		Label:    ws.Label,
+		Class:    ws.Class,
+		Order:    ws.Order,
+		IsActive: ws.IsActive,
+	}
+
+	if ws.CreatedAt != nil {
+		resp.CreatedAt = ws.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if ws.UpdatedAt != nil {
+		resp.UpdatedAt = ws.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return resp
+}
+
+// List maneja la solicitud de listado de estados de reclamación de garantía
+// @Summary Listar estados de reclamación de garantía
+// @Description Obtiene una lista paginada de estados de reclamación de garantía
+// @Tags WarrantyClaimStatuss
+// @Accept json
+// @Produce json
+// @Param page query int false "Número de página" default(1)
+// @Param pageSize query int false "Tamaño de página" default(10)
+// @Param search query string false "Búsqueda por label"
+// @Param isActive query bool false "Filtrar por estado activo"
+// @Success 200 {object} response.PaginatedResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claim-statuses [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	filters := make(map[string]interface{})
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters["search"] = search
+	}
+	if isActive := r.URL.Query().Get("isActive"); isActive != "" {
+		if v, err := strconv.ParseBool(isActive); err == nil {
+			filters["is_active"] = v
+		}
+	}
+
+	statuses, total, err := h.useCase.List(r.Context(), filters, page, pageSize)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al listar estados de reclamación de garantía")
+		return
+	}
+
+	items := make([]WarrantyClaimStatusResponse, len(statuses))
+	for i, ws := range statuses {
+		items[i] = toResponse(ws)
+	}
+
+	response.Paginated(w, items, page, pageSize, total)
+}
+
+// Create maneja la solicitud de creación de un estado de reclamación de garantía
+// @Summary Crear estado de reclamación de garantía
+// @Description Crea un nuevo estado de reclamación de garantía
+// @Tags WarrantyClaimStatuss
+// @Accept json
+// @Produce json
+// @Param warrantyType body CreateWarrantyClaimStatusRequest true "Datos del estado de reclamación de garantía"
+// @Success 201 {object} WarrantyClaimStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claim-statuses [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateWarrantyClaimStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	ws := &warranty_claim_status.WarrantyClaimStatus{
+		Label:    req.Label,
+		Class:    req.Class,
+		Order:    req.Order,
+		IsActive: true,
+	}
+	if req.IsActive != nil {
+		ws.IsActive = *req.IsActive
+	}
+
+	if err := ws.Validate(); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.useCase.Create(r.Context(), ws); err != nil {
+		response.Error(w, http.StatusInternalServerError, "Error al crear estado de reclamación de garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(ws))
+}
+
+// Get maneja la solicitud de obtención de un estado de reclamación de garantía por ID
+// @Summary Obtener estado de reclamación de garantía
+// @Description Obtiene un estado de reclamación de garantía por su ID
+// @Tags WarrantyClaimStatuss
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del estado de reclamación de garantía"
+// @Success 200 {object} WarrantyClaimStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claim-statuses/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	ws, err := h.useCase.GetByID(r.Context(), id)
+	if err != nil {
+		if err == warranty_claim_status.ErrWarrantyClaimStatusNotFound {
+			response.Error(w, http.StatusNotFound, "Estado de reclamación de garantía no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al obtener estado de reclamación de garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(ws))
+}
+
+// Update maneja la solicitud de actualización de un estado de reclamación de garantía
+// @Summary Actualizar estado de reclamación de garantía
+// @Description Actualiza un estado de reclamación de garantía existente
+// @Tags WarrantyClaimStatuss
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del estado de reclamación de garantía"
+// @Param warrantyType body UpdateWarrantyClaimStatusRequest true "Datos del estado de reclamación de garantía"
+// @Success 200 {object} WarrantyClaimStatusResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claim-statuses/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req UpdateWarrantyClaimStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	ws := &warranty_claim_status.WarrantyClaimStatus{
+		ID:       id,
+		Label:    req.Label,
+		Class:    req.Class,
+		Order:    req.Order,
+		IsActive: true,
+	}
+	if req.IsActive != nil {
+		ws.IsActive = *req.IsActive
+	}
+
+	if err := ws.Validate(); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.useCase.Update(r.Context(), ws); err != nil {
+		if err == warranty_claim_status.ErrWarrantyClaimStatusNotFound {
+			response.Error(w, http.StatusNotFound, "Estado de reclamación de garantía no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al actualizar estado de reclamación de garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(ws))
+}
+
+// Delete maneja la solicitud de eliminación de un estado de reclamación de garantía
+// @Summary Eliminar estado de reclamación de garantía
+// @Description Elimina un estado de reclamación de garantía. No se puede eliminar si tiene reclamaciones asociadas
+// @Tags WarrantyClaimStatuss
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del estado de reclamación de garantía"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranty-claim-statuses/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), id); err != nil {
+		if err == warranty_claim_status.ErrWarrantyClaimStatusNotFound {
+			response.Error(w, http.StatusNotFound, "Estado de reclamación de garantía no encontrado")
+			return
+		}
+		if err == warranty_claim_status.ErrWarrantyClaimStatusInUse {
+			response.Error(w, http.StatusConflict, err.Error())
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar estado de reclamación de garantía")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,380 @@
+package warranty_equipment
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	domain "github.com/your-org/jvairv2/pkg/domain/warranty_equipment"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Handler maneja las peticiones HTTP para equipos de garantía
+type Handler struct {
+	useCase domain.Service
+}
+
+// NewHandler crea una nueva instancia del handler de equipos de garantía
+func NewHandler(useCase domain.Service) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// RegisterRoutes registra las rutas del handler como sub-recurso de garantías
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/warranties/{warrantyId}/equipment", func(r chi.Router) {
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/{id}", h.Get)
+		r.Put("/{id}", h.Update)
+		r.Delete("/{id}", h.Delete)
+	})
+}
+
+// WarrantyEquipmentRequest representa la solicitud para crear o actualizar un equipo de garantía
+type WarrantyEquipmentRequest struct {
+	Area                *string `json:"area,omitempty" example:"Main Floor"`
+	OutdoorBrand        *string `json:"outdoorBrand,omitempty" example:"Carrier"`
+	OutdoorModel        *string `json:"outdoorModel,omitempty" example:"24ACC636A003"`
+	OutdoorSerial       *string `json:"outdoorSerial,omitempty" example:"1234567890"`
+	OutdoorInstalled    *string `json:"outdoorInstalled,omitempty" example:"2023-06-15"`
+	FurnaceBrand        *string `json:"furnaceBrand,omitempty" example:"Lennox"`
+	FurnaceModel        *string `json:"furnaceModel,omitempty" example:"SL280UHV"`
+	FurnaceSerial       *string `json:"furnaceSerial,omitempty" example:"0987654321"`
+	FurnaceInstalled    *string `json:"furnaceInstalled,omitempty" example:"2023-06-15"`
+	EvaporatorBrand     *string `json:"evaporatorBrand,omitempty" example:"Carrier"`
+	EvaporatorModel     *string `json:"evaporatorModel,omitempty" example:"CNPVP3617ALA"`
+	EvaporatorSerial    *string `json:"evaporatorSerial,omitempty" example:"1122334455"`
+	EvaporatorInstalled *string `json:"evaporatorInstalled,omitempty" example:"2023-06-15"`
+	AirHandlerBrand     *string `json:"airHandlerBrand,omitempty" example:"Trane"`
+	AirHandlerModel     *string `json:"airHandlerModel,omitempty" example:"GAM5A0A36M21SA"`
+	AirHandlerSerial    *string `json:"airHandlerSerial,omitempty" example:"5566778899"`
+	AirHandlerInstalled *string `json:"airHandlerInstalled,omitempty" example:"2023-06-15"`
+}
+
+// WarrantyEquipmentResponse representa la respuesta de un equipo de garantía
+type WarrantyEquipmentResponse struct {
+	ID                  int64   `json:"id" example:"1"`
+	WarrantyID          int64   `json:"warrantyId" example:"100"`
+	Area                *string `json:"area,omitempty" example:"Main Floor"`
+	OutdoorBrand        *string `json:"outdoorBrand,omitempty" example:"Carrier"`
+	OutdoorModel        *string `json:"outdoorModel,omitempty" example:"24ACC636A003"`
+	OutdoorSerial       *string `json:"outdoorSerial,omitempty" example:"1234567890"`
+	OutdoorInstalled    *string `json:"outdoorInstalled,omitempty" example:"2023-06-15"`
+	FurnaceBrand        *string `json:"furnaceBrand,omitempty" example:"Lennox"`
+	FurnaceModel        *string `json:"furnaceModel,omitempty" example:"SL280UHV"`
+	FurnaceSerial       *string `json:"furnaceSerial,omitempty" example:"0987654321"`
+	FurnaceInstalled    *string `json:"furnaceInstalled,omitempty" example:"2023-06-15"`
+	EvaporatorBrand     *string `json:"evaporatorBrand,omitempty" example:"Carrier"`
+	EvaporatorModel     *string `json:"evaporatorModel,omitempty" example:"CNPVP3617ALA"`
+	EvaporatorSerial    *string `json:"evaporatorSerial,omitempty" example:"1122334455"`
+	EvaporatorInstalled *string `json:"evaporatorInstalled,omitempty" example:"2023-06-15"`
+	AirHandlerBrand     *string `json:"airHandlerBrand,omitempty" example:"Trane"`
+	AirHandlerModel     *string `json:"airHandlerModel,omitempty" example:"GAM5A0A36M21SA"`
+	AirHandlerSerial    *string `json:"airHandlerSerial,omitempty" example:"5566778899"`
+	AirHandlerInstalled *string `json:"airHandlerInstalled,omitempty" example:"2023-06-15"`
+	OutdoorUnit         string  `json:"outdoorUnit" example:"Carrier 24ACC636A003 | S/N 1234567890"`
+	CreatedAt           string  `json:"createdAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt           string  `json:"updatedAt,omitempty" example:"2024-01-18T14:20:00Z"`
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+func formatDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
+
+func toResponse(e *domain.WarrantyEquipment) WarrantyEquipmentResponse {
+	resp := WarrantyEquipmentResponse{
+		ID:                  e.ID,
+		WarrantyID:          e.WarrantyID,
+		Area:                e.Area,
+		OutdoorBrand:        e.OutdoorBrand,
+		OutdoorModel:        e.OutdoorModel,
+		OutdoorSerial:       e.OutdoorSerial,
+		OutdoorInstalled:    formatDatePtr(e.OutdoorInstalled),
+		FurnaceBrand:        e.FurnaceBrand,
+		FurnaceModel:        e.FurnaceModel,
+		FurnaceSerial:       e.FurnaceSerial,
+		FurnaceInstalled:    formatDatePtr(e.FurnaceInstalled),
+		EvaporatorBrand:     e.EvaporatorBrand,
+		EvaporatorModel:     e.EvaporatorModel,
+		EvaporatorSerial:    e.EvaporatorSerial,
+		EvaporatorInstalled: formatDatePtr(e.EvaporatorInstalled),
+		AirHandlerBrand:     e.AirHandlerBrand,
+		AirHandlerModel:     e.AirHandlerModel,
+		AirHandlerSerial:    e.AirHandlerSerial,
+		AirHandlerInstalled: formatDatePtr(e.AirHandlerInstalled),
+		OutdoorUnit:         e.GetOutdoorUnit(),
+	}
+
+	if e.CreatedAt != nil {
+		resp.CreatedAt = e.CreatedAt.Format(timeFormat)
+	}
+	if e.UpdatedAt != nil {
+		resp.UpdatedAt = e.UpdatedAt.Format(timeFormat)
+	}
+
+	return resp
+}
+
+func parseTimePtr(s *string) *time.Time {
+	if s == nil || *s == "" {
+		return nil
+	}
+	t, err := time.Parse(timeFormat, *s)
+	if err != nil {
+		t, err = time.Parse("2006-01-02", *s)
+		if err != nil {
+			return nil
+		}
+	}
+	return &t
+}
+
+func (req *WarrantyEquipmentRequest) toEntity(warrantyID int64) *domain.WarrantyEquipment {
+	return &domain.WarrantyEquipment{
+		WarrantyID:          warrantyID,
+		Area:                req.Area,
+		OutdoorBrand:        req.OutdoorBrand,
+		OutdoorModel:        req.OutdoorModel,
+		OutdoorSerial:       req.OutdoorSerial,
+		OutdoorInstalled:    parseTimePtr(req.OutdoorInstalled),
+		FurnaceBrand:        req.FurnaceBrand,
+		FurnaceModel:        req.FurnaceModel,
+		FurnaceSerial:       req.FurnaceSerial,
+		FurnaceInstalled:    parseTimePtr(req.FurnaceInstalled),
+		EvaporatorBrand:     req.EvaporatorBrand,
+		EvaporatorModel:     req.EvaporatorModel,
+		EvaporatorSerial:    req.EvaporatorSerial,
+		EvaporatorInstalled: parseTimePtr(req.EvaporatorInstalled),
+		AirHandlerBrand:     req.AirHandlerBrand,
+		AirHandlerModel:     req.AirHandlerModel,
+		AirHandlerSerial:    req.AirHandlerSerial,
+		AirHandlerInstalled: parseTimePtr(req.AirHandlerInstalled),
+	}
+}
+
+func parseWarrantyID(r *http.Request) (int64, error) {
+	return strconv.ParseInt(chi.URLParam(r, "warrantyId"), 10, 64)
+}
+
+// List maneja la solicitud de listado de equipos de una garantía
+// @Summary Listar equipos de garantía
+// @Description Obtiene los equipos registrados en una garantía
+// @Tags WarrantyEquipment
+// @Accept json
+// @Produce json
+// @Param warrantyId path int true "ID de la garantía"
+// @Success 200 {array} WarrantyEquipmentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{warrantyId}/equipment [get]
+// @Security BearerAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	warrantyID, err := parseWarrantyID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de garantía inválido")
+		return
+	}
+
+	equipment, err := h.useCase.ListByWarrantyID(r.Context(), warrantyID)
+	if err != nil {
+		if err == domain.ErrInvalidWarranty {
+			response.Error(w, http.StatusNotFound, "Garantía no encontrada")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al listar equipos de garantía")
+		return
+	}
+
+	items := make([]WarrantyEquipmentResponse, len(equipment))
+	for i, e := range equipment {
+		items[i] = toResponse(e)
+	}
+
+	response.JSON(w, http.StatusOK, items)
+}
+
+// Create maneja la solicitud de creación de un equipo de garantía
+// @Summary Crear equipo de garantía
+// @Description Registra un nuevo equipo en una garantía
+// @Tags WarrantyEquipment
+// @Accept json
+// @Produce json
+// @Param warrantyId path int true "ID de la garantía"
+// @Param equipment body WarrantyEquipmentRequest true "Datos del equipo"
+// @Success 201 {object} WarrantyEquipmentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{warrantyId}/equipment [post]
+// @Security BearerAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	warrantyID, err := parseWarrantyID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de garantía inválido")
+		return
+	}
+
+	var req WarrantyEquipmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	e := req.toEntity(warrantyID)
+
+	if err := h.useCase.Create(r.Context(), e); err != nil {
+		if err == domain.ErrInvalidWarranty {
+			response.Error(w, http.StatusNotFound, "Garantía no encontrada")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al crear equipo de garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toResponse(e))
+}
+
+// Get maneja la solicitud de obtención de un equipo de garantía
+// @Summary Obtener equipo de garantía
+// @Description Obtiene un equipo de una garantía por su ID
+// @Tags WarrantyEquipment
+// @Accept json
+// @Produce json
+// @Param warrantyId path int true "ID de la garantía"
+// @Param id path int true "ID del equipo"
+// @Success 200 {object} WarrantyEquipmentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{warrantyId}/equipment/{id} [get]
+// @Security BearerAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	warrantyID, err := parseWarrantyID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de garantía inválido")
+		return
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	e, err := h.useCase.GetByID(r.Context(), warrantyID, id)
+	if err != nil {
+		if err == domain.ErrEquipmentNotFound || err == domain.ErrEquipmentMismatch {
+			response.Error(w, http.StatusNotFound, "Equipo de garantía no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al obtener equipo de garantía")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(e))
+}
+
+// Update maneja la solicitud de actualización de un equipo de garantía
+// @Summary Actualizar equipo de garantía
+// @Description Actualiza un equipo existente de una garantía
+// @Tags WarrantyEquipment
+// @Accept json
+// @Produce json
+// @Param warrantyId path int true "ID de la garantía"
+// @Param id path int true "ID del equipo"
+// @Param equipment body WarrantyEquipmentRequest true "Datos del equipo"
+// @Success 200 {object} WarrantyEquipmentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{warrantyId}/equipment/{id} [put]
+// @Security BearerAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	warrantyID, err := parseWarrantyID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de garantía inválido")
+		return
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	var req WarrantyEquipmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Error al decodificar la solicitud")
+		return
+	}
+
+	e := req.toEntity(warrantyID)
+	e.ID = id
+
+	if err := h.useCase.Update(r.Context(), e); err != nil {
+		if err == domain.ErrEquipmentNotFound || err == domain.ErrEquipmentMismatch {
+			response.Error(w, http.StatusNotFound, "Equipo de garantía no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al actualizar equipo de garantía")
+		return
+	}
+
+	updated, err := h.useCase.GetByID(r.Context(), warrantyID, id)
+	if err != nil {
+		response.JSON(w, http.StatusOK, toResponse(e))
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toResponse(updated))
+}
+
+// Delete maneja la solicitud de eliminación de un equipo de garantía
+// @Summary Eliminar equipo de garantía
+// @Description Elimina un equipo de una garantía
+// @Tags WarrantyEquipment
+// @Accept json
+// @Produce json
+// @Param warrantyId path int true "ID de la garantía"
+// @Param id path int true "ID del equipo"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/warranties/{warrantyId}/equipment/{id} [delete]
+// @Security BearerAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	warrantyID, err := parseWarrantyID(r)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID de garantía inválido")
+		return
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "ID inválido")
+		return
+	}
+
+	if err := h.useCase.Delete(r.Context(), warrantyID, id); err != nil {
+		if err == domain.ErrEquipmentNotFound || err == domain.ErrEquipmentMismatch {
+			response.Error(w, http.StatusNotFound, "Equipo de garantía no encontrado")
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Error al eliminar equipo de garantía")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
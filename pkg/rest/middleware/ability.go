@@ -4,16 +4,25 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
-	"strconv"
 
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
 	"github.com/your-org/jvairv2/pkg/domain/user"
 )
 
 // abilityKey es la clave para almacenar las habilidades del usuario en el contexto
 type abilityKey struct{}
 
+// entityAbilityKey es la clave de la habilidad de la ruta que solo se concedió por un
+// permiso ligado a un modelo y que debe evaluarse al cargar ese modelo
+type entityAbilityKey struct{}
+
+// AbilityLoader carga los permisos de un usuario para evaluarlos con la semántica de Bouncer
+type AbilityLoader interface {
+	ForUser(ctx context.Context, userID int64) (*authorization.Evaluator, error)
+}
+
 // WithAbilities agrega las habilidades del usuario al contexto
-func WithAbilities(userUseCase *user.UseCase) func(http.Handler) http.Handler {
+func WithAbilities(loader AbilityLoader) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			slog.Debug("Iniciando carga de habilidades",
@@ -41,8 +50,8 @@ func WithAbilities(userUseCase *user.UseCase) func(http.Handler) http.Handler {
 				return
 			}
 
-			// Obtener las habilidades del usuario desde la base de datos
-			abilities, err := userUseCase.GetUserAbilities(r.Context(), strconv.FormatInt(u.ID, 10))
+			// Obtener los permisos del usuario (directos, de sus roles y prohibidos)
+			evaluator, err := loader.ForUser(r.Context(), u.ID)
 			if err != nil {
 				slog.Error("Error al obtener habilidades del usuario",
 					"user_id", u.ID,
@@ -56,17 +65,11 @@ func WithAbilities(userUseCase *user.UseCase) func(http.Handler) http.Handler {
 			slog.Info("Habilidades cargadas correctamente",
 				"user_id", u.ID,
 				"email", u.Email,
-				"abilities_count", len(abilities),
+				"abilities_count", len(evaluator.Names()),
 			)
 
-			// Convertir las habilidades a un slice de strings
-			abilityNames := make([]string, len(abilities))
-			for i, a := range abilities {
-				abilityNames[i] = a.Name
-			}
-
-			// Agregar las habilidades al contexto
-			ctx := context.WithValue(r.Context(), abilityKey{}, abilityNames)
+			// Agregar el evaluador al contexto
+			ctx := context.WithValue(r.Context(), abilityKey{}, evaluator)
 
 			// Continuar con el siguiente handler
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -74,44 +77,72 @@ func WithAbilities(userUseCase *user.UseCase) func(http.Handler) http.Handler {
 	}
 }
 
-// HasAbility verifica si el usuario tiene una habilidad específica
+// HasAbility verifica si el usuario tiene una habilidad específica que no depende de un modelo
 func HasAbility(ctx context.Context, ability string) bool {
+	return Can(ctx, ability, nil)
+}
+
+// Can verifica si el usuario puede realizar ability sobre entity (nil si no hay modelo),
+// aplicando prohibiciones, scope, habilidades por modelo y only_owned como Bouncer
+func Can(ctx context.Context, ability string, entity *authorization.Entity) bool {
 	slog.Debug("Verificando permiso", "ability", ability)
 
-	// Obtener las habilidades del contexto
-	abilities, ok := ctx.Value(abilityKey{}).([]string)
+	// Obtener el evaluador del contexto
+	evaluator, ok := ctx.Value(abilityKey{}).(*authorization.Evaluator)
 	if !ok {
 		slog.Warn("No se encontraron habilidades en el contexto")
 		return false
 	}
 
-	// Verificar si el usuario tiene la habilidad "*" (superadmin)
-	for _, a := range abilities {
-		if a == "*" {
-			slog.Info("Acceso concedido por habilidad wildcard",
-				"requested_ability", ability,
-			)
-			return true
-		}
+	if evaluator.Can(ability, entity) {
+		slog.Info("Acceso concedido",
+			"ability", ability,
+		)
+		return true
 	}
 
-	// Verificar si el usuario tiene la habilidad específica
-	for _, a := range abilities {
-		if a == ability {
-			slog.Info("Acceso concedido",
-				"ability", ability,
-			)
-			return true
-		}
+	slog.Warn("Acceso denegado",
+		"ability", ability,
+		"user_id", evaluator.UserID(),
+	)
+	return false
+}
+
+// CanAccess verifica si el usuario puede realizar ability sobre entity, ya sea por la
+// habilidad general o por un permiso ligado al modelo. Sin habilidades en el contexto
+// (procesos internos) no se restringe; en las peticiones las exige el RouteGuard.
+func CanAccess(ctx context.Context, ability string, entity *authorization.Entity) bool {
+	evaluator, ok := ctx.Value(abilityKey{}).(*authorization.Evaluator)
+	if !ok {
+		return true
+	}
+
+	if evaluator.CanAccess(ability, entity) {
+		return true
 	}
 
-	slog.Warn("Acceso denegado - habilidad no encontrada",
+	slog.Warn("Acceso denegado al modelo",
 		"ability", ability,
-		"available_abilities", abilities,
+		"entity_type", entity.Type,
+		"entity_id", entity.ID,
+		"user_id", evaluator.UserID(),
 	)
 	return false
 }
 
+// EntityAbility retorna la habilidad de la ruta en curso cuando el RouteGuard la admitió
+// solo por un permiso ligado a un modelo
+func EntityAbility(ctx context.Context) (string, bool) {
+	ability, ok := ctx.Value(entityAbilityKey{}).(string)
+	return ability, ok
+}
+
+// hasEntityGrant verifica si el usuario tiene ability sobre algún modelo
+func hasEntityGrant(ctx context.Context, ability string) bool {
+	evaluator, ok := ctx.Value(abilityKey{}).(*authorization.Evaluator)
+	return ok && evaluator.HasEntityGrant(ability)
+}
+
 // RequireAbility verifica si el usuario tiene una habilidad específica
 // y devuelve un error 403 si no la tiene
 func RequireAbility(ability string) func(http.Handler) http.Handler {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/your-org/jvairv2/pkg/domain/auth"
+	"github.com/your-org/jvairv2/pkg/domain/user"
 )
 
 // Tipo personalizado para la clave del contexto
@@ -87,6 +88,15 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 	})
 }
 
+// GetUserID obtiene el ID del usuario autenticado desde el contexto
+func GetUserID(ctx context.Context) (int64, bool) {
+	u, ok := ctx.Value(UserContextKey).(*user.User)
+	if !ok || u == nil {
+		return 0, false
+	}
+	return u.ID, true
+}
+
 // extractToken extrae el token JWT del encabezado Authorization
 func extractToken(r *http.Request) string {
 	bearerToken := r.Header.Get("Authorization")
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/your-org/jvairv2/pkg/domain/password_policy"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// PasswordStatusChecker calcula si el usuario debe cambiar su contraseña
+type PasswordStatusChecker interface {
+	Status(ctx context.Context, userID int64) (*password_policy.Status, error)
+}
+
+// PasswordChangeRequiredResponse es la respuesta cuando la contraseña debe cambiarse
+type PasswordChangeRequiredResponse struct {
+	Error              string `json:"error" example:"password change required"`
+	PasswordExpired    bool   `json:"passwordExpired" example:"true"`
+	MustChangePassword bool   `json:"mustChangePassword" example:"false"`
+}
+
+// RequirePasswordCurrent responde 403 a los usuarios cuya contraseña venció o que deben
+// cambiarla, salvo en las rutas que empiezan por alguno de exemptPrefixes (el propio
+// cambio de contraseña). Si no se puede calcular el estado se rechaza la solicitud con 503
+// para no dejar pasar contraseñas vencidas mientras falla la base de datos.
+func RequirePasswordCurrent(checker PasswordStatusChecker, exemptPrefixes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, prefix := range exemptPrefixes {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			userID, ok := GetUserID(r.Context())
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			status, err := checker.Status(r.Context(), userID)
+			if err != nil {
+				slog.Error("Error al obtener el estado de la contraseña, solicitud rechazada",
+					"user_id", userID,
+					"path", r.URL.Path,
+					"error", err,
+				)
+				response.Error(w, http.StatusServiceUnavailable, "No se pudo verificar el estado de la contraseña")
+				return
+			}
+
+			if status.Blocked() {
+				slog.Info("Solicitud bloqueada hasta cambiar la contraseña",
+					"user_id", userID,
+					"path", r.URL.Path,
+				)
+				response.JSON(w, http.StatusForbidden, PasswordChangeRequiredResponse{
+					Error:              "password change required",
+					PasswordExpired:    status.PasswordExpired,
+					MustChangePassword: status.MustChangePassword,
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/jvairv2/pkg/domain/password_policy"
+	"github.com/your-org/jvairv2/pkg/domain/user"
+)
+
+type mockPasswordStatusChecker struct {
+	mock.Mock
+}
+
+func (m *mockPasswordStatusChecker) Status(ctx context.Context, userID int64) (*password_policy.Status, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*password_policy.Status), args.Error(1)
+}
+
+func TestRequirePasswordCurrent(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	serve := func(checker PasswordStatusChecker, path string, authenticated bool) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if authenticated {
+			req = req.WithContext(context.WithValue(req.Context(), UserContextKey, &user.User{ID: 7}))
+		}
+		rec := httptest.NewRecorder()
+		RequirePasswordCurrent(checker, "/api/v1/password")(ok).ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	tests := []struct {
+		name   string
+		status *password_policy.Status
+		err    error
+		want   int
+	}{
+		{"current password", &password_policy.Status{}, nil, http.StatusOK},
+		{"expired password", &password_policy.Status{PasswordExpired: true}, nil, http.StatusForbidden},
+		{"must change password", &password_policy.Status{MustChangePassword: true}, nil, http.StatusForbidden},
+		{"status unavailable fails closed", nil, errors.New("db down"), http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := new(mockPasswordStatusChecker)
+			checker.On("Status", mock.Anything, int64(7)).Return(tt.status, tt.err)
+
+			assert.Equal(t, tt.want, serve(checker, "/api/v1/jobs", true))
+		})
+	}
+
+	t.Run("exempt and anonymous requests skip the check", func(t *testing.T) {
+		checker := new(mockPasswordStatusChecker)
+
+		assert.Equal(t, http.StatusOK, serve(checker, "/api/v1/password/change", true))
+		assert.Equal(t, http.StatusOK, serve(checker, "/api/v1/jobs", false))
+		checker.AssertNotCalled(t, "Status", mock.Anything, mock.Anything)
+	})
+}
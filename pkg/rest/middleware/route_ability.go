@@ -0,0 +1,181 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/your-org/jvairv2/pkg/rest/response"
+)
+
+// Authenticated indica que la ruta solo requiere un usuario autenticado
+const Authenticated = ""
+
+// RouteAbilities asocia "MÉTODO /patrón" (patrón de chi sin barra final) con la habilidad requerida
+type RouteAbilities map[string]string
+
+// Resource agrega las rutas CRUD de un recurso con los nombres de las policies de Laravel:
+// listar y ver exigen <name>_view, crear y actualizar <name>_edit y eliminar <name>_delete
+func (ra RouteAbilities) Resource(pattern, name string) RouteAbilities {
+	ra["GET "+pattern] = name + "_view"
+	ra["POST "+pattern] = name + "_edit"
+	ra["GET "+pattern+"/{id}"] = name + "_view"
+	ra["PUT "+pattern+"/{id}"] = name + "_edit"
+	ra["DELETE "+pattern+"/{id}"] = name + "_delete"
+	return ra
+}
+
+// Child agrega las rutas CRUD de un recurso hijo que se autoriza con las habilidades del
+// padre, como en v1: listar y ver exigen <parent>_view y crear, actualizar o eliminar
+// <parent>_edit, ya que modifican al padre
+func (ra RouteAbilities) Child(pattern, parent string) RouteAbilities {
+	ra["GET "+pattern] = parent + "_view"
+	ra["POST "+pattern] = parent + "_edit"
+	ra["GET "+pattern+"/{id}"] = parent + "_view"
+	ra["PUT "+pattern+"/{id}"] = parent + "_edit"
+	ra["DELETE "+pattern+"/{id}"] = parent + "_edit"
+	return ra
+}
+
+// RouteAbility describe una ruta protegida y la habilidad que requiere
+type RouteAbility struct {
+	Method  string `json:"method" example:"DELETE"`
+	Pattern string `json:"pattern" example:"/api/v1/invoices/{id}"`
+	// Ability vacía indica que basta con estar autenticado
+	Ability string `json:"ability,omitempty" example:"invoice_delete"`
+	// Denied indica que la ruta no está en el mapa y se rechaza siempre
+	Denied bool `json:"denied,omitempty" example:"false"`
+}
+
+// RouteGuard exige en un único punto la habilidad de cada ruta bajo prefix.
+// Las rutas que no están en el mapa se rechazan, para que una ruta nueva no quede abierta.
+type RouteGuard struct {
+	prefix       string
+	abilities    RouteAbilities
+	routes       chi.Routes
+	entityRoutes []string
+}
+
+// NewRouteGuard crea el guardián de rutas para las rutas bajo prefix
+func NewRouteGuard(prefix string, abilities RouteAbilities) *RouteGuard {
+	return &RouteGuard{prefix: prefix, abilities: abilities}
+}
+
+// AllowEntityGrants admite en las rutas bajo los patrones indicados a los usuarios con la
+// habilidad concedida solo sobre algún modelo (por ejemplo un job concreto o los propios).
+// El caso de uso de esas rutas debe evaluar la habilidad sobre el modelo con CanAccess.
+func (g *RouteGuard) AllowEntityGrants(patterns ...string) *RouteGuard {
+	g.entityRoutes = append(g.entityRoutes, patterns...)
+	return g
+}
+
+// allowsEntityGrant indica si el patrón admite habilidades ligadas a un modelo
+func (g *RouteGuard) allowsEntityGrant(pattern string) bool {
+	for _, p := range g.entityRoutes {
+		if pattern == p || strings.HasPrefix(pattern, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Bind asigna el router completo con el que se resuelve el patrón de cada solicitud.
+// Retorna las rutas sin habilidad en el mapa y las entradas del mapa sin ruta.
+func (g *RouteGuard) Bind(routes chi.Routes) (unmapped, stale []string) {
+	g.routes = routes
+
+	registered := make(map[string]bool)
+	for _, route := range g.Routes() {
+		key := route.Method + " " + route.Pattern
+		registered[key] = true
+		if route.Denied {
+			unmapped = append(unmapped, key)
+		}
+	}
+	for key := range g.abilities {
+		if !registered[key] {
+			stale = append(stale, key)
+		}
+	}
+	sort.Strings(stale)
+
+	return unmapped, stale
+}
+
+// Routes lista las rutas bajo prefix con la habilidad que requiere cada una
+func (g *RouteGuard) Routes() []RouteAbility {
+	var list []RouteAbility
+	if g.routes == nil {
+		return list
+	}
+
+	_ = chi.Walk(g.routes, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		pattern := normalizePattern(route)
+		if !strings.HasPrefix(pattern, g.prefix) {
+			return nil
+		}
+		ability, ok := g.abilities[method+" "+pattern]
+		list = append(list, RouteAbility{Method: method, Pattern: pattern, Ability: ability, Denied: !ok})
+		return nil
+	})
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Pattern != list[j].Pattern {
+			return list[i].Pattern < list[j].Pattern
+		}
+		return list[i].Method < list[j].Method
+	})
+	return list
+}
+
+// Require es el middleware que resuelve la ruta de la solicitud y exige su habilidad
+func (g *RouteGuard) Require(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.routes == nil || !strings.HasPrefix(r.URL.Path, g.prefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rctx := chi.NewRouteContext()
+		if !g.routes.Match(rctx, r.Method, r.URL.Path) {
+			// El router responderá 404 o 405
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		pattern := normalizePattern(rctx.RoutePattern())
+		ability, ok := g.abilities[r.Method+" "+pattern]
+		if !ok {
+			slog.Error("Ruta sin habilidad asignada, acceso denegado",
+				"method", r.Method,
+				"pattern", pattern,
+			)
+			response.Error(w, http.StatusForbidden, "No tiene permisos para acceder a esta ruta")
+			return
+		}
+
+		if ability != Authenticated && !HasAbility(r.Context(), ability) {
+			if g.allowsEntityGrant(pattern) && hasEntityGrant(r.Context(), ability) {
+				// El caso de uso decide al cargar el modelo
+				ctx := context.WithValue(r.Context(), entityAbilityKey{}, ability)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+			response.Error(w, http.StatusForbidden, "No tiene permisos para acceder a esta ruta: requiere "+ability)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// normalizePattern quita la barra final que chi deja en las rutas "/" de un subrouter
+func normalizePattern(pattern string) string {
+	if pattern == "/" {
+		return pattern
+	}
+	return strings.TrimSuffix(pattern, "/")
+}
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/jvairv2/pkg/domain/authorization"
+)
+
+// newGuardedRouter arma un router con el guardián y un usuario con las habilidades indicadas
+func newGuardedRouter(abilities RouteAbilities, granted ...string) (*chi.Mux, []string) {
+	guard := NewRouteGuard("/api/v1", abilities)
+
+	grants := make([]authorization.Grant, 0, len(granted))
+	for _, name := range granted {
+		grants = append(grants, authorization.Grant{Name: name})
+	}
+	evaluator := authorization.NewEvaluator(7, grants, nil)
+
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := context.WithValue(req.Context(), abilityKey{}, evaluator)
+			next.ServeHTTP(w, req.WithContext(ctx))
+		})
+	})
+	r.Use(guard.Require)
+	r.Route("/api/v1", func(r chi.Router) {
+		r.Route("/jobs", func(r chi.Router) {
+			r.Get("/", ok)
+			r.Get("/{id}", ok)
+			r.Delete("/{id}", ok)
+		})
+		r.Get("/alerts", ok)
+		r.Get("/unmapped", ok)
+	})
+	r.Get("/health", ok)
+
+	unmapped, _ := guard.Bind(r)
+	return r, unmapped
+}
+
+func TestRouteGuard_Require(t *testing.T) {
+	abilities := RouteAbilities{}.Resource("/api/v1/jobs", "job")
+	abilities["GET /api/v1/alerts"] = Authenticated
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		granted []string
+		want    int
+	}{
+		{"ability granted", http.MethodGet, "/api/v1/jobs/42", []string{"job_view"}, http.StatusOK},
+		{"missing ability", http.MethodDelete, "/api/v1/jobs/42", []string{"job_view"}, http.StatusForbidden},
+		{"unmapped route is denied", http.MethodGet, "/api/v1/unmapped", []string{"*"}, http.StatusForbidden},
+		{"authenticated only", http.MethodGet, "/api/v1/alerts", nil, http.StatusOK},
+		{"subrouter root with trailing slash", http.MethodGet, "/api/v1/jobs", []string{"job_view"}, http.StatusOK},
+		{"subrouter root without ability", http.MethodGet, "/api/v1/jobs/", nil, http.StatusForbidden},
+		{"unknown path falls through to 404", http.MethodGet, "/api/v1/nope", nil, http.StatusNotFound},
+		{"wrong method falls through to 405", http.MethodPost, "/api/v1/alerts", nil, http.StatusMethodNotAllowed},
+		{"outside prefix is not guarded", http.MethodGet, "/health", nil, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newGuardedRouter(abilities, tt.granted...)
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestRouteGuard_Bind(t *testing.T) {
+	abilities := RouteAbilities{}.Resource("/api/v1/jobs", "job")
+	abilities["GET /api/v1/alerts"] = Authenticated
+	abilities["GET /api/v1/removed"] = "removed_view"
+
+	guard := NewRouteGuard("/api/v1", abilities)
+	r, _ := newGuardedRouter(abilities)
+
+	unmapped, stale := guard.Bind(r)
+
+	assert.Equal(t, []string{"GET /api/v1/unmapped"}, unmapped)
+	assert.Equal(t, []string{"GET /api/v1/removed", "POST /api/v1/jobs", "PUT /api/v1/jobs/{id}"}, stale)
+}
+
+func TestRouteAbilities_Resource(t *testing.T) {
+	ra := RouteAbilities{}.Resource("/api/v1/jobs", "job").Child("/api/v1/jobs/{jobId}/tasks", "job")
+
+	assert.Equal(t, "job_view", ra["GET /api/v1/jobs"])
+	assert.Equal(t, "job_edit", ra["POST /api/v1/jobs"])
+	assert.Equal(t, "job_view", ra["GET /api/v1/jobs/{id}"])
+	assert.Equal(t, "job_edit", ra["PUT /api/v1/jobs/{id}"])
+	assert.Equal(t, "job_delete", ra["DELETE /api/v1/jobs/{id}"])
+	assert.Equal(t, "job_edit", ra["DELETE /api/v1/jobs/{jobId}/tasks/{id}"])
+}
+
+func TestRouteGuard_EntityGrants(t *testing.T) {
+	abilities := RouteAbilities{}.Resource("/api/v1/jobs", "job")
+	jobID := int64(42)
+	grants := []authorization.Grant{{Name: "job_edit", EntityType: authorization.JobType, EntityID: &jobID}}
+
+	var pending string
+	capture := func(w http.ResponseWriter, r *http.Request) {
+		pending, _ = EntityAbility(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+
+	newRouter := func(guard *RouteGuard) *chi.Mux {
+		evaluator := authorization.NewEvaluator(7, grants, nil)
+		r := chi.NewRouter()
+		r.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), abilityKey{}, evaluator)))
+			})
+		})
+		r.Use(guard.Require)
+		r.Get("/api/v1/jobs", capture)
+		r.Post("/api/v1/jobs", capture)
+		r.Get("/api/v1/jobs/{id}", capture)
+		r.Put("/api/v1/jobs/{id}", capture)
+		r.Delete("/api/v1/jobs/{id}", capture)
+		guard.Bind(r)
+		return r
+	}
+
+	tests := []struct {
+		name        string
+		entityRoute bool
+		method      string
+		path        string
+		want        int
+		wantPending string
+	}{
+		{"entity grant passes opted-in route", true, http.MethodPut, "/api/v1/jobs/42", http.StatusOK, "job_edit"},
+		{"entity grant is rejected without opt-in", false, http.MethodPut, "/api/v1/jobs/42", http.StatusForbidden, ""},
+		{"entity grant does not cover collection", true, http.MethodPost, "/api/v1/jobs", http.StatusForbidden, ""},
+		{"entity grant for another ability", true, http.MethodDelete, "/api/v1/jobs/42", http.StatusForbidden, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending = ""
+			guard := NewRouteGuard("/api/v1", abilities)
+			if tt.entityRoute {
+				guard.AllowEntityGrants("/api/v1/jobs/{id}")
+			}
+
+			rec := httptest.NewRecorder()
+			newRouter(guard).ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.want, rec.Code)
+			assert.Equal(t, tt.wantPending, pending)
+		})
+	}
+}
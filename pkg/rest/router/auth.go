@@ -3,13 +3,16 @@ package router
 import (
 	"github.com/go-chi/chi/v5"
 	authHandler "github.com/your-org/jvairv2/pkg/rest/handler/auth"
+	passwordResetHandler "github.com/your-org/jvairv2/pkg/rest/handler/password_reset"
 )
 
 // RegisterAuthRoutes registra las rutas de autenticación
-func RegisterAuthRoutes(r chi.Router, handler *authHandler.Handler) {
+func RegisterAuthRoutes(r chi.Router, handler *authHandler.Handler, passwordResetHandler *passwordResetHandler.Handler) {
 	r.Route("/auth", func(r chi.Router) {
 		r.Post("/login", handler.Login)
 		r.Post("/logout", handler.Logout)
 		r.Post("/refresh", handler.RefreshToken)
+		// Restablecimiento de contraseña
+		passwordResetHandler.RegisterRoutes(r)
 	})
 }
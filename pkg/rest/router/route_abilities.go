@@ -0,0 +1,171 @@
+package router
+
+import "github.com/your-org/jvairv2/pkg/rest/middleware"
+
+// APIPrefix es el prefijo de las rutas protegidas por el mapa de habilidades
+const APIPrefix = "/api/v1"
+
+// EntityRoutes son los patrones cuyas rutas admiten habilidades concedidas sobre un job
+// concreto (o los propios); sus casos de uso evalúan la habilidad al cargar el job
+var EntityRoutes = []string{
+	"/api/v1/jobs/{id}",
+	"/api/v1/jobs/{jobId}",
+}
+
+// RouteAbilities retorna la habilidad que exige cada ruta de /api/v1. Toda ruta nueva debe
+// agregarse aquí; las que falten se rechazan con 403 y se reportan al iniciar.
+func RouteAbilities() middleware.RouteAbilities {
+	ra := middleware.RouteAbilities{}
+
+	// Usuarios, roles y permisos (mismos nombres que validan sus handlers)
+	ra["GET /api/v1/users"] = "list_users"
+	ra["POST /api/v1/users"] = "create_user"
+	ra["GET /api/v1/users/{id}"] = "view_user"
+	ra["PUT /api/v1/users/{id}"] = "update_user"
+	ra["DELETE /api/v1/users/{id}"] = "delete_user"
+	ra["GET /api/v1/users/{id}/roles"] = "view_user_roles"
+	ra["GET /api/v1/users/{id}/abilities"] = "view_user_abilities"
+
+	ra["GET /api/v1/roles"] = "list_roles"
+	ra["POST /api/v1/roles"] = "create_role"
+	ra["GET /api/v1/roles/{id}"] = "view_role"
+	ra["PUT /api/v1/roles/{id}"] = "update_role"
+	ra["DELETE /api/v1/roles/{id}"] = "delete_role"
+
+	ra["GET /api/v1/abilities"] = "list_abilities"
+	ra["POST /api/v1/abilities"] = "create_ability"
+	ra["GET /api/v1/abilities/{id}"] = "view_ability"
+	ra["PUT /api/v1/abilities/{id}"] = "update_ability"
+	ra["DELETE /api/v1/abilities/{id}"] = "delete_ability"
+	ra["GET /api/v1/routes"] = "list_abilities"
+
+	ra["GET /api/v1/assigned-roles"] = "list_assigned_roles"
+	ra["POST /api/v1/assigned-roles"] = "assign_role"
+	ra["GET /api/v1/assigned-roles/{id}"] = "view_assigned_role"
+	ra["GET /api/v1/assigned-roles/entity/{entityType}/{entityId}"] = "view_entity_roles"
+	ra["GET /api/v1/assigned-roles/check/{roleId}/{entityType}/{entityId}"] = "check_role"
+	ra["DELETE /api/v1/assigned-roles/revoke/{roleId}/{entityType}/{entityId}"] = "revoke_role"
+
+	ra["GET /api/v1/permissions"] = "list_permissions"
+	ra["POST /api/v1/permissions"] = "create_permission"
+	ra["GET /api/v1/permissions/{id}"] = "view_permission"
+	ra["PUT /api/v1/permissions/{id}"] = "update_permission"
+	ra["DELETE /api/v1/permissions/{id}"] = "delete_permission"
+	ra["GET /api/v1/permissions/ability/{abilityId}"] = "view_ability_permissions"
+	ra["GET /api/v1/permissions/entity/{entityType}/{entityId}"] = "view_entity_permissions"
+	ra["GET /api/v1/permissions/check/{abilityId}/{entityType}/{entityId}"] = "check_permission"
+
+	// Configuración
+	ra["GET /api/v1/settings"] = "view_settings"
+	ra["PUT /api/v1/settings"] = "update_settings"
+	ra["PATCH /api/v1/settings"] = "update_settings"
+
+	ra["GET /api/v1/workflows"] = "view_workflow"
+	ra["POST /api/v1/workflows"] = "create_workflow"
+	ra["GET /api/v1/workflows/{id}"] = "view_workflow"
+	ra["PUT /api/v1/workflows/{id}"] = "update_workflow"
+	ra["DELETE /api/v1/workflows/{id}"] = "delete_workflow"
+	ra["POST /api/v1/workflows/{id}/duplicate"] = "create_workflow"
+
+	ra.Resource("/api/v1/email-templates", "email_template")
+	ra["GET /api/v1/email-templates/placeholders"] = "email_template_view"
+	ra["POST /api/v1/email-templates/preview"] = "email_template_view"
+	ra["GET /api/v1/email-templates/{id}/preview"] = "email_template_view"
+	ra.Resource("/api/v1/sms-templates", "sms_template")
+
+	// Clientes y propiedades
+	ra.Resource("/api/v1/customers", "customer")
+	ra["GET /api/v1/customers/{id}/properties"] = "property_view"
+	ra["GET /api/v1/customers/{customerId}/supervisors"] = "supervisor_view"
+	ra.Resource("/api/v1/supervisors", "supervisor")
+	ra.Resource("/api/v1/properties", "property")
+	ra.Child("/api/v1/properties/{propertyId}/equipment", "property")
+
+	// Trabajos; sus recursos hijos se autorizan con las habilidades del job
+	ra.Resource("/api/v1/jobs", "job")
+	ra["PUT /api/v1/jobs/{id}/close"] = "job_edit"
+	ra["PUT /api/v1/jobs/{jobId}/dispatch"] = "job_edit"
+	ra["PUT /api/v1/jobs/{jobId}/dispatch-sms"] = "job_edit"
+	ra["PUT /api/v1/jobs/{jobId}/dispatch-supervisor"] = "job_edit"
+	ra["GET /api/v1/jobs/{jobId}/emails"] = "job_view"
+	ra["GET /api/v1/jobs/{jobId}/sms"] = "job_view"
+	ra["GET /api/v1/jobs/{jobId}/history"] = "job_view"
+
+	ra["GET /api/v1/jobs/{jobId}/activities"] = "job_view"
+	ra["POST /api/v1/jobs/{jobId}/activities"] = "job_edit"
+	ra["DELETE /api/v1/jobs/{jobId}/activities/{id}"] = "job_edit"
+
+	ra.Child("/api/v1/jobs/{jobId}/equipment", "job")
+	// Las tarifas de técnicos (montos, retención y pago) son nómina: no heredan las
+	// habilidades del job para que un técnico no fije ni marque como pagada su tarifa
+	ra.Child("/api/v1/jobs/{jobId}/rates", "payroll")
+	ra["POST /api/v1/calculate-rate-payment"] = "payroll_view"
+	ra.Child("/api/v1/jobs/{jobId}/residents", "job")
+	ra.Child("/api/v1/jobs/{jobId}/tasks", "job")
+	ra["GET /api/v1/tasks"] = "job_view"
+	ra["GET /api/v1/jobs/{jobId}/tasks/{id}/notifications"] = "job_view"
+	ra["PUT /api/v1/jobs/{jobId}/tasks/{id}/send-notification"] = "job_edit"
+	ra.Child("/api/v1/jobs/{jobId}/visits", "job")
+	ra["GET /api/v1/jobs/{jobId}/visits/{id}/download"] = "job_view"
+
+	// Catálogos de trabajos
+	ra.Resource("/api/v1/job-categories", "job_category")
+	ra.Resource("/api/v1/job-statuses", "job_status")
+	ra.Resource("/api/v1/job-priorities", "job_priority")
+	ra.Resource("/api/v1/job-rate-statuses", "job_rate_status")
+	ra.Resource("/api/v1/technician-job-statuses", "technician_job_status")
+	ra.Resource("/api/v1/task-statuses", "task_status")
+
+	// Cotizaciones, facturas y pagos
+	ra.Resource("/api/v1/quotes", "quote")
+	ra.Resource("/api/v1/quote-statuses", "quote_status")
+	ra.Resource("/api/v1/invoices", "invoice")
+	ra.Child("/api/v1/invoices/{invoiceId}/payments", "invoice")
+
+	// Garantías
+	ra.Resource("/api/v1/warranties", "warranty")
+	ra.Child("/api/v1/warranties/{warrantyId}/equipment", "warranty")
+	ra.Resource("/api/v1/warranty-types", "warranty_type")
+	ra.Resource("/api/v1/warranty-statuses", "warranty_status")
+	ra.Resource("/api/v1/warranty-claims", "warranty_claim")
+	ra.Resource("/api/v1/warranty-claim-types", "warranty_claim_type")
+	ra.Resource("/api/v1/warranty-claim-statuses", "warranty_claim_status")
+
+	// Nómina
+	ra["GET /api/v1/payroll"] = "payroll_view"
+	ra["GET /api/v1/payroll/{userId}/pay"] = "payroll_view"
+	ra["GET /api/v1/payroll/{userId}/history"] = "payroll_view"
+	ra["GET /api/v1/payroll/{userId}/paystub"] = "payroll_view"
+	ra["GET /api/v1/payroll/{userId}/paystub/emails"] = "payroll_view"
+	ra["POST /api/v1/payroll/{userId}/pay/hold"] = "payroll_edit"
+	ra["POST /api/v1/payroll/{userId}/pay/mark-paid"] = "payroll_edit"
+	ra["POST /api/v1/payroll/{userId}/paystub/email"] = "payroll_edit"
+
+	// Archivos
+	ra["GET /api/v1/files"] = "file_view"
+	ra["POST /api/v1/files"] = "file_edit"
+	ra["GET /api/v1/files/{id}"] = "file_view"
+	ra["GET /api/v1/files/{id}/download"] = "file_view"
+	ra["DELETE /api/v1/files/{id}"] = "file_delete"
+
+	// Cola de salida
+	ra["GET /api/v1/outbox"] = "outbox_view"
+	ra["GET /api/v1/outbox/{id}"] = "outbox_view"
+	ra["POST /api/v1/outbox/{id}/retry"] = "outbox_edit"
+
+	// Recursos propios del usuario: bandeja de alertas, eventos (filtrados por
+	// habilidad al suscribirse), su contraseña y sus sesiones
+	ra["GET /api/v1/alerts"] = middleware.Authenticated
+	ra["POST /api/v1/alerts/mark-read"] = middleware.Authenticated
+	ra["POST /api/v1/alerts/{id}/open"] = middleware.Authenticated
+	// Las alertas de llamadas son de un job: se exige poder verlo y el caso de uso aplica su scope
+	ra["POST /api/v1/alerts/mark-call-log/{jobId}"] = "job_view"
+	ra["GET /api/v1/events"] = middleware.Authenticated
+	ra["GET /api/v1/password/status"] = middleware.Authenticated
+	ra["POST /api/v1/password/change"] = middleware.Authenticated
+	ra["GET /api/v1/auth/sessions"] = middleware.Authenticated
+	ra["DELETE /api/v1/auth/sessions/{id}"] = middleware.Authenticated
+	ra["POST /api/v1/auth/sessions/revoke-others"] = middleware.Authenticated
+
+	return ra
+}
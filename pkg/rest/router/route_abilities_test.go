@@ -0,0 +1,74 @@
+package router
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/jvairv2/pkg/rest/middleware"
+)
+
+// newTestGuard arma el router completo con handlers vacíos (solo se registran sus rutas,
+// no se ejecutan) y retorna el guardián junto con lo que reportó Bind
+func newTestGuard(t *testing.T) (guard *middleware.RouteGuard, unmapped, stale []string) {
+	t.Helper()
+
+	guard = middleware.NewRouteGuard(APIPrefix, RouteAbilities())
+
+	newFn := reflect.ValueOf(New)
+	args := make([]reflect.Value, newFn.Type().NumIn())
+	for i := range args {
+		in := newFn.Type().In(i)
+		switch {
+		case in == reflect.TypeOf(guard):
+			args[i] = reflect.ValueOf(guard)
+		case in.Kind() == reflect.Ptr:
+			args[i] = reflect.New(in.Elem())
+		default:
+			args[i] = reflect.Zero(in)
+		}
+	}
+	mux := newFn.Call(args)[0].Interface().(*chi.Mux)
+
+	unmapped, stale = guard.Bind(mux)
+	return guard, unmapped, stale
+}
+
+func TestRouteAbilities_EveryRouteIsMapped(t *testing.T) {
+	guard, unmapped, _ := newTestGuard(t)
+
+	assert.NotEmpty(t, guard.Routes())
+	assert.Empty(t, unmapped, "toda ruta de /api/v1 debe tener su habilidad en RouteAbilities")
+}
+
+func TestRouteAbilities_NoStaleEntries(t *testing.T) {
+	_, _, stale := newTestGuard(t)
+
+	assert.Empty(t, stale, "entradas de RouteAbilities sin ruta registrada")
+}
+
+func TestRouteAbilities_LegacyNames(t *testing.T) {
+	// Las habilidades siguen los nombres existentes en Bouncer: <x>_view, <x>_edit y <x>_delete
+	// en los recursos, y <acción>_<x> en usuarios, roles, permisos, settings y workflows
+	legacy := regexp.MustCompile(`(_(view|edit|delete)$)|(^(list|create|view|update|delete|assign|revoke|check)_)`)
+
+	for route, ability := range RouteAbilities() {
+		if ability == middleware.Authenticated {
+			continue
+		}
+		assert.Regexp(t, legacy, ability, route)
+	}
+}
+
+func TestRouteAbilities_JobRatesRequirePayroll(t *testing.T) {
+	ra := RouteAbilities()
+
+	assert.Equal(t, "payroll_view", ra["GET /api/v1/jobs/{jobId}/rates"])
+	assert.Equal(t, "payroll_view", ra["GET /api/v1/jobs/{jobId}/rates/{id}"])
+	assert.Equal(t, "payroll_edit", ra["POST /api/v1/jobs/{jobId}/rates"])
+	assert.Equal(t, "payroll_edit", ra["PUT /api/v1/jobs/{jobId}/rates/{id}"])
+	assert.Equal(t, "payroll_edit", ra["DELETE /api/v1/jobs/{jobId}/rates/{id}"])
+	assert.Equal(t, "payroll_view", ra["POST /api/v1/calculate-rate-payment"])
+}
@@ -1,34 +1,61 @@
 package router
 
 import (
+	"log/slog"
+
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger"
 	_ "github.com/your-org/jvairv2/docs" // Importación de documentación Swagger
-	"github.com/your-org/jvairv2/pkg/domain/user"
 	"github.com/your-org/jvairv2/pkg/rest/handler"
 	abilityHandler "github.com/your-org/jvairv2/pkg/rest/handler/ability"
+	alertHandler "github.com/your-org/jvairv2/pkg/rest/handler/alert"
 	assignedRoleHandler "github.com/your-org/jvairv2/pkg/rest/handler/assigned_role"
 	authHandler "github.com/your-org/jvairv2/pkg/rest/handler/auth"
 	customerHandler "github.com/your-org/jvairv2/pkg/rest/handler/customer"
+	emailTemplateHandler "github.com/your-org/jvairv2/pkg/rest/handler/email_template"
+	eventHandler "github.com/your-org/jvairv2/pkg/rest/handler/event"
+	fileHandler "github.com/your-org/jvairv2/pkg/rest/handler/file"
 	invoiceHandler "github.com/your-org/jvairv2/pkg/rest/handler/invoice"
 	invoicePaymentHandler "github.com/your-org/jvairv2/pkg/rest/handler/invoice_payment"
 	jobHandler "github.com/your-org/jvairv2/pkg/rest/handler/job"
+	jobActivityHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_activity_log"
 	jobCategoryHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_category"
+	jobEmailHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_email"
 	jobEquipHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_equipment"
+	jobHistoryHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_history"
 	jobPriorityHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_priority"
+	jobRateHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_rate"
+	jobRateStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_rate_status"
+	jobResidentHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_resident"
+	jobSMSHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_sms"
 	jobStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_status"
+	jobTaskHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_task"
+	jobVisitHandler "github.com/your-org/jvairv2/pkg/rest/handler/job_visit"
+	outboxHandler "github.com/your-org/jvairv2/pkg/rest/handler/outbox"
+	passwordPolicyHandler "github.com/your-org/jvairv2/pkg/rest/handler/password_policy"
+	passwordResetHandler "github.com/your-org/jvairv2/pkg/rest/handler/password_reset"
+	payrollHandler "github.com/your-org/jvairv2/pkg/rest/handler/payroll"
 	permissionHandler "github.com/your-org/jvairv2/pkg/rest/handler/permission"
 	propertyHandler "github.com/your-org/jvairv2/pkg/rest/handler/property"
 	propEquipHandler "github.com/your-org/jvairv2/pkg/rest/handler/property_equipment"
 	quoteHandler "github.com/your-org/jvairv2/pkg/rest/handler/quote"
 	quoteStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/quote_status"
 	roleHandler "github.com/your-org/jvairv2/pkg/rest/handler/role"
+	routeHandler "github.com/your-org/jvairv2/pkg/rest/handler/route"
 	settingsHandler "github.com/your-org/jvairv2/pkg/rest/handler/settings"
+	smsTemplateHandler "github.com/your-org/jvairv2/pkg/rest/handler/sms_template"
 	supervisorHandler "github.com/your-org/jvairv2/pkg/rest/handler/supervisor"
 	taskStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/task_status"
 	techJobStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/technician_job_status"
 	userHandler "github.com/your-org/jvairv2/pkg/rest/handler/user"
+	warrantyHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty"
+	warrantyClaimHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_claim"
+	warrantyClaimStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_claim_status"
+	warrantyClaimTypeHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_claim_type"
+	warrantyEquipHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_equipment"
+	warrantyStatusHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_status"
+	warrantyTypeHandler "github.com/your-org/jvairv2/pkg/rest/handler/warranty_type"
 	workflowHandler "github.com/your-org/jvairv2/pkg/rest/handler/workflow"
 	"github.com/your-org/jvairv2/pkg/rest/middleware"
 )
@@ -59,8 +86,36 @@ func New(
 	jobEquipHandler *jobEquipHandler.Handler,
 	invoiceHandler *invoiceHandler.Handler,
 	invoicePaymentHandler *invoicePaymentHandler.Handler,
+	jobActivityHandler *jobActivityHandler.Handler,
+	jobHistoryHandler *jobHistoryHandler.Handler,
+	warrantyHandler *warrantyHandler.Handler,
+	warrantyTypeHandler *warrantyTypeHandler.Handler,
+	warrantyStatusHandler *warrantyStatusHandler.Handler,
+	warrantyEquipHandler *warrantyEquipHandler.Handler,
+	warrantyClaimHandler *warrantyClaimHandler.Handler,
+	warrantyClaimTypeHandler *warrantyClaimTypeHandler.Handler,
+	warrantyClaimStatusHandler *warrantyClaimStatusHandler.Handler,
+	jobTaskHandler *jobTaskHandler.Handler,
+	jobVisitHandler *jobVisitHandler.Handler,
+	jobResidentHandler *jobResidentHandler.Handler,
+	jobRateStatusHandler *jobRateStatusHandler.Handler,
+	jobRateHandler *jobRateHandler.Handler,
+	payrollHandler *payrollHandler.Handler,
+	emailTemplateHandler *emailTemplateHandler.Handler,
+	smsTemplateHandler *smsTemplateHandler.Handler,
+	jobSMSHandler *jobSMSHandler.Handler,
+	jobEmailHandler *jobEmailHandler.Handler,
+	outboxHandler *outboxHandler.Handler,
+	alertHandler *alertHandler.Handler,
+	eventHandler *eventHandler.Handler,
+	fileHandler *fileHandler.Handler,
+	passwordResetHandler *passwordResetHandler.Handler,
+	passwordPolicyHandler *passwordPolicyHandler.Handler,
+	passwordStatus middleware.PasswordStatusChecker,
+	routeHandler *routeHandler.Handler,
+	routeGuard *middleware.RouteGuard,
 	authMiddleware *middleware.AuthMiddleware,
-	userUseCase *user.UseCase, // Añadir esta dependencia
+	abilityLoader middleware.AbilityLoader,
 ) *chi.Mux {
 	r := chi.NewRouter()
 	// Middlewares globales
@@ -73,19 +128,25 @@ func New(
 		// Health check
 		r.Get("/health", healthHandler.Check)
 		// Rutas de autenticación
-		RegisterAuthRoutes(r, authHandler)
+		RegisterAuthRoutes(r, authHandler, passwordResetHandler)
 		// Swagger UI
 		r.Get("/swagger/*", httpSwagger.Handler(
 			httpSwagger.URL("/swagger/doc.json"), // URL para acceder a la documentación JSON
 		))
+		// Descargas firmadas de archivos del disco local
+		fileHandler.RegisterPublicRoutes(r)
 	})
 	// Rutas protegidas que requieren autenticación
 	r.Group(func(r chi.Router) {
 		// Middleware de autenticación
 		r.Use(authMiddleware.Authenticate)
 
-		// Middleware de habilidades - añadir esto
-		r.Use(middleware.WithAbilities(userUseCase))
+		// Middleware de habilidades
+		r.Use(middleware.WithAbilities(abilityLoader))
+		// Bloquear la API si la contraseña venció o debe cambiarse, salvo el propio cambio
+		r.Use(middleware.RequirePasswordCurrent(passwordStatus, "/api/v1/password"))
+		// Exigir la habilidad de cada ruta según RouteAbilities
+		r.Use(routeGuard.Require)
 		// API v1
 		r.Route("/api/v1", func(r chi.Router) {
 			// Rutas de usuarios
@@ -126,7 +187,61 @@ func New(
 			// Rutas de facturas y pagos
 			invoiceHandler.RegisterRoutes(r)
 			invoicePaymentHandler.RegisterRoutes(r)
+			// Rutas de bitácora de actividad de trabajos
+			jobActivityHandler.RegisterRoutes(r)
+			// Rutas de historial de cambios de trabajos
+			jobHistoryHandler.RegisterRoutes(r)
+			// Rutas de garantías y sus catálogos
+			warrantyHandler.RegisterRoutes(r)
+			warrantyTypeHandler.RegisterRoutes(r)
+			warrantyStatusHandler.RegisterRoutes(r)
+			warrantyEquipHandler.RegisterRoutes(r)
+			// Rutas de reclamaciones de garantía y sus catálogos
+			warrantyClaimHandler.RegisterRoutes(r)
+			warrantyClaimTypeHandler.RegisterRoutes(r)
+			warrantyClaimStatusHandler.RegisterRoutes(r)
+			// Rutas de tareas de trabajos
+			jobTaskHandler.RegisterRoutes(r)
+			// Rutas de visitas de trabajos
+			jobVisitHandler.RegisterRoutes(r)
+			// Rutas de residentes de trabajos
+			jobResidentHandler.RegisterRoutes(r)
+			// Rutas de tarifas de trabajos y su catálogo
+			jobRateStatusHandler.RegisterRoutes(r)
+			jobRateHandler.RegisterRoutes(r)
+			// Rutas de nómina
+			payrollHandler.RegisterRoutes(r)
+			// Rutas de plantillas de email
+			emailTemplateHandler.RegisterRoutes(r)
+			// Rutas de plantillas de SMS y envío de SMS de jobs
+			smsTemplateHandler.RegisterRoutes(r)
+			jobSMSHandler.RegisterRoutes(r)
+			// Rutas de correos de despacho e historial de correos de jobs
+			jobEmailHandler.RegisterRoutes(r)
+			// Rutas de la cola de mensajes salientes
+			outboxHandler.RegisterRoutes(r)
+			// Rutas de la bandeja de alertas
+			alertHandler.RegisterRoutes(r)
+			// Stream de eventos en tiempo real (SSE)
+			eventHandler.RegisterRoutes(r)
+			// Rutas de archivos adjuntos
+			fileHandler.RegisterRoutes(r)
+			// Contraseña del usuario autenticado (exenta del bloqueo por contraseña vencida)
+			passwordPolicyHandler.RegisterRoutes(r)
+			// Sesiones abiertas del usuario autenticado
+			authHandler.RegisterSessionRoutes(r)
+			// Mapa de habilidades por ruta
+			routeHandler.RegisterRoutes(r)
 		})
 	})
+
+	// Resolver las rutas del guardián y reportar huecos del mapa de habilidades
+	unmapped, stale := routeGuard.Bind(r)
+	for _, route := range unmapped {
+		slog.Error("Ruta sin habilidad en RouteAbilities, se rechazará", "route", route)
+	}
+	for _, route := range stale {
+		slog.Warn("Entrada de RouteAbilities sin ruta registrada", "route", route)
+	}
 	return r
 }
@@ -0,0 +1,18 @@
+-- Historial de cambios a nivel de campo de los jobs.
+-- Cada fila representa un campo modificado en una actualización del job,
+-- con el usuario que realizó el cambio (NULL si fue un proceso del sistema).
+
+CREATE TABLE IF NOT EXISTS `job_histories` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `job_id` bigint unsigned NOT NULL,
+  `user_id` bigint unsigned DEFAULT NULL,
+  `field` varchar(191) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `old_value` text COLLATE utf8mb4_unicode_ci,
+  `new_value` text COLLATE utf8mb4_unicode_ci,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `job_histories_job_id_foreign` (`job_id`),
+  KEY `job_histories_user_id_foreign` (`user_id`),
+  CONSTRAINT `job_histories_job_id_foreign` FOREIGN KEY (`job_id`) REFERENCES `jobs` (`id`) ON DELETE RESTRICT ON UPDATE RESTRICT,
+  CONSTRAINT `job_histories_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE RESTRICT ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
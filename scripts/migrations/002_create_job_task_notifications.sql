@@ -0,0 +1,18 @@
+-- Registro de notificaciones enviadas para las tareas de los jobs.
+-- Cada fila indica a qué usuario se notificó (el asignado de la tarea)
+-- y quién envió la notificación (NULL si fue un proceso del sistema).
+
+CREATE TABLE IF NOT EXISTS `job_task_notifications` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `job_task_id` bigint unsigned NOT NULL,
+  `user_id` bigint unsigned NOT NULL,
+  `sent_by` bigint unsigned DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `job_task_notifications_job_task_id_foreign` (`job_task_id`),
+  KEY `job_task_notifications_user_id_foreign` (`user_id`),
+  KEY `job_task_notifications_sent_by_foreign` (`sent_by`),
+  CONSTRAINT `job_task_notifications_job_task_id_foreign` FOREIGN KEY (`job_task_id`) REFERENCES `job_tasks` (`id`) ON DELETE RESTRICT ON UPDATE RESTRICT,
+  CONSTRAINT `job_task_notifications_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE RESTRICT ON UPDATE RESTRICT,
+  CONSTRAINT `job_task_notifications_sent_by_foreign` FOREIGN KEY (`sent_by`) REFERENCES `users` (`id`) ON DELETE RESTRICT ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,8 @@
+-- Campos de nómina en las tarifas de técnicos.
+-- `held` retiene una tarifa fuera de la nómina hasta que se libere;
+-- `paid_at` registra cuándo se marcó como pagada.
+
+ALTER TABLE `job_rates`
+  ADD COLUMN `held` tinyint(1) NOT NULL DEFAULT '0' AFTER `paid`,
+  ADD COLUMN `paid_at` timestamp NULL DEFAULT NULL AFTER `held`,
+  ADD KEY `job_rates_user_id_paid_index` (`user_id`, `paid`);
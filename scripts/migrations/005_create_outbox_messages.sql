@@ -0,0 +1,25 @@
+-- Cola persistente de mensajes salientes (email y SMS).
+-- Los mensajes se encolan en `pending` y los procesa el worker de cmd/api:
+-- `processing` mientras se entregan, `sent` al entregarse y `dead` cuando
+-- agotan max_attempts o fallan de forma permanente.
+
+CREATE TABLE IF NOT EXISTS `outbox_messages` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `channel` varchar(10) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `kind` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `reference_id` bigint unsigned DEFAULT NULL,
+  `payload` longtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `status` varchar(20) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'pending',
+  `attempts` int unsigned NOT NULL DEFAULT '0',
+  `max_attempts` int unsigned NOT NULL DEFAULT '5',
+  `next_attempt_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `locked_until` timestamp NULL DEFAULT NULL,
+  `last_error` text COLLATE utf8mb4_unicode_ci,
+  `provider_id` varchar(255) COLLATE utf8mb4_unicode_ci DEFAULT NULL,
+  `sent_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `outbox_messages_status_next_attempt_index` (`status`, `next_attempt_at`),
+  KEY `outbox_messages_kind_reference_index` (`kind`, `reference_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
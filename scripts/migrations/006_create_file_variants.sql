@@ -0,0 +1,19 @@
+-- Variantes reducidas de las imágenes subidas a `files` (miniatura y web).
+-- Se generan al subir la imagen, ya orientadas y sin metadatos EXIF, y se
+-- guardan junto al original con el sufijo del nombre: job/5/abc_thumbnail.jpg.
+
+CREATE TABLE IF NOT EXISTS `file_variants` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `file_id` bigint unsigned NOT NULL,
+  `name` varchar(20) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `path` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `url` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `content_type` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `width` int unsigned NOT NULL,
+  `height` int unsigned NOT NULL,
+  `size` bigint unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `file_variants_file_id_name_unique` (`file_id`, `name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
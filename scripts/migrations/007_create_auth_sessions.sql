@@ -0,0 +1,24 @@
+-- Sesiones de la API (TokenStore MySQL).
+-- Cada fila es un login: el par de tokens de acceso y de refresco vigente, que se
+-- rota en cada refresco, y el dispositivo desde el que se abrió. Se comparte entre
+-- instancias y sobrevive a los despliegues; las expiradas las elimina el barrido
+-- de cmd/api.
+
+CREATE TABLE IF NOT EXISTS `auth_sessions` (
+  `id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `user_id` bigint unsigned NOT NULL,
+  `access_uuid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `refresh_uuid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `access_expires_at` timestamp NOT NULL,
+  `refresh_expires_at` timestamp NOT NULL,
+  `user_agent` varchar(500) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `ip_address` varchar(45) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `last_seen_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `auth_sessions_access_uuid_unique` (`access_uuid`),
+  UNIQUE KEY `auth_sessions_refresh_uuid_unique` (`refresh_uuid`),
+  KEY `auth_sessions_user_id_index` (`user_id`),
+  KEY `auth_sessions_refresh_expires_at_index` (`refresh_expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;